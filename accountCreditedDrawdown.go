@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // AccountCreditedDrawdown is the account which is credited in a drawdown
@@ -45,7 +47,7 @@ func (creditDD *AccountCreditedDrawdown) Parse(record string) error {
 
 	value, read, err := creditDD.parseFixedStringField(record[length:], 9)
 	if err != nil {
-		return fieldError("DrawdownCreditAccountNumber", err)
+		return fieldErrorAt(length, "DrawdownCreditAccountNumber", err)
 	}
 	creditDD.DrawdownCreditAccountNumber = value
 	length += read
@@ -88,27 +90,31 @@ func (creditDD *AccountCreditedDrawdown) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on AccountCreditedDrawdown and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (creditDD *AccountCreditedDrawdown) Validate() error {
-	if err := creditDD.fieldInclusion(); err != nil {
-		return err
-	}
+	return creditDD.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on AccountCreditedDrawdown and returns every error found.
+// Only the first error for each field is returned.
+func (creditDD *AccountCreditedDrawdown) ValidateAll() base.ErrorList {
+	errs := creditDD.fieldInclusion()
 	if creditDD.tag != TagAccountCreditedDrawdown {
-		return fieldError("tag", ErrValidTagForType, creditDD.tag)
+		addFieldError(&errs, TagAccountCreditedDrawdown, fieldError("tag", ErrValidTagForType, creditDD.tag))
 	}
 	if err := creditDD.isNumeric(creditDD.DrawdownCreditAccountNumber); err != nil {
-		return fieldError("DrawdownCreditAccountNumber", err, creditDD.DrawdownCreditAccountNumber)
+		addFieldError(&errs, TagAccountCreditedDrawdown, fieldError("DrawdownCreditAccountNumber", err, creditDD.DrawdownCreditAccountNumber))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (creditDD *AccountCreditedDrawdown) fieldInclusion() error {
+func (creditDD *AccountCreditedDrawdown) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if creditDD.DrawdownCreditAccountNumber == "" {
-		return fieldError("DrawdownCreditAccountNumber", ErrFieldRequired)
+		addFieldError(&errs, TagAccountCreditedDrawdown, fieldError("DrawdownCreditAccountNumber", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // DrawdownCreditAccountNumberField gets a string of the DrawdownCreditAccountNumber field
@@ -11,7 +11,7 @@ import (
 // mockAccountCreditedDrawdown creates a AccountCreditedDrawdown
 func mockAccountCreditedDrawdown() *AccountCreditedDrawdown {
 	creditDD := NewAccountCreditedDrawdown()
-	creditDD.DrawdownCreditAccountNumber = "123456789"
+	creditDD.DrawdownCreditAccountNumber = "231380104"
 	return creditDD
 }
 
@@ -48,7 +48,7 @@ func TestParseAccountCreditedDrawdownWrongLength(t *testing.T) {
 
 	err := r.parseAccountCreditedDrawdown()
 
-	expected := r.parseError(fieldError("DrawdownCreditAccountNumber", ErrValidLength)).Error()
+	expected := r.parseError(fieldErrorAt(6, "DrawdownCreditAccountNumber", ErrValidLength)).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -65,7 +65,7 @@ func TestParseAccountCreditedDrawdownReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("DrawdownCreditAccountNumber", ErrNonNumeric, "12345678Z")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("DrawdownCreditAccountNumber", ErrNonNumeric, "12345678Z"))).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -102,7 +102,7 @@ func TestStringAccountCreditedDrawdownVariableLength(t *testing.T) {
 	r.line = line
 
 	err = r.parseAccountCreditedDrawdown()
-	expected = r.parseError(fieldError("DrawdownCreditAccountNumber", ErrValidLength)).Error()
+	expected = r.parseError(fieldErrorAt(6, "DrawdownCreditAccountNumber", ErrValidLength)).Error()
 	require.EqualError(t, err, expected)
 
 	line = "{5400}1*"
@@ -110,7 +110,7 @@ func TestStringAccountCreditedDrawdownVariableLength(t *testing.T) {
 	r.line = line
 
 	err = r.parseAccountCreditedDrawdown()
-	expected = r.parseError(fieldError("DrawdownCreditAccountNumber", ErrValidLength)).Error()
+	expected = r.parseError(fieldErrorAt(6, "DrawdownCreditAccountNumber", ErrValidLength)).Error()
 	require.EqualError(t, err, expected)
 
 	line = "{5400}1        *"
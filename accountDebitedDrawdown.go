@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // AccountDebitedDrawdown is the account which is debited in a drawdown
@@ -51,35 +53,35 @@ func (debitDD *AccountDebitedDrawdown) Parse(record string) error {
 
 	value, read, err := debitDD.parseVariableStringField(record[length:], 34)
 	if err != nil {
-		return fieldError("Identifier", err)
+		return fieldErrorAt(length, "Identifier", err)
 	}
 	debitDD.Identifier = value
 	length += read
 
 	value, read, err = debitDD.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("Name", err)
+		return fieldErrorAt(length, "Name", err)
 	}
 	debitDD.Name = value
 	length += read
 
 	value, read, err = debitDD.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineOne", err)
+		return fieldErrorAt(length, "AddressLineOne", err)
 	}
 	debitDD.Address.AddressLineOne = value
 	length += read
 
 	value, read, err = debitDD.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineTwo", err)
+		return fieldErrorAt(length, "AddressLineTwo", err)
 	}
 	debitDD.Address.AddressLineTwo = value
 	length += read
 
 	value, read, err = debitDD.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineThree", err)
+		return fieldErrorAt(length, "AddressLineThree", err)
 	}
 	debitDD.Address.AddressLineThree = value
 	length += read
@@ -133,55 +135,62 @@ func (debitDD *AccountDebitedDrawdown) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on AccountDebitedDrawdown and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (debitDD *AccountDebitedDrawdown) Validate() error {
-	if err := debitDD.fieldInclusion(); err != nil {
-		return err
-	}
+	return debitDD.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on AccountDebitedDrawdown and returns every error found.
+// Only the first error for each field is returned.
+func (debitDD *AccountDebitedDrawdown) ValidateAll() base.ErrorList {
+	errs := debitDD.fieldInclusion()
 	if debitDD.tag != TagAccountDebitedDrawdown {
-		return fieldError("tag", ErrValidTagForType, debitDD.tag)
+		addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("tag", ErrValidTagForType, debitDD.tag))
 	}
 	if err := debitDD.isIdentificationCode(debitDD.IdentificationCode); err != nil {
-		return fieldError("IdentificationCode", err, debitDD.IdentificationCode)
+		addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("IdentificationCode", err, debitDD.IdentificationCode))
 	}
 	// Can only be these Identification Codes
 	switch debitDD.IdentificationCode {
 	case
 		DemandDepositAccountNumber:
 	default:
-		return fieldError("IdentificationCode", ErrIdentificationCode, debitDD.IdentificationCode)
+		addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("IdentificationCode", ErrIdentificationCode, debitDD.IdentificationCode))
 	}
 	if err := debitDD.isAlphanumeric(debitDD.Identifier); err != nil {
-		return fieldError("Identifier", err, debitDD.Identifier)
+		addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("Identifier", err, debitDD.Identifier))
+	}
+	if err := debitDD.validateIdentifier(debitDD.IdentificationCode, debitDD.Identifier); err != nil {
+		addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("Identifier", err, debitDD.Identifier))
 	}
 	if err := debitDD.isAlphanumeric(debitDD.Name); err != nil {
-		return fieldError("Name", err, debitDD.Name)
+		addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("Name", err, debitDD.Name))
 	}
 	if err := debitDD.isAlphanumeric(debitDD.Address.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, debitDD.Address.AddressLineOne)
+		addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("AddressLineOne", err, debitDD.Address.AddressLineOne))
 	}
 	if err := debitDD.isAlphanumeric(debitDD.Address.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, debitDD.Address.AddressLineTwo)
+		addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("AddressLineTwo", err, debitDD.Address.AddressLineTwo))
 	}
 	if err := debitDD.isAlphanumeric(debitDD.Address.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, debitDD.Address.AddressLineThree)
+		addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("AddressLineThree", err, debitDD.Address.AddressLineThree))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (debitDD *AccountDebitedDrawdown) fieldInclusion() error {
+func (debitDD *AccountDebitedDrawdown) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if debitDD.IdentificationCode == "" {
-		return fieldError("IdentificationCode", ErrFieldRequired)
+		addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("IdentificationCode", ErrFieldRequired))
 	}
 	if debitDD.Identifier == "" {
-		return fieldError("Identifier", ErrFieldRequired)
+		addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("Identifier", ErrFieldRequired))
 	}
 	if debitDD.Name == "" {
-		return fieldError("Name", ErrFieldRequired)
+		addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("Name", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // IdentificationCodeField gets a string of the IdentificationCode field
@@ -134,7 +134,7 @@ func TestParseAccountDebitedDrawdownWrongLength(t *testing.T) {
 
 	err := r.parseAccountDebitedDrawdown()
 
-	require.EqualError(t, err, r.parseError(fieldError("AddressLineThree", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(150, "AddressLineThree", ErrRequireDelimiter)).Error())
 }
 
 // TestParseAccountDebitedDrawdownReaderParseError parses a wrong AccountDebitedDrawdown reader parse error
@@ -150,7 +150,7 @@ func TestParseAccountDebitedDrawdownReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("Name", ErrNonAlphanumeric, "debitDD ®ame")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("Name", ErrNonAlphanumeric, "debitDD ®ame"))).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -0,0 +1,125 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+	"time"
+)
+
+// Acknowledgment is the outcome of a FEDWireMessage processed by the Fedwire Funds Service, from the tags appended
+// by the Fed: MessageDisposition {1100}, ReceiptTimeStamp {1110}, OutputMessageAccountabilityData {1120} and
+// ErrorWire {1130}.
+type Acknowledgment struct {
+	// Status is the MessageStatusIndicator of the MessageDisposition, such as MessageStatusSuccessfulValue
+	Status string `json:"status"`
+	// TestProductionCode is EnvironmentTest or EnvironmentProduction
+	TestProductionCode string `json:"testProductionCode"`
+	// MessageDuplicationCode is MessageDuplicationOriginal, MessageDuplicationRetrieval or MessageDuplicationResend
+	MessageDuplicationCode string `json:"messageDuplicationCode"`
+	// ReceiptTime is the Eastern time the message was received, or zero without a ReceiptTimeStamp
+	ReceiptTime time.Time `json:"receiptTime"`
+	// OMAD is the OutputMessageAccountabilityData, if any
+	OMAD *OutputMessageAccountabilityData `json:"omad,omitempty"`
+	// ErrorCategory is the ErrorCategory of the ErrorWire, such as ErrorCategoryDataError, if any
+	ErrorCategory string `json:"errorCategory,omitempty"`
+	// ErrorCode is the ErrorCode of the ErrorWire, if any
+	ErrorCode string `json:"errorCode,omitempty"`
+	// ErrorDescription is the ErrorDescription of the ErrorWire, if any
+	ErrorDescription string `json:"errorDescription,omitempty"`
+	// Category is the catalog entry of the ErrorCategory, or nil when there is none
+	Category *ErrorWireCategory `json:"category,omitempty"`
+	// Code is the catalog entry of the ErrorCode within the ErrorCategory, or nil when there is none
+	Code *ErrorWireCode `json:"code,omitempty"`
+}
+
+// NewAcknowledgment returns the Acknowledgment of fwm, which must have a MessageDisposition. The ReceiptDate has no
+// year, so the ReceiptTime is the one nearest to the OutputCycleDate, or the InputCycleDate without an OMAD.
+func NewAcknowledgment(fwm *FEDWireMessage) (*Acknowledgment, error) {
+	if fwm.MessageDisposition == nil {
+		return nil, fieldError("MessageDisposition", ErrFieldRequired)
+	}
+	md := fwm.MessageDisposition
+	ack := &Acknowledgment{
+		Status:                 strings.TrimSpace(md.MessageStatusIndicator),
+		TestProductionCode:     strings.TrimSpace(md.TestProductionCode),
+		MessageDuplicationCode: md.MessageDuplicationCode,
+		OMAD:                   fwm.OutputMessageAccountabilityData,
+	}
+	if strings.TrimSpace(ack.MessageDuplicationCode) == "" {
+		ack.MessageDuplicationCode = MessageDuplicationOriginal
+	}
+
+	if fwm.ReceiptTimeStamp != nil {
+		cycleDate, err := fwm.acknowledgmentCycleDate()
+		if err != nil {
+			return nil, err
+		}
+		ack.ReceiptTime, err = fwm.ReceiptTimeStamp.ReceiptDateTime(cycleDate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if ew := fwm.ErrorWire; ew != nil {
+		ack.ErrorCategory = strings.TrimSpace(ew.ErrorCategory)
+		ack.ErrorCode = strings.TrimSpace(ew.ErrorCode)
+		ack.ErrorDescription = strings.TrimSpace(ew.ErrorDescription)
+		if category, ok := LookupErrorWireCategory(ack.ErrorCategory); ok {
+			ack.Category = &category
+		}
+		if code, ok := LookupErrorWireCode(ack.ErrorCategory, ack.ErrorCode); ok {
+			ack.Code = &code
+		}
+	}
+	return ack, nil
+}
+
+// acknowledgmentCycleDate returns the OutputCycleDate of the OMAD, or the InputCycleDate of the IMAD without one
+func (fwm *FEDWireMessage) acknowledgmentCycleDate() (time.Time, error) {
+	if omad := fwm.OutputMessageAccountabilityData; omad != nil && omad.OutputCycleDate != "" {
+		return omad.CycleDate()
+	}
+	if imad := fwm.InputMessageAccountabilityData; imad != nil && imad.InputCycleDate != "" {
+		return imad.CycleDate()
+	}
+	return time.Time{}, fieldError("OutputCycleDate", ErrFieldRequired)
+}
+
+// Accepted returns true when the message was successful, with or without accounting
+func (ack *Acknowledgment) Accepted() bool {
+	switch ack.Status {
+	case MessageStatusSuccessfulValue, MessageStatusSuccessfulNonValue,
+		MessageStatusIncomingValue, MessageStatusIncomingNonValue:
+		return true
+	}
+	return false
+}
+
+// Rejected returns true when the message was rejected due to an error condition, described by the ErrorCategory
+// and ErrorCode
+func (ack *Acknowledgment) Rejected() bool {
+	return ack.Status == MessageStatusRejected
+}
+
+// InProcess returns true when the message is in process or intercepted, so it is neither accepted nor rejected yet
+func (ack *Acknowledgment) InProcess() bool {
+	return ack.Status == MessageStatusInProcess
+}
+
+// IsTest returns true for a message of the test environment, rather than production
+func (ack *Acknowledgment) IsTest() bool {
+	return ack.TestProductionCode == EnvironmentTest
+}
+
+// IsOriginal returns true for an original message, rather than a retrieval or resend of one
+func (ack *Acknowledgment) IsOriginal() bool {
+	return ack.MessageDuplicationCode == MessageDuplicationOriginal
+}
+
+// IsRetrieval returns true for the retrieval of an original message
+func (ack *Acknowledgment) IsRetrieval() bool {
+	return ack.MessageDuplicationCode == MessageDuplicationRetrieval
+}
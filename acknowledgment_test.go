@@ -0,0 +1,107 @@
+package wire
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAcknowledgment(t *testing.T) {
+	f, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-FedAppendedTags.txt"))
+	require.NoError(t, err)
+	defer f.Close()
+	file, err := NewReader(f).Read()
+	require.NoError(t, err)
+
+	ack, err := NewAcknowledgment(&file.FEDWireMessages[0])
+	require.NoError(t, err)
+	require.True(t, ack.Accepted())
+	require.False(t, ack.Rejected())
+	require.False(t, ack.InProcess())
+	require.False(t, ack.IsTest())
+	require.True(t, ack.IsOriginal())
+	require.False(t, ack.IsRetrieval())
+	require.Equal(t, time.Date(2019, time.May, 2, 12, 30, 0, 0, EasternTime), ack.ReceiptTime)
+	require.Equal(t, "Source08", ack.OMAD.OutputDestinationID)
+	require.Equal(t, ErrorCategoryDataError, ack.ErrorCategory)
+	require.Equal(t, "XYZ", ack.ErrorCode)
+	require.Equal(t, "Data Error", ack.ErrorDescription)
+	require.Equal(t, "Data Error", ack.Category.Description)
+	require.Nil(t, ack.Code)
+}
+
+func TestNewAcknowledgment_rejected(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.MessageDisposition = mockMessageDisposition()
+	fwm.MessageDisposition.TestProductionCode = EnvironmentTest
+	fwm.MessageDisposition.MessageDuplicationCode = MessageDuplicationRetrieval
+	fwm.MessageDisposition.MessageStatusIndicator = MessageStatusRejected
+	fwm.ErrorWire = mockErrorWire()
+
+	ack, err := NewAcknowledgment(&fwm)
+	require.NoError(t, err)
+	require.False(t, ack.Accepted())
+	require.True(t, ack.Rejected())
+	require.True(t, ack.IsTest())
+	require.False(t, ack.IsOriginal())
+	require.True(t, ack.IsRetrieval())
+	require.True(t, ack.ReceiptTime.IsZero())
+	require.Nil(t, ack.OMAD)
+	require.Equal(t, ErrorCategoryDataError, ack.ErrorCategory)
+	require.True(t, ack.Category.Resubmittable)
+
+	fwm.ErrorWire.ErrorCategory = ErrorCategoryCopyMessage
+	fwm.ErrorWire.ErrorCode = "001"
+	ack, err = NewAcknowledgment(&fwm)
+	require.NoError(t, err)
+	require.Equal(t, "Copy Message", ack.Category.Description)
+	require.Equal(t, ErrorCategoryCopyMessage, ack.Code.Category)
+	require.False(t, ack.Code.Resubmittable)
+	require.Equal(t, TagInputMessageAccountabilityData, ack.Code.Tag)
+
+	fwm.ErrorWire = nil
+	fwm.MessageDisposition.MessageStatusIndicator = MessageStatusInProcess
+	ack, err = NewAcknowledgment(&fwm)
+	require.NoError(t, err)
+	require.True(t, ack.InProcess())
+	require.False(t, ack.Accepted())
+}
+
+func TestNewAcknowledgment_receiptTime(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.MessageDisposition = mockMessageDisposition()
+	fwm.ReceiptTimeStamp = mockReceiptTimeStamp()
+	fwm.ReceiptTimeStamp.ReceiptDate = "1231"
+	fwm.ReceiptTimeStamp.ReceiptTime = "2100"
+
+	// without an OMAD the year is the nearest to the InputCycleDate
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20230102"
+	ack, err := NewAcknowledgment(&fwm)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2022, time.December, 31, 21, 0, 0, 0, EasternTime), ack.ReceiptTime)
+
+	// the OutputCycleDate is used before the InputCycleDate
+	fwm.OutputMessageAccountabilityData = mockOutputMessageAccountabilityData()
+	ack, err = NewAcknowledgment(&fwm)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2018, time.December, 31, 21, 0, 0, 0, EasternTime), ack.ReceiptTime)
+
+	fwm.ReceiptTimeStamp.ReceiptTime = "2500"
+	_, err = NewAcknowledgment(&fwm)
+	require.ErrorIs(t, err, ErrValidDate)
+}
+
+func TestNewAcknowledgment_errors(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	_, err := NewAcknowledgment(&fwm)
+	require.ErrorIs(t, err, ErrFieldRequired)
+
+	fwm.MessageDisposition = mockMessageDisposition()
+	fwm.ReceiptTimeStamp = mockReceiptTimeStamp()
+	fwm.InputMessageAccountabilityData = nil
+	_, err = NewAcknowledgment(&fwm)
+	require.ErrorIs(t, err, ErrFieldRequired)
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // ActualAmountPaid is the actual amount paid
@@ -45,14 +47,14 @@ func (aap *ActualAmountPaid) Parse(record string) error {
 
 	value, read, err := aap.parseFixedStringField(record[length:], 3)
 	if err != nil {
-		return fieldError("CurrencyCode", err)
+		return fieldErrorAt(length, "CurrencyCode", err)
 	}
 	aap.RemittanceAmount.CurrencyCode = value
 	length += read
 
 	value, read, err = aap.parseVariableStringField(record[length:], 19)
 	if err != nil {
-		return fieldError("Amount", err)
+		return fieldErrorAt(length, "Amount", err)
 	}
 	aap.RemittanceAmount.Amount = value
 	length += read
@@ -98,35 +100,39 @@ func (aap *ActualAmountPaid) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on ActualAmountPaid and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 // Currency Code and Amount are mandatory for each set of remittance data.
 func (aap *ActualAmountPaid) Validate() error {
-	if err := aap.fieldInclusion(); err != nil {
-		return err
-	}
+	return aap.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on ActualAmountPaid and returns every error found.
+// Only the first error for each field is returned.
+func (aap *ActualAmountPaid) ValidateAll() base.ErrorList {
+	errs := aap.fieldInclusion()
 	if aap.tag != TagActualAmountPaid {
-		return fieldError("tag", ErrValidTagForType, aap.tag)
+		addFieldError(&errs, TagActualAmountPaid, fieldError("tag", ErrValidTagForType, aap.tag))
 	}
 	if err := aap.isCurrencyCode(aap.RemittanceAmount.CurrencyCode); err != nil {
-		return fieldError("CurrencyCode", err, aap.RemittanceAmount.CurrencyCode)
+		addFieldError(&errs, TagActualAmountPaid, fieldError("CurrencyCode", err, aap.RemittanceAmount.CurrencyCode))
 	}
 	if err := aap.isAmount(aap.RemittanceAmount.Amount); err != nil {
-		return fieldError("Amount", err, aap.RemittanceAmount.Amount)
+		addFieldError(&errs, TagActualAmountPaid, fieldError("Amount", err, aap.RemittanceAmount.Amount))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (aap *ActualAmountPaid) fieldInclusion() error {
+func (aap *ActualAmountPaid) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if aap.RemittanceAmount.Amount == "" {
-		return fieldError("Amount", ErrFieldRequired)
+		addFieldError(&errs, TagActualAmountPaid, fieldError("Amount", ErrFieldRequired))
 	}
 	if aap.RemittanceAmount.CurrencyCode == "" {
-		return fieldError("CurrencyCode", ErrFieldRequired)
+		addFieldError(&errs, TagActualAmountPaid, fieldError("CurrencyCode", ErrFieldRequired))
 
 	}
-	return nil
+	return errs
 }
 
 // CurrencyCodeField gets a string of the RemittanceAmount.CurrencyCode field
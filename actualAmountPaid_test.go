@@ -85,7 +85,7 @@ func TestParseActualAmountPaidReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("Amount", ErrNonAmount, "1234.56Z")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("Amount", ErrNonAmount, "1234.56Z"))).Error()
 	require.EqualError(t, err, expected)
 }
 
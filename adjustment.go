@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // Adjustment is adjustment
@@ -51,35 +53,35 @@ func (adj *Adjustment) Parse(record string) error {
 
 	value, read, err := adj.parseFixedStringField(record[length:], 2)
 	if err != nil {
-		return fieldError("AdjustmentReasonCode", err)
+		return fieldErrorAt(length, "AdjustmentReasonCode", err)
 	}
 	adj.AdjustmentReasonCode = value
 	length += read
 
 	value, read, err = adj.parseFixedStringField(record[length:], 4)
 	if err != nil {
-		return fieldError("CreditDebitIndicator", err)
+		return fieldErrorAt(length, "CreditDebitIndicator", err)
 	}
 	adj.CreditDebitIndicator = value
 	length += read
 
 	value, read, err = adj.parseFixedStringField(record[length:], 3)
 	if err != nil {
-		return fieldError("CurrencyCode", err)
+		return fieldErrorAt(length, "CurrencyCode", err)
 	}
 	adj.RemittanceAmount.CurrencyCode = value
 	length += read
 
 	value, read, err = adj.parseVariableStringField(record[length:], 19)
 	if err != nil {
-		return fieldError("Amount", err)
+		return fieldErrorAt(length, "Amount", err)
 	}
 	adj.RemittanceAmount.Amount = value
 	length += read
 
 	value, read, err = adj.parseVariableStringField(record[length:], 140)
 	if err != nil {
-		return fieldError("AdditionalInfo", err)
+		return fieldErrorAt(length, "AdditionalInfo", err)
 	}
 	adj.AdditionalInfo = value
 	length += read
@@ -132,46 +134,50 @@ func (adj *Adjustment) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on Adjustment and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 // Adjustment Reason, Credit Debit Indicator, Currency Code and Amount are mandatory.
 func (adj *Adjustment) Validate() error {
-	if err := adj.fieldInclusion(); err != nil {
-		return err
-	}
+	return adj.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on Adjustment and returns every error found.
+// Only the first error for each field is returned.
+func (adj *Adjustment) ValidateAll() base.ErrorList {
+	errs := adj.fieldInclusion()
 	if adj.tag != TagAdjustment {
-		return fieldError("tag", ErrValidTagForType, adj.tag)
+		addFieldError(&errs, TagAdjustment, fieldError("tag", ErrValidTagForType, adj.tag))
 	}
 	if err := adj.isAdjustmentReasonCode(adj.AdjustmentReasonCode); err != nil {
-		return fieldError("AdjustmentReasonCode", err, adj.AdjustmentReasonCode)
+		addFieldError(&errs, TagAdjustment, fieldError("AdjustmentReasonCode", err, adj.AdjustmentReasonCode))
 	}
 	if err := adj.isCreditDebitIndicator(adj.CreditDebitIndicator); err != nil {
-		return fieldError("CreditDebitIndicator", err, adj.CreditDebitIndicator)
+		addFieldError(&errs, TagAdjustment, fieldError("CreditDebitIndicator", err, adj.CreditDebitIndicator))
 	}
 	if err := adj.isCurrencyCode(adj.RemittanceAmount.CurrencyCode); err != nil {
-		return fieldError("CurrencyCode", err, adj.RemittanceAmount.CurrencyCode)
+		addFieldError(&errs, TagAdjustment, fieldError("CurrencyCode", err, adj.RemittanceAmount.CurrencyCode))
 	}
 	if err := adj.isAmount(adj.RemittanceAmount.Amount); err != nil {
-		return fieldError("Amount", err, adj.RemittanceAmount.Amount)
+		addFieldError(&errs, TagAdjustment, fieldError("Amount", err, adj.RemittanceAmount.Amount))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (adj *Adjustment) fieldInclusion() error {
+func (adj *Adjustment) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if adj.AdjustmentReasonCode == "" {
-		return fieldError("AdjustmentReasonCode", ErrFieldRequired)
+		addFieldError(&errs, TagAdjustment, fieldError("AdjustmentReasonCode", ErrFieldRequired))
 	}
 	if adj.CreditDebitIndicator == "" {
-		return fieldError("CreditDebitIndicator", ErrFieldRequired)
+		addFieldError(&errs, TagAdjustment, fieldError("CreditDebitIndicator", ErrFieldRequired))
 	}
 	if adj.RemittanceAmount.Amount == "" {
-		return fieldError("Amount", ErrFieldRequired)
+		addFieldError(&errs, TagAdjustment, fieldError("Amount", ErrFieldRequired))
 	}
 	if adj.RemittanceAmount.CurrencyCode == "" {
-		return fieldError("CurrencyCode", ErrFieldRequired)
+		addFieldError(&errs, TagAdjustment, fieldError("CurrencyCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // AdjustmentReasonCodeField gets a string of the AdjustmentReasonCode field
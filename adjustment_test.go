@@ -129,7 +129,7 @@ func TestParseAdjustmentReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("Amount", ErrNonAmount, "1234.56Z")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("Amount", ErrNonAmount, "1234.56Z"))).Error()
 	require.EqualError(t, err, expected)
 }
 
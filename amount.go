@@ -6,8 +6,12 @@ package wire
 
 import (
 	"encoding/json"
+	"fmt"
+	"strconv"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // Amount (up to a penny less than $10 billion) {2000}
@@ -71,30 +75,58 @@ func (a *Amount) String() string {
 }
 
 // Validate performs WIRE format rule checks on Amount and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (a *Amount) Validate() error {
-	if err := a.fieldInclusion(); err != nil {
-		return err
-	}
+	return a.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on Amount and returns every error found.
+// Only the first error for each field is returned.
+func (a *Amount) ValidateAll() base.ErrorList {
+	errs := a.fieldInclusion()
 	if a.tag != TagAmount {
-		return fieldError("tag", ErrValidTagForType, a.tag)
+		addFieldError(&errs, TagAmount, fieldError("tag", ErrValidTagForType, a.tag))
 	}
 	if err := a.isAmountImplied(a.Amount); err != nil {
-		return fieldError("Amount", err, a.Amount)
+		addFieldError(&errs, TagAmount, fieldError("Amount", err, a.Amount))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (a *Amount) fieldInclusion() error {
+func (a *Amount) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if a.Amount == "" {
-		return fieldError("Amount", ErrFieldRequired)
+		addFieldError(&errs, TagAmount, fieldError("Amount", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // AmountField gets a string of entry addenda batch count zero padded
 func (a *Amount) AmountField() string {
 	return a.numericStringField(a.Amount, 12)
 }
+
+// Money returns the Amount as USD Money
+func (a *Amount) Money() (Money, error) {
+	if !isDigits(a.Amount) || a.Amount == "" {
+		return Money{}, fieldError("Amount", ErrNonAmount, a.Amount)
+	}
+	cents, err := strconv.ParseInt(a.Amount, 10, 64)
+	if err != nil {
+		return Money{}, fieldError("Amount", ErrAmountRange, a.Amount)
+	}
+	return Money{CurrencyCode: "USD", MinorUnits: cents}, nil
+}
+
+// SetMoney sets the Amount to m, which must be USD of up to a penny less than $10 billion
+func (a *Amount) SetMoney(m Money) error {
+	if !strings.EqualFold(m.CurrencyCode, "USD") {
+		return fieldError("CurrencyCode", ErrCurrencyMismatch, m.CurrencyCode)
+	}
+	if m.MinorUnits < 0 || m.MinorUnits > 999999999999 {
+		return fieldError("Amount", ErrAmountRange, m.MinorUnits)
+	}
+	a.Amount = fmt.Sprintf("%012d", m.MinorUnits)
+	return nil
+}
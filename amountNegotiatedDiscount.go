@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // AmountNegotiatedDiscount is the amount negotiated discount
@@ -45,14 +47,14 @@ func (nd *AmountNegotiatedDiscount) Parse(record string) error {
 
 	value, read, err := nd.parseFixedStringField(record[length:], 3)
 	if err != nil {
-		return fieldError("CurrencyCode", err)
+		return fieldErrorAt(length, "CurrencyCode", err)
 	}
 	nd.RemittanceAmount.CurrencyCode = value
 	length += read
 
 	value, read, err = nd.parseVariableStringField(record[length:], 19)
 	if err != nil {
-		return fieldError("Amount", err)
+		return fieldErrorAt(length, "Amount", err)
 	}
 	nd.RemittanceAmount.Amount = value
 	length += read
@@ -98,33 +100,37 @@ func (nd *AmountNegotiatedDiscount) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on AmountNegotiatedDiscount and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (nd *AmountNegotiatedDiscount) Validate() error {
-	if err := nd.fieldInclusion(); err != nil {
-		return err
-	}
+	return nd.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on AmountNegotiatedDiscount and returns every error found.
+// Only the first error for each field is returned.
+func (nd *AmountNegotiatedDiscount) ValidateAll() base.ErrorList {
+	errs := nd.fieldInclusion()
 	if nd.tag != TagAmountNegotiatedDiscount {
-		return fieldError("tag", ErrValidTagForType, nd.tag)
+		addFieldError(&errs, TagAmountNegotiatedDiscount, fieldError("tag", ErrValidTagForType, nd.tag))
 	}
 	if err := nd.isCurrencyCode(nd.RemittanceAmount.CurrencyCode); err != nil {
-		return fieldError("CurrencyCode", err, nd.RemittanceAmount.CurrencyCode)
+		addFieldError(&errs, TagAmountNegotiatedDiscount, fieldError("CurrencyCode", err, nd.RemittanceAmount.CurrencyCode))
 	}
 	if err := nd.isAmount(nd.RemittanceAmount.Amount); err != nil {
-		return fieldError("Amount", err, nd.RemittanceAmount.Amount)
+		addFieldError(&errs, TagAmountNegotiatedDiscount, fieldError("Amount", err, nd.RemittanceAmount.Amount))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (nd *AmountNegotiatedDiscount) fieldInclusion() error {
+func (nd *AmountNegotiatedDiscount) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if nd.RemittanceAmount.Amount == "" {
-		return fieldError("Amount", ErrFieldRequired)
+		addFieldError(&errs, TagAmountNegotiatedDiscount, fieldError("Amount", ErrFieldRequired))
 	}
 	if nd.RemittanceAmount.CurrencyCode == "" {
-		return fieldError("CurrencyCode", ErrFieldRequired)
+		addFieldError(&errs, TagAmountNegotiatedDiscount, fieldError("CurrencyCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // CurrencyCodeField gets a string of the CurrencyCode field
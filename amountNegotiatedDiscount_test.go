@@ -71,7 +71,7 @@ func TestParseAmountNegotiatedDiscountWrongLength(t *testing.T) {
 
 	err := r.parseAmountNegotiatedDiscount()
 
-	require.EqualError(t, err, r.parseError(fieldError("Amount", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(9, "Amount", ErrRequireDelimiter)).Error())
 }
 
 // TestParseAmountNegotiatedDiscountReaderParseError parses a wrong AmountNegotiatedDiscount reader parse error
@@ -87,7 +87,7 @@ func TestParseAmountNegotiatedDiscountReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("Amount", ErrNonAmount, "1234.56Z")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("Amount", ErrNonAmount, "1234.56Z"))).Error()
 	require.EqualError(t, err, expected)
 }
 
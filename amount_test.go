@@ -27,10 +27,10 @@ func TestAmount_Validate(t *testing.T) {
 		wantErr  error
 	}{
 		{mockAmount().Amount, nil},
-		{"", fieldError("Amount", ErrFieldRequired)},
-		{"X,", fieldError("Amount", ErrNonAmount, "X,")},
-		{"12.05", fieldError("Amount", ErrNonAmount, "12.05")},
-		{"1,000.39", fieldError("Amount", ErrNonAmount, "1,000.39")},
+		{"", &FieldError{Tag: TagAmount, FieldName: "Amount", Err: ErrFieldRequired}},
+		{"X,", &FieldError{Tag: TagAmount, FieldName: "Amount", Value: "X,", Err: ErrNonAmount}},
+		{"12.05", &FieldError{Tag: TagAmount, FieldName: "Amount", Value: "12.05", Err: ErrNonAmount}},
+		{"1,000.39", &FieldError{Tag: TagAmount, FieldName: "Amount", Value: "1,000.39", Err: ErrNonAmount}},
 	}
 
 	for _, tt := range tests {
@@ -74,7 +74,7 @@ func TestParseAmountReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("Amount", ErrNonAmount, "00000Z030022")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("Amount", ErrNonAmount, "00000Z030022"))).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -87,3 +87,23 @@ func TestAmountTagError(t *testing.T) {
 
 	require.EqualError(t, err, fieldError("tag", ErrValidTagForType, a.tag).Error())
 }
+
+func TestAmount_Money(t *testing.T) {
+	m, err := mockAmount().Money()
+	require.NoError(t, err)
+	require.Equal(t, Money{CurrencyCode: "USD", MinorUnits: 1234567}, m)
+
+	a := NewAmount()
+	require.NoError(t, a.SetMoney(Money{CurrencyCode: "USD", MinorUnits: 99}))
+	require.Equal(t, "000000000099", a.Amount)
+	require.NoError(t, a.Validate())
+
+	require.ErrorIs(t, a.SetMoney(Money{CurrencyCode: "EUR", MinorUnits: 99}), ErrCurrencyMismatch)
+	require.ErrorIs(t, a.SetMoney(Money{CurrencyCode: "USD", MinorUnits: 1000000000000}), ErrAmountRange)
+	require.ErrorIs(t, a.SetMoney(Money{CurrencyCode: "USD", MinorUnits: -1}), ErrAmountRange)
+	require.Equal(t, "000000000099", a.Amount)
+
+	a.Amount = "12.05"
+	_, err = a.Money()
+	require.ErrorIs(t, err, ErrNonAmount)
+}
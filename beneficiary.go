@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // Beneficiary is the beneficiary of the wire
@@ -46,35 +48,35 @@ func (ben *Beneficiary) Parse(record string) error {
 
 	value, read, err := ben.parseVariableStringField(record[length:], 34)
 	if err != nil {
-		return fieldError("Identifier", err)
+		return fieldErrorAt(length, "Identifier", err)
 	}
 	ben.Personal.Identifier = value
 	length += read
 
 	value, read, err = ben.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("Name", err)
+		return fieldErrorAt(length, "Name", err)
 	}
 	ben.Personal.Name = value
 	length += read
 
 	value, read, err = ben.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineOne", err)
+		return fieldErrorAt(length, "AddressLineOne", err)
 	}
 	ben.Personal.Address.AddressLineOne = value
 	length += read
 
 	value, read, err = ben.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineTwo", err)
+		return fieldErrorAt(length, "AddressLineTwo", err)
 	}
 	ben.Personal.Address.AddressLineTwo = value
 	length += read
 
 	value, read, err = ben.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineThree", err)
+		return fieldErrorAt(length, "AddressLineThree", err)
 	}
 	ben.Personal.Address.AddressLineThree = value
 	length += read
@@ -128,16 +130,20 @@ func (ben *Beneficiary) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on Beneficiary and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 // If ID Code is present, Identifier is mandatory and vice versa.
 func (ben *Beneficiary) Validate() error {
+	return ben.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on Beneficiary and returns every error found.
+// Only the first error for each field is returned.
+func (ben *Beneficiary) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if ben.tag != TagBeneficiary {
-		return fieldError("tag", ErrValidTagForType, ben.tag)
+		addFieldError(&errs, TagBeneficiary, fieldError("tag", ErrValidTagForType, ben.tag))
 	}
 
-	if err := ben.fieldInclusion(); err != nil {
-		return err
-	}
+	errs = append(errs, ben.fieldInclusion()...)
 
 	// Per FAIM 3.0.6, Beneficiary ID code is optional.
 	// fieldInclusion() above already checks for the mandatory combination of IDCode & Identifier
@@ -145,39 +151,46 @@ func (ben *Beneficiary) Validate() error {
 	if ben.Personal.IdentificationCode != "" {
 		// If it is present, confirm it is a valid code
 		if err := ben.isIdentificationCode(ben.Personal.IdentificationCode); err != nil {
-			return fieldError("IdentificationCode", err, ben.Personal.IdentificationCode)
+			addFieldError(&errs, TagBeneficiary, fieldError("IdentificationCode", err, ben.Personal.IdentificationCode))
 		}
 		// Identifier text must only contain allowed characters
 		if err := ben.isAlphanumeric(ben.Personal.Identifier); err != nil {
-			return fieldError("Identifier", err, ben.Personal.Identifier)
+			addFieldError(&errs, TagBeneficiary, fieldError("Identifier", err, ben.Personal.Identifier))
+		}
+		if err := ben.validateIdentifier(ben.Personal.IdentificationCode, ben.Personal.Identifier); err != nil {
+			addFieldError(&errs, TagBeneficiary, fieldError("Identifier", err, ben.Personal.Identifier))
 		}
 	}
 
 	if err := ben.isAlphanumeric(ben.Personal.Name); err != nil {
-		return fieldError("Name", err, ben.Personal.Name)
+		addFieldError(&errs, TagBeneficiary, fieldError("Name", err, ben.Personal.Name))
+	}
+	if err := ben.validateIdentifierName(ben.Personal.IdentificationCode, ben.Personal.Name); err != nil {
+		addFieldError(&errs, TagBeneficiary, fieldError("Name", err, ben.Personal.Name))
 	}
 	if err := ben.isAlphanumeric(ben.Personal.Address.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, ben.Personal.Address.AddressLineOne)
+		addFieldError(&errs, TagBeneficiary, fieldError("AddressLineOne", err, ben.Personal.Address.AddressLineOne))
 	}
 	if err := ben.isAlphanumeric(ben.Personal.Address.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, ben.Personal.Address.AddressLineTwo)
+		addFieldError(&errs, TagBeneficiary, fieldError("AddressLineTwo", err, ben.Personal.Address.AddressLineTwo))
 	}
 	if err := ben.isAlphanumeric(ben.Personal.Address.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, ben.Personal.Address.AddressLineThree)
+		addFieldError(&errs, TagBeneficiary, fieldError("AddressLineThree", err, ben.Personal.Address.AddressLineThree))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (ben *Beneficiary) fieldInclusion() error {
+func (ben *Beneficiary) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if ben.Personal.IdentificationCode != "" && ben.Personal.Identifier == "" {
-		return fieldError("Identifier", ErrFieldRequired)
+		addFieldError(&errs, TagBeneficiary, fieldError("Identifier", ErrFieldRequired))
 	}
 	if ben.Personal.IdentificationCode == "" && ben.Personal.Identifier != "" {
-		return fieldError("IdentificationCode", ErrFieldRequired)
+		addFieldError(&errs, TagBeneficiary, fieldError("IdentificationCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // IdentificationCodeField gets a string of the IdentificationCode field
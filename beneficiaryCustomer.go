@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // BeneficiaryCustomer is the beneficiary customer
@@ -45,42 +47,42 @@ func (bc *BeneficiaryCustomer) Parse(record string) error {
 
 	value, read, err := bc.parseVariableStringField(record[length:], 5)
 	if err != nil {
-		return fieldError("SwiftFieldTag", err)
+		return fieldErrorAt(length, "SwiftFieldTag", err)
 	}
 	bc.CoverPayment.SwiftFieldTag = value
 	length += read
 
 	value, read, err = bc.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineOne", err)
+		return fieldErrorAt(length, "SwiftLineOne", err)
 	}
 	bc.CoverPayment.SwiftLineOne = value
 	length += read
 
 	value, read, err = bc.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineTwo", err)
+		return fieldErrorAt(length, "SwiftLineTwo", err)
 	}
 	bc.CoverPayment.SwiftLineTwo = value
 	length += read
 
 	value, read, err = bc.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineThree", err)
+		return fieldErrorAt(length, "SwiftLineThree", err)
 	}
 	bc.CoverPayment.SwiftLineThree = value
 	length += read
 
 	value, read, err = bc.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineFour", err)
+		return fieldErrorAt(length, "SwiftLineFour", err)
 	}
 	bc.CoverPayment.SwiftLineFour = value
 	length += read
 
 	value, read, err = bc.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineFive", err)
+		return fieldErrorAt(length, "SwiftLineFive", err)
 	}
 	bc.CoverPayment.SwiftLineFive = value
 	length += read
@@ -134,42 +136,49 @@ func (bc *BeneficiaryCustomer) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on BeneficiaryCustomer and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (bc *BeneficiaryCustomer) Validate() error {
-	if err := bc.fieldInclusion(); err != nil {
-		return err
-	}
+	return bc.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on BeneficiaryCustomer and returns every error found.
+// Only the first error for each field is returned.
+func (bc *BeneficiaryCustomer) ValidateAll() base.ErrorList {
+	errs := bc.fieldInclusion()
 	if bc.tag != TagBeneficiaryCustomer {
-		return fieldError("tag", ErrValidTagForType, bc.tag)
+		addFieldError(&errs, TagBeneficiaryCustomer, fieldError("tag", ErrValidTagForType, bc.tag))
 	}
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, bc.CoverPayment.SwiftFieldTag)
+		addFieldError(&errs, TagBeneficiaryCustomer, fieldError("SwiftFieldTag", err, bc.CoverPayment.SwiftFieldTag))
 	}
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, bc.CoverPayment.SwiftLineOne)
+		addFieldError(&errs, TagBeneficiaryCustomer, fieldError("SwiftLineOne", err, bc.CoverPayment.SwiftLineOne))
 	}
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, bc.CoverPayment.SwiftLineTwo)
+		addFieldError(&errs, TagBeneficiaryCustomer, fieldError("SwiftLineTwo", err, bc.CoverPayment.SwiftLineTwo))
 	}
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, bc.CoverPayment.SwiftLineThree)
+		addFieldError(&errs, TagBeneficiaryCustomer, fieldError("SwiftLineThree", err, bc.CoverPayment.SwiftLineThree))
 	}
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, bc.CoverPayment.SwiftLineFour)
+		addFieldError(&errs, TagBeneficiaryCustomer, fieldError("SwiftLineFour", err, bc.CoverPayment.SwiftLineFour))
 	}
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineFive); err != nil {
-		return fieldError("SwiftLineFive", err, bc.CoverPayment.SwiftLineFive)
+		addFieldError(&errs, TagBeneficiaryCustomer, fieldError("SwiftLineFive", err, bc.CoverPayment.SwiftLineFive))
 	}
-	return nil
+	if i, err := bc.validateCoverPaymentParty(bc.CoverPayment); err != nil {
+		addFieldError(&errs, TagBeneficiaryCustomer, fieldError(swiftLineNames[i], err, bc.CoverPayment.lines()[i]))
+	}
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (bc *BeneficiaryCustomer) fieldInclusion() error {
+func (bc *BeneficiaryCustomer) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if bc.CoverPayment.SwiftLineSix != "" {
-		return fieldError("SwiftLineSix", ErrInvalidProperty, bc.CoverPayment.SwiftLineSix)
+		addFieldError(&errs, TagBeneficiaryCustomer, fieldError("SwiftLineSix", ErrInvalidProperty, bc.CoverPayment.SwiftLineSix))
 	}
-	return nil
+	return errs
 }
 
 // SwiftFieldTagField gets a string of the SwiftFieldTag field
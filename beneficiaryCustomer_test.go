@@ -105,7 +105,7 @@ func TestParseBeneficiaryCustomerWrongLength(t *testing.T) {
 
 	err := r.parseBeneficiaryCustomer()
 
-	require.EqualError(t, err, r.parseError(fieldError("SwiftFieldTag", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "SwiftFieldTag", ErrRequireDelimiter)).Error())
 }
 
 // TestParseBeneficiaryCustomerReaderParseError parses a wrong BeneficiaryCustomer reader parse error
@@ -121,7 +121,7 @@ func TestParseBeneficiaryCustomerReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("SwiftLineOne", ErrNonAlphanumeric, "Swift ®ine One")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("SwiftLineOne", ErrNonAlphanumeric, "Swift ®ine One"))).Error()
 	require.EqualError(t, err, expected)
 }
 
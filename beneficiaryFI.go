@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // BeneficiaryFI is the financial institution of the beneficiary
@@ -46,35 +48,35 @@ func (bfi *BeneficiaryFI) Parse(record string) error {
 
 	value, read, err := bfi.parseVariableStringField(record[length:], 34)
 	if err != nil {
-		return fieldError("Identifier", err)
+		return fieldErrorAt(length, "Identifier", err)
 	}
 	bfi.FinancialInstitution.Identifier = value
 	length += read
 
 	value, read, err = bfi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("Name", err)
+		return fieldErrorAt(length, "Name", err)
 	}
 	bfi.FinancialInstitution.Name = value
 	length += read
 
 	value, read, err = bfi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineOne", err)
+		return fieldErrorAt(length, "AddressLineOne", err)
 	}
 	bfi.FinancialInstitution.Address.AddressLineOne = value
 	length += read
 
 	value, read, err = bfi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineTwo", err)
+		return fieldErrorAt(length, "AddressLineTwo", err)
 	}
 	bfi.FinancialInstitution.Address.AddressLineTwo = value
 	length += read
 
 	value, read, err = bfi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineThree", err)
+		return fieldErrorAt(length, "AddressLineThree", err)
 	}
 	bfi.FinancialInstitution.Address.AddressLineThree = value
 	length += read
@@ -128,16 +130,19 @@ func (bfi *BeneficiaryFI) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on BeneficiaryFI and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (bfi *BeneficiaryFI) Validate() error {
-	if err := bfi.fieldInclusion(); err != nil {
-		return err
-	}
+	return bfi.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on BeneficiaryFI and returns every error found.
+// Only the first error for each field is returned.
+func (bfi *BeneficiaryFI) ValidateAll() base.ErrorList {
+	errs := bfi.fieldInclusion()
 	if bfi.tag != TagBeneficiaryFI {
-		return fieldError("tag", ErrValidTagForType, bfi.tag)
+		addFieldError(&errs, TagBeneficiaryFI, fieldError("tag", ErrValidTagForType, bfi.tag))
 	}
 	if err := bfi.isIdentificationCode(bfi.FinancialInstitution.IdentificationCode); err != nil {
-		return fieldError("IdentificationCode", err, bfi.FinancialInstitution.IdentificationCode)
+		addFieldError(&errs, TagBeneficiaryFI, fieldError("IdentificationCode", err, bfi.FinancialInstitution.IdentificationCode))
 	}
 	// Can only be these Identification Codes
 	switch bfi.FinancialInstitution.IdentificationCode {
@@ -148,36 +153,43 @@ func (bfi *BeneficiaryFI) Validate() error {
 		FEDRoutingNumber,
 		CHIPSIdentifier:
 	default:
-		return fieldError("IdentificationCode", ErrIdentificationCode, bfi.FinancialInstitution.IdentificationCode)
+		addFieldError(&errs, TagBeneficiaryFI, fieldError("IdentificationCode", ErrIdentificationCode, bfi.FinancialInstitution.IdentificationCode))
 	}
 	if err := bfi.isAlphanumeric(bfi.FinancialInstitution.Identifier); err != nil {
-		return fieldError("Identifier", err, bfi.FinancialInstitution.Identifier)
+		addFieldError(&errs, TagBeneficiaryFI, fieldError("Identifier", err, bfi.FinancialInstitution.Identifier))
+	}
+	if err := bfi.validateIdentifier(bfi.FinancialInstitution.IdentificationCode, bfi.FinancialInstitution.Identifier); err != nil {
+		addFieldError(&errs, TagBeneficiaryFI, fieldError("Identifier", err, bfi.FinancialInstitution.Identifier))
 	}
 	if err := bfi.isAlphanumeric(bfi.FinancialInstitution.Name); err != nil {
-		return fieldError("Name", err, bfi.FinancialInstitution.Name)
+		addFieldError(&errs, TagBeneficiaryFI, fieldError("Name", err, bfi.FinancialInstitution.Name))
+	}
+	if err := bfi.validateIdentifierName(bfi.FinancialInstitution.IdentificationCode, bfi.FinancialInstitution.Name); err != nil {
+		addFieldError(&errs, TagBeneficiaryFI, fieldError("Name", err, bfi.FinancialInstitution.Name))
 	}
 	if err := bfi.isAlphanumeric(bfi.FinancialInstitution.Address.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, bfi.FinancialInstitution.Address.AddressLineOne)
+		addFieldError(&errs, TagBeneficiaryFI, fieldError("AddressLineOne", err, bfi.FinancialInstitution.Address.AddressLineOne))
 	}
 	if err := bfi.isAlphanumeric(bfi.FinancialInstitution.Address.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, bfi.FinancialInstitution.Address.AddressLineTwo)
+		addFieldError(&errs, TagBeneficiaryFI, fieldError("AddressLineTwo", err, bfi.FinancialInstitution.Address.AddressLineTwo))
 	}
 	if err := bfi.isAlphanumeric(bfi.FinancialInstitution.Address.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, bfi.FinancialInstitution.Address.AddressLineThree)
+		addFieldError(&errs, TagBeneficiaryFI, fieldError("AddressLineThree", err, bfi.FinancialInstitution.Address.AddressLineThree))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (bfi *BeneficiaryFI) fieldInclusion() error {
+func (bfi *BeneficiaryFI) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if bfi.FinancialInstitution.IdentificationCode != "" && bfi.FinancialInstitution.Identifier == "" {
-		return fieldError("Identifier", ErrFieldRequired)
+		addFieldError(&errs, TagBeneficiaryFI, fieldError("Identifier", ErrFieldRequired))
 	}
 	if bfi.FinancialInstitution.IdentificationCode == "" && bfi.FinancialInstitution.Identifier != "" {
-		return fieldError("IdentificationCode", ErrFieldRequired)
+		addFieldError(&errs, TagBeneficiaryFI, fieldError("IdentificationCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // IdentificationCodeField gets a string of the IdentificationCode field
@@ -117,6 +117,51 @@ func TestBeneficiaryFIIdentifierRequired(t *testing.T) {
 	require.EqualError(t, err, fieldError("Identifier", ErrFieldRequired).Error())
 }
 
+// TestBeneficiaryFIIdentifierBIC validates BeneficiaryFI Identifier is a BIC for SWIFTBankIdentifierCode
+func TestBeneficiaryFIIdentifierBIC(t *testing.T) {
+	bfi := mockBeneficiaryFI()
+	bfi.FinancialInstitution.IdentificationCode = SWIFTBankIdentifierCode
+	bfi.FinancialInstitution.Identifier = "CITIUS33"
+
+	require.NoError(t, bfi.Validate())
+
+	bfi.FinancialInstitution.Identifier = "CITI US 33"
+
+	err := bfi.Validate()
+
+	require.EqualError(t, err, fieldError("Identifier", ErrNonBIC, bfi.FinancialInstitution.Identifier).Error())
+}
+
+// TestBeneficiaryFIIdentifierIBAN validates the check digits of a BeneficiaryFI account Identifier which is an IBAN
+func TestBeneficiaryFIIdentifierIBAN(t *testing.T) {
+	bfi := mockBeneficiaryFI()
+	bfi.FinancialInstitution.Identifier = "DE89370400440532013000"
+
+	require.NoError(t, bfi.Validate())
+
+	bfi.FinancialInstitution.Identifier = "DE89370400440532013001"
+
+	err := bfi.Validate()
+
+	require.EqualError(t, err, fieldError("Identifier", ErrIBANCheckDigit, bfi.FinancialInstitution.Identifier).Error())
+}
+
+// TestBeneficiaryFIIdentifierCHIPS validates BeneficiaryFI Identifier is a CHIPS number for the CHIPS codes
+func TestBeneficiaryFIIdentifierCHIPS(t *testing.T) {
+	bfi := mockBeneficiaryFI()
+	bfi.FinancialInstitution.IdentificationCode = CHIPSParticipant
+
+	err := bfi.Validate()
+
+	require.EqualError(t, err, fieldError("Identifier", ErrCHIPSParticipant, bfi.FinancialInstitution.Identifier).Error())
+
+	bfi.FinancialInstitution.IdentificationCode = CHIPSIdentifier
+
+	err = bfi.Validate()
+
+	require.EqualError(t, err, fieldError("Identifier", ErrCHIPSIdentifier, bfi.FinancialInstitution.Identifier).Error())
+}
+
 // TestParseBeneficiaryFIWrongLength parses a wrong BeneficiaryFI record length
 func TestParseBeneficiaryFIWrongLength(t *testing.T) {
 	var line = "{4100}D123456789                         FI Name                            Address One                        Address Two                        Address Three                    "
@@ -125,7 +170,7 @@ func TestParseBeneficiaryFIWrongLength(t *testing.T) {
 
 	err := r.parseBeneficiaryFI()
 
-	require.EqualError(t, err, r.parseError(fieldError("Identifier", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(7, "Identifier", ErrRequireDelimiter)).Error())
 }
 
 // TestParseBeneficiaryFIReaderParseError parses a wrong BeneficiaryFI reader parse error
@@ -141,7 +186,7 @@ func TestParseBeneficiaryFIReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("Name", ErrNonAlphanumeric, "F® Name")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("Name", ErrNonAlphanumeric, "F® Name"))).Error()
 	require.EqualError(t, err, expected)
 }
 
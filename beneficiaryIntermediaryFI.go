@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // BeneficiaryIntermediaryFI {4000}
@@ -46,35 +48,35 @@ func (bifi *BeneficiaryIntermediaryFI) Parse(record string) error {
 
 	value, read, err := bifi.parseVariableStringField(record[length:], 34)
 	if err != nil {
-		return fieldError("Identifier", err)
+		return fieldErrorAt(length, "Identifier", err)
 	}
 	bifi.FinancialInstitution.Identifier = value
 	length += read
 
 	value, read, err = bifi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("Name", err)
+		return fieldErrorAt(length, "Name", err)
 	}
 	bifi.FinancialInstitution.Name = value
 	length += read
 
 	value, read, err = bifi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineOne", err)
+		return fieldErrorAt(length, "AddressLineOne", err)
 	}
 	bifi.FinancialInstitution.Address.AddressLineOne = value
 	length += read
 
 	value, read, err = bifi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineTwo", err)
+		return fieldErrorAt(length, "AddressLineTwo", err)
 	}
 	bifi.FinancialInstitution.Address.AddressLineTwo = value
 	length += read
 
 	value, read, err = bifi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineThree", err)
+		return fieldErrorAt(length, "AddressLineThree", err)
 	}
 	bifi.FinancialInstitution.Address.AddressLineThree = value
 	length += read
@@ -128,53 +130,63 @@ func (bifi *BeneficiaryIntermediaryFI) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on BeneficiaryIntermediaryFI and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 // If ID Code is present, Identifier is mandatory and vice versa.
 func (bifi *BeneficiaryIntermediaryFI) Validate() error {
-	if err := bifi.fieldInclusion(); err != nil {
-		return err
-	}
+	return bifi.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on BeneficiaryIntermediaryFI and returns every error found.
+// Only the first error for each field is returned.
+func (bifi *BeneficiaryIntermediaryFI) ValidateAll() base.ErrorList {
+	errs := bifi.fieldInclusion()
 	if bifi.tag != TagBeneficiaryIntermediaryFI {
-		return fieldError("tag", ErrValidTagForType, bifi.tag)
+		addFieldError(&errs, TagBeneficiaryIntermediaryFI, fieldError("tag", ErrValidTagForType, bifi.tag))
 	}
 	if err := bifi.isIdentificationCode(bifi.FinancialInstitution.IdentificationCode); err != nil {
-		return fieldError("IdentificationCode", err, bifi.FinancialInstitution.IdentificationCode)
+		addFieldError(&errs, TagBeneficiaryIntermediaryFI, fieldError("IdentificationCode", err, bifi.FinancialInstitution.IdentificationCode))
 	}
 	// Can only be these Identification Codes
 	switch bifi.FinancialInstitution.IdentificationCode {
 	case
 		"B", "C", "D", "F", "U":
 	default:
-		return fieldError("IdentificationCode", ErrIdentificationCode, bifi.FinancialInstitution.IdentificationCode)
+		addFieldError(&errs, TagBeneficiaryIntermediaryFI, fieldError("IdentificationCode", ErrIdentificationCode, bifi.FinancialInstitution.IdentificationCode))
 	}
 	if err := bifi.isAlphanumeric(bifi.FinancialInstitution.Identifier); err != nil {
-		return fieldError("Identifier", err, bifi.FinancialInstitution.Identifier)
+		addFieldError(&errs, TagBeneficiaryIntermediaryFI, fieldError("Identifier", err, bifi.FinancialInstitution.Identifier))
+	}
+	if err := bifi.validateIdentifier(bifi.FinancialInstitution.IdentificationCode, bifi.FinancialInstitution.Identifier); err != nil {
+		addFieldError(&errs, TagBeneficiaryIntermediaryFI, fieldError("Identifier", err, bifi.FinancialInstitution.Identifier))
 	}
 	if err := bifi.isAlphanumeric(bifi.FinancialInstitution.Name); err != nil {
-		return fieldError("Name", err, bifi.FinancialInstitution.Name)
+		addFieldError(&errs, TagBeneficiaryIntermediaryFI, fieldError("Name", err, bifi.FinancialInstitution.Name))
+	}
+	if err := bifi.validateIdentifierName(bifi.FinancialInstitution.IdentificationCode, bifi.FinancialInstitution.Name); err != nil {
+		addFieldError(&errs, TagBeneficiaryIntermediaryFI, fieldError("Name", err, bifi.FinancialInstitution.Name))
 	}
 	if err := bifi.isAlphanumeric(bifi.FinancialInstitution.Address.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, bifi.FinancialInstitution.Address.AddressLineOne)
+		addFieldError(&errs, TagBeneficiaryIntermediaryFI, fieldError("AddressLineOne", err, bifi.FinancialInstitution.Address.AddressLineOne))
 	}
 	if err := bifi.isAlphanumeric(bifi.FinancialInstitution.Address.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, bifi.FinancialInstitution.Address.AddressLineTwo)
+		addFieldError(&errs, TagBeneficiaryIntermediaryFI, fieldError("AddressLineTwo", err, bifi.FinancialInstitution.Address.AddressLineTwo))
 	}
 	if err := bifi.isAlphanumeric(bifi.FinancialInstitution.Address.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, bifi.FinancialInstitution.Address.AddressLineThree)
+		addFieldError(&errs, TagBeneficiaryIntermediaryFI, fieldError("AddressLineThree", err, bifi.FinancialInstitution.Address.AddressLineThree))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (bifi *BeneficiaryIntermediaryFI) fieldInclusion() error {
+func (bifi *BeneficiaryIntermediaryFI) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if bifi.FinancialInstitution.IdentificationCode != "" && bifi.FinancialInstitution.Identifier == "" {
-		return fieldError("BeneficiaryIntermediaryFI.FinancialInstitution.Identifier", ErrFieldRequired)
+		addFieldError(&errs, TagBeneficiaryIntermediaryFI, fieldError("BeneficiaryIntermediaryFI.FinancialInstitution.Identifier", ErrFieldRequired))
 	}
 	if bifi.FinancialInstitution.IdentificationCode == "" && bifi.FinancialInstitution.Identifier != "" {
-		return fieldError("BeneficiaryIntermediaryFI.FinancialInstitution.IdentificationCode", ErrFieldRequired)
+		addFieldError(&errs, TagBeneficiaryIntermediaryFI, fieldError("BeneficiaryIntermediaryFI.FinancialInstitution.IdentificationCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // IdentificationCodeField gets a string of the IdentificationCode field
@@ -125,7 +125,7 @@ func TestParseBeneficiaryIntermediaryFIWrongLength(t *testing.T) {
 
 	err := r.parseBeneficiaryIntermediaryFI()
 
-	require.EqualError(t, err, r.parseError(fieldError("Identifier", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(7, "Identifier", ErrRequireDelimiter)).Error())
 }
 
 // TestParseBeneficiaryIntermediaryFIReaderParseError parses a wrong BeneficiaryIntermediaryFI reader parse error
@@ -144,7 +144,7 @@ func TestParseBeneficiaryIntermediaryFIReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("Name", ErrNonAlphanumeric, "F® Name")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("Name", ErrNonAlphanumeric, "F® Name"))).Error()
 	require.EqualError(t, err, expected)
 }
 
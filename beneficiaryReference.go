@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // BeneficiaryReference is a reference for the beneficiary
@@ -45,7 +47,7 @@ func (br *BeneficiaryReference) Parse(record string) error {
 
 	value, read, err := br.parseVariableStringField(record[length:], 16)
 	if err != nil {
-		return fieldError("BeneficiaryReference", err)
+		return fieldErrorAt(length, "BeneficiaryReference", err)
 	}
 	br.BeneficiaryReference = value
 	length += read
@@ -90,15 +92,21 @@ func (br *BeneficiaryReference) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on BeneficiaryReference and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (br *BeneficiaryReference) Validate() error {
+	return br.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on BeneficiaryReference and returns every error found.
+// Only the first error for each field is returned.
+func (br *BeneficiaryReference) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if br.tag != TagBeneficiaryReference {
-		return fieldError("tag", ErrValidTagForType, br.tag)
+		addFieldError(&errs, TagBeneficiaryReference, fieldError("tag", ErrValidTagForType, br.tag))
 	}
 	if err := br.isAlphanumeric(br.BeneficiaryReference); err != nil {
-		return fieldError("BeneficiaryReference", err, br.BeneficiaryReference)
+		addFieldError(&errs, TagBeneficiaryReference, fieldError("BeneficiaryReference", err, br.BeneficiaryReference))
 	}
-	return nil
+	return errs
 }
 
 // BeneficiaryReferenceField gets a string of the BeneficiaryReference field
@@ -40,7 +40,7 @@ func TestParseBeneficiaryReferenceWrongLength(t *testing.T) {
 
 	err := r.parseBeneficiaryReference()
 
-	require.EqualError(t, err, r.parseError(fieldError("BeneficiaryReference", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "BeneficiaryReference", ErrRequireDelimiter)).Error())
 }
 
 // TestParseBeneficiaryReferenceReaderParseError parses a wrong BeneficiaryReference reader parse error
@@ -56,7 +56,7 @@ func TestParseBeneficiaryReferenceReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("BeneficiaryReference", ErrNonAlphanumeric, "Reference®")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("BeneficiaryReference", ErrNonAlphanumeric, "Reference®"))).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -111,6 +111,28 @@ func TestBeneficiaryIdentifierWithNoIdentificationCode(t *testing.T) {
 	require.EqualError(t, err, fieldError("IdentificationCode", ErrFieldRequired).Error())
 }
 
+// TestBeneficiaryIdentifierBICAndAccount validates a Beneficiary identified by SWIFTBICORBEIANDAccountNumber
+func TestBeneficiaryIdentifierBICAndAccount(t *testing.T) {
+	ben := mockBeneficiary()
+	ben.Personal.IdentificationCode = SWIFTBICORBEIANDAccountNumber
+	ben.Personal.Identifier = "DE89370400440532013000"
+	ben.Personal.Name = "DRESDEFFXXX Name"
+
+	require.NoError(t, ben.Validate())
+
+	ben.Personal.Name = "Name"
+
+	err := ben.Validate()
+
+	require.EqualError(t, err, fieldError("Name", ErrNonBIC, ben.Personal.Name).Error())
+
+	ben.Personal.Identifier = "DE89370400440532013001"
+
+	err = ben.Validate()
+
+	require.EqualError(t, err, fieldError("Identifier", ErrIBANCheckDigit, ben.Personal.Identifier).Error())
+}
+
 // TestParseBeneficiaryWrongLength parses a wrong Beneficiary record length
 func TestParseBeneficiaryWrongLength(t *testing.T) {
 	var line = "{4200}31234                              *Name                               *Address One                        *Address Two                        *Address Three                    "
@@ -119,7 +141,7 @@ func TestParseBeneficiaryWrongLength(t *testing.T) {
 
 	err := r.parseBeneficiary()
 
-	require.EqualError(t, err, r.parseError(fieldError("AddressLineThree", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(150, "AddressLineThree", ErrRequireDelimiter)).Error())
 }
 
 // TestParseBeneficiaryReaderParseError parses a wrong Beneficiary reader parse error
@@ -135,7 +157,7 @@ func TestParseBeneficiaryReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("Name", ErrNonAlphanumeric, "Na®e")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("Name", ErrNonAlphanumeric, "Na®e"))).Error()
 	require.EqualError(t, err, expected)
 }
 
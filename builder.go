@@ -0,0 +1,607 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// messageBuilder builds a FEDWireMessage with the tags permitted for every business function code. B is the builder
+// embedding it, which its methods return so calls can be chained.
+type messageBuilder[B any] struct {
+	self B
+	fwm  FEDWireMessage
+	err  error
+}
+
+// init sets the mandatory tags of the FEDWireMessage. A SenderSupplied of an original production message is used
+// until WithSenderSupplied is called.
+func (b *messageBuilder[B]) init(self B, typeCode, subTypeCode, businessFunctionCode string, amount Money,
+	sender *SenderDepositoryInstitution, receiver *ReceiverDepositoryInstitution) {
+	b.self = self
+	b.fwm.SenderSupplied = NewSenderSupplied()
+
+	tst := NewTypeSubType()
+	tst.TypeCode = typeCode
+	tst.SubTypeCode = subTypeCode
+	b.fwm.TypeSubType = tst
+
+	amt := NewAmount()
+	b.err = amt.SetMoney(amount)
+	b.fwm.Amount = amt
+
+	b.fwm.SenderDepositoryInstitution = sender
+	b.fwm.ReceiverDepositoryInstitution = receiver
+
+	bfc := NewBusinessFunctionCode()
+	bfc.BusinessFunctionCode = businessFunctionCode
+	b.fwm.BusinessFunctionCode = bfc
+}
+
+// Build validates the FEDWireMessage with the rules of its business function code and returns a File holding it
+func (b *messageBuilder[B]) Build() (*File, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if err := b.fwm.verify(); err != nil {
+		return nil, err
+	}
+	file := NewFile()
+	file.AddFEDWireMessage(b.fwm)
+	return file, nil
+}
+
+// WithValidateOptions sets the ValidateOpts used to validate the FEDWireMessage
+func (b *messageBuilder[B]) WithValidateOptions(opts *ValidateOpts) B {
+	b.fwm.ValidateOptions = opts
+	return b.self
+}
+
+// WithSenderSupplied sets the SenderSupplied tag
+func (b *messageBuilder[B]) WithSenderSupplied(ss *SenderSupplied) B {
+	b.fwm.SenderSupplied = ss
+	return b.self
+}
+
+// WithIMAD sets the InputMessageAccountabilityData (IMAD) tag
+func (b *messageBuilder[B]) WithIMAD(imad *InputMessageAccountabilityData) B {
+	b.fwm.InputMessageAccountabilityData = imad
+	return b.self
+}
+
+// WithSenderReference sets the SenderReference tag
+func (b *messageBuilder[B]) WithSenderReference(sr *SenderReference) B {
+	b.fwm.SenderReference = sr
+	return b.self
+}
+
+// WithPreviousMessageIdentifier sets the PreviousMessageIdentifier tag
+func (b *messageBuilder[B]) WithPreviousMessageIdentifier(pmi *PreviousMessageIdentifier) B {
+	b.fwm.PreviousMessageIdentifier = pmi
+	return b.self
+}
+
+// WithBeneficiaryIntermediaryFI sets the BeneficiaryIntermediaryFI tag
+func (b *messageBuilder[B]) WithBeneficiaryIntermediaryFI(bifi *BeneficiaryIntermediaryFI) B {
+	b.fwm.BeneficiaryIntermediaryFI = bifi
+	return b.self
+}
+
+// WithBeneficiaryFI sets the BeneficiaryFI tag
+func (b *messageBuilder[B]) WithBeneficiaryFI(bfi *BeneficiaryFI) B {
+	b.fwm.BeneficiaryFI = bfi
+	return b.self
+}
+
+// WithBeneficiaryReference sets the BeneficiaryReference tag
+func (b *messageBuilder[B]) WithBeneficiaryReference(br *BeneficiaryReference) B {
+	b.fwm.BeneficiaryReference = br
+	return b.self
+}
+
+// WithOriginatorFI sets the OriginatorFI tag
+func (b *messageBuilder[B]) WithOriginatorFI(ofi *OriginatorFI) B {
+	b.fwm.OriginatorFI = ofi
+	return b.self
+}
+
+// WithInstructingFI sets the InstructingFI tag
+func (b *messageBuilder[B]) WithInstructingFI(ifi *InstructingFI) B {
+	b.fwm.InstructingFI = ifi
+	return b.self
+}
+
+// WithOriginatorToBeneficiary sets the OriginatorToBeneficiary tag
+func (b *messageBuilder[B]) WithOriginatorToBeneficiary(otb *OriginatorToBeneficiary) B {
+	b.fwm.OriginatorToBeneficiary = otb
+	return b.self
+}
+
+// WithFIIntermediaryFI sets the FIIntermediaryFI tag
+func (b *messageBuilder[B]) WithFIIntermediaryFI(fiifi *FIIntermediaryFI) B {
+	b.fwm.FIIntermediaryFI = fiifi
+	return b.self
+}
+
+// WithFIIntermediaryFIAdvice sets the FIIntermediaryFIAdvice tag
+func (b *messageBuilder[B]) WithFIIntermediaryFIAdvice(fiifia *FIIntermediaryFIAdvice) B {
+	b.fwm.FIIntermediaryFIAdvice = fiifia
+	return b.self
+}
+
+// WithFIBeneficiaryFI sets the FIBeneficiaryFI tag
+func (b *messageBuilder[B]) WithFIBeneficiaryFI(fibfi *FIBeneficiaryFI) B {
+	b.fwm.FIBeneficiaryFI = fibfi
+	return b.self
+}
+
+// WithFIBeneficiaryFIAdvice sets the FIBeneficiaryFIAdvice tag
+func (b *messageBuilder[B]) WithFIBeneficiaryFIAdvice(fibfia *FIBeneficiaryFIAdvice) B {
+	b.fwm.FIBeneficiaryFIAdvice = fibfia
+	return b.self
+}
+
+// WithFIBeneficiary sets the FIBeneficiary tag
+func (b *messageBuilder[B]) WithFIBeneficiary(fib *FIBeneficiary) B {
+	b.fwm.FIBeneficiary = fib
+	return b.self
+}
+
+// WithFIBeneficiaryAdvice sets the FIBeneficiaryAdvice tag
+func (b *messageBuilder[B]) WithFIBeneficiaryAdvice(fiba *FIBeneficiaryAdvice) B {
+	b.fwm.FIBeneficiaryAdvice = fiba
+	return b.self
+}
+
+// WithFIPaymentMethodToBeneficiary sets the FIPaymentMethodToBeneficiary tag
+func (b *messageBuilder[B]) WithFIPaymentMethodToBeneficiary(fipmtb *FIPaymentMethodToBeneficiary) B {
+	b.fwm.FIPaymentMethodToBeneficiary = fipmtb
+	return b.self
+}
+
+// WithFIAdditionalFIToFI sets the FIAdditionalFIToFI tag
+func (b *messageBuilder[B]) WithFIAdditionalFIToFI(fiafitfi *FIAdditionalFIToFI) B {
+	b.fwm.FIAdditionalFIToFI = fiafitfi
+	return b.self
+}
+
+// CustomerTransferBuilder builds a CustomerTransfer (CTR) FEDWireMessage
+type CustomerTransferBuilder struct {
+	messageBuilder[*CustomerTransferBuilder]
+}
+
+// NewCustomerTransfer returns a CustomerTransferBuilder of a basic funds transfer of amount from the originator to
+// the beneficiary
+func NewCustomerTransfer(amount Money, sender *SenderDepositoryInstitution, receiver *ReceiverDepositoryInstitution,
+	originator *Originator, beneficiary *Beneficiary) *CustomerTransferBuilder {
+	b := &CustomerTransferBuilder{}
+	b.init(b, FundsTransfer, BasicFundsTransfer, CustomerTransfer, amount, sender, receiver)
+	b.fwm.Originator = originator
+	b.fwm.Beneficiary = beneficiary
+	return b
+}
+
+// WithTypeCode sets the TypeCode of the TypeSubType tag, such as ForeignTransfer
+func (b *CustomerTransferBuilder) WithTypeCode(typeCode string) *CustomerTransferBuilder {
+	b.fwm.TypeSubType.TypeCode = typeCode
+	return b
+}
+
+// WithFIReceiverFI sets the FIReceiverFI tag
+func (b *CustomerTransferBuilder) WithFIReceiverFI(firfi *FIReceiverFI) *CustomerTransferBuilder {
+	b.fwm.FIReceiverFI = firfi
+	return b
+}
+
+// WithCharges sets the Charges tag
+func (b *CustomerTransferBuilder) WithCharges(c *Charges) *CustomerTransferBuilder {
+	b.fwm.Charges = c
+	return b
+}
+
+// WithInstructedAmount sets the InstructedAmount tag
+func (b *CustomerTransferBuilder) WithInstructedAmount(ia *InstructedAmount) *CustomerTransferBuilder {
+	b.fwm.InstructedAmount = ia
+	return b
+}
+
+// WithExchangeRate sets the ExchangeRate tag
+func (b *CustomerTransferBuilder) WithExchangeRate(er *ExchangeRate) *CustomerTransferBuilder {
+	b.fwm.ExchangeRate = er
+	return b
+}
+
+// CustomerTransferPlusBuilder builds a CustomerTransferPlus (CTP) FEDWireMessage. Its originator is set with
+// WithOriginator or WithOriginatorOptionF.
+type CustomerTransferPlusBuilder struct {
+	messageBuilder[*CustomerTransferPlusBuilder]
+}
+
+// NewCustomerTransferPlus returns a CustomerTransferPlusBuilder of a basic funds transfer of amount to the beneficiary
+func NewCustomerTransferPlus(amount Money, sender *SenderDepositoryInstitution, receiver *ReceiverDepositoryInstitution,
+	beneficiary *Beneficiary) *CustomerTransferPlusBuilder {
+	b := &CustomerTransferPlusBuilder{}
+	b.init(b, FundsTransfer, BasicFundsTransfer, CustomerTransferPlus, amount, sender, receiver)
+	b.fwm.Beneficiary = beneficiary
+	return b
+}
+
+// WithTypeCode sets the TypeCode of the TypeSubType tag, such as ForeignTransfer
+func (b *CustomerTransferPlusBuilder) WithTypeCode(typeCode string) *CustomerTransferPlusBuilder {
+	b.fwm.TypeSubType.TypeCode = typeCode
+	return b
+}
+
+// WithOriginator sets the Originator tag
+func (b *CustomerTransferPlusBuilder) WithOriginator(o *Originator) *CustomerTransferPlusBuilder {
+	b.fwm.Originator = o
+	return b
+}
+
+// WithOriginatorOptionF sets the OriginatorOptionF tag
+func (b *CustomerTransferPlusBuilder) WithOriginatorOptionF(oof *OriginatorOptionF) *CustomerTransferPlusBuilder {
+	b.fwm.OriginatorOptionF = oof
+	return b
+}
+
+// WithLocalInstrument sets the LocalInstrument tag
+func (b *CustomerTransferPlusBuilder) WithLocalInstrument(li *LocalInstrument) *CustomerTransferPlusBuilder {
+	b.fwm.LocalInstrument = li
+	return b
+}
+
+// WithPaymentNotification sets the PaymentNotification tag
+func (b *CustomerTransferPlusBuilder) WithPaymentNotification(pn *PaymentNotification) *CustomerTransferPlusBuilder {
+	b.fwm.PaymentNotification = pn
+	return b
+}
+
+// WithCharges sets the Charges tag
+func (b *CustomerTransferPlusBuilder) WithCharges(c *Charges) *CustomerTransferPlusBuilder {
+	b.fwm.Charges = c
+	return b
+}
+
+// WithInstructedAmount sets the InstructedAmount tag
+func (b *CustomerTransferPlusBuilder) WithInstructedAmount(ia *InstructedAmount) *CustomerTransferPlusBuilder {
+	b.fwm.InstructedAmount = ia
+	return b
+}
+
+// WithExchangeRate sets the ExchangeRate tag
+func (b *CustomerTransferPlusBuilder) WithExchangeRate(er *ExchangeRate) *CustomerTransferPlusBuilder {
+	b.fwm.ExchangeRate = er
+	return b
+}
+
+// WithCurrencyInstructedAmount sets the CurrencyInstructedAmount tag
+func (b *CustomerTransferPlusBuilder) WithCurrencyInstructedAmount(cia *CurrencyInstructedAmount) *CustomerTransferPlusBuilder {
+	b.fwm.CurrencyInstructedAmount = cia
+	return b
+}
+
+// WithOrderingCustomer sets the OrderingCustomer tag
+func (b *CustomerTransferPlusBuilder) WithOrderingCustomer(oc *OrderingCustomer) *CustomerTransferPlusBuilder {
+	b.fwm.OrderingCustomer = oc
+	return b
+}
+
+// WithOrderingInstitution sets the OrderingInstitution tag
+func (b *CustomerTransferPlusBuilder) WithOrderingInstitution(oi *OrderingInstitution) *CustomerTransferPlusBuilder {
+	b.fwm.OrderingInstitution = oi
+	return b
+}
+
+// WithIntermediaryInstitution sets the IntermediaryInstitution tag
+func (b *CustomerTransferPlusBuilder) WithIntermediaryInstitution(ii *IntermediaryInstitution) *CustomerTransferPlusBuilder {
+	b.fwm.IntermediaryInstitution = ii
+	return b
+}
+
+// WithInstitutionAccount sets the InstitutionAccount tag
+func (b *CustomerTransferPlusBuilder) WithInstitutionAccount(ia *InstitutionAccount) *CustomerTransferPlusBuilder {
+	b.fwm.InstitutionAccount = ia
+	return b
+}
+
+// WithBeneficiaryCustomer sets the BeneficiaryCustomer tag
+func (b *CustomerTransferPlusBuilder) WithBeneficiaryCustomer(bc *BeneficiaryCustomer) *CustomerTransferPlusBuilder {
+	b.fwm.BeneficiaryCustomer = bc
+	return b
+}
+
+// WithRemittance sets the Remittance tag
+func (b *CustomerTransferPlusBuilder) WithRemittance(r *Remittance) *CustomerTransferPlusBuilder {
+	b.fwm.Remittance = r
+	return b
+}
+
+// WithSenderToReceiver sets the SenderToReceiver tag
+func (b *CustomerTransferPlusBuilder) WithSenderToReceiver(str *SenderToReceiver) *CustomerTransferPlusBuilder {
+	b.fwm.SenderToReceiver = str
+	return b
+}
+
+// WithUnstructuredAddenda sets the UnstructuredAddenda tag
+func (b *CustomerTransferPlusBuilder) WithUnstructuredAddenda(ua *UnstructuredAddenda) *CustomerTransferPlusBuilder {
+	b.fwm.UnstructuredAddenda = ua
+	return b
+}
+
+// WithRelatedRemittance sets the RelatedRemittance tag
+func (b *CustomerTransferPlusBuilder) WithRelatedRemittance(rr *RelatedRemittance) *CustomerTransferPlusBuilder {
+	b.fwm.RelatedRemittance = rr
+	return b
+}
+
+// WithRemittanceOriginator sets the RemittanceOriginator tag
+func (b *CustomerTransferPlusBuilder) WithRemittanceOriginator(ro *RemittanceOriginator) *CustomerTransferPlusBuilder {
+	b.fwm.RemittanceOriginator = ro
+	return b
+}
+
+// WithRemittanceBeneficiary sets the RemittanceBeneficiary tag
+func (b *CustomerTransferPlusBuilder) WithRemittanceBeneficiary(rb *RemittanceBeneficiary) *CustomerTransferPlusBuilder {
+	b.fwm.RemittanceBeneficiary = rb
+	return b
+}
+
+// WithPrimaryRemittanceDocument sets the PrimaryRemittanceDocument tag
+func (b *CustomerTransferPlusBuilder) WithPrimaryRemittanceDocument(prd *PrimaryRemittanceDocument) *CustomerTransferPlusBuilder {
+	b.fwm.PrimaryRemittanceDocument = prd
+	return b
+}
+
+// WithActualAmountPaid sets the ActualAmountPaid tag
+func (b *CustomerTransferPlusBuilder) WithActualAmountPaid(aap *ActualAmountPaid) *CustomerTransferPlusBuilder {
+	b.fwm.ActualAmountPaid = aap
+	return b
+}
+
+// WithGrossAmountRemittanceDocument sets the GrossAmountRemittanceDocument tag
+func (b *CustomerTransferPlusBuilder) WithGrossAmountRemittanceDocument(gard *GrossAmountRemittanceDocument) *CustomerTransferPlusBuilder {
+	b.fwm.GrossAmountRemittanceDocument = gard
+	return b
+}
+
+// WithAmountNegotiatedDiscount sets the AmountNegotiatedDiscount tag
+func (b *CustomerTransferPlusBuilder) WithAmountNegotiatedDiscount(discount *AmountNegotiatedDiscount) *CustomerTransferPlusBuilder {
+	b.fwm.AmountNegotiatedDiscount = discount
+	return b
+}
+
+// WithAdjustment sets the Adjustment tag
+func (b *CustomerTransferPlusBuilder) WithAdjustment(a *Adjustment) *CustomerTransferPlusBuilder {
+	b.fwm.Adjustment = a
+	return b
+}
+
+// WithDateRemittanceDocument sets the DateRemittanceDocument tag
+func (b *CustomerTransferPlusBuilder) WithDateRemittanceDocument(drd *DateRemittanceDocument) *CustomerTransferPlusBuilder {
+	b.fwm.DateRemittanceDocument = drd
+	return b
+}
+
+// WithSecondaryRemittanceDocument sets the SecondaryRemittanceDocument tag
+func (b *CustomerTransferPlusBuilder) WithSecondaryRemittanceDocument(srd *SecondaryRemittanceDocument) *CustomerTransferPlusBuilder {
+	b.fwm.SecondaryRemittanceDocument = srd
+	return b
+}
+
+// WithRemittanceFreeText sets the RemittanceFreeText tag
+func (b *CustomerTransferPlusBuilder) WithRemittanceFreeText(rft *RemittanceFreeText) *CustomerTransferPlusBuilder {
+	b.fwm.RemittanceFreeText = rft
+	return b
+}
+
+// BankTransferBuilder builds a BankTransfer (BTR) FEDWireMessage
+type BankTransferBuilder struct {
+	messageBuilder[*BankTransferBuilder]
+}
+
+// NewBankTransfer returns a BankTransferBuilder of a basic funds transfer of amount from the sender to the receiver
+func NewBankTransfer(amount Money, sender *SenderDepositoryInstitution, receiver *ReceiverDepositoryInstitution) *BankTransferBuilder {
+	b := &BankTransferBuilder{}
+	b.init(b, FundsTransfer, BasicFundsTransfer, BankTransfer, amount, sender, receiver)
+	return b
+}
+
+// WithTypeCode sets the TypeCode of the TypeSubType tag, such as SettlementTransfer
+func (b *BankTransferBuilder) WithTypeCode(typeCode string) *BankTransferBuilder {
+	b.fwm.TypeSubType.TypeCode = typeCode
+	return b
+}
+
+// WithBeneficiary sets the Beneficiary tag
+func (b *BankTransferBuilder) WithBeneficiary(ben *Beneficiary) *BankTransferBuilder {
+	b.fwm.Beneficiary = ben
+	return b
+}
+
+// WithOriginator sets the Originator tag
+func (b *BankTransferBuilder) WithOriginator(o *Originator) *BankTransferBuilder {
+	b.fwm.Originator = o
+	return b
+}
+
+// WithFIReceiverFI sets the FIReceiverFI tag
+func (b *BankTransferBuilder) WithFIReceiverFI(firfi *FIReceiverFI) *BankTransferBuilder {
+	b.fwm.FIReceiverFI = firfi
+	return b
+}
+
+// SettlementTransferBuilder builds a CheckSameDaySettlement (CKS), DepositSendersAccount (DEP), FEDFundsReturned
+// (FFR) or FEDFundsSold (FFS) FEDWireMessage, which are settlement transfers
+type SettlementTransferBuilder struct {
+	messageBuilder[*SettlementTransferBuilder]
+}
+
+func newSettlementTransfer(businessFunctionCode string, amount Money, sender *SenderDepositoryInstitution,
+	receiver *ReceiverDepositoryInstitution) *SettlementTransferBuilder {
+	b := &SettlementTransferBuilder{}
+	b.init(b, SettlementTransfer, BasicFundsTransfer, businessFunctionCode, amount, sender, receiver)
+	return b
+}
+
+// NewCheckSameDaySettlement returns a SettlementTransferBuilder of a CheckSameDaySettlement of amount
+func NewCheckSameDaySettlement(amount Money, sender *SenderDepositoryInstitution, receiver *ReceiverDepositoryInstitution) *SettlementTransferBuilder {
+	return newSettlementTransfer(CheckSameDaySettlement, amount, sender, receiver)
+}
+
+// NewDepositSendersAccount returns a SettlementTransferBuilder of a DepositSendersAccount of amount
+func NewDepositSendersAccount(amount Money, sender *SenderDepositoryInstitution, receiver *ReceiverDepositoryInstitution) *SettlementTransferBuilder {
+	return newSettlementTransfer(DepositSendersAccount, amount, sender, receiver)
+}
+
+// NewFEDFundsReturned returns a SettlementTransferBuilder of a FEDFundsReturned of amount
+func NewFEDFundsReturned(amount Money, sender *SenderDepositoryInstitution, receiver *ReceiverDepositoryInstitution) *SettlementTransferBuilder {
+	return newSettlementTransfer(FEDFundsReturned, amount, sender, receiver)
+}
+
+// NewFEDFundsSold returns a SettlementTransferBuilder of a FEDFundsSold of amount
+func NewFEDFundsSold(amount Money, sender *SenderDepositoryInstitution, receiver *ReceiverDepositoryInstitution) *SettlementTransferBuilder {
+	return newSettlementTransfer(FEDFundsSold, amount, sender, receiver)
+}
+
+// WithBeneficiary sets the Beneficiary tag
+func (b *SettlementTransferBuilder) WithBeneficiary(ben *Beneficiary) *SettlementTransferBuilder {
+	b.fwm.Beneficiary = ben
+	return b
+}
+
+// WithOriginator sets the Originator tag
+func (b *SettlementTransferBuilder) WithOriginator(o *Originator) *SettlementTransferBuilder {
+	b.fwm.Originator = o
+	return b
+}
+
+// WithFIReceiverFI sets the FIReceiverFI tag
+func (b *SettlementTransferBuilder) WithFIReceiverFI(firfi *FIReceiverFI) *SettlementTransferBuilder {
+	b.fwm.FIReceiverFI = firfi
+	return b
+}
+
+// DrawdownRequestBuilder builds a CustomerCorporateDrawdownRequest (DRC) or BankDrawDownRequest (DRB)
+// FEDWireMessage, a request for credit asking the receiver to debit the AccountDebitedDrawdown
+type DrawdownRequestBuilder struct {
+	messageBuilder[*DrawdownRequestBuilder]
+}
+
+// NewDrawdownRequest returns a DrawdownRequestBuilder of a CustomerCorporateDrawdownRequest for amount to be
+// debited from debitAccount and credited to creditAccount for the beneficiary
+func NewDrawdownRequest(amount Money, sender *SenderDepositoryInstitution, receiver *ReceiverDepositoryInstitution,
+	beneficiary *Beneficiary, debitAccount *AccountDebitedDrawdown, creditAccount *AccountCreditedDrawdown) *DrawdownRequestBuilder {
+	b := &DrawdownRequestBuilder{}
+	b.init(b, FundsTransfer, RequestCredit, CustomerCorporateDrawdownRequest, amount, sender, receiver)
+	b.fwm.Beneficiary = beneficiary
+	b.fwm.AccountDebitedDrawdown = debitAccount
+	b.fwm.AccountCreditedDrawdown = creditAccount
+	return b
+}
+
+// NewBankDrawdownRequest returns a DrawdownRequestBuilder of a BankDrawDownRequest for amount to be debited from
+// debitAccount and credited to creditAccount
+func NewBankDrawdownRequest(amount Money, sender *SenderDepositoryInstitution, receiver *ReceiverDepositoryInstitution,
+	debitAccount *AccountDebitedDrawdown, creditAccount *AccountCreditedDrawdown) *DrawdownRequestBuilder {
+	b := &DrawdownRequestBuilder{}
+	b.init(b, SettlementTransfer, RequestCredit, BankDrawDownRequest, amount, sender, receiver)
+	b.fwm.AccountDebitedDrawdown = debitAccount
+	b.fwm.AccountCreditedDrawdown = creditAccount
+	return b
+}
+
+// WithBeneficiary sets the Beneficiary tag
+func (b *DrawdownRequestBuilder) WithBeneficiary(ben *Beneficiary) *DrawdownRequestBuilder {
+	b.fwm.Beneficiary = ben
+	return b
+}
+
+// WithOriginator sets the Originator tag
+func (b *DrawdownRequestBuilder) WithOriginator(o *Originator) *DrawdownRequestBuilder {
+	b.fwm.Originator = o
+	return b
+}
+
+// WithFIReceiverFI sets the FIReceiverFI tag
+func (b *DrawdownRequestBuilder) WithFIReceiverFI(firfi *FIReceiverFI) *DrawdownRequestBuilder {
+	b.fwm.FIReceiverFI = firfi
+	return b
+}
+
+// WithFIDrawdownDebitAccountAdvice sets the FIDrawdownDebitAccountAdvice tag
+func (b *DrawdownRequestBuilder) WithFIDrawdownDebitAccountAdvice(fiddaa *FIDrawdownDebitAccountAdvice) *DrawdownRequestBuilder {
+	b.fwm.FIDrawdownDebitAccountAdvice = fiddaa
+	return b
+}
+
+// DrawdownResponseBuilder builds a DrawdownResponse (DRW) FEDWireMessage, the funds transfer honoring a drawdown
+// request
+type DrawdownResponseBuilder struct {
+	messageBuilder[*DrawdownResponseBuilder]
+}
+
+// NewDrawdownResponseBuilder returns a DrawdownResponseBuilder of the DrawdownResponse answering request, as made by
+// NewDrawdownResponse. Build checks the response against request unless WithValidateOptions replaces the
+// ValidateOpts.DrawdownRequest it is given.
+func NewDrawdownResponseBuilder(request *FEDWireMessage, opts ...DrawdownResponseOption) *DrawdownResponseBuilder {
+	b := &DrawdownResponseBuilder{}
+	b.self = b
+	b.fwm, b.err = NewDrawdownResponse(request, opts...)
+	b.fwm.ValidateOptions = &ValidateOpts{DrawdownRequest: request}
+	return b
+}
+
+// WithOriginator sets the Originator tag, which must be the debited account of the drawdown request
+func (b *DrawdownResponseBuilder) WithOriginator(o *Originator) *DrawdownResponseBuilder {
+	b.fwm.Originator = o
+	return b
+}
+
+// WithBeneficiary sets the Beneficiary tag
+func (b *DrawdownResponseBuilder) WithBeneficiary(ben *Beneficiary) *DrawdownResponseBuilder {
+	b.fwm.Beneficiary = ben
+	return b
+}
+
+// WithFIReceiverFI sets the FIReceiverFI tag
+func (b *DrawdownResponseBuilder) WithFIReceiverFI(firfi *FIReceiverFI) *DrawdownResponseBuilder {
+	b.fwm.FIReceiverFI = firfi
+	return b
+}
+
+// BFCServiceMessageBuilder builds a BFCServiceMessage (SVC) FEDWireMessage. It is named for the BFCServiceMessage
+// code, as NewServiceMessage returns the ServiceMessage tag.
+type BFCServiceMessageBuilder struct {
+	messageBuilder[*BFCServiceMessageBuilder]
+}
+
+// NewBFCServiceMessage returns a BFCServiceMessageBuilder of a non-value SSIServiceMessage holding serviceMessage
+func NewBFCServiceMessage(sender *SenderDepositoryInstitution, receiver *ReceiverDepositoryInstitution,
+	serviceMessage *ServiceMessage) *BFCServiceMessageBuilder {
+	b := &BFCServiceMessageBuilder{}
+	b.init(b, FundsTransfer, SSIServiceMessage, BFCServiceMessage, Money{CurrencyCode: "USD"}, sender, receiver)
+	b.fwm.ServiceMessage = serviceMessage
+	return b
+}
+
+// WithTypeCode sets the TypeCode of the TypeSubType tag, such as ForeignTransfer
+func (b *BFCServiceMessageBuilder) WithTypeCode(typeCode string) *BFCServiceMessageBuilder {
+	b.fwm.TypeSubType.TypeCode = typeCode
+	return b
+}
+
+// WithBeneficiary sets the Beneficiary tag
+func (b *BFCServiceMessageBuilder) WithBeneficiary(ben *Beneficiary) *BFCServiceMessageBuilder {
+	b.fwm.Beneficiary = ben
+	return b
+}
+
+// WithOriginator sets the Originator tag
+func (b *BFCServiceMessageBuilder) WithOriginator(o *Originator) *BFCServiceMessageBuilder {
+	b.fwm.Originator = o
+	return b
+}
+
+// WithFIReceiverFI sets the FIReceiverFI tag
+func (b *BFCServiceMessageBuilder) WithFIReceiverFI(firfi *FIReceiverFI) *BFCServiceMessageBuilder {
+	b.fwm.FIReceiverFI = firfi
+	return b
+}
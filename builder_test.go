@@ -0,0 +1,204 @@
+package wire
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mockMoney(t *testing.T) Money {
+	t.Helper()
+	m, err := ParseMoney("USD", "12345.67")
+	require.NoError(t, err)
+	return m
+}
+
+func TestNewCustomerTransfer(t *testing.T) {
+	file, err := NewCustomerTransfer(mockMoney(t), mockSenderDepositoryInstitution(), mockReceiverDepositoryInstitution(),
+		mockOriginator(), mockBeneficiary()).
+		WithIMAD(mockInputMessageAccountabilityData()).
+		WithSenderReference(mockSenderReference()).
+		WithBeneficiaryFI(mockBeneficiaryFI()).
+		WithOriginatorFI(mockOriginatorFI()).
+		WithCharges(mockCharges()).
+		WithFIReceiverFI(mockFIReceiverFI()).
+		Build()
+	require.NoError(t, err)
+	require.Len(t, file.FEDWireMessages, 1)
+
+	fwm := file.FEDWireMessages[0]
+	require.Equal(t, CustomerTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, FundsTransfer+BasicFundsTransfer, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, "000001234567", fwm.Amount.Amount)
+	require.Equal(t, FormatVersion, fwm.SenderSupplied.FormatVersion)
+
+	// the File can be written and read back
+	var buf bytes.Buffer
+	require.NoError(t, NewWriter(&buf).Write(file))
+	read, err := NewReader(strings.NewReader(buf.String())).Read()
+	require.NoError(t, err)
+	require.Len(t, read.FEDWireMessages, 1)
+}
+
+func TestNewCustomerTransfer_errors(t *testing.T) {
+	// the mandatory parties are validated
+	_, err := NewCustomerTransfer(mockMoney(t), mockSenderDepositoryInstitution(), mockReceiverDepositoryInstitution(),
+		nil, mockBeneficiary()).WithIMAD(mockInputMessageAccountabilityData()).Build()
+	require.ErrorIs(t, err, ErrFieldRequired)
+	require.ErrorContains(t, err, "Originator")
+
+	// the amount must be USD
+	eur, err := NewMoney("EUR", 100)
+	require.NoError(t, err)
+	_, err = NewCustomerTransfer(eur, mockSenderDepositoryInstitution(), mockReceiverDepositoryInstitution(),
+		mockOriginator(), mockBeneficiary()).WithIMAD(mockInputMessageAccountabilityData()).Build()
+	require.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	// the TypeSubType must be permitted for the business function code
+	_, err = NewCustomerTransfer(mockMoney(t), mockSenderDepositoryInstitution(), mockReceiverDepositoryInstitution(),
+		mockOriginator(), mockBeneficiary()).WithIMAD(mockInputMessageAccountabilityData()).WithTypeCode("20").Build()
+	require.Error(t, err)
+}
+
+func TestNewCustomerTransferPlus(t *testing.T) {
+	b := NewCustomerTransferPlus(mockMoney(t), mockSenderDepositoryInstitution(), mockReceiverDepositoryInstitution(),
+		mockBeneficiary()).
+		WithIMAD(mockInputMessageAccountabilityData())
+
+	// the originator is mandatory
+	_, err := b.Build()
+	require.ErrorIs(t, err, ErrFieldRequired)
+
+	file, err := b.WithOriginatorOptionF(mockOriginatorOptionF()).
+		WithTypeCode(ForeignTransfer).
+		WithInstructedAmount(mockInstructedAmount()).
+		WithExchangeRate(mockExchangeRate()).
+		Build()
+	require.NoError(t, err)
+	fwm := file.FEDWireMessages[0]
+	require.Equal(t, CustomerTransferPlus, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, ForeignTransfer, fwm.TypeSubType.TypeCode)
+}
+
+func TestNewBankTransfer(t *testing.T) {
+	file, err := NewBankTransfer(mockMoney(t), mockSenderDepositoryInstitution(), mockReceiverDepositoryInstitution()).
+		WithIMAD(mockInputMessageAccountabilityData()).
+		WithTypeCode(SettlementTransfer).
+		WithFIReceiverFI(mockFIReceiverFI()).
+		Build()
+	require.NoError(t, err)
+	require.Equal(t, BankTransfer, file.FEDWireMessages[0].BusinessFunctionCode.BusinessFunctionCode)
+
+	// a Beneficiary identified by a BIC and account is not permitted
+	ben := mockBeneficiary()
+	ben.Personal.IdentificationCode = SWIFTBICORBEIANDAccountNumber
+	ben.Personal.Identifier = "DE89370400440532013000"
+	ben.Personal.Name = "DRESDEFFXXX Name"
+	_, err = NewBankTransfer(mockMoney(t), mockSenderDepositoryInstitution(), mockReceiverDepositoryInstitution()).
+		WithIMAD(mockInputMessageAccountabilityData()).
+		WithBeneficiary(ben).
+		Build()
+	require.ErrorIs(t, err, ErrInvalidProperty)
+}
+
+func TestNewSettlementTransfers(t *testing.T) {
+	builders := map[string]func(Money, *SenderDepositoryInstitution, *ReceiverDepositoryInstitution) *SettlementTransferBuilder{
+		CheckSameDaySettlement: NewCheckSameDaySettlement,
+		DepositSendersAccount:  NewDepositSendersAccount,
+		FEDFundsReturned:       NewFEDFundsReturned,
+		FEDFundsSold:           NewFEDFundsSold,
+	}
+	for code, newBuilder := range builders {
+		file, err := newBuilder(mockMoney(t), mockSenderDepositoryInstitution(), mockReceiverDepositoryInstitution()).
+			WithIMAD(mockInputMessageAccountabilityData()).
+			WithSenderReference(mockSenderReference()).
+			Build()
+		require.NoError(t, err, code)
+		fwm := file.FEDWireMessages[0]
+		require.Equal(t, code, fwm.BusinessFunctionCode.BusinessFunctionCode)
+		require.Equal(t, SettlementTransfer, fwm.TypeSubType.TypeCode)
+	}
+}
+
+func TestNewDrawdownRequest(t *testing.T) {
+	file, err := NewDrawdownRequest(mockMoney(t), mockSenderDepositoryInstitution(), mockReceiverDepositoryInstitution(),
+		mockBeneficiary(), mockAccountDebitedDrawdown(), mockAccountCreditedDrawdown()).
+		WithIMAD(mockInputMessageAccountabilityData()).
+		WithFIDrawdownDebitAccountAdvice(mockFIDrawdownDebitAccountAdvice()).
+		Build()
+	require.NoError(t, err)
+	fwm := file.FEDWireMessages[0]
+	require.Equal(t, CustomerCorporateDrawdownRequest, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, FundsTransfer+RequestCredit, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+
+	_, err = NewDrawdownRequest(mockMoney(t), mockSenderDepositoryInstitution(), mockReceiverDepositoryInstitution(),
+		mockBeneficiary(), nil, mockAccountCreditedDrawdown()).
+		WithIMAD(mockInputMessageAccountabilityData()).
+		Build()
+	require.ErrorIs(t, err, ErrFieldRequired)
+}
+
+func TestNewBankDrawdownRequest(t *testing.T) {
+	file, err := NewBankDrawdownRequest(mockMoney(t), mockSenderDepositoryInstitution(), mockReceiverDepositoryInstitution(),
+		mockAccountDebitedDrawdown(), mockAccountCreditedDrawdown()).
+		WithIMAD(mockInputMessageAccountabilityData()).
+		Build()
+	require.NoError(t, err)
+	fwm := file.FEDWireMessages[0]
+	require.Equal(t, BankDrawDownRequest, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, SettlementTransfer+RequestCredit, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+}
+
+func TestNewDrawdownResponseBuilder(t *testing.T) {
+	request := mockDrawdownRequest(t)
+	file, err := NewDrawdownResponseBuilder(request, PartialAmount(Money{CurrencyCode: "USD", MinorUnits: 100000})).
+		WithIMAD(mockInputMessageAccountabilityData()).
+		WithFIReceiverFI(mockFIReceiverFI()).
+		Build()
+	require.NoError(t, err)
+	fwm := file.FEDWireMessages[0]
+	require.Equal(t, DrawdownResponse, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, FundsTransfer+FundsTransferRequestCredit, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, "000000100000", fwm.Amount.Amount)
+
+	// Build checks the response against the request
+	o := mockOriginator()
+	o.Personal.Identifier = "987654321"
+	_, err = NewDrawdownResponseBuilder(request).
+		WithIMAD(mockInputMessageAccountabilityData()).
+		WithOriginator(o).
+		Build()
+	require.ErrorIs(t, err, ErrDrawdownRequestMismatch)
+
+	// and the rules of the DrawdownResponse business function code
+	_, err = NewDrawdownResponseBuilder(request).
+		WithIMAD(mockInputMessageAccountabilityData()).
+		WithBeneficiary(nil).
+		Build()
+	require.ErrorIs(t, err, ErrFieldRequired)
+
+	_, err = NewDrawdownResponseBuilder(request, PartialAmount(Money{CurrencyCode: "USD", MinorUnits: 1234568})).Build()
+	require.ErrorIs(t, err, ErrDrawdownAmountExceeded)
+}
+
+func TestNewBFCServiceMessage(t *testing.T) {
+	file, err := NewBFCServiceMessage(mockSenderDepositoryInstitution(), mockReceiverDepositoryInstitution(), mockServiceMessage()).
+		WithIMAD(mockInputMessageAccountabilityData()).
+		Build()
+	require.NoError(t, err)
+	fwm := file.FEDWireMessages[0]
+	require.Equal(t, BFCServiceMessage, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "000000000000", fwm.Amount.Amount)
+}
+
+func TestMessageBuilder_WithValidateOptions(t *testing.T) {
+	b := NewBankTransfer(mockMoney(t), mockSenderDepositoryInstitution(), mockReceiverDepositoryInstitution())
+	_, err := b.Build()
+	require.ErrorIs(t, err, ErrFieldRequired)
+
+	file, err := b.WithValidateOptions(&ValidateOpts{SkipMandatoryIMAD: true}).Build()
+	require.NoError(t, err)
+	require.True(t, file.FEDWireMessages[0].ValidateOptions.SkipMandatoryIMAD)
+}
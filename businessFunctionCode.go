@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // BusinessFunctionCode {3600}
@@ -48,7 +50,7 @@ func (bfc *BusinessFunctionCode) Parse(record string) error {
 
 	value, read, err := bfc.parseVariableStringField(record[length:], 3)
 	if err != nil {
-		return fieldError("TransactionTypeCode", err)
+		return fieldErrorAt(length, "TransactionTypeCode", err)
 	}
 	bfc.TransactionTypeCode = value
 	length += read
@@ -94,32 +96,36 @@ func (bfc *BusinessFunctionCode) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on BusinessFunctionCode and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (bfc *BusinessFunctionCode) Validate() error {
-	if err := bfc.fieldInclusion(); err != nil {
-		return err
-	}
+	return bfc.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on BusinessFunctionCode and returns every error found.
+// Only the first error for each field is returned.
+func (bfc *BusinessFunctionCode) ValidateAll() base.ErrorList {
+	errs := bfc.fieldInclusion()
 	if bfc.tag != TagBusinessFunctionCode {
-		return fieldError("tag", ErrValidTagForType, bfc.tag)
+		addFieldError(&errs, TagBusinessFunctionCode, fieldError("tag", ErrValidTagForType, bfc.tag))
 	}
 	if err := bfc.isBusinessFunctionCode(bfc.BusinessFunctionCode); err != nil {
-		return fieldError("BusinessFunctionCode", err, bfc.BusinessFunctionCode)
+		addFieldError(&errs, TagBusinessFunctionCode, fieldError("BusinessFunctionCode", err, bfc.BusinessFunctionCode))
 	}
 	if err := bfc.isTransactionTypeCode(bfc.TransactionTypeCode); err != nil {
-		return fieldError("TransactionTypeCode", err, bfc.TransactionTypeCode)
+		addFieldError(&errs, TagBusinessFunctionCode, fieldError("TransactionTypeCode", err, bfc.TransactionTypeCode))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (bfc *BusinessFunctionCode) fieldInclusion() error {
+func (bfc *BusinessFunctionCode) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 
 	// only BusinessFunctionCode is required
 	if bfc.BusinessFunctionCode == "" {
-		return fieldError("BusinessFunctionCode", ErrFieldRequired, bfc.BusinessFunctionCode)
+		addFieldError(&errs, TagBusinessFunctionCode, fieldError("BusinessFunctionCode", ErrFieldRequired, bfc.BusinessFunctionCode))
 	}
-	return nil
+	return errs
 }
 
 // BusinessFunctionCodeField gets a string of the BusinessFunctionCode field
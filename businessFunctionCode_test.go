@@ -67,7 +67,7 @@ func TestParseBusinessFunctionCodeReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("BusinessFunctionCode", ErrBusinessFunctionCode, "CTA")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("BusinessFunctionCode", ErrBusinessFunctionCode, "CTA"))).Error()
 	require.EqualError(t, err, expected)
 }
 
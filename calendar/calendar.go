@@ -0,0 +1,155 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package calendar holds the business days and operating hours of the Fedwire Funds Service.
+//
+// A business day, or cycle date, is a weekday which is not a Federal Reserve holiday. The Funds Service opens for a
+// business day at 9:00 p.m. Eastern time on the preceding calendar day, and accepts customer transfers until 6:45
+// p.m. and all other transfers until it closes at 7:00 p.m. on the business day. Dates are the calendar day of a
+// time in its own location, so a CCYYMMDD date parsed in any time zone is the same business day.
+package calendar
+
+import (
+	"time"
+	// tzdata is embedded so EasternTime loads on systems without a time zone database
+	_ "time/tzdata"
+
+	"github.com/rickar/cal/v2"
+	"github.com/rickar/cal/v2/us"
+)
+
+// EasternTime is the America/New_York time zone of the operating hours of the Fedwire Funds Service
+var EasternTime = mustLoadLocation("America/New_York")
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+// Operating hours of the Fedwire Funds Service, as times of day in Eastern time
+const (
+	// Opening is when the Funds Service opens for a business day, on the preceding calendar day
+	Opening = 21 * time.Hour
+	// CustomerTransferCutoff is the last time customer transfers (CTR and CTP) are accepted on a business day
+	CustomerTransferCutoff = 18*time.Hour + 45*time.Minute
+	// BankTransferCutoff is the last time bank transfers (BTR) and every other transfer are accepted on a business
+	// day, when the Funds Service closes
+	BankTransferCutoff = 19 * time.Hour
+)
+
+// Business function codes with their own cutoff
+const (
+	customerTransfer     = "CTR"
+	customerTransferPlus = "CTP"
+)
+
+// fedObserved moves a holiday falling on a Sunday to the following Monday. Unlike other federal holidays, a
+// holiday falling on a Saturday is not observed on the preceding Friday, which is a business day.
+var fedObserved = []cal.AltDay{
+	{Day: time.Sunday, Offset: 1},
+}
+
+// Holidays are the Federal Reserve holidays on which the Fedwire Funds Service is closed
+var Holidays = []*cal.Holiday{
+	us.NewYear.Clone(&cal.Holiday{Observed: fedObserved}),
+	us.MlkDay,
+	us.PresidentsDay,
+	us.MemorialDay,
+	us.Juneteenth.Clone(&cal.Holiday{Observed: fedObserved}),
+	us.IndependenceDay.Clone(&cal.Holiday{Observed: fedObserved}),
+	us.LaborDay,
+	us.ColumbusDay,
+	us.VeteransDay.Clone(&cal.Holiday{Observed: fedObserved}),
+	us.ThanksgivingDay,
+	us.ChristmasDay.Clone(&cal.Holiday{Observed: fedObserved}),
+}
+
+// fedwire is the business calendar of the Fedwire Funds Service. It is not Cacheable, so it is safe to use from
+// multiple goroutines.
+var fedwire = newBusinessCalendar()
+
+func newBusinessCalendar() *cal.BusinessCalendar {
+	c := cal.NewBusinessCalendar()
+	c.Name = "Fedwire Funds Service"
+	c.AddHoliday(Holidays...)
+	return c
+}
+
+// date returns midnight Eastern time of the calendar day of t in its own location
+func date(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, EasternTime)
+}
+
+// at returns the time of day clock in Eastern time on the calendar day of d. It uses the wall clock, so it is not
+// moved by the change to or from daylight saving time.
+func at(d time.Time, clock time.Duration) time.Time {
+	year, month, day := d.Date()
+	return time.Date(year, month, day, int(clock/time.Hour), int(clock%time.Hour/time.Minute), 0, 0, EasternTime)
+}
+
+// clockOf returns the time of day of t on the wall clock
+func clockOf(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+}
+
+// IsBusinessDay returns true when the date is a Fedwire Funds Service business day
+func IsBusinessDay(d time.Time) bool {
+	return fedwire.IsWorkday(date(d))
+}
+
+// IsHoliday returns true when the date is a Federal Reserve holiday, or the Monday on which a holiday falling on a
+// Sunday is observed. A holiday falling on a Saturday is not observed on the preceding Friday.
+func IsHoliday(d time.Time) bool {
+	actual, observed, _ := fedwire.IsHoliday(date(d))
+	return actual || observed
+}
+
+// NextBusinessDay returns the first business day after the date, at midnight Eastern time
+func NextBusinessDay(d time.Time) time.Time {
+	next := date(d).AddDate(0, 0, 1)
+	for !fedwire.IsWorkday(next) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// Cutoff returns the time of day in Eastern time after which transfers of the business function code are no longer
+// accepted on a business day
+func Cutoff(businessFunctionCode string) time.Duration {
+	switch businessFunctionCode {
+	case customerTransfer, customerTransferPlus:
+		return CustomerTransferCutoff
+	default:
+		return BankTransferCutoff
+	}
+}
+
+// CycleDate returns the business day, at midnight Eastern time, on which a transfer of the business function code
+// sent at t is processed. A transfer sent after its cutoff, while the Funds Service is closed or on a day which is
+// not a business day is processed on the next business day. A blank business function code has the
+// BankTransferCutoff.
+func CycleDate(t time.Time, businessFunctionCode string) time.Time {
+	t = t.In(EasternTime)
+	d := date(t)
+	if clockOf(t) > Cutoff(businessFunctionCode) || !fedwire.IsWorkday(d) {
+		return NextBusinessDay(d)
+	}
+	return d
+}
+
+// OpeningTime returns when the Funds Service opens for the cycle date, at 9:00 p.m. Eastern time on the preceding
+// calendar day
+func OpeningTime(cycleDate time.Time) time.Time {
+	return at(date(cycleDate).AddDate(0, 0, -1), Opening)
+}
+
+// CutoffTime returns the last time transfers of the business function code are accepted on the cycle date
+func CutoffTime(cycleDate time.Time, businessFunctionCode string) time.Time {
+	return at(cycleDate, Cutoff(businessFunctionCode))
+}
@@ -0,0 +1,111 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, EasternTime)
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	tests := []struct {
+		date time.Time
+		want bool
+	}{
+		{day(2023, time.January, 16), false}, // Martin Luther King Jr. Day
+		{day(2023, time.February, 20), false},
+		{day(2023, time.May, 29), false},
+		{day(2023, time.July, 4), false},
+		{day(2023, time.September, 4), false},
+		{day(2023, time.October, 9), false},
+		{day(2023, time.November, 23), false},
+		{day(2023, time.November, 24), true}, // the day after Thanksgiving is a business day
+		{day(2023, time.December, 25), false},
+		{day(2023, time.July, 8), false}, // Saturday
+		{day(2023, time.July, 9), false}, // Sunday
+		{day(2023, time.July, 10), true},
+		// holidays falling on a Sunday are observed on the following Monday
+		{day(2022, time.June, 20), false},
+		{day(2022, time.December, 26), false},
+		{day(2023, time.January, 2), false},
+		// holidays falling on a Saturday are not observed on the preceding Friday
+		{day(2021, time.December, 24), true},
+		{day(2021, time.December, 31), true},
+		{day(2023, time.November, 10), true},
+		// Juneteenth is a holiday from 2021
+		{day(2020, time.June, 19), true},
+		{day(2023, time.June, 19), false},
+		// the calendar day of a date is in its own location
+		{time.Date(2023, time.July, 4, 23, 0, 0, 0, time.UTC), false},
+		{time.Date(2023, time.July, 5, 1, 0, 0, 0, time.UTC), true},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, IsBusinessDay(tt.date), tt.date.String())
+	}
+}
+
+func TestIsHoliday(t *testing.T) {
+	require.True(t, IsHoliday(day(2023, time.July, 4)))
+	require.True(t, IsHoliday(day(2022, time.June, 20)))
+	require.True(t, IsHoliday(day(2022, time.June, 19)))
+	require.True(t, IsHoliday(day(2023, time.November, 11)))
+	require.False(t, IsHoliday(day(2023, time.November, 10)))
+	require.False(t, IsHoliday(day(2023, time.July, 8)))
+}
+
+func TestNextBusinessDay(t *testing.T) {
+	require.Equal(t, day(2023, time.July, 5), NextBusinessDay(day(2023, time.July, 3)))
+	require.Equal(t, day(2023, time.July, 10), NextBusinessDay(day(2023, time.July, 7)))
+	require.Equal(t, day(2023, time.January, 3), NextBusinessDay(day(2022, time.December, 30)))
+}
+
+func TestCutoff(t *testing.T) {
+	require.Equal(t, CustomerTransferCutoff, Cutoff("CTR"))
+	require.Equal(t, CustomerTransferCutoff, Cutoff("CTP"))
+	require.Equal(t, BankTransferCutoff, Cutoff("BTR"))
+	require.Equal(t, BankTransferCutoff, Cutoff("DRW"))
+	require.Equal(t, BankTransferCutoff, Cutoff(""))
+}
+
+func TestCycleDate(t *testing.T) {
+	tests := []struct {
+		sent time.Time
+		code string
+		want time.Time
+	}{
+		{time.Date(2023, time.July, 3, 10, 0, 0, 0, EasternTime), "CTR", day(2023, time.July, 3)},
+		{time.Date(2023, time.July, 3, 18, 45, 0, 0, EasternTime), "CTR", day(2023, time.July, 3)},
+		// customer transfers after their cutoff are for the next business day
+		{time.Date(2023, time.July, 3, 18, 50, 0, 0, EasternTime), "CTP", day(2023, time.July, 5)},
+		{time.Date(2023, time.July, 3, 18, 50, 0, 0, EasternTime), "BTR", day(2023, time.July, 3)},
+		{time.Date(2023, time.July, 3, 19, 0, 1, 0, EasternTime), "BTR", day(2023, time.July, 5)},
+		// the Funds Service opens at 9:00 p.m. on the preceding calendar day
+		{time.Date(2023, time.July, 4, 21, 0, 0, 0, EasternTime), "", day(2023, time.July, 5)},
+		{time.Date(2023, time.July, 5, 1, 0, 0, 0, time.UTC), "", day(2023, time.July, 5)},
+		// weekends and holidays are for the next business day
+		{time.Date(2023, time.July, 8, 12, 0, 0, 0, EasternTime), "CTR", day(2023, time.July, 10)},
+		{time.Date(2023, time.July, 9, 22, 0, 0, 0, EasternTime), "CTR", day(2023, time.July, 10)},
+		{time.Date(2023, time.July, 4, 9, 0, 0, 0, EasternTime), "BTR", day(2023, time.July, 5)},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, CycleDate(tt.sent, tt.code), tt.sent.String()+" "+tt.code)
+	}
+}
+
+func TestOperatingTimes(t *testing.T) {
+	// the Monday after daylight saving time starts
+	cycleDate := day(2023, time.March, 13)
+	require.Equal(t, time.Date(2023, time.March, 12, 21, 0, 0, 0, EasternTime), OpeningTime(cycleDate))
+	require.Equal(t, time.Date(2023, time.March, 13, 18, 45, 0, 0, EasternTime), CutoffTime(cycleDate, "CTR"))
+	require.Equal(t, time.Date(2023, time.November, 5, 19, 0, 0, 0, EasternTime),
+		CutoffTime(day(2023, time.November, 5), "BTR"))
+	require.Equal(t, day(2023, time.March, 13), CycleDate(OpeningTime(cycleDate), ""))
+}
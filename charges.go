@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // Charges is the Charges of the wire
@@ -62,28 +64,28 @@ func (c *Charges) Parse(record string) error {
 
 	value, read, err := c.parseVariableStringField(record[length:], 15)
 	if err != nil {
-		return fieldError("SendersChargesOne", err)
+		return fieldErrorAt(length, "SendersChargesOne", err)
 	}
 	c.SendersChargesOne = value
 	length += read
 
 	value, read, err = c.parseVariableStringField(record[length:], 15)
 	if err != nil {
-		return fieldError("SendersChargesTwo", err)
+		return fieldErrorAt(length, "SendersChargesTwo", err)
 	}
 	c.SendersChargesTwo = value
 	length += read
 
 	value, read, err = c.parseVariableStringField(record[length:], 15)
 	if err != nil {
-		return fieldError("SendersChargesThree", err)
+		return fieldErrorAt(length, "SendersChargesThree", err)
 	}
 	c.SendersChargesThree = value
 	length += read
 
 	value, read, err = c.parseVariableStringField(record[length:], 15)
 	if err != nil {
-		return fieldError("SendersChargesFour", err)
+		return fieldErrorAt(length, "SendersChargesFour", err)
 	}
 	c.SendersChargesFour = value
 	length += read
@@ -136,44 +138,47 @@ func (c *Charges) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on Charges and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (c *Charges) Validate() error {
-	if err := c.fieldInclusion(); err != nil {
-		return err
-	}
+	return c.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on Charges and returns every error found.
+// Only the first error for each field is returned.
+func (c *Charges) ValidateAll() base.ErrorList {
+	errs := c.fieldInclusion()
 	if err := c.isChargeDetails(c.ChargeDetails); err != nil {
-		return fieldError("ChargeDetails", ErrChargeDetails, c.ChargeDetails)
+		addFieldError(&errs, TagCharges, fieldError("ChargeDetails", ErrChargeDetails, c.ChargeDetails))
 	}
 	if err := c.isAlphanumeric(c.SendersChargesOne); err != nil {
-		return fieldError("SendersChargesOne", err, c.SendersChargesOne)
+		addFieldError(&errs, TagCharges, fieldError("SendersChargesOne", err, c.SendersChargesOne))
 	}
 	/*	if err := c.validateCharges(c.SendersChargesOne); err != nil {
 		return fieldError("SendersChargesOne", err, c.SendersChargesOne)
 	}*/
 	if err := c.isAlphanumeric(c.SendersChargesTwo); err != nil {
-		return fieldError("SendersChargesTwo", err, c.SendersChargesTwo)
+		addFieldError(&errs, TagCharges, fieldError("SendersChargesTwo", err, c.SendersChargesTwo))
 	}
 	/*	if err := c.validateCharges(c.SendersChargesTwo); err != nil {
 		return fieldError("SendersChargesTwo", err, c.SendersChargesTwo)
 	}*/
 	if err := c.isAlphanumeric(c.SendersChargesThree); err != nil {
-		return fieldError("SendersChargesThree", err, c.SendersChargesThree)
+		addFieldError(&errs, TagCharges, fieldError("SendersChargesThree", err, c.SendersChargesThree))
 	}
 	/*	if err := c.validateCharges(c.SendersChargesThree); err != nil {
 		return fieldError("SendersChargesThree", err, c.SendersChargesThree)
 	}*/
 	if err := c.isAlphanumeric(c.SendersChargesFour); err != nil {
-		return fieldError("SendersChargesFour", err, c.SendersChargesFour)
+		addFieldError(&errs, TagCharges, fieldError("SendersChargesFour", err, c.SendersChargesFour))
 	}
 	/*	if err := c.validateCharges(c.SendersChargesFour); err != nil {
 		return fieldError("SendersChargesFour", err, c.SendersChargesFour)
 	}*/
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (c *Charges) fieldInclusion() error {
+func (c *Charges) fieldInclusion() base.ErrorList {
 	return nil
 }
 
@@ -221,3 +226,45 @@ func (c *Charges) FormatSendersChargesThree(options FormatOptions) string {
 func (c *Charges) FormatSendersChargesFour(options FormatOptions) string {
 	return c.formatAlphaField(c.SendersChargesFour, 15, options)
 }
+
+// SendersCharges returns the sender's charges which are set, in order, as Money
+func (c *Charges) SendersCharges() ([]Money, error) {
+	var charges []Money
+	for _, s := range []string{c.SendersChargesOne, c.SendersChargesTwo, c.SendersChargesThree, c.SendersChargesFour} {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if len(s) < 4 {
+			return nil, fieldError("SendersCharges", ErrNonAmount, s)
+		}
+		m, err := ParseMoney(s[:3], s[3:])
+		if err != nil {
+			return nil, err
+		}
+		charges = append(charges, m)
+	}
+	return charges, nil
+}
+
+// SetSendersCharges sets the sender's charges to up to four charges, writing each as its currency code followed
+// by its amount with a decimal comma. Sender's charges which are not given are cleared.
+func (c *Charges) SetSendersCharges(charges ...Money) error {
+	if len(charges) > 4 {
+		return fieldError("SendersCharges", ErrAmountRange, len(charges))
+	}
+	values := make([]string, 4)
+	for i, m := range charges {
+		m, err := NewMoney(m.CurrencyCode, m.MinorUnits)
+		if err != nil {
+			return err
+		}
+		amount, err := formatMoney(m, ",", 12)
+		if err != nil {
+			return err
+		}
+		values[i] = m.CurrencyCode + amount
+	}
+	c.SendersChargesOne, c.SendersChargesTwo, c.SendersChargesThree, c.SendersChargesFour = values[0], values[1], values[2], values[3]
+	return nil
+}
@@ -90,3 +90,34 @@ func TestStringChargesOptions(t *testing.T) {
 	require.Equal(t, record.Format(FormatOptions{VariableLengthFields: true}), "{3700}B*")
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
+
+func TestCharges_SendersCharges(t *testing.T) {
+	c := mockCharges()
+	charges, err := c.SendersCharges()
+	require.NoError(t, err)
+	require.Equal(t, []Money{
+		{CurrencyCode: "USD", MinorUnits: 99},
+		{CurrencyCode: "USD", MinorUnits: 299},
+		{CurrencyCode: "USD", MinorUnits: 399},
+		{CurrencyCode: "USD", MinorUnits: 100},
+	}, charges)
+
+	require.NoError(t, c.SetSendersCharges(Money{CurrencyCode: "EUR", MinorUnits: 123456}, Money{CurrencyCode: "JPY", MinorUnits: 500}))
+	require.Equal(t, "EUR1234,56", c.SendersChargesOne)
+	require.Equal(t, "JPY500,", c.SendersChargesTwo)
+	require.Empty(t, c.SendersChargesThree)
+	require.Empty(t, c.SendersChargesFour)
+	require.NoError(t, c.Validate())
+
+	charges, err = c.SendersCharges()
+	require.NoError(t, err)
+	require.Len(t, charges, 2)
+
+	usd := Money{CurrencyCode: "USD", MinorUnits: 1}
+	require.ErrorIs(t, c.SetSendersCharges(usd, usd, usd, usd, usd), ErrAmountRange)
+	require.ErrorIs(t, c.SetSendersCharges(Money{CurrencyCode: "USD", MinorUnits: 100000000000}), ErrAmountRange)
+
+	c.SendersChargesOne = "USD"
+	_, err = c.SendersCharges()
+	require.ErrorIs(t, err, ErrNonAmount)
+}
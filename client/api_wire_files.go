@@ -112,6 +112,10 @@ type CreateWireFileOpts struct {
 	XRequestID                 optional.String
 	SkipMandatoryIMAD          optional.Bool
 	AllowMissingSenderSupplied optional.Bool
+	AllowUnknownTags           optional.Bool
+	SkipTagSequenceCheck       optional.Bool
+	CheckBusinessDay           optional.Bool
+	SkipRoutingNumberCheck     optional.Bool
 }
 
 /*
@@ -123,6 +127,10 @@ Upload a new Wire file, or create one from JSON. When uploading a file, query pa
   - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
   - @param "SkipMandatoryIMAD" (optional.Bool) -  Optional flag to skip mandatory IMAD validation
   - @param "AllowMissingSenderSupplied" (optional.Bool) -  Optional flag to allow SenderSupplied to be nil, which is generally the case in incoming files.
+  - @param "AllowUnknownTags" (optional.Bool) -  Optional flag to keep tags which are not part of the Fedwire format, such as vendor specific tags, instead of rejecting the file.
+  - @param "SkipTagSequenceCheck" (optional.Bool) -  Optional flag to accept tags which are out of FAIM order or repeated. The last value read for a repeated tag is kept.
+  - @param "CheckBusinessDay" (optional.Bool) -  Optional flag to reject files whose IMAD cycle date is not a Fedwire Funds Service business day, such as a weekend or Federal Reserve holiday.
+  - @param "SkipRoutingNumberCheck" (optional.Bool) -  Optional flag to skip checking the ABA check digit of routing numbers, such as the sender and receiver ABA numbers and Fed routing number identifiers.
 
 @return WireFile
 */
@@ -149,6 +157,18 @@ func (a *WireFilesApiService) CreateWireFile(ctx _context.Context, wireFile Wire
 	if localVarOptionals != nil && localVarOptionals.AllowMissingSenderSupplied.IsSet() {
 		localVarQueryParams.Add("allowMissingSenderSupplied", parameterToString(localVarOptionals.AllowMissingSenderSupplied.Value(), ""))
 	}
+	if localVarOptionals != nil && localVarOptionals.AllowUnknownTags.IsSet() {
+		localVarQueryParams.Add("allowUnknownTags", parameterToString(localVarOptionals.AllowUnknownTags.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.SkipTagSequenceCheck.IsSet() {
+		localVarQueryParams.Add("skipTagSequenceCheck", parameterToString(localVarOptionals.SkipTagSequenceCheck.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.CheckBusinessDay.IsSet() {
+		localVarQueryParams.Add("checkBusinessDay", parameterToString(localVarOptionals.CheckBusinessDay.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.SkipRoutingNumberCheck.IsSet() {
+		localVarQueryParams.Add("skipRoutingNumberCheck", parameterToString(localVarOptionals.SkipRoutingNumberCheck.Value(), ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{"application/json", "text/plain"}
 
@@ -303,6 +323,102 @@ func (a *WireFilesApiService) DeleteWireFileByID(ctx _context.Context, fileID st
 	return localVarHTTPResponse, nil
 }
 
+// GetErrorWireCategoriesOpts Optional parameters for the method 'GetErrorWireCategories'
+type GetErrorWireCategoriesOpts struct {
+	XRequestID optional.String
+}
+
+/*
+GetErrorWireCategories List error categories
+List the catalog of Fedwire reject error categories of ErrorWire, with whether a rejected message can be resubmitted and how to remedy the error.
+  - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @param optional nil or *GetErrorWireCategoriesOpts - Optional Parameters:
+  - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+
+@return []ErrorWireCategory
+*/
+func (a *WireFilesApiService) GetErrorWireCategories(ctx _context.Context, localVarOptionals *GetErrorWireCategoriesOpts) ([]ErrorWireCategory, *_nethttp.Response, error) {
+	var (
+		localVarHTTPMethod   = _nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  []ErrorWireCategory
+	)
+
+	// create path and map variables
+	localVarPath := a.client.cfg.BasePath + "/errorWire/categories"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := _neturl.Values{}
+	localVarFormParams := _neturl.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if localVarOptionals != nil && localVarOptionals.XRequestID.IsSet() {
+		localVarHeaderParams["X-Request-ID"] = parameterToString(localVarOptionals.XRequestID.Value(), "")
+	}
+	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(r)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := _ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 200 {
+			var v []ErrorWireCategory
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 // GetWireFileByIDOpts Optional parameters for the method 'GetWireFileByID'
 type GetWireFileByIDOpts struct {
 	XRequestID optional.String
@@ -416,7 +532,7 @@ Assembles the existing file, computes sequence numbers and totals. Returns plain
   - @param fileID File ID
   - @param optional nil or *GetWireFileContentsOpts - Optional Parameters:
   - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
-  - @param "Format" (optional.String) -  Optional file type to get file as fixed length or variable length type
+  - @param "Format" (optional.String) -  Optional file type to get file as fixed length or variable length type, or as source to write the file in the format it was read in
   - @param "Newline" (optional.Bool) -  Optional new line flag to have new line or no new line
 
 @return string
@@ -0,0 +1,24 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ErrorWireCategory struct for ErrorWireCategory
+type ErrorWireCategory struct {
+	// ErrorCategory of ErrorWire
+	Category string `json:"category,omitempty"`
+	// Description of the error category
+	Description string `json:"description,omitempty"`
+	// The message can be sent again once the error is remedied
+	Resubmittable bool `json:"resubmittable,omitempty"`
+	// How to remedy the error
+	Remediation string `json:"remediation,omitempty"`
+	// Error codes of the category
+	Codes []ErrorWireCode `json:"codes,omitempty"`
+}
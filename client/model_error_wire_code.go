@@ -0,0 +1,24 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ErrorWireCode struct for ErrorWireCode
+type ErrorWireCode struct {
+	// ErrorCategory of ErrorWire
+	Category string `json:"category,omitempty"`
+	// ErrorCode of ErrorWire
+	Code string `json:"code,omitempty"`
+	// Description of the error
+	Description string `json:"description,omitempty"`
+	// The message can be sent again once the error is remedied
+	Resubmittable bool `json:"resubmittable,omitempty"`
+	// Tag the error refers to, or blank when it refers to the message as a whole
+	Tag string `json:"tag,omitempty"`
+}
@@ -13,6 +13,7 @@ package openapi
 type FedWireMessage struct {
 	// Fedwire Message ID
 	ID                              string                          `json:"ID,omitempty"`
+	InterfaceHeader                 *InterfaceHeader                `json:"interfaceHeader,omitempty"`
 	MessageDisposition              MessageDisposition              `json:"messageDisposition,omitempty"`
 	ReceiptTimeStamp                ReceiptTimeStamp                `json:"receiptTimeStamp,omitempty"`
 	OutputMessageAccountabilityData OutputMessageAccountabilityData `json:"outputMessageAccountabilityData,omitempty"`
@@ -73,5 +74,7 @@ type FedWireMessage struct {
 	SecondaryRemittanceDocument     SecondaryRemittanceDocument     `json:"secondaryRemittanceDocument,omitempty"`
 	RemittanceFreeText              RemittanceFreeText              `json:"remittanceFreeText,omitempty"`
 	ServiceMessage                  ServiceMessage                  `json:"serviceMessage,omitempty"`
-	ValidateOptions                 *ValidateOptions                `json:"validateOptions,omitempty"`
+	// Tags which are not part of the Fedwire format, in the order they were read
+	UnknownTags     []UnknownTag     `json:"unknownTags,omitempty"`
+	ValidateOptions *ValidateOptions `json:"validateOptions,omitempty"`
 }
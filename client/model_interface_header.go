@@ -0,0 +1,26 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// InterfaceHeader Interface data read before the tags of a message, such as the routing and control data added by FedLine or a vendor interface. The header read before the first message is the file's interfaceHeader.
+type InterfaceHeader struct {
+	// Layout of the header
+	Layout string `json:"layout"`
+	// ApplicationID of the FAIM layout
+	ApplicationID string `json:"applicationID,omitempty"`
+	// SequenceNumber of the FAIM layout
+	SequenceNumber string `json:"sequenceNumber,omitempty"`
+	// TerminalID of the FAIM layout
+	TerminalID string `json:"terminalID,omitempty"`
+	// Fields of the VENDOR layout, separated by * when written
+	Fields []string `json:"fields,omitempty"`
+	// Header of the RAW layout, kept as read
+	Data string `json:"data,omitempty"`
+}
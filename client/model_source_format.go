@@ -0,0 +1,20 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// SourceFormat How the file was encoded when it was read. Files are written in their source format with format=source.
+type SourceFormat struct {
+	// Line break which followed tags, or empty when tags were not separated by line breaks
+	NewlineCharacter string `json:"newlineCharacter,omitempty"`
+	// True when any tag was read with variable length fields
+	VariableLengthFields bool `json:"variableLengthFields,omitempty"`
+	// Format of each tag read, by the index of its message in fedWireMessages
+	Tags [][]TagFormat `json:"tags,omitempty"`
+}
@@ -0,0 +1,18 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// TagFormat How a tag was encoded when it was read
+type TagFormat struct {
+	// Tag such as {3100}
+	Tag string `json:"tag"`
+	// True when the tag's fields were delimited rather than fixed-width
+	VariableLength bool `json:"variableLength"`
+}
@@ -0,0 +1,20 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// UnknownTag struct for UnknownTag
+type UnknownTag struct {
+	// Tag which is not part of the Fedwire format
+	Tag string `json:"tag"`
+	// Raw contents following the tag
+	Value string `json:"value"`
+	// Tag read before this one, used to write the tag back in its original position
+	After string `json:"after,omitempty"`
+}
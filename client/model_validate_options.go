@@ -15,4 +15,12 @@ type ValidateOptions struct {
 	SkipMandatoryIMAD bool `json:"skipMandatoryIMAD,omitempty"`
 	// Allow FedWireMessage.SenderSupplied to be nil
 	AllowMissingSenderSupplied bool `json:"allowMissingSenderSupplied,omitempty"`
+	// Keep tags which are not part of the Fedwire format as FedWireMessage.unknownTags
+	AllowUnknownTags bool `json:"allowUnknownTags,omitempty"`
+	// Skip checking that tags are in FAIM order and are not repeated
+	SkipTagSequenceCheck bool `json:"skipTagSequenceCheck,omitempty"`
+	// Check that the InputMessageAccountabilityData (IMAD) cycle date is a Fedwire Funds Service business day
+	CheckBusinessDay bool `json:"checkBusinessDay,omitempty"`
+	// Skip checking the ABA check digit of routing numbers
+	SkipRoutingNumberCheck bool `json:"skipRoutingNumberCheck,omitempty"`
 }
@@ -12,6 +12,9 @@ package openapi
 // WireFile struct for WireFile
 type WireFile struct {
 	// File ID
-	ID             string         `json:"ID,omitempty"`
-	FedWireMessage FedWireMessage `json:"fedWireMessage"`
+	ID              string           `json:"ID,omitempty"`
+	InterfaceHeader *InterfaceHeader `json:"interfaceHeader,omitempty"`
+	// Fedwire messages contained in the file. This replaces the single `fedWireMessage` object of earlier releases, which is no longer written. Files with a `fedWireMessage` are still read, as the first of their `fedWireMessages`.
+	FedWireMessages []FedWireMessage `json:"fedWireMessages"`
+	SourceFormat    *SourceFormat    `json:"sourceFormat,omitempty"`
 }
@@ -0,0 +1,32 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorWire_getErrorWireCategories(t *testing.T) {
+	router := mux.NewRouter()
+	addErrorWireRoutes(log.NewNopLogger(), router)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/errorWire/categories", nil)
+	router.ServeHTTP(w, req)
+	w.Flush()
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	var categories []wire.ErrorWireCategory
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&categories))
+	require.Equal(t, wire.ErrorWireCategories(), categories)
+}
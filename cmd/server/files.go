@@ -38,9 +38,9 @@ var (
 	errNoFEDWireMessageID = errors.New("no FEDWireMessage ID found")
 )
 
-func addFileRoutes(logger log.Logger, r *mux.Router, repo WireFileRepository) {
+func addFileRoutes(logger log.Logger, r *mux.Router, repo WireFileRepository, imads *imadAssigner) {
 	r.Methods("GET").Path("/files").HandlerFunc(getFiles(logger, repo))
-	r.Methods("POST").Path("/files/create").HandlerFunc(createFile(logger, repo))
+	r.Methods("POST").Path("/files/create").HandlerFunc(createFile(logger, repo, imads))
 	r.Methods("GET").Path("/files/{fileId}").HandlerFunc(getFile(logger, repo))
 	r.Methods("DELETE").Path("/files/{fileId}").HandlerFunc(deleteFile(logger, repo))
 	r.Methods("GET").Path("/files/{fileId}/contents").HandlerFunc(getFileContents(logger, repo))
@@ -89,7 +89,7 @@ func getFiles(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 	}
 }
 
-func createFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+func createFile(logger log.Logger, repo WireFileRepository, imads *imadAssigner) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if requestID := moovhttp.GetRequestID(r); requestID != "" {
 			logger = logger.Set("requestID", log.String(requestID))
@@ -105,13 +105,13 @@ func createFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 				return
 			}
 
-			if err := file.Validate(); err != nil {
+			if err := imads.validate(file); err != nil {
 				err = logger.LogErrorf("file validation failed: %v", err).Err()
 				moovhttp.Problem(w, err)
 				return
 			}
 		} else {
-			f, err := wire.NewReader(r.Body).ReadWithOpts(validateOptsFromQuery(r.URL.Query()))
+			f, err := imads.read(r.Body, validateOptsFromQuery(r.URL.Query()))
 			if err != nil {
 				err = logger.LogErrorf("error reading file: %v", err).Err()
 				moovhttp.Problem(w, err)
@@ -120,6 +120,12 @@ func createFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 			file = &f
 		}
 
+		if err := imads.assign(file); err != nil {
+			err = logger.LogErrorf("problem assigning IMADs: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+
 		if file.ID == "" {
 			file.ID = base.ID()
 		}
@@ -290,18 +296,61 @@ func validateFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 			return
 		}
 
-		logger.Log("validated file")
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(http.StatusOK)
-
 		type response struct {
-			Error error `json:"error"`
+			Error  error             `json:"error"`
+			Errors []validationError `json:"errors,omitempty"`
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		if errs := file.ValidateAll(); !errs.Empty() {
+			logger.LogErrorf("file was invalid: %v", errs)
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(&response{Error: errs, Errors: validationErrors(errs)})
+			return
 		}
 
+		logger.Log("validated file")
+		w.WriteHeader(http.StatusOK)
+
 		json.NewEncoder(w).Encode(&response{Error: nil})
 	}
 }
 
+// validationError describes one rule a File failed when validated
+type validationError struct {
+	FEDWireMessage *int        `json:"fedWireMessage,omitempty"`
+	Tag            string      `json:"tag,omitempty"`
+	FieldName      string      `json:"fieldName,omitempty"`
+	Value          interface{} `json:"value,omitempty"`
+	Rule           string      `json:"rule"`
+	Message        string      `json:"message"`
+}
+
+func validationErrors(errs base.ErrorList) []validationError {
+	out := make([]validationError, 0, len(errs))
+	for _, err := range errs {
+		ve := validationError{
+			Rule:    err.Error(),
+			Message: err.Error(),
+		}
+		var msgErr wire.FEDWireMessageError
+		if errors.As(err, &msgErr) {
+			index := msgErr.Index
+			ve.FEDWireMessage = &index
+			ve.Rule = msgErr.Err.Error()
+		}
+		var fieldErr *wire.FieldError
+		if errors.As(err, &fieldErr) {
+			ve.Tag = fieldErr.Tag
+			ve.FieldName = fieldErr.FieldName
+			ve.Value = fieldErr.Value
+			ve.Rule = fieldErr.Err.Error()
+		}
+		out = append(out, ve)
+	}
+	return out
+}
+
 func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if requestID := moovhttp.GetRequestID(r); requestID != "" {
@@ -337,7 +386,7 @@ func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository) http.Ha
 			return
 		}
 
-		file.FEDWireMessage = file.AddFEDWireMessage(req)
+		file.AddFEDWireMessage(req)
 		if err := repo.saveFile(file); err != nil {
 			err = logger.LogErrorf("error saving file: %v", err).Err()
 			moovhttp.Problem(w, err)
@@ -353,6 +402,7 @@ func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository) http.Ha
 
 // GetWriter returns a new Writer based on request param `type` that writes to w.
 // query param `format`=variable - we set VariableLengthFields to `true`
+// query param `format`=source - we write the file in the SourceFormat it was read in
 // query param `newline`=false - we set NewlineCharacter to ""
 // no query param - writer defaults to fixed-length fields and use "\n" for NewlineCharacter.
 func GetWriter(w io.Writer, r *http.Request) (*wire.Writer, error) {
@@ -371,6 +421,9 @@ func GetWriter(w io.Writer, r *http.Request) (*wire.Writer, error) {
 
 	// check for query param `type`. if "variable" then update VariableLengthFields OptionFunc to true
 	fileType := queryParams.Get("format")
+	if fileType == "source" {
+		return wire.NewWriter(w, wire.PreserveSourceFormat(true)), nil
+	}
 	if fileType == "variable" {
 		lengthFormatOption = wire.VariableLengthFields(true)
 	}
@@ -402,11 +455,19 @@ func validateOptsFromQuery(query url.Values) (opts *wire.ValidateOpts) {
 	const (
 		skipMandatoryIMAD          = "skipMandatoryIMAD"
 		allowMissingSenderSupplied = "allowMissingSenderSupplied"
+		allowUnknownTags           = "allowUnknownTags"
+		skipTagSequenceCheck       = "skipTagSequenceCheck"
+		checkBusinessDay           = "checkBusinessDay"
+		skipRoutingNumberCheck     = "skipRoutingNumberCheck"
 	)
 
 	validationNames := []string{
 		skipMandatoryIMAD,
 		allowMissingSenderSupplied,
+		allowUnknownTags,
+		skipTagSequenceCheck,
+		checkBusinessDay,
+		skipRoutingNumberCheck,
 	}
 
 	for _, param := range validationNames {
@@ -420,6 +481,14 @@ func validateOptsFromQuery(query url.Values) (opts *wire.ValidateOpts) {
 				opts.SkipMandatoryIMAD = true
 			case allowMissingSenderSupplied:
 				opts.AllowMissingSenderSupplied = true
+			case allowUnknownTags:
+				opts.AllowUnknownTags = true
+			case skipTagSequenceCheck:
+				opts.SkipTagSequenceCheck = true
+			case checkBusinessDay:
+				opts.CheckBusinessDay = true
+			case skipRoutingNumberCheck:
+				opts.SkipRoutingNumberCheck = true
 			}
 		}
 	}
@@ -49,7 +49,7 @@ func TestFiles_getFiles(t *testing.T) {
 		},
 	}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil)
 	req := httptest.NewRequest("GET", "/files", nil)
 
 	t.Run("retrieves file", func(t *testing.T) {
@@ -87,10 +87,10 @@ func readFile(filename string) (*wire.File, error) {
 func TestFiles_createWithInterfaceData(t *testing.T) {
 	router := mux.NewRouter()
 	repo := &testWireFileRepository{}
-	addFileRoutes(log.NewTestLogger(), router, repo)
+	addFileRoutes(log.NewTestLogger(), router, repo, nil)
 
 	w := httptest.NewRecorder()
-	raw := `FTI0811 XFT811  {1500}30        T {1510}1000{1520}20220128DOVTAL3C000001{2000}000000010000{3100}123456789DOVETAIL BANK US F*{3320}XX22012800000051*{3400}021000089CITIBANK NYC*{3600}CTP{3620}3*3AC4C307-0FFB-4028-BD8E-53D55BDB90E1*{3700}SUSD0,*{4200}D000100002*{5000}T000100011*DRESDEFFXXX*`
+	raw := `FTI0811 XFT811  {1500}30        T {1510}1000{1520}20220128DOVTAL3C000001{2000}000000010000{3100}123456780DOVETAIL BANK US F*{3320}XX22012800000051*{3400}021000089CITIBANK NYC*{3600}CTP{3620}3*3AC4C307-0FFB-4028-BD8E-53D55BDB90E1*{3700}SUSD0,*{4200}D000100002*{5000}T000100011*DRESDEFFXXX*`
 	req, err := http.NewRequest(http.MethodPost, "/files/create", bytes.NewReader([]byte(raw)))
 	require.NoError(t, err)
 
@@ -110,6 +110,8 @@ func TestFiles_createWithInterfaceData(t *testing.T) {
 	var file wire.File
 	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &file))
 	require.Equal(t, created.ID, file.ID)
+	require.NotNil(t, file.InterfaceHeader)
+	require.Equal(t, "XFT811", file.InterfaceHeader.TerminalID)
 
 	// retrieve the file contents
 	w = httptest.NewRecorder()
@@ -119,6 +121,7 @@ func TestFiles_createWithInterfaceData(t *testing.T) {
 	require.Equal(t, http.StatusOK, w.Code, w.Body)
 	contents := w.Body.String()
 	expectedTags := []string{"{1500}", "{1510}", "{1520}", "{2000}", "{3100}", "{3320}", "{3400}", "{3600}", "{3620}", "{3700}", "{4200}", "{5000}"}
+	require.True(t, strings.HasPrefix(contents, "FTI0811 XFT811  \n"), contents)
 	for _, tag := range expectedTags {
 		require.Contains(t, contents, tag)
 	}
@@ -130,7 +133,7 @@ func TestFiles_createFile(t *testing.T) {
 	req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs))
 	repo := &testWireFileRepository{}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil)
 
 	t.Run("creates file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -142,7 +145,7 @@ func TestFiles_createFile(t *testing.T) {
 		var resp wire.File
 		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
 		assert.NotEmpty(t, resp.ID)
-		assert.NotNil(t, resp.FEDWireMessage.FIAdditionalFIToFI)
+		assert.NotNil(t, resp.FEDWireMessages[0].FIAdditionalFIToFI)
 	})
 
 	t.Run("repo error", func(t *testing.T) {
@@ -159,7 +162,7 @@ func TestFiles_createFile(t *testing.T) {
 func TestFiles_createFileJSON(t *testing.T) {
 	repo := &testWireFileRepository{}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil)
 
 	t.Run("creates file from JSON", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -176,7 +179,7 @@ func TestFiles_createFileJSON(t *testing.T) {
 		var resp wire.File
 		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
 		assert.NotEmpty(t, resp.ID)
-		assert.NotNil(t, resp.FEDWireMessage.FIAdditionalFIToFI)
+		assert.NotNil(t, resp.FEDWireMessages[0].FIAdditionalFIToFI)
 	})
 
 	t.Run("creates file from JSON", func(t *testing.T) {
@@ -194,8 +197,8 @@ func TestFiles_createFileJSON(t *testing.T) {
 		var resp wire.File
 		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
 		assert.NotEmpty(t, resp.ID)
-		assert.NotEmpty(t, resp.FEDWireMessage)
-		assert.Nil(t, resp.FEDWireMessage.ValidateOptions)
+		assert.NotEmpty(t, resp.FEDWireMessages)
+		assert.Nil(t, resp.FEDWireMessages[0].ValidateOptions)
 	})
 
 	t.Run("invalid JSON", func(t *testing.T) {
@@ -213,7 +216,7 @@ func TestFiles_createFileJSON(t *testing.T) {
 func TestFiles_createFile_missingSenderSupplied(t *testing.T) {
 	repo := &testWireFileRepository{}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil)
 
 	// set up a message with no SenderSupplied field
 	fwm := mockFEDWireMessage()
@@ -228,21 +231,21 @@ func TestFiles_createFile_missingSenderSupplied(t *testing.T) {
 	require.Contains(t, resp.Body.String(), "SenderSupplied")
 
 	// create from JSON, using validation options, should succeed without sender supplied
-	file.FEDWireMessage.ValidateOptions = &wire.ValidateOpts{
+	file.FEDWireMessages[0].ValidateOptions = &wire.ValidateOpts{
 		AllowMissingSenderSupplied: true,
 	}
 	resp, uploaded := routerUploadJSON(t, router, file)
 	require.Equal(t, http.StatusCreated, resp.Code, resp.Body)
 	assert.NotEmpty(t, uploaded.ID)
-	assert.Nil(t, uploaded.FEDWireMessage.SenderSupplied)
+	assert.Nil(t, uploaded.FEDWireMessages[0].SenderSupplied)
 
 	// make sure the file was saved
 	resp, found := routerGetFile(t, router, uploaded.ID)
 	require.Equal(t, http.StatusOK, resp.Code, resp.Body)
 	assert.Equal(t, uploaded.ID, found.ID)
-	assert.Nil(t, found.FEDWireMessage.SenderSupplied)
-	assert.NotNil(t, found.FEDWireMessage.ValidateOptions)
-	assert.True(t, found.FEDWireMessage.ValidateOptions.AllowMissingSenderSupplied)
+	assert.Nil(t, found.FEDWireMessages[0].SenderSupplied)
+	assert.NotNil(t, found.FEDWireMessages[0].ValidateOptions)
+	assert.True(t, found.FEDWireMessages[0].ValidateOptions.AllowMissingSenderSupplied)
 
 	// get file contents calls Validate()
 	// if isIncoming was passed properly, then the file should be valid
@@ -256,15 +259,15 @@ func TestFiles_createFile_missingSenderSupplied(t *testing.T) {
 	)
 	require.Equal(t, http.StatusCreated, resp.Code, resp.Body)
 	assert.NotEmpty(t, rawUpload.ID)
-	assert.Nil(t, rawUpload.FEDWireMessage.SenderSupplied)
-	assert.NotNil(t, rawUpload.FEDWireMessage.ValidateOptions)
-	assert.True(t, rawUpload.FEDWireMessage.ValidateOptions.AllowMissingSenderSupplied)
+	assert.Nil(t, rawUpload.FEDWireMessages[0].SenderSupplied)
+	assert.NotNil(t, rawUpload.FEDWireMessages[0].ValidateOptions)
+	assert.True(t, rawUpload.FEDWireMessages[0].ValidateOptions.AllowMissingSenderSupplied)
 
 	// get new file
 	resp, found = routerGetFile(t, router, rawUpload.ID)
 	require.Equal(t, http.StatusOK, resp.Code, resp.Body)
 	assert.Equal(t, rawUpload.ID, found.ID)
-	assert.Nil(t, found.FEDWireMessage.SenderSupplied)
+	assert.Nil(t, found.FEDWireMessages[0].SenderSupplied)
 
 	// get new file contents
 	resp = routerGetFileContents(t, router, rawUpload.ID)
@@ -350,7 +353,7 @@ func TestFiles_getFile(t *testing.T) {
 		},
 	}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil)
 
 	t.Run("gets file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -390,7 +393,7 @@ func TestFiles_deleteFile(t *testing.T) {
 	req := httptest.NewRequest("DELETE", "/files/foo", nil)
 	repo := &testWireFileRepository{}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil)
 
 	t.Run("deletes file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -418,12 +421,12 @@ func TestFiles_getFileContents(t *testing.T) {
 	fwm := mockFEDWireMessage()
 	repo := &testWireFileRepository{
 		file: &wire.File{
-			ID:             base.ID(),
-			FEDWireMessage: fwm,
+			ID:              base.ID(),
+			FEDWireMessages: []wire.FEDWireMessage{fwm},
 		},
 	}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil)
 
 	t.Run("gets file contents", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -461,12 +464,12 @@ func TestFiles_getFileContentsWithFormatAndNewLineQueryParams(t *testing.T) {
 	fwm := mockFEDWireMessage()
 	repo := &testWireFileRepository{
 		file: &wire.File{
-			ID:             base.ID(),
-			FEDWireMessage: fwm,
+			ID:              base.ID(),
+			FEDWireMessages: []wire.FEDWireMessage{fwm},
 		},
 	}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil)
 
 	// test with no format no newline=false
 	req := httptest.NewRequest("GET", "/files/foo/contents", nil)
@@ -533,13 +536,32 @@ func TestFiles_getFileContentsWithFormatAndNewLineQueryParams(t *testing.T) {
 	})
 }
 
+func TestFiles_getFileContentsWithSourceFormat(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-BankTransfer.txt"))
+	require.NoError(t, err)
+	file, err := wire.NewReader(bytes.NewReader(bs)).Read()
+	require.NoError(t, err)
+
+	repo := &testWireFileRepository{file: &file}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil)
+
+	req := httptest.NewRequest("GET", "/files/foo/contents?format=source", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	w.Flush()
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, string(bs), w.Body.String())
+}
+
 func TestFiles_validateFile(t *testing.T) {
 	req := httptest.NewRequest("GET", "/files/foo/validate", nil)
 	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
 	require.NoError(t, err)
 	repo := &testWireFileRepository{file: f}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil)
 
 	t.Run("validates file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -550,6 +572,40 @@ func TestFiles_validateFile(t *testing.T) {
 		assert.Contains(t, w.Body.String(), `{"error":null}`)
 	})
 
+	t.Run("invalid file", func(t *testing.T) {
+		invalid, err := readFile("fedWireMessage-CustomerTransfer.txt")
+		require.NoError(t, err)
+		invalid.FEDWireMessages[0].Amount.Amount = "00000012345Z"
+		invalid.FEDWireMessages[0].Beneficiary = nil
+		repo := &testWireFileRepository{file: invalid}
+		router := mux.NewRouter()
+		addFileRoutes(log.NewNopLogger(), router, repo, nil)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		w.Flush()
+
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+
+		var resp struct {
+			Error  string            `json:"error"`
+			Errors []validationError `json:"errors"`
+		}
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		require.Len(t, resp.Errors, 2)
+		require.Contains(t, resp.Error, "Amount 00000012345Z is an incorrect amount format")
+
+		require.Equal(t, 0, *resp.Errors[0].FEDWireMessage)
+		require.Equal(t, wire.TagAmount, resp.Errors[0].Tag)
+		require.Equal(t, "Amount", resp.Errors[0].FieldName)
+		require.Equal(t, "00000012345Z", resp.Errors[0].Value)
+		require.Equal(t, wire.ErrNonAmount.Error(), resp.Errors[0].Rule)
+
+		require.Equal(t, wire.TagBeneficiary, resp.Errors[1].Tag)
+		require.Equal(t, "Beneficiary", resp.Errors[1].FieldName)
+		require.Equal(t, wire.ErrFieldRequired.Error(), resp.Errors[1].Rule)
+	})
+
 	t.Run("repo error", func(t *testing.T) {
 		w := httptest.NewRecorder()
 		repo.err = errors.New("bad error")
@@ -578,7 +634,7 @@ func TestFiles_addFEDWireMessageToFile(t *testing.T) {
 	fwm := mockFEDWireMessage()
 	repo := &testWireFileRepository{file: f}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil)
 
 	t.Run("adds message to file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -592,7 +648,7 @@ func TestFiles_addFEDWireMessageToFile(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code, w.Body)
 		var out wire.File
 		require.NoError(t, json.NewDecoder(w.Body).Decode(&out))
-		assert.NotNil(t, out.FEDWireMessage.SenderSupplied)
+		assert.NotNil(t, out.FEDWireMessages[0].SenderSupplied)
 	})
 
 	t.Run("repo error", func(t *testing.T) {
@@ -631,13 +687,13 @@ func TestFiles_addFEDWireMessageToFile(t *testing.T) {
 	repo := &testWireFileRepository{file: f}
 
 	FEDWireMessageID := base.ID()
-	repo.file.FEDWireMessage.ID = FedWireMessageID
+	repo.file.FEDWireMessages[0].ID = FedWireMessageID
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest("DELETE", fmt.Sprintf("/files/foo/FEDWireMessage/%s", FEDWireMessageID), nil)
 
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil)
 	router.ServeHTTP(w, req)
 	w.Flush()
 
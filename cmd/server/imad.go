@@ -0,0 +1,104 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io"
+
+	"github.com/moov-io/wire"
+)
+
+// imadAssigner assigns InputMessageAccountabilityData from its generator to the FEDWireMessages of created files
+// which have none. A nil imadAssigner assigns none.
+type imadAssigner struct {
+	generator   *wire.IMADGenerator
+	inputSource string
+}
+
+// newIMADAssigner returns an imadAssigner of IMADs of the input source whose sequences are kept in the file at
+// storePath, or in memory when storePath is blank. It returns nil when the input source is blank.
+func newIMADAssigner(inputSource, storePath string) *imadAssigner {
+	if inputSource == "" {
+		return nil
+	}
+	var store wire.IMADStore = wire.NewMemoryIMADStore()
+	if storePath != "" {
+		store = wire.NewFileIMADStore(storePath)
+	}
+	return &imadAssigner{
+		generator:   wire.NewIMADGenerator(store),
+		inputSource: inputSource,
+	}
+}
+
+// needsIMAD returns true when fwm has no InputMessageAccountabilityData for assign to keep
+func needsIMAD(fwm *wire.FEDWireMessage) bool {
+	imad := fwm.InputMessageAccountabilityData
+	return imad == nil || imad.InputCycleDate+imad.InputSource+imad.InputSequenceNumber == ""
+}
+
+// read reads a file from body, validating everything except the InputMessageAccountabilityData which assign sets.
+// A nil imadAssigner validates all of the file.
+func (a *imadAssigner) read(body io.Reader, opts *wire.ValidateOpts) (wire.File, error) {
+	if a == nil {
+		return wire.NewReader(body).ReadWithOpts(opts)
+	}
+	if opts == nil {
+		opts = &wire.ValidateOpts{}
+	}
+	// the reader shares opts with the file, so restoring it restores the file's validation
+	skip := opts.SkipMandatoryIMAD
+	opts.SkipMandatoryIMAD = true
+	defer func() { opts.SkipMandatoryIMAD = skip }()
+
+	return wire.NewReader(body).ReadWithOpts(opts)
+}
+
+// validate validates file except for the InputMessageAccountabilityData which assign sets, so a file which is
+// rejected does not use up input sequence numbers. A nil imadAssigner validates all of file.
+func (a *imadAssigner) validate(file *wire.File) error {
+	if a == nil {
+		return file.Validate()
+	}
+	saved := make([]*wire.ValidateOpts, len(file.FEDWireMessages))
+	for i := range file.FEDWireMessages {
+		fwm := &file.FEDWireMessages[i]
+		saved[i] = fwm.ValidateOptions
+		if !needsIMAD(fwm) {
+			continue
+		}
+		opts := wire.ValidateOpts{}
+		if fwm.ValidateOptions != nil {
+			opts = *fwm.ValidateOptions
+		}
+		opts.SkipMandatoryIMAD = true
+		fwm.ValidateOptions = &opts
+	}
+	err := file.Validate()
+	for i := range file.FEDWireMessages {
+		file.FEDWireMessages[i].ValidateOptions = saved[i]
+	}
+	return err
+}
+
+// assign sets the InputMessageAccountabilityData of each FEDWireMessage of file which has none. It is called once
+// the file is read and validated, so only accepted files use up input sequence numbers.
+func (a *imadAssigner) assign(file *wire.File) error {
+	if a == nil {
+		return nil
+	}
+	for i := range file.FEDWireMessages {
+		fwm := &file.FEDWireMessages[i]
+		if !needsIMAD(fwm) {
+			continue
+		}
+		imad, err := a.generator.Next(a.inputSource)
+		if err != nil {
+			return err
+		}
+		fwm.InputMessageAccountabilityData = imad
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIMADAssigner(t *testing.T) {
+	require.Nil(t, newIMADAssigner("", ""))
+	require.NoError(t, newIMADAssigner("", "").assign(wire.NewFile()))
+
+	path := filepath.Join(t.TempDir(), "imad.json")
+	a := newIMADAssigner("Source08", path)
+
+	file := wire.NewFile()
+	file.AddFEDWireMessage(wire.FEDWireMessage{})
+	file.AddFEDWireMessage(wire.FEDWireMessage{InputMessageAccountabilityData: wire.NewInputMessageAccountabilityData()})
+	kept := wire.NewInputMessageAccountabilityData()
+	kept.InputCycleDate, kept.InputSource, kept.InputSequenceNumber = "20190410", "Source09", "000001"
+	file.AddFEDWireMessage(wire.FEDWireMessage{InputMessageAccountabilityData: kept})
+	require.NoError(t, a.assign(file))
+
+	first, second := file.FEDWireMessages[0].InputMessageAccountabilityData, file.FEDWireMessages[1].InputMessageAccountabilityData
+	require.Equal(t, "Source08", first.InputSource)
+	require.Equal(t, "000001", first.InputSequenceNumber)
+	require.Equal(t, "000002", second.InputSequenceNumber)
+	require.Equal(t, kept, file.FEDWireMessages[2].InputMessageAccountabilityData)
+
+	_, err := os.Stat(path)
+	require.NoError(t, err)
+
+	file = wire.NewFile()
+	file.AddFEDWireMessage(wire.FEDWireMessage{})
+	require.Error(t, newIMADAssigner("Source{}", "").assign(file))
+}
+
+func TestFiles_createFileAssignsIMAD(t *testing.T) {
+	repo := &testWireFileRepository{}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, newIMADAssigner("Source08", ""))
+
+	bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-BankTransfer.json"))
+	require.NoError(t, err)
+	var file wire.File
+	require.NoError(t, json.Unmarshal(bs, &file))
+	file.FEDWireMessages[0].InputMessageAccountabilityData = nil
+	bs, err = json.Marshal(file)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs))
+	req.Header.Set("content-type", "application/json")
+	router.ServeHTTP(w, req)
+	w.Flush()
+
+	require.Equal(t, http.StatusCreated, w.Code, w.Body)
+	var resp wire.File
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	imad := resp.FEDWireMessages[0].InputMessageAccountabilityData
+	require.NotNil(t, imad)
+	require.Equal(t, "Source08", imad.InputSource)
+	require.Equal(t, "000001", imad.InputSequenceNumber)
+}
+
+func TestFiles_createFileAssignsIMADToRawFile(t *testing.T) {
+	repo := &testWireFileRepository{}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, newIMADAssigner("Source08", ""))
+
+	bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-BankTransfer.txt"))
+	require.NoError(t, err)
+	var lines []string
+	for _, line := range strings.Split(string(bs), "\n") {
+		if !strings.HasPrefix(line, "{1520}") {
+			lines = append(lines, line)
+		}
+	}
+	raw := strings.Join(lines, "\n")
+
+	// a file which is rejected does not use up an input sequence number
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/files/create", strings.NewReader(strings.Replace(raw, "{3600}BTR", "{3600}XXX", 1)))
+	router.ServeHTTP(w, req)
+	w.Flush()
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/files/create", strings.NewReader(raw))
+	router.ServeHTTP(w, req)
+	w.Flush()
+
+	require.Equal(t, http.StatusCreated, w.Code, w.Body)
+	var resp wire.File
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	fwm := resp.FEDWireMessages[0]
+	require.NotNil(t, fwm.InputMessageAccountabilityData)
+	require.Equal(t, "Source08", fwm.InputMessageAccountabilityData.InputSource)
+	require.Equal(t, "000001", fwm.InputMessageAccountabilityData.InputSequenceNumber)
+	// the IMAD is only skipped while reading
+	require.False(t, fwm.ValidateOptions.SkipMandatoryIMAD)
+}
+
+func TestFiles_createFileRejectedKeepsIMADSequence(t *testing.T) {
+	repo := &testWireFileRepository{}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, newIMADAssigner("Source08", ""))
+
+	bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-BankTransfer.json"))
+	require.NoError(t, err)
+	var file wire.File
+	require.NoError(t, json.Unmarshal(bs, &file))
+	file.FEDWireMessages[0].InputMessageAccountabilityData = nil
+
+	create := func(file wire.File) *httptest.ResponseRecorder {
+		bs, err := json.Marshal(file)
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs))
+		req.Header.Set("content-type", "application/json")
+		router.ServeHTTP(w, req)
+		w.Flush()
+		return w
+	}
+
+	invalid := file
+	invalid.FEDWireMessages = []wire.FEDWireMessage{file.FEDWireMessages[0]}
+	bfc := *invalid.FEDWireMessages[0].BusinessFunctionCode
+	bfc.BusinessFunctionCode = "XXX"
+	invalid.FEDWireMessages[0].BusinessFunctionCode = &bfc
+	w := create(invalid)
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+
+	w = create(file)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body)
+	var resp wire.File
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Equal(t, "000001", resp.FEDWireMessages[0].InputMessageAccountabilityData.InputSequenceNumber)
+	require.Nil(t, resp.FEDWireMessages[0].ValidateOptions)
+}
@@ -83,7 +83,8 @@ func main() {
 	router := mux.NewRouter()
 	moovhttp.AddCORSHandler(router)
 	addPingRoute(router)
-	addFileRoutes(logger, router, repo)
+	addFileRoutes(logger, router, repo, newIMADAssigner(os.Getenv("IMAD_INPUT_SOURCE"), os.Getenv("IMAD_STORE_FILE")))
+	addErrorWireRoutes(logger, router)
 
 	// Start business HTTP server
 	readTimeout, _ := time.ParseDuration("30s")
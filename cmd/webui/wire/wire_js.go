@@ -98,8 +98,8 @@ func validateWrapper() js.Func {
 			return msg
 		}
 
-		if err = file.Validate(); err != nil {
-			msg = fmt.Sprintf("invalid wire file - %v", err)
+		if errs := file.ValidateAll(); !errs.Empty() {
+			msg = fmt.Sprintf("invalid wire file - %v", errs)
 		} else {
 			msg = "valid wire file"
 		}
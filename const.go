@@ -146,6 +146,40 @@ const (
 	MessageDuplicationOriginal = " "
 	// MessageDuplicationResend designates a resend of a message
 	MessageDuplicationResend = "P"
+	// MessageDuplicationRetrieval designates the retrieval of an original message, in MessageDisposition {1100}
+	MessageDuplicationRetrieval = "R"
+
+	// MessageStatusIndicator of MessageDisposition {1100}
+
+	// MessageStatusInProcess designates an outgoing message which is in process or intercepted
+	MessageStatusInProcess = "0"
+	// MessageStatusSuccessfulValue designates an outgoing message which was successful with accounting (value)
+	MessageStatusSuccessfulValue = "2"
+	// MessageStatusRejected designates an outgoing message which was rejected due to an error condition
+	MessageStatusRejected = "3"
+	// MessageStatusSuccessfulNonValue designates an outgoing message which was successful without accounting
+	// (non-value)
+	MessageStatusSuccessfulNonValue = "7"
+	// MessageStatusIncomingValue designates an incoming message which was successful with accounting (value)
+	MessageStatusIncomingValue = "N"
+	// MessageStatusIncomingNonValue designates an incoming message which was successful without accounting
+	// (non-value)
+	MessageStatusIncomingNonValue = "S"
+
+	// ErrorCategory of ErrorWire {1130}
+
+	// ErrorCategoryDataError designates a message rejected for a data error
+	ErrorCategoryDataError = "E"
+	// ErrorCategoryInsufficientBalance designates a message rejected for an insufficient balance
+	ErrorCategoryInsufficientBalance = "F"
+	// ErrorCategoryAccountabilityError designates a message rejected for an accountability error
+	ErrorCategoryAccountabilityError = "H"
+	// ErrorCategoryInProcess designates a message which is in process or intercepted
+	ErrorCategoryInProcess = "I"
+	// ErrorCategoryCutoffHourError designates a message rejected for being received after the cutoff hour
+	ErrorCategoryCutoffHourError = "W"
+	// ErrorCategoryCopyMessage designates a copy message, rejected for the IMAD of a message already received
+	ErrorCategoryCopyMessage = "X"
 
 	// TypeCode
 
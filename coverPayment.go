@@ -4,6 +4,8 @@
 
 package wire
 
+import "strings"
+
 // CoverPayment is cover payment data
 type CoverPayment struct {
 	// SwiftFieldTag
@@ -21,3 +23,18 @@ type CoverPayment struct {
 	// SwiftLineSix
 	SwiftLineSix string `json:"swiftLineSix,omitempty"`
 }
+
+// swiftLineNames are the names of the lines of a CoverPayment
+var swiftLineNames = []string{"SwiftLineOne", "SwiftLineTwo", "SwiftLineThree", "SwiftLineFour", "SwiftLineFive", "SwiftLineSix"}
+
+// lines returns SwiftLineOne to SwiftLineSix of cp
+func (cp CoverPayment) lines() []string {
+	return []string{cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree, cp.SwiftLineFour, cp.SwiftLineFive, cp.SwiftLineSix}
+}
+
+// isOptionA returns true when SwiftFieldTag is an option A field of an MT message, such as 52A, whose party is
+// identified by a BIC
+func (cp CoverPayment) isOptionA() bool {
+	tag := strings.Trim(cp.SwiftFieldTag, ": ")
+	return len(tag) == 3 && isDigits(tag[:2]) && tag[2] == 'A'
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // CurrencyInstructedAmount is the currency instructed amount
@@ -48,14 +50,14 @@ func (cia *CurrencyInstructedAmount) Parse(record string) error {
 
 	value, read, err := cia.parseVariableStringField(record[length:], 5)
 	if err != nil {
-		return fieldError("SwiftFieldTag", err)
+		return fieldErrorAt(length, "SwiftFieldTag", err)
 	}
 	cia.SwiftFieldTag = value
 	length += read
 
 	value, read, err = cia.parseVariableStringField(record[length:], 18)
 	if err != nil {
-		return fieldError("Amount", err)
+		return fieldErrorAt(length, "Amount", err)
 	}
 	cia.Amount = value
 	length += read
@@ -101,18 +103,24 @@ func (cia *CurrencyInstructedAmount) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on CurrencyInstructedAmount and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (cia *CurrencyInstructedAmount) Validate() error {
+	return cia.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on CurrencyInstructedAmount and returns every error found.
+// Only the first error for each field is returned.
+func (cia *CurrencyInstructedAmount) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if cia.tag != TagCurrencyInstructedAmount {
-		return fieldError("tag", ErrValidTagForType, cia.tag)
+		addFieldError(&errs, TagCurrencyInstructedAmount, fieldError("tag", ErrValidTagForType, cia.tag))
 	}
 	if err := cia.isAlphanumeric(cia.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, cia.SwiftFieldTag)
+		addFieldError(&errs, TagCurrencyInstructedAmount, fieldError("SwiftFieldTag", err, cia.SwiftFieldTag))
 	}
 	if err := cia.isAmount(cia.Amount); err != nil {
-		return fieldError("Amount", err, cia.Amount)
+		addFieldError(&errs, TagCurrencyInstructedAmount, fieldError("Amount", err, cia.Amount))
 	}
-	return nil
+	return errs
 }
 
 // SwiftFieldTagField gets a string of the SwiftFieldTag field
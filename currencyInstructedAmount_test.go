@@ -50,11 +50,11 @@ func TestParseCurrencyInstructedAmountWrongLength(t *testing.T) {
 
 	err := r.parseCurrencyInstructedAmount()
 
-	require.EqualError(t, err, r.parseError(fieldError("Amount", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(12, "Amount", ErrRequireDelimiter)).Error())
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("Amount", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldErrorAt(12, "Amount", ErrRequireDelimiter))).Error())
 }
 
 // TestParseCurrencyInstructedAmountReaderParseError parses a wrong CurrencyInstructedAmount reader parse error
@@ -69,7 +69,7 @@ func TestParseCurrencyInstructedAmountReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("Amount", ErrNonAmount, "00000000Z001500,49")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("Amount", ErrNonAmount, "00000000Z001500,49"))).Error())
 }
 
 // TestCurrencyInstructedAmountTagError validates a CurrencyInstructedAmount tag
@@ -7,7 +7,10 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"time"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // DateRemittanceDocument is the date of remittance document
@@ -68,30 +71,44 @@ func (drd *DateRemittanceDocument) String() string {
 }
 
 // Validate performs WIRE format rule checks on DateRemittanceDocument and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (drd *DateRemittanceDocument) Validate() error {
-	if err := drd.fieldInclusion(); err != nil {
-		return err
-	}
+	return drd.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on DateRemittanceDocument and returns every error found.
+// Only the first error for each field is returned.
+func (drd *DateRemittanceDocument) ValidateAll() base.ErrorList {
+	errs := drd.fieldInclusion()
 	if drd.tag != TagDateRemittanceDocument {
-		return fieldError("tag", ErrValidTagForType, drd.tag)
+		addFieldError(&errs, TagDateRemittanceDocument, fieldError("tag", ErrValidTagForType, drd.tag))
 	}
 	if err := drd.validateDate(drd.DateRemittanceDocument); err != nil {
-		return err
+		addFieldError(&errs, TagDateRemittanceDocument, err)
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (drd *DateRemittanceDocument) fieldInclusion() error {
+func (drd *DateRemittanceDocument) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if drd.DateRemittanceDocument == "" {
-		return fieldError("DateRemittanceDocument", ErrFieldRequired)
+		addFieldError(&errs, TagDateRemittanceDocument, fieldError("DateRemittanceDocument", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // DateRemittanceDocumentField gets a string of the DateRemittanceDocument field
 func (drd *DateRemittanceDocument) DateRemittanceDocumentField() string {
 	return drd.alphaField(drd.DateRemittanceDocument, 8)
 }
+
+// Date returns the DateRemittanceDocument at midnight Eastern time
+func (drd *DateRemittanceDocument) Date() (time.Time, error) {
+	return parseDate("DateRemittanceDocument", drd.DateRemittanceDocument)
+}
+
+// SetDate sets the DateRemittanceDocument to the date of t in its location
+func (drd *DateRemittanceDocument) SetDate(t time.Time) {
+	drd.DateRemittanceDocument = formatDate(t)
+}
@@ -55,7 +55,7 @@ func TestParseDateRemittanceDocumentReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(ErrValidDate).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(ErrValidDate)).Error())
 }
 
 // TestDateRemittanceDocumentTagError validates a DateRemittanceDocument tag
@@ -67,3 +67,18 @@ func TestDateRemittanceDocumentTagError(t *testing.T) {
 
 	require.EqualError(t, err, fieldError("tag", ErrValidTagForType, drd.tag).Error())
 }
+
+func TestDateRemittanceDocument_Date(t *testing.T) {
+	drd := NewDateRemittanceDocument()
+	drd.SetDate(time.Date(2019, time.April, 10, 0, 0, 0, 0, time.UTC))
+	require.Equal(t, "20190410", drd.DateRemittanceDocument)
+	require.NoError(t, drd.Validate())
+
+	date, err := drd.Date()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.April, 10, 0, 0, 0, 0, EasternTime), date)
+
+	drd.DateRemittanceDocument = "2019041"
+	_, err = drd.Date()
+	require.ErrorIs(t, err, ErrValidDate)
+}
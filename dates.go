@@ -0,0 +1,72 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"time"
+
+	"github.com/moov-io/wire/calendar"
+)
+
+// EasternTime is the America/New_York time zone of the dates and times of the Fedwire Funds Service
+var EasternTime = calendar.EasternTime
+
+// Layouts of the dates and times of tags
+const (
+	// dateLayout is a CCYYMMDD date, such as the InputCycleDate
+	dateLayout = "20060102"
+	// monthDayTimeLayout is a MMDD date followed by a HHMM time, such as the ReceiptDate and ReceiptTime
+	monthDayTimeLayout = "01021504"
+)
+
+// parseDate returns the CCYYMMDD date value of the field at midnight Eastern time
+func parseDate(field, value string) (time.Time, error) {
+	t, err := time.ParseInLocation(dateLayout, value, EasternTime)
+	if err != nil {
+		return time.Time{}, fieldError(field, ErrValidDate, value)
+	}
+	return t, nil
+}
+
+// formatDate returns the CCYYMMDD date of t in its own location, so a date is not moved to another day by a
+// change of time zone
+func formatDate(t time.Time) string {
+	return t.Format(dateLayout)
+}
+
+// parseMonthDayTime returns the Eastern time of the MMDD date and HHMM time of the field, in the year which puts it
+// nearest to the cycle date
+func parseMonthDayTime(field, date, clock string, cycleDate time.Time) (time.Time, error) {
+	// year 0 is a leap year, so February 29 is accepted
+	t, err := time.ParseInLocation(monthDayTimeLayout, date+clock, EasternTime)
+	if err != nil {
+		return time.Time{}, fieldError(field, ErrValidDate, date+clock)
+	}
+	var nearest time.Time
+	for year := cycleDate.Year() - 1; year <= cycleDate.Year()+1; year++ {
+		candidate := time.Date(year, t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, EasternTime)
+		if candidate.Day() != t.Day() {
+			// February 29 of a year which is not a leap year
+			continue
+		}
+		if nearest.IsZero() || absDuration(candidate.Sub(cycleDate)) < absDuration(nearest.Sub(cycleDate)) {
+			nearest = candidate
+		}
+	}
+	return nearest, nil
+}
+
+// formatMonthDayTime returns the MMDD date and HHMM time of t in Eastern time
+func formatMonthDayTime(t time.Time) (string, string) {
+	value := t.In(EasternTime).Format(monthDayTimeLayout)
+	return value[:4], value[4:]
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
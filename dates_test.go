@@ -0,0 +1,59 @@
+package wire
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEasternTime(t *testing.T) {
+	require.Equal(t, "America/New_York", EasternTime.String())
+
+	_, offset := time.Date(2019, time.January, 10, 12, 0, 0, 0, EasternTime).Zone()
+	require.Equal(t, -5*60*60, offset)
+	_, offset = time.Date(2019, time.July, 10, 12, 0, 0, 0, EasternTime).Zone()
+	require.Equal(t, -4*60*60, offset)
+}
+
+func TestParseMonthDayTime(t *testing.T) {
+	cycleDate := func(s string) time.Time {
+		t.Helper()
+		date, err := parseDate("CycleDate", s)
+		require.NoError(t, err)
+		return date
+	}
+
+	tests := []struct {
+		date, clock, cycleDate string
+		want                   time.Time
+	}{
+		{"0502", "1230", "20190502", time.Date(2019, time.May, 2, 12, 30, 0, 0, EasternTime)},
+		// the funds-transfer business day starts at 9:00 p.m. on the prior calendar day
+		{"1231", "2100", "20200102", time.Date(2019, time.December, 31, 21, 0, 0, 0, EasternTime)},
+		{"0102", "0830", "20191231", time.Date(2020, time.January, 2, 8, 30, 0, 0, EasternTime)},
+		{"0229", "1000", "20200228", time.Date(2020, time.February, 29, 10, 0, 0, 0, EasternTime)},
+		{"0229", "1000", "20190301", time.Date(2020, time.February, 29, 10, 0, 0, 0, EasternTime)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.date+tt.clock+" "+tt.cycleDate, func(t *testing.T) {
+			got, err := parseMonthDayTime("Date", tt.date, tt.clock, cycleDate(tt.cycleDate))
+			require.NoError(t, err)
+			require.True(t, tt.want.Equal(got), got)
+		})
+	}
+
+	_, err := parseMonthDayTime("ReceiptDate", "1302", "1230", cycleDate("20190502"))
+	require.ErrorIs(t, err, ErrValidDate)
+	_, err = parseMonthDayTime("ReceiptDate", "0502", "2460", cycleDate("20190502"))
+	require.ErrorIs(t, err, ErrValidDate)
+}
+
+func TestFormatDate(t *testing.T) {
+	// the date is that of the time's own location, not of Eastern time
+	require.Equal(t, "20190502", formatDate(time.Date(2019, time.May, 2, 0, 0, 0, 0, time.UTC)))
+
+	date, clock := formatMonthDayTime(time.Date(2019, time.May, 2, 2, 30, 0, 0, time.UTC))
+	require.Equal(t, "0501", date)
+	require.Equal(t, "2230", clock)
+}
@@ -0,0 +1,235 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package directory reads the Fedwire Funds Service participants of the Federal Reserve E-Payments Routing
+// Directory (the fedwire directory) from its fixed width or JSON download.
+//
+// The fixed width download has a line of 101 characters for each participant:
+//
+//	Routing number                       9
+//	Telegraphic name                    18
+//	Customer name                       36
+//	State or territory abbreviation      2
+//	City                                25
+//	Funds transfer status                1  Y is eligible, N is ineligible
+//	Funds settlement-only status         1  S is settlement-only
+//	Book-Entry Securities status         1  Y is eligible, N is ineligible
+//	Date of last revision                8  CCYYMMDD, or blank
+package directory
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// LineLength is the length of a participant line of the fixed width download
+const LineLength = 101
+
+var (
+	// ErrLineLength is returned for a line of the fixed width download which is not LineLength characters
+	ErrLineLength = errors.New("line is not 101 characters")
+	// ErrRoutingNumber is returned for a participant whose routing number is not 9 digits
+	ErrRoutingNumber = errors.New("routing number is not 9 digits")
+)
+
+// Participant is a financial institution of the fedwire directory
+type Participant struct {
+	// RoutingNumber is the 9 digit ABA routing number of the participant
+	RoutingNumber string `json:"routingNumber"`
+	// TelegraphicName is the abbreviated name of the participant, used as the ReceiverShortName and
+	// SenderShortName of a FEDWireMessage
+	TelegraphicName string `json:"telegraphicName"`
+	// CustomerName is the full name of the participant
+	CustomerName string `json:"customerName"`
+	// State is the state or territory abbreviation of the participant
+	State string `json:"state"`
+	// City is the city of the participant
+	City string `json:"city"`
+	// FundsTransferEligible is true when the participant can send and receive funds transfers
+	FundsTransferEligible bool `json:"fundsTransferEligible"`
+	// SettlementOnly is true when the participant is a funds settlement-only participant
+	SettlementOnly bool `json:"settlementOnly"`
+	// BookEntrySecuritiesEligible is true when the participant can send and receive book-entry securities transfers
+	BookEntrySecuritiesEligible bool `json:"bookEntrySecuritiesEligible"`
+	// RevisionDate is the CCYYMMDD date of the last revision of the participant
+	RevisionDate string `json:"revisionDate"`
+}
+
+// Directory is the participants of the fedwire directory, by routing number. A Directory is not modified after it is
+// read, so it is safe to use from multiple goroutines.
+type Directory struct {
+	participants    []*Participant
+	byRoutingNumber map[string]*Participant
+}
+
+// NewDirectory returns a Directory of participants. A participant listed more than once is the last one listed.
+func NewDirectory(participants []*Participant) *Directory {
+	d := &Directory{
+		participants:    participants,
+		byRoutingNumber: make(map[string]*Participant, len(participants)),
+	}
+	for _, p := range participants {
+		d.byRoutingNumber[p.RoutingNumber] = p
+	}
+	return d
+}
+
+// Participants returns every participant of the Directory, in the order they were read
+func (d *Directory) Participants() []*Participant {
+	return d.participants
+}
+
+// Lookup returns the participant with the ABA routing number
+func (d *Directory) Lookup(routingNumber string) (*Participant, bool) {
+	if d == nil {
+		return nil, false
+	}
+	p, ok := d.byRoutingNumber[routingNumber]
+	return p, ok
+}
+
+// ReadFile reads the Directory of a fixed width or JSON download saved at path
+func ReadFile(path string) (*Directory, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+// Read reads the Directory of a fixed width or JSON download. A download beginning with { is read as JSON.
+func Read(r io.Reader) (*Directory, error) {
+	br := bufio.NewReader(r)
+	for {
+		b, err := br.Peek(1)
+		if err == io.EOF {
+			return NewDirectory(nil), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !isSpace(b[0]) {
+			if b[0] == '{' {
+				return ReadJSON(br)
+			}
+			return ReadFixedWidth(br)
+		}
+		br.ReadByte()
+	}
+}
+
+// ReadFixedWidth reads the Directory of a fixed width download. Blank lines are skipped.
+func ReadFixedWidth(r io.Reader) (*Directory, error) {
+	var participants []*Participant
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		p, err := parseLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+		participants = append(participants, p)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return NewDirectory(participants), nil
+}
+
+// parseLine parses the participant of a line of the fixed width download. Trailing spaces may be trimmed, as the
+// date of last revision can be blank.
+func parseLine(line string) (*Participant, error) {
+	if len(line) < LineLength-8 || len(line) > LineLength {
+		return nil, ErrLineLength
+	}
+	line += strings.Repeat(" ", LineLength-len(line))
+	p := &Participant{
+		RoutingNumber:               line[0:9],
+		TelegraphicName:             strings.TrimSpace(line[9:27]),
+		CustomerName:                strings.TrimSpace(line[27:63]),
+		State:                       strings.TrimSpace(line[63:65]),
+		City:                        strings.TrimSpace(line[65:90]),
+		FundsTransferEligible:       line[90] == 'Y',
+		SettlementOnly:              line[91] == 'S',
+		BookEntrySecuritiesEligible: line[92] == 'Y',
+		RevisionDate:                strings.TrimSpace(line[93:101]),
+	}
+	if !isRoutingNumber(p.RoutingNumber) {
+		return nil, ErrRoutingNumber
+	}
+	return p, nil
+}
+
+// jsonDirectory is the JSON download of the fedwire directory
+type jsonDirectory struct {
+	FedwireParticipants []jsonParticipant `json:"fedwireParticipants"`
+}
+
+// jsonParticipant is a participant of the JSON download, whose statuses are the characters of the fixed width
+// download
+type jsonParticipant struct {
+	RoutingNumber             string `json:"routingNumber"`
+	TelegraphicName           string `json:"telegraphicName"`
+	CustomerName              string `json:"customerName"`
+	CustomerState             string `json:"customerState"`
+	CustomerCity              string `json:"customerCity"`
+	FundsEligibility          string `json:"fundsEligibility"`
+	FundsSettlementOnlyStatus string `json:"fundsSettlementOnlyStatus"`
+	SecuritiesEligibility     string `json:"securitiesEligibility"`
+	ChangeDate                string `json:"changeDate"`
+}
+
+// ReadJSON reads the Directory of a JSON download
+func ReadJSON(r io.Reader) (*Directory, error) {
+	var download jsonDirectory
+	if err := json.NewDecoder(r).Decode(&download); err != nil {
+		return nil, err
+	}
+	participants := make([]*Participant, 0, len(download.FedwireParticipants))
+	for i, jp := range download.FedwireParticipants {
+		p := &Participant{
+			RoutingNumber:               strings.TrimSpace(jp.RoutingNumber),
+			TelegraphicName:             strings.TrimSpace(jp.TelegraphicName),
+			CustomerName:                strings.TrimSpace(jp.CustomerName),
+			State:                       strings.TrimSpace(jp.CustomerState),
+			City:                        strings.TrimSpace(jp.CustomerCity),
+			FundsTransferEligible:       strings.TrimSpace(jp.FundsEligibility) == "Y",
+			SettlementOnly:              strings.TrimSpace(jp.FundsSettlementOnlyStatus) == "S",
+			BookEntrySecuritiesEligible: strings.TrimSpace(jp.SecuritiesEligibility) == "Y",
+			RevisionDate:                strings.TrimSpace(jp.ChangeDate),
+		}
+		if !isRoutingNumber(p.RoutingNumber) {
+			return nil, fmt.Errorf("participant %d: %w", i, ErrRoutingNumber)
+		}
+		participants = append(participants, p)
+	}
+	return NewDirectory(participants), nil
+}
+
+func isRoutingNumber(s string) bool {
+	if len(s) != 9 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\r' || b == '\n'
+}
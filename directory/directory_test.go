@@ -0,0 +1,98 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package directory
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadFile(t *testing.T) {
+	for _, name := range []string{"fpddir.txt", "fpddir.json"} {
+		d, err := ReadFile(filepath.Join("testdata", name))
+		require.NoError(t, err, name)
+		require.Len(t, d.Participants(), 4, name)
+
+		p, ok := d.Lookup("121042882")
+		require.True(t, ok, name)
+		require.Equal(t, &Participant{
+			RoutingNumber:               "121042882",
+			TelegraphicName:             "WELLS SF",
+			CustomerName:                "WELLS FARGO BANK, NA",
+			State:                       "CA",
+			City:                        "SAN FRANCISCO",
+			FundsTransferEligible:       true,
+			BookEntrySecuritiesEligible: true,
+			RevisionDate:                "20190311",
+		}, p, name)
+
+		p, ok = d.Lookup("231380104")
+		require.True(t, ok, name)
+		require.False(t, p.FundsTransferEligible, name)
+
+		p, ok = d.Lookup("021000021")
+		require.True(t, ok, name)
+		require.True(t, p.SettlementOnly, name)
+		require.Empty(t, p.RevisionDate, name)
+
+		_, ok = d.Lookup("123456780")
+		require.False(t, ok, name)
+	}
+}
+
+func TestReadFile_missing(t *testing.T) {
+	_, err := ReadFile(filepath.Join("testdata", "missing.txt"))
+	require.Error(t, err)
+}
+
+func TestRead(t *testing.T) {
+	d, err := Read(strings.NewReader(""))
+	require.NoError(t, err)
+	require.Empty(t, d.Participants())
+
+	d, err = Read(strings.NewReader("\n  {\"fedwireParticipants\": []}"))
+	require.NoError(t, err)
+	require.Empty(t, d.Participants())
+
+	_, err = Read(strings.NewReader("{\"fedwireParticipants\": [{\"routingNumber\": \"12345\"}]}"))
+	require.ErrorIs(t, err, ErrRoutingNumber)
+
+	_, err = Read(strings.NewReader("{"))
+	require.Error(t, err)
+}
+
+func TestReadFixedWidth(t *testing.T) {
+	// the trailing spaces of a blank revision date may be trimmed
+	line := "011000015FRB BOS           FEDERAL RESERVE BANK OF BOSTON      MABOSTON                   Y Y"
+	d, err := ReadFixedWidth(strings.NewReader(line + "\r\n\r\n"))
+	require.NoError(t, err)
+	require.Len(t, d.Participants(), 1)
+	require.Equal(t, "FRB BOS", d.Participants()[0].TelegraphicName)
+
+	_, err = ReadFixedWidth(strings.NewReader(line + "\n" + line[:50]))
+	require.ErrorIs(t, err, ErrLineLength)
+	require.ErrorContains(t, err, "line 2")
+
+	_, err = ReadFixedWidth(strings.NewReader("01100001A" + line[9:]))
+	require.ErrorIs(t, err, ErrRoutingNumber)
+}
+
+func TestDirectory_Lookup(t *testing.T) {
+	var d *Directory
+	_, ok := d.Lookup("011000015")
+	require.False(t, ok)
+
+	// a participant listed more than once is the last one listed
+	d = NewDirectory([]*Participant{
+		{RoutingNumber: "011000015", TelegraphicName: "OLD"},
+		{RoutingNumber: "011000015", TelegraphicName: "NEW"},
+	})
+	p, ok := d.Lookup("011000015")
+	require.True(t, ok)
+	require.Equal(t, "NEW", p.TelegraphicName)
+}
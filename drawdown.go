@@ -0,0 +1,273 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+
+	"github.com/moov-io/base"
+)
+
+var (
+	// ErrNotDrawdownRequest is returned for a FEDWireMessage answered as a drawdown request which is not a
+	// BankDrawDownRequest or CustomerCorporateDrawdownRequest with the RequestCredit subtype
+	ErrNotDrawdownRequest = errors.New("is not a drawdown request")
+	// ErrDrawdownRequestMismatch is returned for a property of a drawdown response or refusal which does not match the
+	// drawdown request it answers
+	ErrDrawdownRequestMismatch = errors.New("does not match the drawdown request")
+	// ErrDrawdownAmountExceeded is returned for a drawdown response whose Amount is more than the drawdown request
+	ErrDrawdownAmountExceeded = errors.New("exceeds the amount of the drawdown request")
+)
+
+// DrawdownResponseOption sets an optional property of a drawdown response or refusal
+type DrawdownResponseOption func(response *FEDWireMessage) error
+
+// PartialAmount answers a drawdown request with amount, which must be USD and less than the amount requested,
+// rather than the amount requested
+func PartialAmount(amount Money) DrawdownResponseOption {
+	return func(response *FEDWireMessage) error {
+		return response.Amount.SetMoney(amount)
+	}
+}
+
+// NewDrawdownResponse returns the DrawdownResponse (DRW) transferring the funds asked for by a drawdown request, with
+// the FundsTransferRequestCredit subtype. It is sent by the ReceiverDepositoryInstitution of the request back to its
+// SenderDepositoryInstitution, for the Amount requested unless a PartialAmount is given. The Originator is the
+// debited account of the AccountDebitedDrawdown, and the Beneficiary is the Beneficiary of the request, or the
+// AccountCreditedDrawdown when the request has none. The AccountDebitedDrawdown and AccountCreditedDrawdown of the
+// request are carried, and the PreviousMessageIdentifier is the IMAD of the request, or its OMAD when it has no IMAD.
+//
+// The response has no IMAD, so only its consistency with the request is validated. Set
+// ValidateOpts.DrawdownRequest to check the consistency again once it is complete.
+func NewDrawdownResponse(request *FEDWireMessage, opts ...DrawdownResponseOption) (FEDWireMessage, error) {
+	fwm, err := newDrawdownAnswer(request, FundsTransferRequestCredit, DrawdownResponse)
+	if err != nil {
+		return fwm, err
+	}
+
+	if add := request.AccountDebitedDrawdown; add != nil {
+		o := NewOriginator()
+		o.Personal.IdentificationCode = add.IdentificationCode
+		o.Personal.Identifier = add.Identifier
+		o.Personal.Name = add.Name
+		o.Personal.Address = add.Address
+		fwm.Originator = o
+	}
+	if request.Beneficiary == nil && request.AccountCreditedDrawdown != nil {
+		ben := NewBeneficiary()
+		ben.Personal.IdentificationCode = FEDRoutingNumber
+		ben.Personal.Identifier = request.AccountCreditedDrawdown.DrawdownCreditAccountNumber
+		if request.SenderDepositoryInstitution != nil {
+			ben.Personal.Name = request.SenderDepositoryInstitution.SenderShortName
+		}
+		fwm.Beneficiary = ben
+	}
+
+	for _, opt := range opts {
+		if err := opt(&fwm); err != nil {
+			return fwm, err
+		}
+	}
+	if err := fwm.validateDrawdownAnswer(request).Err(); err != nil {
+		return fwm, err
+	}
+	return fwm, nil
+}
+
+// NewDrawdownRefusal returns the refusal to honor a drawdown request, with the RefusalRequestCredit subtype and the
+// business function code of the request. It is sent by the ReceiverDepositoryInstitution of the request back to its
+// SenderDepositoryInstitution, for the Amount requested. The Beneficiary, AccountDebitedDrawdown and
+// AccountCreditedDrawdown of the request are carried, and the PreviousMessageIdentifier is the IMAD of the request,
+// or its OMAD when it has no IMAD.
+//
+// The refusal has no IMAD, so only its consistency with the request is validated. Set
+// ValidateOpts.DrawdownRequest to check the consistency again once it is complete.
+func NewDrawdownRefusal(request *FEDWireMessage, opts ...DrawdownResponseOption) (FEDWireMessage, error) {
+	if request.BusinessFunctionCode == nil {
+		return FEDWireMessage{}, fieldError("BusinessFunctionCode", ErrFieldRequired)
+	}
+	fwm, err := newDrawdownAnswer(request, RefusalRequestCredit, request.BusinessFunctionCode.BusinessFunctionCode)
+	if err != nil {
+		return fwm, err
+	}
+	for _, opt := range opts {
+		if err := opt(&fwm); err != nil {
+			return fwm, err
+		}
+	}
+	if err := fwm.validateDrawdownAnswer(request).Err(); err != nil {
+		return fwm, err
+	}
+	return fwm, nil
+}
+
+// newDrawdownAnswer returns a FEDWireMessage of the subtype and business function code answering the drawdown
+// request, from its receiver back to its sender, with the Amount, Beneficiary, drawdown accounts and
+// PreviousMessageIdentifier of the request
+func newDrawdownAnswer(request *FEDWireMessage, subTypeCode, businessFunctionCode string) (FEDWireMessage, error) {
+	fwm := FEDWireMessage{}
+	if err := request.isDrawdownRequest(); err != nil {
+		return fwm, err
+	}
+	if request.Amount == nil {
+		return fwm, fieldError("Amount", ErrFieldRequired)
+	}
+	pmi, err := previousMessageIdentifierOf(request)
+	if err != nil {
+		return fwm, err
+	}
+
+	fwm.SenderSupplied = NewSenderSupplied()
+	tst := NewTypeSubType()
+	tst.TypeCode = request.TypeSubType.TypeCode
+	tst.SubTypeCode = subTypeCode
+	fwm.TypeSubType = tst
+	amt := NewAmount()
+	amt.Amount = request.Amount.Amount
+	fwm.Amount = amt
+	bfc := NewBusinessFunctionCode()
+	bfc.BusinessFunctionCode = businessFunctionCode
+	fwm.BusinessFunctionCode = bfc
+	fwm.PreviousMessageIdentifier = pmi
+
+	if sdi := request.SenderDepositoryInstitution; sdi != nil {
+		rdi := NewReceiverDepositoryInstitution()
+		rdi.ReceiverABANumber = sdi.SenderABANumber
+		rdi.ReceiverShortName = sdi.SenderShortName
+		fwm.ReceiverDepositoryInstitution = rdi
+	}
+	if rdi := request.ReceiverDepositoryInstitution; rdi != nil {
+		sdi := NewSenderDepositoryInstitution()
+		sdi.SenderABANumber = rdi.ReceiverABANumber
+		sdi.SenderShortName = rdi.ReceiverShortName
+		fwm.SenderDepositoryInstitution = sdi
+	}
+	if request.Beneficiary != nil {
+		ben := *request.Beneficiary
+		fwm.Beneficiary = &ben
+	}
+	if request.AccountDebitedDrawdown != nil {
+		add := *request.AccountDebitedDrawdown
+		fwm.AccountDebitedDrawdown = &add
+	}
+	if request.AccountCreditedDrawdown != nil {
+		acd := *request.AccountCreditedDrawdown
+		fwm.AccountCreditedDrawdown = &acd
+	}
+	return fwm, nil
+}
+
+// isDrawdownRequest returns an error when fwm is not a BankDrawDownRequest or CustomerCorporateDrawdownRequest with
+// the RequestCredit subtype
+func (fwm *FEDWireMessage) isDrawdownRequest() error {
+	if fwm.TypeSubType == nil {
+		return fieldError("TypeSubType", ErrFieldRequired)
+	}
+	if fwm.BusinessFunctionCode == nil {
+		return fieldError("BusinessFunctionCode", ErrFieldRequired)
+	}
+	switch fwm.BusinessFunctionCode.BusinessFunctionCode {
+	case BankDrawDownRequest, CustomerCorporateDrawdownRequest:
+	default:
+		return fieldError("BusinessFunctionCode", ErrNotDrawdownRequest, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	}
+	if fwm.TypeSubType.SubTypeCode != RequestCredit {
+		return fieldError("SubTypeCode", ErrNotDrawdownRequest, fwm.TypeSubType.SubTypeCode)
+	}
+	return nil
+}
+
+// validateDrawdownAnswer checks a DrawdownResponse or refusal is consistent with the drawdown request it answers.
+// It is sent from the receiver of the request back to its sender, with the TypeCode and drawdown accounts of the
+// request and a PreviousMessageIdentifier of the request. A DrawdownResponse is for up to the Amount requested, and
+// a refusal, which has the business function code of the request, is for the Amount requested.
+func (fwm *FEDWireMessage) validateDrawdownAnswer(request *FEDWireMessage) base.ErrorList {
+	var errs base.ErrorList
+	if request == nil {
+		addFieldError(&errs, "", fieldError("DrawdownRequest", ErrFieldRequired))
+		return errs
+	}
+	if err := request.isDrawdownRequest(); err != nil {
+		addFieldError(&errs, "", err)
+		return errs
+	}
+	if fwm.TypeSubType == nil || fwm.BusinessFunctionCode == nil {
+		// reported by mandatoryFields
+		return errs
+	}
+
+	refusal := fwm.TypeSubType.SubTypeCode == RefusalRequestCredit
+	switch {
+	case refusal && fwm.BusinessFunctionCode.BusinessFunctionCode != request.BusinessFunctionCode.BusinessFunctionCode:
+		addFieldError(&errs, TagBusinessFunctionCode, fieldError("BusinessFunctionCode", ErrDrawdownRequestMismatch, fwm.BusinessFunctionCode.BusinessFunctionCode))
+	case !refusal && fwm.BusinessFunctionCode.BusinessFunctionCode != DrawdownResponse:
+		addFieldError(&errs, TagBusinessFunctionCode, fieldError("BusinessFunctionCode", ErrDrawdownRequestMismatch, fwm.BusinessFunctionCode.BusinessFunctionCode))
+	case !refusal && fwm.TypeSubType.SubTypeCode != FundsTransferRequestCredit:
+		addFieldError(&errs, TagTypeSubType, fieldError("SubTypeCode", ErrDrawdownRequestMismatch, fwm.TypeSubType.SubTypeCode))
+	}
+	if fwm.TypeSubType.TypeCode != request.TypeSubType.TypeCode {
+		addFieldError(&errs, TagTypeSubType, fieldError("TypeCode", ErrDrawdownRequestMismatch, fwm.TypeSubType.TypeCode))
+	}
+
+	if pmi, err := previousMessageIdentifierOf(request); err == nil {
+		if fwm.PreviousMessageIdentifier == nil {
+			addFieldError(&errs, TagPreviousMessageIdentifier, fieldError("PreviousMessageIdentifier", ErrFieldRequired))
+		} else if fwm.PreviousMessageIdentifier.PreviousMessageIdentifier != pmi.PreviousMessageIdentifier {
+			addFieldError(&errs, TagPreviousMessageIdentifier, fieldError("PreviousMessageIdentifier", ErrDrawdownRequestMismatch, fwm.PreviousMessageIdentifier.PreviousMessageIdentifier))
+		}
+	}
+
+	if fwm.SenderDepositoryInstitution != nil && request.ReceiverDepositoryInstitution != nil &&
+		fwm.SenderDepositoryInstitution.SenderABANumber != request.ReceiverDepositoryInstitution.ReceiverABANumber {
+		addFieldError(&errs, TagSenderDepositoryInstitution, fieldError("SenderABANumber", ErrDrawdownRequestMismatch, fwm.SenderDepositoryInstitution.SenderABANumber))
+	}
+	if fwm.ReceiverDepositoryInstitution != nil && request.SenderDepositoryInstitution != nil &&
+		fwm.ReceiverDepositoryInstitution.ReceiverABANumber != request.SenderDepositoryInstitution.SenderABANumber {
+		addFieldError(&errs, TagReceiverDepositoryInstitution, fieldError("ReceiverABANumber", ErrDrawdownRequestMismatch, fwm.ReceiverDepositoryInstitution.ReceiverABANumber))
+	}
+
+	if fwm.Amount != nil && request.Amount != nil {
+		amount, err := fwm.Amount.Money()
+		requested, requestErr := request.Amount.Money()
+		switch {
+		case err != nil || requestErr != nil:
+			// reported by validateAmount
+		case refusal && amount.MinorUnits != requested.MinorUnits:
+			addFieldError(&errs, TagAmount, fieldError("Amount", ErrDrawdownRequestMismatch, fwm.Amount.Amount))
+		case !refusal && amount.IsZero():
+			addFieldError(&errs, TagAmount, fieldError("Amount", ErrAmountRange, fwm.Amount.Amount))
+		case amount.MinorUnits > requested.MinorUnits:
+			addFieldError(&errs, TagAmount, fieldError("Amount", ErrDrawdownAmountExceeded, fwm.Amount.Amount))
+		}
+	}
+
+	if add := request.AccountDebitedDrawdown; add != nil {
+		switch {
+		case fwm.AccountDebitedDrawdown == nil:
+			addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("AccountDebitedDrawdown", ErrFieldRequired))
+		case fwm.AccountDebitedDrawdown.IdentificationCode != add.IdentificationCode:
+			addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("IdentificationCode", ErrDrawdownRequestMismatch, fwm.AccountDebitedDrawdown.IdentificationCode))
+		case fwm.AccountDebitedDrawdown.Identifier != add.Identifier:
+			addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("Identifier", ErrDrawdownRequestMismatch, fwm.AccountDebitedDrawdown.Identifier))
+		}
+		// the Originator of a response is the debited account of the request
+		if o := fwm.Originator; !refusal && o != nil {
+			switch {
+			case o.Personal.IdentificationCode != add.IdentificationCode:
+				addFieldError(&errs, TagOriginator, fieldError("IdentificationCode", ErrDrawdownRequestMismatch, o.Personal.IdentificationCode))
+			case o.Personal.Identifier != add.Identifier:
+				addFieldError(&errs, TagOriginator, fieldError("Identifier", ErrDrawdownRequestMismatch, o.Personal.Identifier))
+			}
+		}
+	}
+	if acd := request.AccountCreditedDrawdown; acd != nil {
+		if fwm.AccountCreditedDrawdown == nil {
+			addFieldError(&errs, TagAccountCreditedDrawdown, fieldError("AccountCreditedDrawdown", ErrFieldRequired))
+		} else if fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber != acd.DrawdownCreditAccountNumber {
+			addFieldError(&errs, TagAccountCreditedDrawdown, fieldError("DrawdownCreditAccountNumber", ErrDrawdownRequestMismatch, fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber))
+		}
+	}
+	return errs
+}
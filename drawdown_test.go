@@ -0,0 +1,188 @@
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mockDrawdownRequest(t *testing.T) *FEDWireMessage {
+	t.Helper()
+	file, err := NewDrawdownRequest(mockMoney(t), mockSenderDepositoryInstitution(), mockReceiverDepositoryInstitution(),
+		mockBeneficiary(), mockAccountDebitedDrawdown(), mockAccountCreditedDrawdown()).
+		WithIMAD(mockInputMessageAccountabilityData()).
+		Build()
+	require.NoError(t, err)
+	file.FEDWireMessages[0].InputMessageAccountabilityData.InputCycleDate = "20230705"
+	return &file.FEDWireMessages[0]
+}
+
+func mockBankDrawdownRequest(t *testing.T) *FEDWireMessage {
+	t.Helper()
+	file, err := NewBankDrawdownRequest(mockMoney(t), mockSenderDepositoryInstitution(), mockReceiverDepositoryInstitution(),
+		mockAccountDebitedDrawdown(), mockAccountCreditedDrawdown()).
+		WithIMAD(mockInputMessageAccountabilityData()).
+		Build()
+	require.NoError(t, err)
+	file.FEDWireMessages[0].InputMessageAccountabilityData.InputCycleDate = "20230705"
+	return &file.FEDWireMessages[0]
+}
+
+func TestNewDrawdownResponse(t *testing.T) {
+	request := mockDrawdownRequest(t)
+
+	fwm, err := NewDrawdownResponse(request)
+	require.NoError(t, err)
+	require.Equal(t, FundsTransfer+FundsTransferRequestCredit, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, DrawdownResponse, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, request.Amount.Amount, fwm.Amount.Amount)
+	require.Equal(t, "20230705Source08000001", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+
+	// the response is sent back from the receiver to the sender
+	require.Equal(t, "231380104", fwm.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, "121042882", fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+
+	// the debited account is the originator
+	require.Equal(t, *request.AccountDebitedDrawdown, *fwm.AccountDebitedDrawdown)
+	require.Equal(t, *request.AccountCreditedDrawdown, *fwm.AccountCreditedDrawdown)
+	require.Equal(t, "123456789", fwm.Originator.Personal.Identifier)
+	require.Equal(t, "debitDD Name", fwm.Originator.Personal.Name)
+	require.Equal(t, request.Beneficiary.Personal, fwm.Beneficiary.Personal)
+
+	fwm.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	fwm.ValidateOptions = &ValidateOpts{DrawdownRequest: request}
+	require.NoError(t, fwm.verify())
+}
+
+func TestNewDrawdownResponse_bank(t *testing.T) {
+	request := mockBankDrawdownRequest(t)
+
+	fwm, err := NewDrawdownResponse(request)
+	require.NoError(t, err)
+	require.Equal(t, SettlementTransfer+FundsTransferRequestCredit, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+
+	// without a beneficiary the credited account of the sender is the beneficiary
+	require.Equal(t, FEDRoutingNumber, fwm.Beneficiary.Personal.IdentificationCode)
+	require.Equal(t, "231380104", fwm.Beneficiary.Personal.Identifier)
+	require.Equal(t, "Wells Fargo NA", fwm.Beneficiary.Personal.Name)
+
+	fwm.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	require.NoError(t, fwm.verify())
+}
+
+func TestNewDrawdownResponse_partialAmount(t *testing.T) {
+	request := mockDrawdownRequest(t)
+
+	fwm, err := NewDrawdownResponse(request, PartialAmount(Money{CurrencyCode: "USD", MinorUnits: 100000}))
+	require.NoError(t, err)
+	require.Equal(t, "000000100000", fwm.Amount.Amount)
+
+	_, err = NewDrawdownResponse(request, PartialAmount(Money{CurrencyCode: "USD", MinorUnits: 1234568}))
+	require.ErrorIs(t, err, ErrDrawdownAmountExceeded)
+
+	_, err = NewDrawdownResponse(request, PartialAmount(Money{CurrencyCode: "USD"}))
+	require.ErrorIs(t, err, ErrAmountRange)
+
+	_, err = NewDrawdownResponse(request, PartialAmount(Money{CurrencyCode: "EUR", MinorUnits: 100}))
+	require.ErrorIs(t, err, ErrCurrencyMismatch)
+}
+
+func TestNewDrawdownRefusal(t *testing.T) {
+	request := mockDrawdownRequest(t)
+
+	fwm, err := NewDrawdownRefusal(request)
+	require.NoError(t, err)
+	require.Equal(t, FundsTransfer+RefusalRequestCredit, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, CustomerCorporateDrawdownRequest, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, request.Amount.Amount, fwm.Amount.Amount)
+	require.Equal(t, "20230705Source08000001", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, "231380104", fwm.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, "121042882", fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+	require.Equal(t, request.Beneficiary.Personal, fwm.Beneficiary.Personal)
+
+	fwm.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	fwm.ValidateOptions = &ValidateOpts{DrawdownRequest: request}
+	require.NoError(t, fwm.verify())
+
+	// a refusal is for the amount requested
+	_, err = NewDrawdownRefusal(request, PartialAmount(Money{CurrencyCode: "USD", MinorUnits: 100000}))
+	require.ErrorIs(t, err, ErrDrawdownRequestMismatch)
+
+	fwm, err = NewDrawdownRefusal(mockBankDrawdownRequest(t))
+	require.NoError(t, err)
+	require.Equal(t, SettlementTransfer+RefusalRequestCredit, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, BankDrawDownRequest, fwm.BusinessFunctionCode.BusinessFunctionCode)
+}
+
+func TestNewDrawdownResponse_errors(t *testing.T) {
+	request := mockDrawdownRequest(t)
+	request.BusinessFunctionCode.BusinessFunctionCode = CustomerTransfer
+	_, err := NewDrawdownResponse(request)
+	require.ErrorIs(t, err, ErrNotDrawdownRequest)
+	_, err = NewDrawdownRefusal(request)
+	require.ErrorIs(t, err, ErrNotDrawdownRequest)
+
+	request = mockDrawdownRequest(t)
+	request.TypeSubType.SubTypeCode = RefusalRequestCredit
+	_, err = NewDrawdownResponse(request)
+	require.ErrorIs(t, err, ErrNotDrawdownRequest)
+
+	request = mockDrawdownRequest(t)
+	request.InputMessageAccountabilityData = nil
+	_, err = NewDrawdownResponse(request)
+	require.ErrorIs(t, err, ErrFieldRequired)
+
+	request = mockDrawdownRequest(t)
+	request.Amount = nil
+	_, err = NewDrawdownRefusal(request)
+	require.ErrorIs(t, err, ErrFieldRequired)
+}
+
+func TestValidateDrawdownResponse(t *testing.T) {
+	request := mockDrawdownRequest(t)
+	fwm, err := NewDrawdownResponse(request)
+	require.NoError(t, err)
+	fwm.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	fwm.ValidateOptions = &ValidateOpts{DrawdownRequest: request}
+
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = "20230705Source08000002"
+	require.ErrorIs(t, fwm.verify(), ErrDrawdownRequestMismatch)
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = "20230705Source08000001"
+
+	fwm.ReceiverDepositoryInstitution.ReceiverABANumber = "021000021"
+	require.ErrorIs(t, fwm.verify(), ErrDrawdownRequestMismatch)
+	fwm.ReceiverDepositoryInstitution.ReceiverABANumber = "121042882"
+
+	fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber = "021000021"
+	require.ErrorIs(t, fwm.verify(), ErrDrawdownRequestMismatch)
+	fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber = "231380104"
+
+	fwm.Amount.Amount = "000001234568"
+	require.ErrorIs(t, fwm.verify(), ErrDrawdownAmountExceeded)
+	fwm.Amount.Amount = "000001234567"
+
+	fwm.TypeSubType.TypeCode = SettlementTransfer
+	require.ErrorIs(t, fwm.verify(), ErrDrawdownRequestMismatch)
+	fwm.TypeSubType.TypeCode = FundsTransfer
+	require.NoError(t, fwm.verify())
+
+	// the originator is the debited account of the drawdown request
+	identifier := fwm.Originator.Personal.Identifier
+	fwm.Originator.Personal.Identifier = "987654321"
+	err = fwm.verify()
+	require.ErrorIs(t, err, ErrDrawdownRequestMismatch)
+	require.Contains(t, err.Error(), "Identifier")
+	fwm.Originator.Personal.Identifier = identifier
+
+	code := fwm.Originator.Personal.IdentificationCode
+	fwm.Originator.Personal.IdentificationCode = PassportNumber
+	err = fwm.verify()
+	require.ErrorIs(t, err, ErrDrawdownRequestMismatch)
+	require.Contains(t, err.Error(), "IdentificationCode")
+
+	// which is only known with the drawdown request
+	fwm.ValidateOptions = nil
+	fwm.Originator.Personal.Identifier = "987654321"
+	require.NoError(t, fwm.verify())
+	fwm.Originator.Personal.IdentificationCode = code
+}
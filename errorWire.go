@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // ErrorWire is a wire error with the fedwire message
@@ -49,21 +51,21 @@ func (ew *ErrorWire) Parse(record string) error {
 
 	value, read, err := ew.parseFixedStringField(record[length:], 1)
 	if err != nil {
-		return fieldError("ErrorCategory", err)
+		return fieldErrorAt(length, "ErrorCategory", err)
 	}
 	ew.ErrorCategory = value
 	length += read
 
 	value, read, err = ew.parseFixedStringField(record[length:], 3)
 	if err != nil {
-		return fieldError("ErrorCode", err)
+		return fieldErrorAt(length, "ErrorCode", err)
 	}
 	ew.ErrorCode = value
 	length += read
 
 	value, read, err = ew.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("ErrorDescription", err)
+		return fieldErrorAt(length, "ErrorDescription", err)
 	}
 	ew.ErrorDescription = value
 	length += read
@@ -114,10 +116,33 @@ func (ew *ErrorWire) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on ErrorWire and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (ew *ErrorWire) Validate() error {
-	// Currently no validation as the FED is responsible for the values
-	return nil
+	return ew.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on ErrorWire and returns every error found.
+// Only the first error for each field is returned.
+func (ew *ErrorWire) ValidateAll() base.ErrorList {
+	// The FED is responsible for the values and may send codes the catalog lacks, so only the ErrorCategory is
+	// checked against the catalog
+	var errs base.ErrorList
+	if ew.ErrorCategory != "" {
+		if _, ok := ew.Category(); !ok {
+			addFieldError(&errs, TagErrorWire, fieldError("ErrorCategory", ErrErrorCategory, ew.ErrorCategory))
+		}
+	}
+	return errs
+}
+
+// Category returns the catalog entry of the ErrorCategory, or false when it is not a Fedwire reject error category
+func (ew *ErrorWire) Category() (ErrorWireCategory, bool) {
+	return LookupErrorWireCategory(ew.ErrorCategory)
+}
+
+// Code returns the catalog entry of the ErrorCode within the ErrorCategory, or false when the catalog has no such
+// code
+func (ew *ErrorWire) Code() (ErrorWireCode, bool) {
+	return LookupErrorWireCode(ew.ErrorCategory, ew.ErrorCode)
 }
 
 // ErrorCategoryField gets a string of the ErrorCategory field
@@ -0,0 +1,140 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// ErrorWireCategory is an entry of the catalog of Fedwire reject error categories of ErrorWire {1130}
+type ErrorWireCategory struct {
+	// Category is the ErrorCategory, such as ErrorCategoryDataError
+	Category string `json:"category"`
+	// Description describes the error category
+	Description string `json:"description"`
+	// Resubmittable is true when the message can be sent again once the error is remedied
+	Resubmittable bool `json:"resubmittable"`
+	// Remediation describes how to remedy the error
+	Remediation string `json:"remediation"`
+	// Codes are the error codes of the category, in ErrorCode order
+	Codes []ErrorWireCode `json:"codes"`
+}
+
+// ErrorWireCode is an entry of the catalog of Fedwire reject error codes of ErrorWire {1130}, within an
+// ErrorWireCategory
+type ErrorWireCode struct {
+	// Category is the ErrorCategory of the code, such as ErrorCategoryDataError
+	Category string `json:"category"`
+	// Code is the ErrorCode, such as 002
+	Code string `json:"code"`
+	// Description describes the error
+	Description string `json:"description"`
+	// Resubmittable is true when the message can be sent again once the error is remedied
+	Resubmittable bool `json:"resubmittable"`
+	// Tag is the tag the error refers to, or blank when it refers to the message as a whole
+	Tag string `json:"tag,omitempty"`
+}
+
+// errorWireCategories is the catalog of error categories and their codes, in ErrorCategory order
+var errorWireCategories = []ErrorWireCategory{
+	{
+		Category:      ErrorCategoryDataError,
+		Description:   "Data Error",
+		Resubmittable: true,
+		Remediation:   "Correct the tag named by the ErrorCode and ErrorDescription and resubmit the message",
+		Codes: []ErrorWireCode{
+			{Category: ErrorCategoryDataError, Code: "001", Description: "Invalid message format", Resubmittable: true},
+			{Category: ErrorCategoryDataError, Code: "002", Description: "Invalid or missing Sender Supplied", Resubmittable: true, Tag: TagSenderSupplied},
+			{Category: ErrorCategoryDataError, Code: "003", Description: "Invalid or missing Type/Subtype", Resubmittable: true, Tag: TagTypeSubType},
+			{Category: ErrorCategoryDataError, Code: "004", Description: "Invalid or missing Amount", Resubmittable: true, Tag: TagAmount},
+			{Category: ErrorCategoryDataError, Code: "005", Description: "Invalid or missing Sender DI", Resubmittable: true, Tag: TagSenderDepositoryInstitution},
+			{Category: ErrorCategoryDataError, Code: "006", Description: "Invalid or missing Receiver DI", Resubmittable: true, Tag: TagReceiverDepositoryInstitution},
+			{Category: ErrorCategoryDataError, Code: "007", Description: "Invalid Business Function Code", Resubmittable: true, Tag: TagBusinessFunctionCode},
+			{Category: ErrorCategoryDataError, Code: "008", Description: "Tag not permitted for the BFC", Resubmittable: true},
+		},
+	},
+	{
+		Category:      ErrorCategoryInsufficientBalance,
+		Description:   "Insufficient Balance",
+		Resubmittable: true,
+		Remediation:   "Fund the account of the sender, or resubmit the message for a smaller amount",
+		Codes: []ErrorWireCode{
+			{Category: ErrorCategoryInsufficientBalance, Code: "001", Description: "Insufficient balance of Sender DI", Resubmittable: true, Tag: TagAmount},
+			{Category: ErrorCategoryInsufficientBalance, Code: "002", Description: "Amount exceeds Sender DI debit cap", Resubmittable: true, Tag: TagAmount},
+		},
+	},
+	{
+		Category:      ErrorCategoryAccountabilityError,
+		Description:   "Accountability Error",
+		Resubmittable: true,
+		Remediation:   "Correct the input cycle date, source or sequence number of the IMAD and resubmit the message",
+		Codes: []ErrorWireCode{
+			{Category: ErrorCategoryAccountabilityError, Code: "001", Description: "Invalid input cycle date", Resubmittable: true, Tag: TagInputMessageAccountabilityData},
+			{Category: ErrorCategoryAccountabilityError, Code: "002", Description: "Invalid input source", Resubmittable: true, Tag: TagInputMessageAccountabilityData},
+			{Category: ErrorCategoryAccountabilityError, Code: "003", Description: "Input sequence number out of order", Resubmittable: true, Tag: TagInputMessageAccountabilityData},
+		},
+	},
+	{
+		Category:    ErrorCategoryInProcess,
+		Description: "In Process or Intercepted",
+		Remediation: "Wait for the final disposition of the message, as resubmitting it could send the funds twice",
+		Codes: []ErrorWireCode{
+			{Category: ErrorCategoryInProcess, Code: "001", Description: "Message in process", Tag: TagMessageDisposition},
+			{Category: ErrorCategoryInProcess, Code: "002", Description: "Message intercepted for review", Tag: TagMessageDisposition},
+		},
+	},
+	{
+		Category:      ErrorCategoryCutoffHourError,
+		Description:   "Cutoff Hour Error",
+		Resubmittable: true,
+		Remediation:   "Resubmit the message with the input cycle date of the next business day",
+		Codes: []ErrorWireCode{
+			{Category: ErrorCategoryCutoffHourError, Code: "001", Description: "Received after cutoff hour", Resubmittable: true, Tag: TagInputMessageAccountabilityData},
+		},
+	},
+	{
+		Category:    ErrorCategoryCopyMessage,
+		Description: "Copy Message",
+		Remediation: "Do not resubmit the message, which is a copy of one already received with the same IMAD",
+		Codes: []ErrorWireCode{
+			{Category: ErrorCategoryCopyMessage, Code: "001", Description: "Copy of message with same IMAD", Tag: TagInputMessageAccountabilityData},
+		},
+	},
+}
+
+// ErrorWireCategories returns the catalog of Fedwire reject error categories and their codes, in ErrorCategory
+// order
+func ErrorWireCategories() []ErrorWireCategory {
+	categories := make([]ErrorWireCategory, len(errorWireCategories))
+	for i, c := range errorWireCategories {
+		c.Codes = append([]ErrorWireCode(nil), c.Codes...)
+		categories[i] = c
+	}
+	return categories
+}
+
+// LookupErrorWireCategory returns the catalog entry of the ErrorCategory, or false when it is not a Fedwire reject
+// error category
+func LookupErrorWireCategory(category string) (ErrorWireCategory, bool) {
+	for _, c := range errorWireCategories {
+		if c.Category == category {
+			c.Codes = append([]ErrorWireCode(nil), c.Codes...)
+			return c, true
+		}
+	}
+	return ErrorWireCategory{}, false
+}
+
+// LookupErrorWireCode returns the catalog entry of the ErrorCode within the ErrorCategory, or false when the
+// catalog has no such code
+func LookupErrorWireCode(category, code string) (ErrorWireCode, bool) {
+	for _, c := range errorWireCategories {
+		if c.Category != category {
+			continue
+		}
+		for _, ec := range c.Codes {
+			if ec.Code == code {
+				return ec, true
+			}
+		}
+	}
+	return ErrorWireCode{}, false
+}
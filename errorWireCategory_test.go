@@ -0,0 +1,85 @@
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorWireCategories(t *testing.T) {
+	categories := ErrorWireCategories()
+	require.Len(t, categories, 6)
+
+	var codes string
+	for _, c := range categories {
+		codes += c.Category
+		require.NotEmpty(t, c.Description)
+		require.NotEmpty(t, c.Remediation)
+	}
+	require.Equal(t, "EFHIWX", codes)
+
+	// the catalog can not be changed through the returned entries
+	categories[0].Description = "changed"
+	categories[0].Codes[0].Description = "changed"
+	require.Equal(t, "Data Error", ErrorWireCategories()[0].Description)
+	require.NotEqual(t, "changed", ErrorWireCategories()[0].Codes[0].Description)
+}
+
+func TestErrorWireCategories_codes(t *testing.T) {
+	for _, c := range ErrorWireCategories() {
+		require.NotEmpty(t, c.Codes, c.Category)
+		seen := make(map[string]bool)
+		for _, code := range c.Codes {
+			require.Equal(t, c.Category, code.Category)
+			require.Len(t, code.Code, 3)
+			require.False(t, seen[code.Code], "%s%s is repeated", c.Category, code.Code)
+			seen[code.Code] = true
+			require.NotEmpty(t, code.Description)
+			require.LessOrEqual(t, len(code.Description), 35)
+			require.Equal(t, c.Resubmittable, code.Resubmittable, "%s%s", c.Category, code.Code)
+		}
+	}
+}
+
+func TestLookupErrorWireCode(t *testing.T) {
+	code, ok := LookupErrorWireCode(ErrorCategoryAccountabilityError, "003")
+	require.True(t, ok)
+	require.Equal(t, TagInputMessageAccountabilityData, code.Tag)
+	require.True(t, code.Resubmittable)
+
+	code, ok = LookupErrorWireCode(ErrorCategoryDataError, "008")
+	require.True(t, ok)
+	require.Empty(t, code.Tag)
+
+	code, ok = LookupErrorWireCode(ErrorCategoryInProcess, "002")
+	require.True(t, ok)
+	require.False(t, code.Resubmittable)
+	require.Equal(t, TagMessageDisposition, code.Tag)
+
+	_, ok = LookupErrorWireCode(ErrorCategoryCutoffHourError, "002")
+	require.False(t, ok)
+	_, ok = LookupErrorWireCode("", "001")
+	require.False(t, ok)
+}
+
+func TestLookupErrorWireCategory(t *testing.T) {
+	c, ok := LookupErrorWireCategory(ErrorCategoryInsufficientBalance)
+	require.True(t, ok)
+	require.Equal(t, "Insufficient Balance", c.Description)
+	require.True(t, c.Resubmittable)
+	require.NotEmpty(t, c.Codes)
+
+	c, ok = LookupErrorWireCategory(ErrorCategoryCopyMessage)
+	require.True(t, ok)
+	require.Equal(t, "Copy Message", c.Description)
+	require.False(t, c.Resubmittable)
+
+	c, ok = LookupErrorWireCategory(ErrorCategoryInProcess)
+	require.True(t, ok)
+	require.False(t, c.Resubmittable)
+
+	_, ok = LookupErrorWireCategory("e")
+	require.False(t, ok)
+	_, ok = LookupErrorWireCategory("")
+	require.False(t, ok)
+}
@@ -27,21 +27,21 @@ func TestMockErrorWire(t *testing.T) {
 
 // TestParseErrorWire parses a known ErrorWire  record string
 func TestParseErrorWire(t *testing.T) {
-	var line = "{1130}1XYZData Error                         *"
+	var line = "{1130}EXYZData Error                         *"
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 
 	require.NoError(t, r.parseErrorWire())
 	record := r.currentFEDWireMessage.ErrorWire
 
-	assert.Equal(t, "1", record.ErrorCategory)
+	assert.Equal(t, "E", record.ErrorCategory)
 	assert.Equal(t, "XYZ", record.ErrorCode)
 	assert.Equal(t, "Data Error", record.ErrorDescription)
 }
 
 // TestWriteErrorWire writes a ErrorWire record string
 func TestWriteErrorWire(t *testing.T) {
-	var line = "{1130}1XYZData Error                         *"
+	var line = "{1130}EXYZData Error                         *"
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 	require.NoError(t, r.parseErrorWire())
@@ -59,21 +59,21 @@ func TestStringErrorWireAmountVariableLength(t *testing.T) {
 	err := r.parseErrorWire()
 	require.Nil(t, err)
 
-	line = "{1130}1XYZData Error                         NNN"
+	line = "{1130}EXYZData Error                         NNN"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
 	err = r.parseErrorWire()
 	require.ErrorContains(t, err, ErrRequireDelimiter.Error())
 
-	line = "{1130}1XYZData Error***"
+	line = "{1130}EXYZData Error***"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
 	err = r.parseErrorWire()
 	require.ErrorContains(t, err, r.parseError(NewTagMaxLengthErr(errors.New(""))).Error())
 
-	line = "{1130}1XYZData Error*"
+	line = "{1130}EXYZData Error*"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -83,7 +83,7 @@ func TestStringErrorWireAmountVariableLength(t *testing.T) {
 
 // TestStringErrorWireOptions validates Format() formatted according to the FormatOptions
 func TestStringErrorWireOptions(t *testing.T) {
-	var line = "{1130}1XYZData Error*"
+	var line = "{1130}EXYZData Error*"
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -91,7 +91,57 @@ func TestStringErrorWireOptions(t *testing.T) {
 	require.Equal(t, err, nil)
 
 	record := r.currentFEDWireMessage.ErrorWire
-	require.Equal(t, record.String(), "{1130}1XYZData Error                         *")
-	require.Equal(t, record.Format(FormatOptions{VariableLengthFields: true}), "{1130}1XYZData Error*")
+	require.Equal(t, record.String(), "{1130}EXYZData Error                         *")
+	require.Equal(t, record.Format(FormatOptions{VariableLengthFields: true}), "{1130}EXYZData Error*")
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
+
+// TestErrorWireErrorCategory validates ErrorWire ErrorCategory is a Fedwire reject error category
+func TestErrorWireErrorCategory(t *testing.T) {
+	ew := mockErrorWire()
+	ew.ErrorCategory = "1"
+
+	err := ew.Validate()
+	require.ErrorIs(t, err, ErrErrorCategory)
+
+	line := "{1130}1XYZData Error*"
+	r := NewReader(strings.NewReader(line))
+	r.line = line
+	require.ErrorIs(t, r.parseErrorWire(), ErrErrorCategory)
+
+	fwm := mockCustomerTransferData()
+	fwm.Originator = mockOriginator()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.ErrorWire = ew
+	require.ErrorIs(t, fwm.verify(), ErrErrorCategory)
+}
+
+// TestErrorWireCategory validates the catalog entry of the ErrorCategory
+func TestErrorWireCategory(t *testing.T) {
+	ew := mockErrorWire()
+	category, ok := ew.Category()
+	require.True(t, ok)
+	require.Equal(t, ErrorCategoryDataError, category.Category)
+	require.True(t, category.Resubmittable)
+
+	ew.ErrorCategory = ""
+	_, ok = ew.Category()
+	require.False(t, ok)
+}
+
+// TestErrorWireCode validates the catalog entry of the ErrorCode
+func TestErrorWireCode(t *testing.T) {
+	ew := mockErrorWire()
+	ew.ErrorCode = "004"
+	code, ok := ew.Code()
+	require.True(t, ok)
+	require.Equal(t, ErrorCategoryDataError, code.Category)
+	require.Equal(t, TagAmount, code.Tag)
+	require.True(t, code.Resubmittable)
+
+	// codes the catalog lacks are still valid
+	ew.ErrorCode = "XYZ"
+	_, ok = ew.Code()
+	require.False(t, ok)
+	require.NoError(t, ew.Validate())
+}
@@ -132,7 +132,7 @@ func main() {
 	fwm.InstructingFI = ifi
 
 	creditDD := wire.NewAccountCreditedDrawdown()
-	creditDD.DrawdownCreditAccountNumber = "123456789"
+	creditDD.DrawdownCreditAccountNumber = "231380104"
 	fwm.AccountCreditedDrawdown = creditDD
 
 	ob := wire.NewOriginatorToBeneficiary()
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // ExchangeRate is the ExchangeRate of the wire
@@ -46,7 +48,7 @@ func (eRate *ExchangeRate) Parse(record string) error {
 
 	value, read, err := eRate.parseVariableStringField(record[length:], 12)
 	if err != nil {
-		return fieldError("ExchangeRate", err)
+		return fieldErrorAt(length, "ExchangeRate", err)
 	}
 	eRate.ExchangeRate = value
 	length += read
@@ -95,15 +97,21 @@ func (eRate *ExchangeRate) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on ExchangeRate and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (eRate *ExchangeRate) Validate() error {
+	return eRate.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on ExchangeRate and returns every error found.
+// Only the first error for each field is returned.
+func (eRate *ExchangeRate) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if eRate.tag != TagExchangeRate {
-		return fieldError("tag", ErrValidTagForType, eRate.tag)
+		addFieldError(&errs, TagExchangeRate, fieldError("tag", ErrValidTagForType, eRate.tag))
 	}
 	if err := eRate.isAmount(eRate.ExchangeRate); err != nil {
-		return fieldError("ExchangeRate", err, eRate.ExchangeRate)
+		addFieldError(&errs, TagExchangeRate, fieldError("ExchangeRate", err, eRate.ExchangeRate))
 	}
-	return nil
+	return errs
 }
 
 // ExchangeRateField gets a string of the ExchangeRate field
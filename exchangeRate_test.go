@@ -40,11 +40,11 @@ func TestParseExchangeRateWrongLength(t *testing.T) {
 
 	err := r.parseExchangeRate()
 
-	require.EqualError(t, err, r.parseError(fieldError("ExchangeRate", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "ExchangeRate", ErrRequireDelimiter)).Error())
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("ExchangeRate", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldErrorAt(6, "ExchangeRate", ErrRequireDelimiter))).Error())
 }
 
 // TestParseExchangeRateReaderParseError parses a wrong ExchangeRate reader parse error
@@ -59,7 +59,7 @@ func TestParseExchangeRateReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("ExchangeRate", ErrNonAmount, "1,2345Z")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldErrorAt(6, "ExchangeRate", ErrNonAmount, "1,2345Z"))).Error())
 }
 
 // TestExchangeRateTagError validates a ExchangeRate tag
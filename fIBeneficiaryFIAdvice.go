@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIBeneficiaryFIAdvice is the financial institution beneficiary financial institution
@@ -46,42 +48,42 @@ func (fibfia *FIBeneficiaryFIAdvice) Parse(record string) error {
 
 	value, read, err := fibfia.parseVariableStringField(record[length:], 26)
 	if err != nil {
-		return fieldError("LineOne", err)
+		return fieldErrorAt(length, "LineOne", err)
 	}
 	fibfia.Advice.LineOne = value
 	length += read
 
 	value, read, err = fibfia.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineTwo", err)
+		return fieldErrorAt(length, "LineTwo", err)
 	}
 	fibfia.Advice.LineTwo = value
 	length += read
 
 	value, read, err = fibfia.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineThree", err)
+		return fieldErrorAt(length, "LineThree", err)
 	}
 	fibfia.Advice.LineThree = value
 	length += read
 
 	value, read, err = fibfia.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineFour", err)
+		return fieldErrorAt(length, "LineFour", err)
 	}
 	fibfia.Advice.LineFour = value
 	length += read
 
 	value, read, err = fibfia.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineFive", err)
+		return fieldErrorAt(length, "LineFive", err)
 	}
 	fibfia.Advice.LineFive = value
 	length += read
 
 	value, read, err = fibfia.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineSix", err)
+		return fieldErrorAt(length, "LineSix", err)
 	}
 	fibfia.Advice.LineSix = value
 	length += read
@@ -136,33 +138,39 @@ func (fibfia *FIBeneficiaryFIAdvice) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on FIBeneficiaryFIAdvice and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (fibfia *FIBeneficiaryFIAdvice) Validate() error {
+	return fibfia.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on FIBeneficiaryFIAdvice and returns every error found.
+// Only the first error for each field is returned.
+func (fibfia *FIBeneficiaryFIAdvice) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if fibfia.tag != TagFIBeneficiaryFIAdvice {
-		return fieldError("tag", ErrValidTagForType, fibfia.tag)
+		addFieldError(&errs, TagFIBeneficiaryFIAdvice, fieldError("tag", ErrValidTagForType, fibfia.tag))
 	}
 	if err := fibfia.isAdviceCode(fibfia.Advice.AdviceCode); err != nil {
-		return fieldError("AdviceCode", err, fibfia.Advice.AdviceCode)
+		addFieldError(&errs, TagFIBeneficiaryFIAdvice, fieldError("AdviceCode", err, fibfia.Advice.AdviceCode))
 	}
 	if err := fibfia.isAlphanumeric(fibfia.Advice.LineOne); err != nil {
-		return fieldError("LineOne", err, fibfia.Advice.LineOne)
+		addFieldError(&errs, TagFIBeneficiaryFIAdvice, fieldError("LineOne", err, fibfia.Advice.LineOne))
 	}
 	if err := fibfia.isAlphanumeric(fibfia.Advice.LineTwo); err != nil {
-		return fieldError("LineTwo", err, fibfia.Advice.LineTwo)
+		addFieldError(&errs, TagFIBeneficiaryFIAdvice, fieldError("LineTwo", err, fibfia.Advice.LineTwo))
 	}
 	if err := fibfia.isAlphanumeric(fibfia.Advice.LineThree); err != nil {
-		return fieldError("LineThree", err, fibfia.Advice.LineThree)
+		addFieldError(&errs, TagFIBeneficiaryFIAdvice, fieldError("LineThree", err, fibfia.Advice.LineThree))
 	}
 	if err := fibfia.isAlphanumeric(fibfia.Advice.LineFour); err != nil {
-		return fieldError("LineFour", err, fibfia.Advice.LineFour)
+		addFieldError(&errs, TagFIBeneficiaryFIAdvice, fieldError("LineFour", err, fibfia.Advice.LineFour))
 	}
 	if err := fibfia.isAlphanumeric(fibfia.Advice.LineFive); err != nil {
-		return fieldError("LineFive", err, fibfia.Advice.LineFive)
+		addFieldError(&errs, TagFIBeneficiaryFIAdvice, fieldError("LineFive", err, fibfia.Advice.LineFive))
 	}
 	if err := fibfia.isAlphanumeric(fibfia.Advice.LineSix); err != nil {
-		return fieldError("LineSix", err, fibfia.Advice.LineSix)
+		addFieldError(&errs, TagFIBeneficiaryFIAdvice, fieldError("LineSix", err, fibfia.Advice.LineSix))
 	}
-	return nil
+	return errs
 }
 
 // AdviceCodeField gets a string of the AdviceCode field
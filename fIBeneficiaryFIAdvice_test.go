@@ -106,7 +106,7 @@ func TestParseFIBeneficiaryFIAdviceWrongLength(t *testing.T) {
 
 	err := r.parseFIBeneficiaryFIAdvice()
 
-	require.EqualError(t, err, r.parseError(fieldError("LineOne", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(9, "LineOne", ErrRequireDelimiter)).Error())
 }
 
 // TestParseFIBeneficiaryFIAdviceReaderParseError parses a wrong FIBeneficiaryFIAdvice reader parse error
@@ -122,7 +122,7 @@ func TestParseFIBeneficiaryFIAdviceReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("LineOne", ErrNonAlphanumeric, "Line ®ne")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("LineOne", ErrNonAlphanumeric, "Line ®ne"))).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -4,12 +4,19 @@
 
 package wire
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/wire/calendar"
+)
 
 // FEDWireMessage is a FedWire Message
 type FEDWireMessage struct {
 	// ID
 	ID string `json:"id"`
+	// InterfaceHeader is the interface data read before the FEDWireMessage, when it is not the File's InterfaceHeader
+	InterfaceHeader *InterfaceHeader `json:"interfaceHeader,omitempty"`
 	// MessageDisposition
 	MessageDisposition *MessageDisposition `json:"messageDisposition,omitempty"`
 	// ReceiptTimeStamp
@@ -131,8 +138,13 @@ type FEDWireMessage struct {
 	RemittanceFreeText *RemittanceFreeText `json:"remittanceFreeText,omitempty"`
 	// ServiceMessage
 	ServiceMessage *ServiceMessage `json:"serviceMessage,omitempty"`
+	// UnknownTags are the tags read which are not part of the WIRE format, in the order they were read
+	UnknownTags []UnknownTag `json:"unknownTags,omitempty"`
 	// ValidateOpts
 	ValidateOptions *ValidateOpts `json:"validateOptions,omitempty"`
+
+	// source holds the tags and interface header of the FEDWireMessage as they were read
+	source []sourceSegment
 }
 
 func (fwm *FEDWireMessage) requireSenderSupplied() bool {
@@ -146,62 +158,72 @@ func (fwm *FEDWireMessage) requireSenderSupplied() bool {
 // verify checks basic WIRE rules. Assumes properly parsed records. Each validation func should
 // check for the expected relationships between fields within a FedWireMessage.
 func (fwm *FEDWireMessage) verify() error {
+	return fwm.ValidateAll().Err()
+}
 
-	if err := fwm.mandatoryFields(); err != nil {
-		return err
-	}
-
-	if err := fwm.otherTransferInformation(); err != nil {
-		return err
-	}
-	if err := fwm.validateBeneficiaryIntermediaryFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateBeneficiaryFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateOriginatorFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateInstructingFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateOriginatorToBeneficiary(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIIntermediaryFI(); err != nil {
-		return err
+// ValidateAll checks basic WIRE rules and returns every error found, rather than stopping at the first.
+// Only the first error for each field of a tag is returned.
+func (fwm *FEDWireMessage) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
+	if fwm.InterfaceHeader != nil {
+		addFieldErrors(&errs, fwm.InterfaceHeader.ValidateAll())
 	}
-	if err := fwm.validateFIIntermediaryFIAdvice(); err != nil {
-		return err
+	addFieldErrors(&errs, fwm.mandatoryFields())
+	if fwm.ErrorWire != nil {
+		addFieldErrors(&errs, fwm.ErrorWire.ValidateAll())
 	}
-	if err := fwm.validateFIBeneficiaryFI(); err != nil {
-		return err
+	if fwm.ValidateOptions == nil || !fwm.ValidateOptions.SkipRoutingNumberCheck {
+		addFieldErrors(&errs, fwm.validateRoutingNumbers())
 	}
-	if err := fwm.validateFIBeneficiaryFIAdvice(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIBeneficiary(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIBeneficiaryAdvice(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIPaymentMethodToBeneficiary(); err != nil {
-		return err
+	if fwm.ValidateOptions != nil && fwm.ValidateOptions.Directory != nil && fwm.ReceiverDepositoryInstitution != nil {
+		if err := fwm.ValidateReceiver(fwm.ValidateOptions.Directory); err != nil {
+			addFieldError(&errs, TagReceiverDepositoryInstitution, err)
+		}
 	}
-	if err := fwm.validateUnstructuredAddenda(); err != nil {
-		return err
+	// the remaining rules depend on TypeSubType and BusinessFunctionCode
+	if fwm.TypeSubType == nil || fwm.BusinessFunctionCode == nil {
+		return errs
 	}
 
-	if err := fwm.validateRelatedRemittance(); err != nil {
-		return err
+	addFieldErrors(&errs, fwm.otherTransferInformation())
+	addFieldErrors(&errs, fwm.validateBeneficiaryIntermediaryFI())
+	addFieldErrors(&errs, fwm.validateBeneficiaryFI())
+	addFieldErrors(&errs, fwm.validateOriginatorFI())
+	addFieldErrors(&errs, fwm.validateInstructingFI())
+	addFieldErrors(&errs, fwm.validateOriginatorToBeneficiary())
+	addFieldErrors(&errs, fwm.validateFIIntermediaryFI())
+	addFieldErrors(&errs, fwm.validateFIIntermediaryFIAdvice())
+	addFieldErrors(&errs, fwm.validateFIBeneficiaryFI())
+	addFieldErrors(&errs, fwm.validateFIBeneficiaryFIAdvice())
+	addFieldErrors(&errs, fwm.validateFIBeneficiary())
+	addFieldErrors(&errs, fwm.validateFIBeneficiaryAdvice())
+	addFieldErrors(&errs, fwm.validateFIPaymentMethodToBeneficiary())
+	addFieldErrors(&errs, fwm.validateUnstructuredAddenda())
+
+	addFieldErrors(&errs, fwm.validateRelatedRemittance())
+
+	addFieldErrors(&errs, fwm.isRemittanceValid())
+	addFieldErrors(&errs, fwm.validateUnknownTags())
+	return errs
+}
+
+// validateUnknownTags validates each UnknownTag can be written back
+func (fwm *FEDWireMessage) validateUnknownTags() base.ErrorList {
+	var errs base.ErrorList
+	for i := range fwm.UnknownTags {
+		addFieldErrors(&errs, fwm.UnknownTags[i].ValidateAll())
 	}
+	return errs
+}
 
-	if err := fwm.isRemittanceValid(); err != nil {
-		return err
+// hasUnknownTag returns true when tag was read as one of the UnknownTags
+func (fwm *FEDWireMessage) hasUnknownTag(tag string) bool {
+	for i := range fwm.UnknownTags {
+		if fwm.UnknownTags[i].Tag == tag {
+			return true
+		}
 	}
-	return nil
+	return false
 }
 
 // mandatoryFields validates mandatory tags for a FEDWireMessage are defined
@@ -220,169 +242,178 @@ func (fwm *FEDWireMessage) verify() error {
 //
 //		 	NOTE: Not specified mandatory elements in each incoming message
 //	          Need to specify mandatory elements in this case
-func (fwm *FEDWireMessage) mandatoryFields() error {
+func (fwm *FEDWireMessage) mandatoryFields() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.requireSenderSupplied() {
-		if err := fwm.validateSenderSupplied(); err != nil {
-			return err
-		}
-	}
-	if err := fwm.validateTypeSubType(); err != nil {
-		return err
+		addFieldErrors(&errs, fwm.validateSenderSupplied())
 	}
+	addFieldErrors(&errs, fwm.validateTypeSubType())
 
 	if fwm.ValidateOptions == nil || !fwm.ValidateOptions.SkipMandatoryIMAD {
-		if err := fwm.validateIMAD(); err != nil {
-			return err
-		}
-	}
-	if err := fwm.validateAmount(); err != nil {
-		return err
-	}
-	if err := fwm.validateSenderDI(); err != nil {
-		return err
-	}
-	if err := fwm.validateReceiverDI(); err != nil {
-		return err
+		addFieldErrors(&errs, fwm.validateIMAD())
 	}
-	if err := fwm.validateBusinessFunctionCode(); err != nil {
-		return err
+	if fwm.ValidateOptions != nil && fwm.ValidateOptions.CheckBusinessDay {
+		addFieldErrors(&errs, fwm.validateIMADCycleDate())
 	}
-	return nil
+	addFieldErrors(&errs, fwm.validateAmount())
+	addFieldErrors(&errs, fwm.validateSenderDI())
+	addFieldErrors(&errs, fwm.validateReceiverDI())
+	addFieldErrors(&errs, fwm.validateBusinessFunctionCode())
+	return errs
 }
 
 // validateSenderSupplied validates TagSenderSupplied within a FEDWireMessage
 // Mandatory for all requests
-func (fwm *FEDWireMessage) validateSenderSupplied() error {
+func (fwm *FEDWireMessage) validateSenderSupplied() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.SenderSupplied == nil {
-		return fieldError("SenderSupplied", ErrFieldRequired)
+		addFieldError(&errs, TagSenderSupplied, fieldError("SenderSupplied", ErrFieldRequired))
+		return errs
 	}
-	return fwm.SenderSupplied.Validate()
+	addFieldErrors(&errs, fwm.SenderSupplied.ValidateAll())
+	return errs
 }
 
 // validateTypeSubType validates TagTypeSubType within a FEDWireMessage
 // Mandatory for all requests
-func (fwm *FEDWireMessage) validateTypeSubType() error {
+func (fwm *FEDWireMessage) validateTypeSubType() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.TypeSubType == nil {
-		return fieldError("TypeSubType", ErrFieldRequired)
+		addFieldError(&errs, TagTypeSubType, fieldError("TypeSubType", ErrFieldRequired))
+		return errs
 	}
-	return fwm.TypeSubType.Validate()
+	addFieldErrors(&errs, fwm.TypeSubType.ValidateAll())
+	return errs
 }
 
 // validateIMAD validates TagInputMessageAccountabilityData within a FEDWireMessage
 // Mandatory for all requests
-func (fwm *FEDWireMessage) validateIMAD() error {
+func (fwm *FEDWireMessage) validateIMAD() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.InputMessageAccountabilityData == nil {
-		return fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+		addFieldError(&errs, TagInputMessageAccountabilityData, fieldError("InputMessageAccountabilityData", ErrFieldRequired))
+		return errs
+	}
+	addFieldErrors(&errs, fwm.InputMessageAccountabilityData.ValidateAll())
+	return errs
+}
+
+// validateIMADCycleDate validates the InputCycleDate of TagInputMessageAccountabilityData is a Fedwire Funds Service
+// business day. An invalid or missing date is reported by validateIMAD.
+func (fwm *FEDWireMessage) validateIMADCycleDate() base.ErrorList {
+	var errs base.ErrorList
+	if fwm.InputMessageAccountabilityData == nil {
+		return errs
+	}
+	cycleDate, err := fwm.InputMessageAccountabilityData.CycleDate()
+	if err != nil {
+		return errs
 	}
-	return fwm.InputMessageAccountabilityData.Validate()
+	if !calendar.IsBusinessDay(cycleDate) {
+		addFieldError(&errs, TagInputMessageAccountabilityData,
+			fieldError("InputCycleDate", ErrNonBusinessDay, fwm.InputMessageAccountabilityData.InputCycleDate))
+	}
+	return errs
 }
 
 // validateAmount validates TagAmount within a FEDWireMessage
 // * Mandatory for all requests
 // * Can be all zeros for TypeSubType code 90
-func (fwm *FEDWireMessage) validateAmount() error {
+func (fwm *FEDWireMessage) validateAmount() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.Amount == nil {
-		return fieldError("Amount", ErrFieldRequired)
+		addFieldError(&errs, TagAmount, fieldError("Amount", ErrFieldRequired))
+		return errs
 	}
-	if fwm.Amount.Amount == "000000000000" && fwm.TypeSubType.SubTypeCode != "90" {
-		return NewErrInvalidPropertyForProperty("Amount", fwm.Amount.Amount,
-			"SubTypeCode", fwm.TypeSubType.SubTypeCode)
+	if fwm.TypeSubType != nil && fwm.Amount.Amount == "000000000000" && fwm.TypeSubType.SubTypeCode != "90" {
+		errs.Add(NewErrInvalidPropertyForProperty("Amount", fwm.Amount.Amount,
+			"SubTypeCode", fwm.TypeSubType.SubTypeCode))
 	}
-	return fwm.Amount.Validate()
+	addFieldErrors(&errs, fwm.Amount.ValidateAll())
+	return errs
 }
 
 // validateSenderDI validates TagSenderDepositoryInstitution within a FEDWireMessage
 // Mandatory for all requests
-func (fwm *FEDWireMessage) validateSenderDI() error {
+func (fwm *FEDWireMessage) validateSenderDI() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.SenderDepositoryInstitution == nil {
-		return fieldError("SenderDepositoryInstitution", ErrFieldRequired)
+		addFieldError(&errs, TagSenderDepositoryInstitution, fieldError("SenderDepositoryInstitution", ErrFieldRequired))
+		return errs
 	}
-	return fwm.SenderDepositoryInstitution.Validate()
+	addFieldErrors(&errs, fwm.SenderDepositoryInstitution.ValidateAll())
+	return errs
 }
 
 // validateReceiverDI validates TagReceiverDepositoryInstitution within a FEDWireMessage
 // Mandatory for all requests
-func (fwm *FEDWireMessage) validateReceiverDI() error {
+func (fwm *FEDWireMessage) validateReceiverDI() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.ReceiverDepositoryInstitution == nil {
-		return fieldError("ReceiverDepositoryInstitution", ErrFieldRequired)
+		addFieldError(&errs, TagReceiverDepositoryInstitution, fieldError("ReceiverDepositoryInstitution", ErrFieldRequired))
+		return errs
 	}
-	return fwm.ReceiverDepositoryInstitution.Validate()
+	addFieldErrors(&errs, fwm.ReceiverDepositoryInstitution.ValidateAll())
+	return errs
 }
 
 // validateBusinessFunctionCode validates TagBusinessFunctionCode within a FEDWireMessage
 // Mandatory for all requests
-func (fwm *FEDWireMessage) validateBusinessFunctionCode() error {
+func (fwm *FEDWireMessage) validateBusinessFunctionCode() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.BusinessFunctionCode == nil {
-		return fieldError("BusinessFunctionCode", ErrFieldRequired)
+		addFieldError(&errs, TagBusinessFunctionCode, fieldError("BusinessFunctionCode", ErrFieldRequired))
+		return errs
+	}
+	// the rules for each business function code depend on TypeSubType
+	if fwm.TypeSubType == nil {
+		addFieldErrors(&errs, fwm.BusinessFunctionCode.ValidateAll())
+		return errs
 	}
 
 	switch fwm.BusinessFunctionCode.BusinessFunctionCode {
 	case BankTransfer:
-		if err := fwm.validateBankTransfer(); err != nil {
-			return err
-		}
+		addFieldErrors(&errs, fwm.validateBankTransfer())
 	case CustomerTransfer:
-		if err := fwm.validateCustomerTransfer(); err != nil {
-			return err
-		}
+		addFieldErrors(&errs, fwm.validateCustomerTransfer())
 	case CustomerTransferPlus:
-		if err := fwm.validateCustomerTransferPlus(); err != nil {
-			return err
-		}
+		addFieldErrors(&errs, fwm.validateCustomerTransferPlus())
 	case CheckSameDaySettlement:
-		if err := fwm.validateCheckSameDaySettlement(); err != nil {
-			return err
-		}
+		addFieldErrors(&errs, fwm.validateCheckSameDaySettlement())
 	case DepositSendersAccount:
-		if err := fwm.validateDepositSendersAccount(); err != nil {
-			return err
-		}
+		addFieldErrors(&errs, fwm.validateDepositSendersAccount())
 	case FEDFundsReturned:
-		if err := fwm.validateFEDFundsReturned(); err != nil {
-			return err
-		}
+		addFieldErrors(&errs, fwm.validateFEDFundsReturned())
 	case FEDFundsSold:
-		if err := fwm.validateFEDFundsSold(); err != nil {
-			return err
-		}
+		addFieldErrors(&errs, fwm.validateFEDFundsSold())
 	case DrawdownResponse:
-		if err := fwm.validateDrawdownResponse(); err != nil {
-			return err
-		}
+		addFieldErrors(&errs, fwm.validateDrawdownResponse())
 	case BankDrawDownRequest:
-		if err := fwm.validateBankDrawdownRequest(); err != nil {
-			return err
-		}
+		addFieldErrors(&errs, fwm.validateBankDrawdownRequest())
 	case CustomerCorporateDrawdownRequest:
-		if err := fwm.validateCustomerCorporateDrawdownRequest(); err != nil {
-			return err
-		}
+		addFieldErrors(&errs, fwm.validateCustomerCorporateDrawdownRequest())
 	case BFCServiceMessage:
-		if err := fwm.validateServiceMessage(); err != nil {
-			return err
-		}
+		addFieldErrors(&errs, fwm.validateServiceMessage())
 	}
-	return fwm.BusinessFunctionCode.Validate()
+	addFieldErrors(&errs, fwm.BusinessFunctionCode.ValidateAll())
+	return errs
 }
 
 // validateBankTransfer validates the BankTransfer code and associated tags
 // Requires the standard "mandatory" tags checked in mandatoryFields
 // If TypeSubType is ReversalTransfer or ReversalPriorDayTransfer, then PreviousMessageIdentifier is mandatory.
-func (fwm *FEDWireMessage) validateBankTransfer() error {
-	if err := fwm.checkProhibitedBankTransferTags(); err != nil {
-		return err
-	}
-	if err := fwm.checkPreviousMessageIdentifier(); err != nil {
-		return err
-	}
+func (fwm *FEDWireMessage) validateBankTransfer() base.ErrorList {
+	var errs base.ErrorList
+	addFieldErrors(&errs, fwm.checkProhibitedBankTransferTags())
+	addFieldErrors(&errs, fwm.checkPreviousMessageIdentifier())
 
 	typeSubType := fwm.TypeSubType.TypeCode + fwm.TypeSubType.SubTypeCode
 	if !btrTypeSubTypes.Contains(typeSubType) {
-		return NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
-			fwm.BusinessFunctionCode.BusinessFunctionCode)
+		errs.Add(NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
+			fwm.BusinessFunctionCode.BusinessFunctionCode))
 	}
 
-	return nil
+	return errs
 }
 
 // checkProhibitedBankTransferTags ensures there are no tags present in the message that are incompatible with the BankTransfer code
@@ -392,87 +423,82 @@ func (fwm *FEDWireMessage) validateBankTransfer() error {
 //	Beneficiary Code SWIFTBICORBEIANDAccountNumber, AccountDebitedDrawdown, Originator Code SWIFTBICORBEIANDAccountNumber,
 //	OriginatorOptionF, AccountCreditedDrawdown, FIDrawdownDebitAccountAdvice, Any CoverPayment Information tag ({7xxx}),
 //	Any UnstructuredAddenda or remittance tags ({8xxx}), and ServiceMessage
-func (fwm *FEDWireMessage) checkProhibitedBankTransferTags() error {
+func (fwm *FEDWireMessage) checkProhibitedBankTransferTags() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.BusinessFunctionCode != nil {
 		if strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode) != "" {
-			return fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode)
+			addFieldError(&errs, TagBusinessFunctionCode, fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode))
 		}
 	}
 	if fwm.LocalInstrument != nil {
-		return fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument)
+		addFieldError(&errs, TagLocalInstrument, fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument))
 	}
 	if fwm.PaymentNotification != nil {
-		return fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification)
+		addFieldError(&errs, TagPaymentNotification, fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification))
 	}
 	if fwm.Charges != nil {
-		return fieldError("Charges", ErrInvalidProperty, fwm.Charges)
+		addFieldError(&errs, TagCharges, fieldError("Charges", ErrInvalidProperty, fwm.Charges))
 	}
 	if fwm.InstructedAmount != nil {
-		return fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount)
+		addFieldError(&errs, TagInstructedAmount, fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount))
 	}
 	if fwm.ExchangeRate != nil {
-		return fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate)
+		addFieldError(&errs, TagExchangeRate, fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate))
 	}
 	if fwm.Beneficiary != nil && fwm.Beneficiary.Personal.IdentificationCode == SWIFTBICORBEIANDAccountNumber {
-		return fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty, fwm.Beneficiary.Personal.IdentificationCode)
+		addFieldError(&errs, TagBeneficiary, fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty, fwm.Beneficiary.Personal.IdentificationCode))
 	}
 	if fwm.AccountDebitedDrawdown != nil {
-		return fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown)
+		addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown))
 	}
 	if fwm.Originator != nil && fwm.Originator.Personal.IdentificationCode == SWIFTBICORBEIANDAccountNumber {
-		return fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty, fwm.Originator.Personal.IdentificationCode)
+		addFieldError(&errs, TagOriginator, fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty, fwm.Originator.Personal.IdentificationCode))
 	}
 	if fwm.OriginatorOptionF != nil {
-		return fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF)
+		addFieldError(&errs, TagOriginatorOptionF, fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF))
 	}
 	if fwm.AccountCreditedDrawdown != nil {
-		return fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown)
+		addFieldError(&errs, TagAccountCreditedDrawdown, fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown))
 	}
 	if fwm.FIDrawdownDebitAccountAdvice != nil {
-		return fieldError("FIDrawdownDebitAccountAdvice", ErrInvalidProperty, fwm.FIDrawdownDebitAccountAdvice)
+		addFieldError(&errs, TagFIDrawdownDebitAccountAdvice, fieldError("FIDrawdownDebitAccountAdvice", ErrInvalidProperty, fwm.FIDrawdownDebitAccountAdvice))
 	}
 	if fwm.ServiceMessage != nil {
-		return fieldError("ServiceMessage", ErrInvalidProperty, fwm.ServiceMessage)
+		addFieldError(&errs, TagServiceMessage, fieldError("ServiceMessage", ErrInvalidProperty, fwm.ServiceMessage))
 	}
 	if fwm.UnstructuredAddenda != nil {
-		return fieldError("UnstructuredAddenda", ErrInvalidProperty, fwm.UnstructuredAddenda)
+		addFieldError(&errs, TagUnstructuredAddenda, fieldError("UnstructuredAddenda", ErrInvalidProperty, fwm.UnstructuredAddenda))
 	}
-	if err := fwm.invalidCoverPaymentTags(); err != nil {
-		return err
-	}
-	if err := fwm.invalidRemittanceTags(); err != nil {
-		return err
-	}
-	return nil
+	addFieldErrors(&errs, fwm.invalidCoverPaymentTags())
+	addFieldErrors(&errs, fwm.invalidRemittanceTags())
+	return errs
 }
 
 // validateCustomerTransfer validates the CustomerTransfer business function code
-func (fwm *FEDWireMessage) validateCustomerTransfer() error {
-	if err := fwm.checkMandatoryCustomerTransferTags(); err != nil {
-		return err
-	}
+func (fwm *FEDWireMessage) validateCustomerTransfer() base.ErrorList {
+	var errs base.ErrorList
+	addFieldErrors(&errs, fwm.checkMandatoryCustomerTransferTags())
 	typeSubType := fwm.TypeSubType.TypeCode + fwm.TypeSubType.SubTypeCode
 	if !ctrTypeSubTypes.Contains(typeSubType) {
-		return fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
-			fwm.BusinessFunctionCode.BusinessFunctionCode))
+		addFieldError(&errs, TagTypeSubType, fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
+			fwm.BusinessFunctionCode.BusinessFunctionCode)))
 	}
-	return nil
+	return errs
 }
 
 // checkMandatoryCustomerTransferTags checks for the tags required by CustomerTransfer in addition to the standard mandatoryFields.
 // Additional mandatory tags: Beneficiary, Originator
 // If TypeSubType = ReversalTransfer or ReversalPriorDayTransfer, then PreviousMessageIdentifier is mandatory.
-func (fwm *FEDWireMessage) checkMandatoryCustomerTransferTags() error {
+func (fwm *FEDWireMessage) checkMandatoryCustomerTransferTags() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.Beneficiary == nil {
-		return fieldError("Beneficiary", ErrFieldRequired)
+		addFieldError(&errs, TagBeneficiary, fieldError("Beneficiary", ErrFieldRequired))
 	}
 	if fwm.Originator == nil {
-		return fieldError("Originator", ErrFieldRequired)
-	}
-	if err := fwm.checkPreviousMessageIdentifier(); err != nil {
-		return err
+		addFieldError(&errs, TagOriginator, fieldError("Originator", ErrFieldRequired))
 	}
-	return nil
+	addFieldErrors(&errs, fwm.checkPreviousMessageIdentifier())
+	return errs
 }
 
 // checkProhibitedCustomerTransferTags ensures there are no tags present in the message that are incompatible with the CustomerTransfer code
@@ -480,58 +506,52 @@ func (fwm *FEDWireMessage) checkMandatoryCustomerTransferTags() error {
 //
 //	BusinessFunctionCode Element 02 = COV, LocalInstrument, PaymentNotification, AccountDebitedDrawdown, OriginatorOptionF, AccountCreditedDrawdown,
 //	FIDrawdownDebitAccountAdvice, any CoverPayment Information tag ({7xxx}), any UnstructuredAddenda or remittance tags ({8xxx}) and ServiceMessage
-func (fwm *FEDWireMessage) checkProhibitedCustomerTransferTags() error {
+func (fwm *FEDWireMessage) checkProhibitedCustomerTransferTags() base.ErrorList {
+	var errs base.ErrorList
 	// This covers the edit requirement
 	if fwm.BusinessFunctionCode.TransactionTypeCode == "COV" {
-		return fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode)
+		addFieldError(&errs, TagBusinessFunctionCode, fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode))
 	}
 	if fwm.LocalInstrument != nil {
-		return fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument)
+		addFieldError(&errs, TagLocalInstrument, fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument))
 	}
 	if fwm.PaymentNotification != nil {
-		return fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification)
+		addFieldError(&errs, TagPaymentNotification, fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification))
 	}
 	if fwm.AccountDebitedDrawdown != nil {
-		return fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown)
+		addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown))
 	}
 	if fwm.OriginatorOptionF != nil {
-		return fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF)
+		addFieldError(&errs, TagOriginatorOptionF, fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF))
 	}
 	if fwm.AccountCreditedDrawdown != nil {
-		return fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown)
+		addFieldError(&errs, TagAccountCreditedDrawdown, fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown))
 	}
 	if fwm.FIDrawdownDebitAccountAdvice != nil {
-		return fieldError("FIDrawdownDebitAccountAdvice", ErrInvalidProperty, fwm.FIDrawdownDebitAccountAdvice)
+		addFieldError(&errs, TagFIDrawdownDebitAccountAdvice, fieldError("FIDrawdownDebitAccountAdvice", ErrInvalidProperty, fwm.FIDrawdownDebitAccountAdvice))
 	}
 	if fwm.ServiceMessage != nil {
-		return fieldError("ServiceMessage", ErrInvalidProperty, fwm.ServiceMessage)
+		addFieldError(&errs, TagServiceMessage, fieldError("ServiceMessage", ErrInvalidProperty, fwm.ServiceMessage))
 	}
 	if fwm.UnstructuredAddenda != nil {
-		return fieldError("UnstructuredAddenda", ErrInvalidProperty, fwm.UnstructuredAddenda)
+		addFieldError(&errs, TagUnstructuredAddenda, fieldError("UnstructuredAddenda", ErrInvalidProperty, fwm.UnstructuredAddenda))
 	}
-	if err := fwm.invalidCoverPaymentTags(); err != nil {
-		return err
-	}
-	if err := fwm.invalidRemittanceTags(); err != nil {
-		return err
-	}
-	return nil
+	addFieldErrors(&errs, fwm.invalidCoverPaymentTags())
+	addFieldErrors(&errs, fwm.invalidRemittanceTags())
+	return errs
 }
 
 // validateCustomerTransferPlus validates the CustomerTransferPlus business function code
-func (fwm *FEDWireMessage) validateCustomerTransferPlus() error {
-	if err := fwm.checkMandatoryCustomerTransferPlusTags(); err != nil {
-		return err
-	}
-	if err := fwm.checkProhibitedCustomerTransferPlusTags(); err != nil {
-		return err
-	}
+func (fwm *FEDWireMessage) validateCustomerTransferPlus() base.ErrorList {
+	var errs base.ErrorList
+	addFieldErrors(&errs, fwm.checkMandatoryCustomerTransferPlusTags())
+	addFieldErrors(&errs, fwm.checkProhibitedCustomerTransferPlusTags())
 	typeSubType := fwm.TypeSubType.TypeCode + fwm.TypeSubType.SubTypeCode
 	if !ctpTypeSubTypes.Contains(typeSubType) {
-		return fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
-			fwm.BusinessFunctionCode.BusinessFunctionCode))
+		addFieldError(&errs, TagTypeSubType, fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
+			fwm.BusinessFunctionCode.BusinessFunctionCode)))
 	}
-	return nil
+	return errs
 }
 
 // checkMandatoryCustomerTransferPlusTags checks for the tags required by CustomerTransferPlus in addition to the standard mandatoryFields
@@ -545,60 +565,59 @@ func (fwm *FEDWireMessage) validateCustomerTransferPlus() error {
 // If LocalInstrument = RelatedRemittanceInformation, then RelatedRemittance is mandatory.
 // If LocalInstrument = RemittanceInformationStructured, then RemittanceOriginator, RemittanceBeneficiary, PrimaryRemittanceDocument & ActualAmountPaid are mandatory.
 // If LocalInstrument = ProprietaryLocalInstrumentCode, then LocalInstrument Element 02 is mandatory.
-func (fwm *FEDWireMessage) checkMandatoryCustomerTransferPlusTags() error {
+func (fwm *FEDWireMessage) checkMandatoryCustomerTransferPlusTags() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.Beneficiary == nil {
-		return fieldError("Beneficiary", ErrFieldRequired)
+		addFieldError(&errs, TagBeneficiary, fieldError("Beneficiary", ErrFieldRequired))
 	}
 	if fwm.Originator == nil && fwm.OriginatorOptionF == nil { // one or the other must be present
-		return fieldError("Originator OR OriginatorOptionF", ErrFieldRequired)
-	}
-	if err := fwm.checkPreviousMessageIdentifier(); err != nil {
-		return err
+		addFieldError(&errs, TagOriginator, fieldError("Originator OR OriginatorOptionF", ErrFieldRequired))
 	}
+	addFieldErrors(&errs, fwm.checkPreviousMessageIdentifier())
 
 	// LocalInstrument is optional for Customer Transfer Plus
 	if fwm.LocalInstrument != nil {
 		switch fwm.LocalInstrument.LocalInstrumentCode {
 		case SequenceBCoverPaymentStructured:
 			if fwm.BeneficiaryReference == nil {
-				return fieldError("BeneficiaryReference", ErrFieldRequired)
+				addFieldError(&errs, TagBeneficiaryReference, fieldError("BeneficiaryReference", ErrFieldRequired))
 			}
 			if fwm.OrderingCustomer == nil {
-				return fieldError("OrderingCustomer", ErrFieldRequired)
+				addFieldError(&errs, TagOrderingCustomer, fieldError("OrderingCustomer", ErrFieldRequired))
 			}
 			if fwm.BeneficiaryCustomer == nil {
-				return fieldError("BeneficiaryCustomer", ErrFieldRequired)
+				addFieldError(&errs, TagBeneficiaryCustomer, fieldError("BeneficiaryCustomer", ErrFieldRequired))
 			}
 		case ANSIX12format, GeneralXMLformat, ISO20022XMLformat,
 			NarrativeText, STP820format, SWIFTfield70, UNEDIFACTformat:
 			if fwm.UnstructuredAddenda == nil {
-				return fieldError("UnstructuredAddenda", ErrFieldRequired)
+				addFieldError(&errs, TagUnstructuredAddenda, fieldError("UnstructuredAddenda", ErrFieldRequired))
 			}
 		case RelatedRemittanceInformation:
 			if fwm.RelatedRemittance == nil {
-				return fieldError("RelatedRemittance", ErrFieldRequired)
+				addFieldError(&errs, TagRelatedRemittance, fieldError("RelatedRemittance", ErrFieldRequired))
 			}
 		case RemittanceInformationStructured:
 			if fwm.RemittanceOriginator == nil {
-				return fieldError("RemittanceOriginator", ErrFieldRequired)
+				addFieldError(&errs, TagRemittanceOriginator, fieldError("RemittanceOriginator", ErrFieldRequired))
 			}
 			if fwm.RemittanceBeneficiary == nil {
-				return fieldError("RemittanceBeneficiary", ErrFieldRequired)
+				addFieldError(&errs, TagRemittanceBeneficiary, fieldError("RemittanceBeneficiary", ErrFieldRequired))
 			}
 			if fwm.PrimaryRemittanceDocument == nil {
-				return fieldError("PrimaryRemittanceDocument", ErrFieldRequired)
+				addFieldError(&errs, TagPrimaryRemittanceDocument, fieldError("PrimaryRemittanceDocument", ErrFieldRequired))
 			}
 			if fwm.ActualAmountPaid == nil {
-				return fieldError("ActualAmountPaid", ErrFieldRequired)
+				addFieldError(&errs, TagActualAmountPaid, fieldError("ActualAmountPaid", ErrFieldRequired))
 			}
 		case ProprietaryLocalInstrumentCode:
 			if fwm.LocalInstrument.ProprietaryCode == "" {
-				return fieldError("ProprietaryCode", ErrFieldRequired)
+				addFieldError(&errs, TagLocalInstrument, fieldError("ProprietaryCode", ErrFieldRequired))
 			}
 		}
 	}
 
-	return nil
+	return errs
 }
 
 // checkProhibitedCustomerTransferPlusTags ensures there are no tags present in the message that are incompatible with the CustomerTransferPlus code
@@ -608,187 +627,206 @@ func (fwm *FEDWireMessage) checkMandatoryCustomerTransferPlusTags() error {
 //
 // If LocalInstrument = SequenceBCoverPaymentStructured, Charges, InstructedAmount & ExchangeRate are not permitted.
 // Certain {7xxx} tags & {8xxx} tags may not be permitted depending upon value of LocalInstrument.
-func (fwm *FEDWireMessage) checkProhibitedCustomerTransferPlusTags() error {
+func (fwm *FEDWireMessage) checkProhibitedCustomerTransferPlusTags() base.ErrorList {
+	var errs base.ErrorList
 	if strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode) != "" {
-		return fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode)
+		addFieldError(&errs, TagBusinessFunctionCode, fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode))
 	}
 	if fwm.AccountDebitedDrawdown != nil {
-		return fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown)
+		addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown))
 	}
 	if fwm.AccountCreditedDrawdown != nil {
-		return fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown)
+		addFieldError(&errs, TagAccountCreditedDrawdown, fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown))
 	}
 	if fwm.FIReceiverFI != nil {
-		return fieldError("FIReceiverFI", ErrInvalidProperty, fwm.FIReceiverFI)
+		addFieldError(&errs, TagFIReceiverFI, fieldError("FIReceiverFI", ErrInvalidProperty, fwm.FIReceiverFI))
 	}
 
 	if fwm.LocalInstrument != nil {
 		if fwm.LocalInstrument.LocalInstrumentCode == SequenceBCoverPaymentStructured {
 			if fwm.Charges != nil {
-				return fieldError("Charges", ErrInvalidProperty, fwm.Charges)
+				addFieldError(&errs, TagCharges, fieldError("Charges", ErrInvalidProperty, fwm.Charges))
 			}
 			if fwm.InstructedAmount != nil {
-				return fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount)
+				addFieldError(&errs, TagInstructedAmount, fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount))
 			}
 			if fwm.ExchangeRate != nil {
-				return fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate)
+				addFieldError(&errs, TagExchangeRate, fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate))
 			}
 		}
 		if fwm.LocalInstrument.LocalInstrumentCode != SequenceBCoverPaymentStructured {
-			if err := fwm.invalidCoverPaymentTags(); err != nil {
-				return err
-			}
+			addFieldErrors(&errs, fwm.invalidCoverPaymentTags())
 		}
 	}
 
 	// ToDo: From the spec - Certain {7xxx} tags & {8xxx} tags may not be permitted depending upon value of {3610}.  I'm not sure how to code this yet
-	return nil
+	return errs
 }
 
 // checkPreviousMessageIdentifier returns an error if ReversalTransfer or ReversalPriorDayTransfer options are set and PreviousMessageIdentifier is missing
-func (fwm *FEDWireMessage) checkPreviousMessageIdentifier() error {
+func (fwm *FEDWireMessage) checkPreviousMessageIdentifier() base.ErrorList {
 	if fwm.TypeSubType == nil || fwm.BusinessFunctionCode == nil {
 		return nil
 	}
 
+	var errs base.ErrorList
 	switch fwm.TypeSubType.SubTypeCode {
 	case ReversalTransfer, ReversalPriorDayTransfer:
 		if fwm.PreviousMessageIdentifier == nil {
-			return fieldError("PreviousMessageIdentifier", ErrFieldRequired)
+			addFieldError(&errs, TagPreviousMessageIdentifier, fieldError("PreviousMessageIdentifier", ErrFieldRequired))
 		}
 	}
-	return nil
+	return errs
 }
 
 // validateCheckSameDaySettlement validates the CheckSameDaySettlement business function code
-func (fwm *FEDWireMessage) validateCheckSameDaySettlement() error {
+func (fwm *FEDWireMessage) validateCheckSameDaySettlement() base.ErrorList {
+	var errs base.ErrorList
 	typeSubType := fwm.TypeSubType.TypeCode + fwm.TypeSubType.SubTypeCode
 	if !cksTypeSubTypes.Contains(typeSubType) {
-		return fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
-			fwm.BusinessFunctionCode.BusinessFunctionCode))
+		addFieldError(&errs, TagTypeSubType, fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
+			fwm.BusinessFunctionCode.BusinessFunctionCode)))
 	}
-	return fwm.checkSharedProhibitedTags()
+	addFieldErrors(&errs, fwm.checkSharedProhibitedTags())
+	return errs
 }
 
 // validateDepositSendersAccount validates the DepositSendersAccount business function code
-func (fwm *FEDWireMessage) validateDepositSendersAccount() error {
+func (fwm *FEDWireMessage) validateDepositSendersAccount() base.ErrorList {
+	var errs base.ErrorList
 	typeSubType := fwm.TypeSubType.TypeCode + fwm.TypeSubType.SubTypeCode
 	if !depTypeSubTypes.Contains(typeSubType) {
-		return fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
-			fwm.BusinessFunctionCode.BusinessFunctionCode))
+		addFieldError(&errs, TagTypeSubType, fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
+			fwm.BusinessFunctionCode.BusinessFunctionCode)))
 	}
-	return fwm.checkSharedProhibitedTags()
+	addFieldErrors(&errs, fwm.checkSharedProhibitedTags())
+	return errs
 }
 
 // validateFEDFundsReturned validates the FEDFundsReturned business function code
-func (fwm *FEDWireMessage) validateFEDFundsReturned() error {
+func (fwm *FEDWireMessage) validateFEDFundsReturned() base.ErrorList {
+	var errs base.ErrorList
 	typeSubType := fwm.TypeSubType.TypeCode + fwm.TypeSubType.SubTypeCode
 	if !ffrTypeSubTypes.Contains(typeSubType) {
-		return fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
-			fwm.BusinessFunctionCode.BusinessFunctionCode))
+		addFieldError(&errs, TagTypeSubType, fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
+			fwm.BusinessFunctionCode.BusinessFunctionCode)))
 	}
-	return fwm.checkSharedProhibitedTags()
+	addFieldErrors(&errs, fwm.checkSharedProhibitedTags())
+	return errs
 }
 
 // validateFEDFundsSold validates the FEDFundsSold business function code
-func (fwm *FEDWireMessage) validateFEDFundsSold() error {
+func (fwm *FEDWireMessage) validateFEDFundsSold() base.ErrorList {
+	var errs base.ErrorList
 	typeSubType := fwm.TypeSubType.TypeCode + fwm.TypeSubType.SubTypeCode
 	if !ffsTypeSubTypes.Contains(typeSubType) {
-		return fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
-			fwm.BusinessFunctionCode.BusinessFunctionCode))
+		addFieldError(&errs, TagTypeSubType, fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
+			fwm.BusinessFunctionCode.BusinessFunctionCode)))
 	}
-	return fwm.checkSharedProhibitedTags()
+	addFieldErrors(&errs, fwm.checkSharedProhibitedTags())
+	return errs
 }
 
 // validateDrawdownResponse validates the DrawdownResponse business function code
-func (fwm *FEDWireMessage) validateDrawdownResponse() error {
+func (fwm *FEDWireMessage) validateDrawdownResponse() base.ErrorList {
+	var errs base.ErrorList
 	typeSubType := fwm.TypeSubType.TypeCode + fwm.TypeSubType.SubTypeCode
 	if !drwTypeSubTypes.Contains(typeSubType) {
-		return fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
-			fwm.BusinessFunctionCode.BusinessFunctionCode))
+		addFieldError(&errs, TagTypeSubType, fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
+			fwm.BusinessFunctionCode.BusinessFunctionCode)))
 	}
-	if err := fwm.checkMandatoryDrawdownResponseTags(); err != nil {
-		return err
+	addFieldErrors(&errs, fwm.checkMandatoryDrawdownResponseTags())
+	addFieldErrors(&errs, fwm.checkSharedProhibitedTags())
+	if fwm.ValidateOptions != nil && fwm.ValidateOptions.DrawdownRequest != nil {
+		addFieldErrors(&errs, fwm.validateDrawdownAnswer(fwm.ValidateOptions.DrawdownRequest))
 	}
-	return fwm.checkSharedProhibitedTags()
+	return errs
 }
 
 // checkMandatoryDrawdownResponseTags checks for the tags required by DrawdownResponse in addition to the standard mandatoryFields
 // Additional mandatory fields: Beneficiary, Originator
-func (fwm *FEDWireMessage) checkMandatoryDrawdownResponseTags() error {
+func (fwm *FEDWireMessage) checkMandatoryDrawdownResponseTags() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.Beneficiary == nil {
-		return fieldError("Beneficiary", ErrFieldRequired)
+		addFieldError(&errs, TagBeneficiary, fieldError("Beneficiary", ErrFieldRequired))
 	}
 	if fwm.Originator == nil {
-		return fieldError("Originator", ErrFieldRequired)
+		addFieldError(&errs, TagOriginator, fieldError("Originator", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // validateBankDrawdownRequest validates the BankDrawDownRequest business function code
-func (fwm *FEDWireMessage) validateBankDrawdownRequest() error {
+func (fwm *FEDWireMessage) validateBankDrawdownRequest() base.ErrorList {
+	var errs base.ErrorList
 	typeSubType := fwm.TypeSubType.TypeCode + fwm.TypeSubType.SubTypeCode
 	if !drbTypeSubTypes.Contains(typeSubType) {
-		return fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
-			fwm.BusinessFunctionCode.BusinessFunctionCode))
+		addFieldError(&errs, TagTypeSubType, fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
+			fwm.BusinessFunctionCode.BusinessFunctionCode)))
 	}
-	if err := fwm.checkMandatoryBankDrawdownRequestTags(); err != nil {
-		return err
+	addFieldErrors(&errs, fwm.checkMandatoryBankDrawdownRequestTags())
+	addFieldErrors(&errs, fwm.checkSharedProhibitedTags())
+	if fwm.TypeSubType.SubTypeCode == RefusalRequestCredit && fwm.ValidateOptions != nil && fwm.ValidateOptions.DrawdownRequest != nil {
+		addFieldErrors(&errs, fwm.validateDrawdownAnswer(fwm.ValidateOptions.DrawdownRequest))
 	}
-	return fwm.checkSharedProhibitedTags()
+	return errs
 }
 
 // checkMandatoryBankDrawdownRequestTags checks for the tags required by BankDrawDownRequest in addition to the standard mandatoryFields
 // Additional mandatory fields: AccountDebitedDrawdown, AccountCreditedDrawdown
-func (fwm *FEDWireMessage) checkMandatoryBankDrawdownRequestTags() error {
+func (fwm *FEDWireMessage) checkMandatoryBankDrawdownRequestTags() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.AccountDebitedDrawdown == nil {
-		return fieldError("AccountDebitedDrawdown", ErrFieldRequired)
+		addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("AccountDebitedDrawdown", ErrFieldRequired))
 	}
 	if fwm.AccountCreditedDrawdown == nil {
-		return fieldError("AccountCreditedDrawdown", ErrFieldRequired)
+		addFieldError(&errs, TagAccountCreditedDrawdown, fieldError("AccountCreditedDrawdown", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // validateCustomerCorporateDrawdownRequest validates the CustomerCorporateDrawdownRequest business function code
-func (fwm *FEDWireMessage) validateCustomerCorporateDrawdownRequest() error {
+func (fwm *FEDWireMessage) validateCustomerCorporateDrawdownRequest() base.ErrorList {
+	var errs base.ErrorList
 	typeSubType := fwm.TypeSubType.TypeCode + fwm.TypeSubType.SubTypeCode
 	if !drcTypeSubTypes.Contains(typeSubType) {
-		return fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
-			fwm.BusinessFunctionCode.BusinessFunctionCode))
+		addFieldError(&errs, TagTypeSubType, fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
+			fwm.BusinessFunctionCode.BusinessFunctionCode)))
 	}
-	if err := fwm.checkMandatoryCustomerCorporateDrawdownRequestTags(); err != nil {
-		return err
+	addFieldErrors(&errs, fwm.checkMandatoryCustomerCorporateDrawdownRequestTags())
+	addFieldErrors(&errs, fwm.checkSharedProhibitedTags())
+	if fwm.TypeSubType.SubTypeCode == RefusalRequestCredit && fwm.ValidateOptions != nil && fwm.ValidateOptions.DrawdownRequest != nil {
+		addFieldErrors(&errs, fwm.validateDrawdownAnswer(fwm.ValidateOptions.DrawdownRequest))
 	}
-	return fwm.checkSharedProhibitedTags()
+	return errs
 }
 
 // checkMandatoryCustomerCorporateDrawdownRequestTags checks for the tags required by CustomerCorporateDrawdownRequest in addition to the standard mandatoryFields
 // Additional mandatory fields: Beneficiary, AccountDebitedDrawdown, AccountCreditedDrawdown
-func (fwm *FEDWireMessage) checkMandatoryCustomerCorporateDrawdownRequestTags() error {
+func (fwm *FEDWireMessage) checkMandatoryCustomerCorporateDrawdownRequestTags() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.Beneficiary == nil {
-		return fieldError("Beneficiary", ErrFieldRequired)
+		addFieldError(&errs, TagBeneficiary, fieldError("Beneficiary", ErrFieldRequired))
 	}
 	if fwm.AccountDebitedDrawdown == nil {
-		return fieldError("AccountDebitedDrawdown", ErrFieldRequired)
+		addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("AccountDebitedDrawdown", ErrFieldRequired))
 	}
 	if fwm.AccountCreditedDrawdown == nil {
-		return fieldError("AccountCreditedDrawdown", ErrFieldRequired)
+		addFieldError(&errs, TagAccountCreditedDrawdown, fieldError("AccountCreditedDrawdown", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // validateServiceMessage validates the BFCServiceMessage business function code
-func (fwm *FEDWireMessage) validateServiceMessage() error {
+func (fwm *FEDWireMessage) validateServiceMessage() base.ErrorList {
+	var errs base.ErrorList
 	typeSubType := fwm.TypeSubType.TypeCode + fwm.TypeSubType.SubTypeCode
 	if !svcTypeSubTypes.Contains(typeSubType) {
-		return fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
-			fwm.BusinessFunctionCode.BusinessFunctionCode))
-	}
-	if err := fwm.checkProhibitedServiceMessageTags(); err != nil {
-		return err
+		addFieldError(&errs, TagTypeSubType, fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType,
+			fwm.BusinessFunctionCode.BusinessFunctionCode)))
 	}
-	return nil
+	addFieldErrors(&errs, fwm.checkProhibitedServiceMessageTags())
+	return errs
 }
 
 // checkProhibitedServiceMessageTags ensures there are no tags present in the message that are incompatible with the BFCServiceMessage code
@@ -797,445 +835,457 @@ func (fwm *FEDWireMessage) validateServiceMessage() error {
 //	BusinessFunctionCode.TransactionTypeCode, LocalInstrument, PaymentNotification, Charges, InstructedAmount, ExchangeRate,
 //	Beneficiary Code = SWIFTBICORBEIANDAccountNumber, Originator Code = SWIFTBICORBEIANDAccountNumber, OriginatorOptionF,
 //	any {7xxx} tag, any {8xxx} tag
-func (fwm *FEDWireMessage) checkProhibitedServiceMessageTags() error {
+func (fwm *FEDWireMessage) checkProhibitedServiceMessageTags() base.ErrorList {
+	var errs base.ErrorList
 	// BusinessFunctionCode.TransactionTypeCode (Element 02) is invalid
 	if fwm.BusinessFunctionCode != nil {
 		if strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode) != "" {
-			return fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode)
+			addFieldError(&errs, TagBusinessFunctionCode, fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode))
 		}
 	}
 	if fwm.LocalInstrument != nil {
-		return fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument)
+		addFieldError(&errs, TagLocalInstrument, fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument))
 	}
 	if fwm.PaymentNotification != nil {
-		return fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification)
+		addFieldError(&errs, TagPaymentNotification, fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification))
 	}
 	if fwm.Charges != nil {
-		return fieldError("Charges", ErrInvalidProperty, fwm.Charges)
+		addFieldError(&errs, TagCharges, fieldError("Charges", ErrInvalidProperty, fwm.Charges))
 	}
 	if fwm.InstructedAmount != nil {
-		return fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount)
+		addFieldError(&errs, TagInstructedAmount, fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount))
 	}
 	if fwm.ExchangeRate != nil {
-		return fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate)
+		addFieldError(&errs, TagExchangeRate, fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate))
 	}
 	if fwm.Beneficiary != nil && fwm.Beneficiary.Personal.IdentificationCode == SWIFTBICORBEIANDAccountNumber {
-		return fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty, fwm.Beneficiary.Personal.IdentificationCode)
+		addFieldError(&errs, TagBeneficiary, fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty, fwm.Beneficiary.Personal.IdentificationCode))
 	}
 	if fwm.Originator != nil && fwm.Originator.Personal.IdentificationCode == SWIFTBICORBEIANDAccountNumber {
-		return fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty, fwm.Originator.Personal.IdentificationCode)
+		addFieldError(&errs, TagOriginator, fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty, fwm.Originator.Personal.IdentificationCode))
 	}
 	if fwm.OriginatorOptionF != nil {
-		return fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF)
+		addFieldError(&errs, TagOriginatorOptionF, fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF))
 	}
 	if fwm.UnstructuredAddenda != nil {
-		return fieldError("BusinessFunctionCode", ErrInvalidProperty, "Unstructured Addenda")
-	}
-	if err := fwm.invalidCoverPaymentTags(); err != nil {
-		return err
+		addFieldError(&errs, TagBusinessFunctionCode, fieldError("BusinessFunctionCode", ErrInvalidProperty, "Unstructured Addenda"))
 	}
-	if err := fwm.invalidRemittanceTags(); err != nil {
-		return err
-	}
-	return nil
+	addFieldErrors(&errs, fwm.invalidCoverPaymentTags())
+	addFieldErrors(&errs, fwm.invalidRemittanceTags())
+	return errs
 }
 
 // checkSharedProhibitedTags uses case logic for BusinessFunctionCodes that have the same invalid tags.  If this were to change per
 // BusinessFunctionCode, create function isInvalidBusinessFunctionCodeTag() with the specific invalid tags for that
 // BusinessFunctionCode (e.g. checkProhibitedBankTransferTags)
-func (fwm *FEDWireMessage) checkSharedProhibitedTags() error {
+func (fwm *FEDWireMessage) checkSharedProhibitedTags() base.ErrorList {
+	var errs base.ErrorList
 	// shared between CheckSameDaySettlement, DepositSendersAccount, FEDFundsReturned, FEDFundsSold, DrawdownResponse, BankDrawDownRequest, and CustomerCorporateDrawdownRequest
 	if strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode) != "" {
-		return fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode)
+		addFieldError(&errs, TagBusinessFunctionCode, fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode))
 	}
 	if fwm.LocalInstrument != nil {
-		return fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument)
+		addFieldError(&errs, TagLocalInstrument, fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument))
 	}
 	if fwm.PaymentNotification != nil {
-		return fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification)
+		addFieldError(&errs, TagPaymentNotification, fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification))
 	}
 	if fwm.Charges != nil {
-		return fieldError("Charges", ErrInvalidProperty, fwm.Charges)
+		addFieldError(&errs, TagCharges, fieldError("Charges", ErrInvalidProperty, fwm.Charges))
 	}
 	if fwm.InstructedAmount != nil {
-		return fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount)
+		addFieldError(&errs, TagInstructedAmount, fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount))
 	}
 	if fwm.ExchangeRate != nil {
-		return fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate)
+		addFieldError(&errs, TagExchangeRate, fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate))
 	}
 	if fwm.Beneficiary != nil {
 		if fwm.Beneficiary.Personal.IdentificationCode == SWIFTBICORBEIANDAccountNumber {
-			return fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty, fwm.Beneficiary.Personal.IdentificationCode)
+			addFieldError(&errs, TagBeneficiary, fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty, fwm.Beneficiary.Personal.IdentificationCode))
 		}
 	}
 	if fwm.Originator != nil {
 		if fwm.Originator.Personal.IdentificationCode == SWIFTBICORBEIANDAccountNumber {
-			return fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty, fwm.Originator.Personal.IdentificationCode)
+			addFieldError(&errs, TagOriginator, fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty, fwm.Originator.Personal.IdentificationCode))
 		}
 	}
 	if fwm.OriginatorOptionF != nil {
-		return fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF)
+		addFieldError(&errs, TagOriginatorOptionF, fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF))
 	}
 	if fwm.ServiceMessage != nil {
-		return fieldError("BusinessFunctionCode", ErrInvalidProperty, "ServiceMessage")
+		addFieldError(&errs, TagBusinessFunctionCode, fieldError("BusinessFunctionCode", ErrInvalidProperty, "ServiceMessage"))
 	}
 	if fwm.UnstructuredAddenda != nil {
-		return fieldError("BusinessFunctionCode", ErrInvalidProperty, "Unstructured Addenda")
-	}
-	if err := fwm.invalidCoverPaymentTags(); err != nil {
-		return err
-	}
-	if err := fwm.invalidRemittanceTags(); err != nil {
-		return err
+		addFieldError(&errs, TagBusinessFunctionCode, fieldError("BusinessFunctionCode", ErrInvalidProperty, "Unstructured Addenda"))
 	}
+	addFieldErrors(&errs, fwm.invalidCoverPaymentTags())
+	addFieldErrors(&errs, fwm.invalidRemittanceTags())
 
 	switch fwm.BusinessFunctionCode.BusinessFunctionCode {
 	case CheckSameDaySettlement, DepositSendersAccount, FEDFundsReturned, FEDFundsSold:
 		// unique exclusions: AccountDebitedDrawdown, AccountCreditedDrawdown, FIDrawdownDebitAccountAdvice
 		if fwm.AccountDebitedDrawdown != nil {
-			return fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown)
+			addFieldError(&errs, TagAccountDebitedDrawdown, fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown))
 		}
 		if fwm.AccountCreditedDrawdown != nil {
-			return fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown)
+			addFieldError(&errs, TagAccountCreditedDrawdown, fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown))
 		}
 		if fwm.FIDrawdownDebitAccountAdvice != nil {
-			return fieldError("FIDrawdownDebitAccountAdvice", ErrInvalidProperty, fwm.FIDrawdownDebitAccountAdvice)
+			addFieldError(&errs, TagFIDrawdownDebitAccountAdvice, fieldError("FIDrawdownDebitAccountAdvice", ErrInvalidProperty, fwm.FIDrawdownDebitAccountAdvice))
 		}
 	case DrawdownResponse, BankDrawDownRequest, CustomerCorporateDrawdownRequest:
 		// this group has no unique exclusions
 	}
-	return nil
+	return errs
 }
 
 // invalidRemittanceTags returns an error if certain {8xxx} range tags are present.
 // The validity of these tags generally depends on the value of the LocalInstrument tag.
-func (fwm *FEDWireMessage) invalidRemittanceTags() error {
+func (fwm *FEDWireMessage) invalidRemittanceTags() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.RelatedRemittance != nil {
-		return fieldError("RelatedRemittance", ErrInvalidProperty, fwm.RelatedRemittance)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("RelatedRemittance", ErrInvalidProperty, fwm.RelatedRemittance))
 	}
 	if fwm.RemittanceOriginator != nil {
-		return fieldError("RemittanceOriginator", ErrInvalidProperty, "RemittanceOriginator")
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("RemittanceOriginator", ErrInvalidProperty, "RemittanceOriginator"))
 	}
 	if fwm.RemittanceBeneficiary != nil {
-		return fieldError("RemittanceBeneficiary", ErrInvalidProperty, "RemittanceBeneficiary")
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("RemittanceBeneficiary", ErrInvalidProperty, "RemittanceBeneficiary"))
 	}
 	if fwm.PrimaryRemittanceDocument != nil {
-		return fieldError("PrimaryRemittanceDocument", ErrInvalidProperty, "PrimaryRemittanceDocument")
+		addFieldError(&errs, TagPrimaryRemittanceDocument, fieldError("PrimaryRemittanceDocument", ErrInvalidProperty, "PrimaryRemittanceDocument"))
 	}
 	if fwm.ActualAmountPaid != nil {
-		return fieldError("ActualAmountPaid", ErrInvalidProperty, "ActualAmountPaid")
+		addFieldError(&errs, TagActualAmountPaid, fieldError("ActualAmountPaid", ErrInvalidProperty, "ActualAmountPaid"))
 	}
 	if fwm.GrossAmountRemittanceDocument != nil {
-		return fieldError("GrossAmountRemittanceDocument", ErrInvalidProperty, "GrossAmountRemittanceDocument")
+		addFieldError(&errs, TagGrossAmountRemittanceDocument, fieldError("GrossAmountRemittanceDocument", ErrInvalidProperty, "GrossAmountRemittanceDocument"))
 	}
 	if fwm.AmountNegotiatedDiscount != nil {
-		return fieldError("AmountNegotiatedDiscount", ErrInvalidProperty, "AmountNegotiatedDiscount")
+		addFieldError(&errs, TagAmountNegotiatedDiscount, fieldError("AmountNegotiatedDiscount", ErrInvalidProperty, "AmountNegotiatedDiscount"))
 	}
 	if fwm.Adjustment != nil {
-		return fieldError("Adjustment", ErrInvalidProperty, "Adjustment")
+		addFieldError(&errs, TagAdjustment, fieldError("Adjustment", ErrInvalidProperty, "Adjustment"))
 	}
 	if fwm.DateRemittanceDocument != nil {
-		return fieldError("DateRemittanceDocument", ErrInvalidProperty, "DateRemittanceDocument")
+		addFieldError(&errs, TagDateRemittanceDocument, fieldError("DateRemittanceDocument", ErrInvalidProperty, "DateRemittanceDocument"))
 	}
 	if fwm.SecondaryRemittanceDocument != nil {
-		return fieldError("SecondaryRemittanceDocument", ErrInvalidProperty, "SecondaryRemittanceDocument")
+		addFieldError(&errs, TagSecondaryRemittanceDocument, fieldError("SecondaryRemittanceDocument", ErrInvalidProperty, "SecondaryRemittanceDocument"))
 	}
 	if fwm.RemittanceFreeText != nil {
-		return fieldError("RemittanceFreeText", ErrInvalidProperty, "RemittanceFreeText")
+		addFieldError(&errs, TagRemittanceFreeText, fieldError("RemittanceFreeText", ErrInvalidProperty, "RemittanceFreeText"))
 	}
-	return nil
+	return errs
 }
 
 // invalidCoverPaymentTags returns an error if certain {7xxx} range tags are present.
 // The validity of these tags generally depends on the value of the LocalInstrument tag.
-func (fwm *FEDWireMessage) invalidCoverPaymentTags() error {
+func (fwm *FEDWireMessage) invalidCoverPaymentTags() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.CurrencyInstructedAmount != nil {
-		return fieldError("CurrencyInstructedAmount", ErrInvalidProperty, fwm.CurrencyInstructedAmount)
+		addFieldError(&errs, TagCurrencyInstructedAmount, fieldError("CurrencyInstructedAmount", ErrInvalidProperty, fwm.CurrencyInstructedAmount))
 	}
 	if fwm.OrderingCustomer != nil {
-		return fieldError("OrderingCustomer", ErrInvalidProperty, fwm.OrderingCustomer)
+		addFieldError(&errs, TagOrderingCustomer, fieldError("OrderingCustomer", ErrInvalidProperty, fwm.OrderingCustomer))
 	}
 	if fwm.OrderingInstitution != nil {
-		return fieldError("OrderingInstitution", ErrInvalidProperty, fwm.OrderingInstitution)
+		addFieldError(&errs, TagOrderingInstitution, fieldError("OrderingInstitution", ErrInvalidProperty, fwm.OrderingInstitution))
 	}
 	if fwm.IntermediaryInstitution != nil {
-		return fieldError("IntermediaryInstitution", ErrInvalidProperty, fwm.IntermediaryInstitution)
+		addFieldError(&errs, TagIntermediaryInstitution, fieldError("IntermediaryInstitution", ErrInvalidProperty, fwm.IntermediaryInstitution))
 	}
 	if fwm.InstitutionAccount != nil {
-		return fieldError("InstitutionAccount", ErrInvalidProperty, fwm.InstitutionAccount)
+		addFieldError(&errs, TagInstitutionAccount, fieldError("InstitutionAccount", ErrInvalidProperty, fwm.InstitutionAccount))
 	}
 	if fwm.BeneficiaryCustomer != nil {
-		return fieldError("BeneficiaryCustomer", ErrInvalidProperty, fwm.BeneficiaryCustomer)
+		addFieldError(&errs, TagBeneficiaryCustomer, fieldError("BeneficiaryCustomer", ErrInvalidProperty, fwm.BeneficiaryCustomer))
 	}
 	if fwm.Remittance != nil {
-		return fieldError("Remittance", ErrInvalidProperty, fwm.Remittance)
+		addFieldError(&errs, TagRemittance, fieldError("Remittance", ErrInvalidProperty, fwm.Remittance))
 	}
 	if fwm.SenderToReceiver != nil {
-		return fieldError("SenderToReceiver", ErrInvalidProperty, fwm.SenderToReceiver)
+		addFieldError(&errs, TagSenderToReceiver, fieldError("SenderToReceiver", ErrInvalidProperty, fwm.SenderToReceiver))
 	}
-	return nil
+	return errs
 }
 
 // Only allowed if BusinessFunctionCode is CustomerTransferPlus.
-func (fwm *FEDWireMessage) validateLocalInstrumentCode() error {
+func (fwm *FEDWireMessage) validateLocalInstrumentCode() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.LocalInstrument != nil {
 		if fwm.BusinessFunctionCode.BusinessFunctionCode != CustomerTransferPlus {
-			return fieldError("LocalInstrument", ErrLocalInstrumentNotPermitted)
+			addFieldError(&errs, TagLocalInstrument, fieldError("LocalInstrument", ErrLocalInstrumentNotPermitted))
 		}
-		return fwm.LocalInstrument.Validate()
+		addFieldErrors(&errs, fwm.LocalInstrument.ValidateAll())
 	}
-	return nil
+	return errs
 
 }
 
 // BusinessFunctionCode must be CustomerTransfer or CustomerTransferPlus. Not permitted if LocalInstrument Code is SequenceBCoverPaymentStructured.
-func (fwm *FEDWireMessage) validateCharges() error {
+func (fwm *FEDWireMessage) validateCharges() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.Charges != nil {
 		bfc := fwm.BusinessFunctionCode.BusinessFunctionCode
 		if !(bfc == CustomerTransfer || bfc == CustomerTransferPlus) {
-			return NewErrInvalidPropertyForProperty("BusinessFunctionCode", bfc, "Charges", fwm.Charges.String())
+			errs.Add(NewErrInvalidPropertyForProperty("BusinessFunctionCode", bfc, "Charges", fwm.Charges.String()))
 		}
 		if fwm.LocalInstrument != nil && fwm.LocalInstrument.LocalInstrumentCode == SequenceBCoverPaymentStructured {
-			return NewErrInvalidPropertyForProperty("LocalInstrumentCode", fwm.LocalInstrument.LocalInstrumentCode,
-				"Charges", fwm.Charges.String())
+			errs.Add(NewErrInvalidPropertyForProperty("LocalInstrumentCode", fwm.LocalInstrument.LocalInstrumentCode,
+				"Charges", fwm.Charges.String()))
 		}
-		return fwm.Charges.Validate()
+		addFieldErrors(&errs, fwm.Charges.ValidateAll())
 	}
-	return nil
+	return errs
 }
 
 // Mandatory if ExchangeRate is present.
 // BusinessFunctionCode must be CustomerTransfer or CustomerTransferPlus.
 // Not permitted if LocalInstrument Code is SequenceBCoverPaymentStructured.
-func (fwm *FEDWireMessage) validateInstructedAmount() error {
+func (fwm *FEDWireMessage) validateInstructedAmount() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.ExchangeRate != nil && fwm.InstructedAmount == nil {
-		return fieldError("InstructedAmount", ErrFieldRequired)
+		addFieldError(&errs, TagInstructedAmount, fieldError("InstructedAmount", ErrFieldRequired))
 
 	}
 	if fwm.InstructedAmount != nil {
 		bfc := fwm.BusinessFunctionCode.BusinessFunctionCode
 		if !(bfc == CustomerTransfer || bfc == CustomerTransferPlus) {
-			return NewErrInvalidPropertyForProperty("BusinessFunctionCode", bfc, "InstructedAmount", fwm.InstructedAmount.String())
+			errs.Add(NewErrInvalidPropertyForProperty("BusinessFunctionCode", bfc, "InstructedAmount", fwm.InstructedAmount.String()))
 		}
 		if fwm.LocalInstrument != nil && fwm.LocalInstrument.LocalInstrumentCode == SequenceBCoverPaymentStructured {
-			return NewErrInvalidPropertyForProperty("LocalInstrumentCode",
-				fwm.LocalInstrument.LocalInstrumentCode, "Instructed Amount", fwm.InstructedAmount.String())
+			errs.Add(NewErrInvalidPropertyForProperty("LocalInstrumentCode",
+				fwm.LocalInstrument.LocalInstrumentCode, "Instructed Amount", fwm.InstructedAmount.String()))
 		}
-		return fwm.InstructedAmount.Validate()
+		addFieldErrors(&errs, fwm.InstructedAmount.ValidateAll())
 	}
-	return nil
+	return errs
 }
 
 // validateExchangeRate validates TagExchangeRate within a FEDWireMessage
 // * If present, InstructedAmount is mandatory.
 // * BusinessFunctionCode must be CustomerTransfer or CustomerTransferPlus.
 // * Not permitted if LocalInstrument Code is SequenceBCoverPaymentStructured.
-func (fwm *FEDWireMessage) validateExchangeRate() error {
+func (fwm *FEDWireMessage) validateExchangeRate() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.ExchangeRate != nil {
 		if fwm.InstructedAmount == nil {
-			return fieldError("InstructedAmount", ErrFieldRequired)
+			addFieldError(&errs, TagInstructedAmount, fieldError("InstructedAmount", ErrFieldRequired))
 		}
 		bfc := fwm.BusinessFunctionCode.BusinessFunctionCode
-		if !(bfc == CustomerTransfer || bfc == CustomerTransferPlus) {
-			return NewErrInvalidPropertyForProperty("BusinessFunctionCode", bfc, "InstructedAmount", fwm.InstructedAmount.String())
+		if fwm.InstructedAmount != nil && !(bfc == CustomerTransfer || bfc == CustomerTransferPlus) {
+			errs.Add(NewErrInvalidPropertyForProperty("BusinessFunctionCode", bfc, "InstructedAmount", fwm.InstructedAmount.String()))
 		}
 		if fwm.LocalInstrument != nil && fwm.LocalInstrument.LocalInstrumentCode == SequenceBCoverPaymentStructured {
-			return NewErrInvalidPropertyForProperty("LocalInstrumentCode",
-				fwm.LocalInstrument.LocalInstrumentCode, "ExchangeRate", fwm.ExchangeRate.ExchangeRate)
+			errs.Add(NewErrInvalidPropertyForProperty("LocalInstrumentCode",
+				fwm.LocalInstrument.LocalInstrumentCode, "ExchangeRate", fwm.ExchangeRate.ExchangeRate))
 		}
-		return fwm.ExchangeRate.Validate()
+		addFieldErrors(&errs, fwm.ExchangeRate.ValidateAll())
 	}
-	return nil
+	return errs
 }
 
 // If present, tags BeneficiaryFI and Beneficiary are mandatory.
-func (fwm *FEDWireMessage) validateBeneficiaryIntermediaryFI() error {
+func (fwm *FEDWireMessage) validateBeneficiaryIntermediaryFI() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.BeneficiaryIntermediaryFI != nil {
 		if fwm.BeneficiaryFI == nil {
-			return fieldError("BeneficiaryFI", ErrFieldRequired)
+			addFieldError(&errs, TagBeneficiaryFI, fieldError("BeneficiaryFI", ErrFieldRequired))
 		}
 		if fwm.Beneficiary == nil {
-			return fieldError("Beneficiary", ErrFieldRequired)
+			addFieldError(&errs, TagBeneficiary, fieldError("Beneficiary", ErrFieldRequired))
 		}
-		return fwm.BeneficiaryIntermediaryFI.Validate()
+		addFieldErrors(&errs, fwm.BeneficiaryIntermediaryFI.ValidateAll())
 	}
-	return nil
+	return errs
 }
 
 // If present, the Beneficiary tag is mandatory.
-func (fwm *FEDWireMessage) validateBeneficiaryFI() error {
+func (fwm *FEDWireMessage) validateBeneficiaryFI() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.BeneficiaryFI != nil {
 		if fwm.Beneficiary == nil {
-			return fieldError("Beneficiary", ErrFieldRequired)
+			addFieldError(&errs, TagBeneficiary, fieldError("Beneficiary", ErrFieldRequired))
 		}
-		return fwm.BeneficiaryFI.Validate()
+		addFieldErrors(&errs, fwm.BeneficiaryFI.ValidateAll())
 	}
-	return nil
+	return errs
 }
 
 // If present, Originator (or OriginatorOptionF if BusinessFunctionCode is CustomerTransferPlus) is mandatory.
-func (fwm *FEDWireMessage) validateOriginatorFI() error {
+func (fwm *FEDWireMessage) validateOriginatorFI() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.OriginatorFI != nil {
 		switch fwm.BusinessFunctionCode.BusinessFunctionCode {
 		case CustomerTransferPlus:
 			if fwm.OriginatorOptionF == nil && fwm.Originator == nil {
-				return fieldError("Originator or OriginatorOptionF", ErrFieldRequired)
+				addFieldError(&errs, TagOriginator, fieldError("Originator or OriginatorOptionF", ErrFieldRequired))
 			}
 		default:
 			if fwm.Originator == nil {
-				return fieldError("Originator", ErrFieldRequired)
+				addFieldError(&errs, TagOriginator, fieldError("Originator", ErrFieldRequired))
 			}
 		}
-		return fwm.OriginatorFI.Validate()
+		addFieldErrors(&errs, fwm.OriginatorFI.ValidateAll())
 	}
-	return nil
+	return errs
 }
 
 // If present, Originator (or OriginatorOptionF if BusinessFunctionCode is CustomerTransferPlus) and OriginatorFI are mandatory.
-func (fwm *FEDWireMessage) validateInstructingFI() error {
+func (fwm *FEDWireMessage) validateInstructingFI() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.InstructingFI != nil {
 		switch fwm.BusinessFunctionCode.BusinessFunctionCode {
 		case CustomerTransferPlus:
 			if fwm.OriginatorOptionF == nil && fwm.Originator == nil {
-				return fieldError("OriginatorOptionF", ErrFieldRequired)
+				addFieldError(&errs, TagOriginatorOptionF, fieldError("OriginatorOptionF", ErrFieldRequired))
 			}
 		default:
 			if fwm.Originator == nil {
-				return fieldError("Originator", ErrFieldRequired)
+				addFieldError(&errs, TagOriginator, fieldError("Originator", ErrFieldRequired))
 			}
 		}
 		if fwm.OriginatorFI == nil {
-			return fieldError("OriginatorFI", ErrFieldRequired)
+			addFieldError(&errs, TagOriginatorFI, fieldError("OriginatorFI", ErrFieldRequired))
 		}
-		return fwm.InstructingFI.Validate()
+		addFieldErrors(&errs, fwm.InstructingFI.ValidateAll())
 	}
-	return nil
+	return errs
 }
 
 // If present, Beneficiary and Originator (or OriginatorOptionF if BusinessFunctionCode is CustomerTransferPlus) are mandatory.
-func (fwm *FEDWireMessage) validateOriginatorToBeneficiary() error {
+func (fwm *FEDWireMessage) validateOriginatorToBeneficiary() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.OriginatorToBeneficiary != nil {
 		if fwm.Beneficiary == nil {
-			return fieldError("Beneficiary", ErrFieldRequired)
+			addFieldError(&errs, TagBeneficiary, fieldError("Beneficiary", ErrFieldRequired))
 		}
 		switch fwm.BusinessFunctionCode.BusinessFunctionCode {
 		case CustomerTransferPlus:
 			if fwm.OriginatorOptionF == nil && fwm.Originator == nil {
-				return fieldError("Originator or OriginatorOptionF", ErrFieldRequired)
+				addFieldError(&errs, TagOriginator, fieldError("Originator or OriginatorOptionF", ErrFieldRequired))
 			}
 		default:
 			if fwm.Originator == nil {
-				return fieldError("Originator", ErrFieldRequired)
+				addFieldError(&errs, TagOriginator, fieldError("Originator", ErrFieldRequired))
 			}
 		}
-		return fwm.OriginatorToBeneficiary.Validate()
+		addFieldErrors(&errs, fwm.OriginatorToBeneficiary.ValidateAll())
 	}
-	return nil
+	return errs
 }
 
 // validateFIIntermediaryFI validates TagFIIntermediaryFI within a FEDWireMessage
 // If present, BeneficiaryIntermediaryFI, BeneficiaryFI and Beneficiary are required.
-func (fwm *FEDWireMessage) validateFIIntermediaryFI() error {
+func (fwm *FEDWireMessage) validateFIIntermediaryFI() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.FIIntermediaryFI != nil {
 		if fwm.BeneficiaryIntermediaryFI == nil {
-			return fieldError("BeneficiaryIntermediaryFI", ErrFieldRequired)
+			addFieldError(&errs, TagBeneficiaryIntermediaryFI, fieldError("BeneficiaryIntermediaryFI", ErrFieldRequired))
 		}
 		if fwm.BeneficiaryFI == nil {
-			return fieldError("BeneficiaryFI", ErrFieldRequired)
+			addFieldError(&errs, TagBeneficiaryFI, fieldError("BeneficiaryFI", ErrFieldRequired))
 		}
 		if fwm.Beneficiary == nil {
-			return fieldError("Beneficiary", ErrFieldRequired)
+			addFieldError(&errs, TagBeneficiary, fieldError("Beneficiary", ErrFieldRequired))
 		}
-		return fwm.FIIntermediaryFI.Validate()
+		addFieldErrors(&errs, fwm.FIIntermediaryFI.ValidateAll())
 	}
-	return nil
+	return errs
 }
 
 // validateFIIntermediaryFIAdvice validates TagFIIntermediaryFIAdvice within a FEDWireMessage
 // If present, BeneficiaryIntermediaryFI, BeneficiaryFI and Beneficiary are required.
-func (fwm *FEDWireMessage) validateFIIntermediaryFIAdvice() error {
+func (fwm *FEDWireMessage) validateFIIntermediaryFIAdvice() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.FIIntermediaryFIAdvice != nil {
 		if fwm.BeneficiaryIntermediaryFI == nil {
-			return fieldError("BeneficiaryIntermediaryFI", ErrFieldRequired)
+			addFieldError(&errs, TagBeneficiaryIntermediaryFI, fieldError("BeneficiaryIntermediaryFI", ErrFieldRequired))
 		}
 		if fwm.BeneficiaryFI == nil {
-			return fieldError("BeneficiaryFI", ErrFieldRequired)
+			addFieldError(&errs, TagBeneficiaryFI, fieldError("BeneficiaryFI", ErrFieldRequired))
 		}
 		if fwm.Beneficiary == nil {
-			return fieldError("Beneficiary", ErrFieldRequired)
+			addFieldError(&errs, TagBeneficiary, fieldError("Beneficiary", ErrFieldRequired))
 		}
-		return fwm.FIIntermediaryFIAdvice.Validate()
+		addFieldErrors(&errs, fwm.FIIntermediaryFIAdvice.ValidateAll())
 	}
-	return nil
+	return errs
 }
 
 // validateFIBeneficiaryFI validates TagFIBeneficiaryFI within a FEDWireMessage
 // If present, BeneficiaryFI and Beneficiary are required.
-func (fwm *FEDWireMessage) validateFIBeneficiaryFI() error {
+func (fwm *FEDWireMessage) validateFIBeneficiaryFI() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.FIBeneficiaryFI != nil {
 		if fwm.BeneficiaryFI == nil {
-			return fieldError("BeneficiaryFI", ErrFieldRequired)
+			addFieldError(&errs, TagBeneficiaryFI, fieldError("BeneficiaryFI", ErrFieldRequired))
 		}
 		if fwm.Beneficiary == nil {
-			return fieldError("Beneficiary", ErrFieldRequired)
+			addFieldError(&errs, TagBeneficiary, fieldError("Beneficiary", ErrFieldRequired))
 		}
-		return fwm.FIBeneficiaryFI.Validate()
+		addFieldErrors(&errs, fwm.FIBeneficiaryFI.ValidateAll())
 	}
-	return nil
+	return errs
 }
 
 // validateFIBeneficiaryFIAdvice validates TagFIBeneficiaryFIAdvice within a FEDWireMessage
 // If present, BeneficiaryFI and Beneficiary are required.
-func (fwm *FEDWireMessage) validateFIBeneficiaryFIAdvice() error {
+func (fwm *FEDWireMessage) validateFIBeneficiaryFIAdvice() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.FIBeneficiaryFIAdvice != nil {
 		if fwm.BeneficiaryFI == nil {
-			return fieldError("BeneficiaryFI", ErrFieldRequired)
+			addFieldError(&errs, TagBeneficiaryFI, fieldError("BeneficiaryFI", ErrFieldRequired))
 		}
 		if fwm.Beneficiary == nil {
-			return fieldError("Beneficiary", ErrFieldRequired)
+			addFieldError(&errs, TagBeneficiary, fieldError("Beneficiary", ErrFieldRequired))
 		}
-		return fwm.FIBeneficiaryFIAdvice.Validate()
+		addFieldErrors(&errs, fwm.FIBeneficiaryFIAdvice.ValidateAll())
 	}
-	return nil
+	return errs
 }
 
 // validateFIBeneficiary validates TagFIBeneficiary within a FEDWireMessage
 // If present, Beneficiary is required.
-func (fwm *FEDWireMessage) validateFIBeneficiary() error {
+func (fwm *FEDWireMessage) validateFIBeneficiary() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.FIBeneficiary != nil {
 		if fwm.Beneficiary == nil {
-			return fieldError("Beneficiary", ErrFieldRequired)
+			addFieldError(&errs, TagBeneficiary, fieldError("Beneficiary", ErrFieldRequired))
 		}
-		return fwm.FIBeneficiary.Validate()
+		addFieldErrors(&errs, fwm.FIBeneficiary.ValidateAll())
 	}
-	return nil
+	return errs
 }
 
 // validateFIBeneficiaryAdvice validates TagFIBeneficiaryAdvice within a FEDWireMessage
 // If present, Beneficiary is required.
-func (fwm *FEDWireMessage) validateFIBeneficiaryAdvice() error {
+func (fwm *FEDWireMessage) validateFIBeneficiaryAdvice() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.FIBeneficiaryAdvice != nil {
 		if fwm.Beneficiary == nil {
-			return fieldError("Beneficiary", ErrFieldRequired)
+			addFieldError(&errs, TagBeneficiary, fieldError("Beneficiary", ErrFieldRequired))
 		}
-		return fwm.FIBeneficiaryAdvice.Validate()
+		addFieldErrors(&errs, fwm.FIBeneficiaryAdvice.ValidateAll())
 	}
-	return nil
+	return errs
 }
 
 // validateFIPaymentMethodToBeneficiary validates TagFIPaymentMethodToBeneficiary within a FEDWireMessage
 // If present, FIBeneficiaryAdvice and Beneficiary are required.
-func (fwm *FEDWireMessage) validateFIPaymentMethodToBeneficiary() error {
+func (fwm *FEDWireMessage) validateFIPaymentMethodToBeneficiary() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.FIPaymentMethodToBeneficiary != nil {
 		if fwm.FIBeneficiaryAdvice == nil {
-			return fieldError("FIBeneficiaryAdvice", ErrFieldRequired)
+			addFieldError(&errs, TagFIBeneficiaryAdvice, fieldError("FIBeneficiaryAdvice", ErrFieldRequired))
 		}
 		if fwm.Beneficiary == nil {
-			return fieldError("Beneficiary", ErrFieldRequired)
+			addFieldError(&errs, TagBeneficiary, fieldError("Beneficiary", ErrFieldRequired))
 		}
-		return fwm.FIPaymentMethodToBeneficiary.Validate()
+		addFieldErrors(&errs, fwm.FIPaymentMethodToBeneficiary.ValidateAll())
 	}
-	return nil
+	return errs
 }
 
 // validateUnstructuredAddenda validates TagUnstructuredAddenda within a FEDWireMessage
@@ -1247,271 +1297,270 @@ func (fwm *FEDWireMessage) validateFIPaymentMethodToBeneficiary() error {
 //   - If LocalInstrument is GeneralXMLformat, ISO20022XMLformat, NarrativeText, SWIFTfield70 or
 //     UNEDIFACTformat, only the SWIFT MX ISO 20022 Character Set* is permitted in Addenda Information
 //     element.
-func (fwm *FEDWireMessage) validateUnstructuredAddenda() error {
+func (fwm *FEDWireMessage) validateUnstructuredAddenda() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus && fwm.LocalInstrument != nil {
 		switch fwm.LocalInstrument.LocalInstrumentCode {
 		case ANSIX12format, GeneralXMLformat, ISO20022XMLformat, NarrativeText, STP820format, SWIFTfield70, UNEDIFACTformat:
 			if fwm.UnstructuredAddenda == nil {
-				return fieldError("UnstructuredAddenda", ErrFieldRequired)
+				addFieldError(&errs, TagUnstructuredAddenda, fieldError("UnstructuredAddenda", ErrFieldRequired))
+			} else {
+				addFieldErrors(&errs, fwm.UnstructuredAddenda.ValidateAll())
 			}
-			return fwm.UnstructuredAddenda.Validate()
 		default:
 			if fwm.UnstructuredAddenda != nil {
-				return NewErrInvalidPropertyForProperty("UnstructuredAddenda", fwm.UnstructuredAddenda.String(),
-					"LocalInstrumentCode", fwm.LocalInstrument.LocalInstrumentCode)
+				errs.Add(NewErrInvalidPropertyForProperty("UnstructuredAddenda", fwm.UnstructuredAddenda.String(),
+					"LocalInstrumentCode", fwm.LocalInstrument.LocalInstrumentCode))
 			}
 		}
 	} else {
 		if fwm.UnstructuredAddenda != nil {
-			return fieldError("UnstructuredAddenda", ErrNotPermitted)
+			addFieldError(&errs, TagUnstructuredAddenda, fieldError("UnstructuredAddenda", ErrNotPermitted))
 		}
 	}
 
 	// TODO: if LocalInstrument is ANSIX12format or STP820format, make sure Addenda Information only contains charaters within the X12 character set
 	// TODO: if LocalInstrument is any of the other permitted formats, make sure Addenda Information only contains charaters within the SWIFT MX ISO 20022 character set
 
-	return nil
+	return errs
 }
 
 // validateRelatedRemittance validates TagRelatedRemittance within a FEDWireMessage
 // Must be present if BusinessFunctionCode is CustomerTransferPlus and LocalInstrument is
 //
 //	RelatedRemittanceInformation; otherwise not permitted.
-func (fwm *FEDWireMessage) validateRelatedRemittance() error {
+func (fwm *FEDWireMessage) validateRelatedRemittance() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus && fwm.LocalInstrument != nil &&
 		fwm.LocalInstrument.LocalInstrumentCode == RelatedRemittanceInformation {
 		if fwm.RelatedRemittance == nil {
-			return fieldError("RelatedRemittance", ErrFieldRequired)
+			addFieldError(&errs, TagRelatedRemittance, fieldError("RelatedRemittance", ErrFieldRequired))
+		} else {
+			addFieldErrors(&errs, fwm.RelatedRemittance.ValidateAll())
 		}
-		return fwm.RelatedRemittance.Validate()
 	} else {
 		if fwm.RelatedRemittance != nil {
-			return fieldError("RelatedRemittance", ErrNotPermitted)
+			addFieldError(&errs, TagRelatedRemittance, fieldError("RelatedRemittance", ErrNotPermitted))
 		}
 	}
 
-	return nil
+	return errs
 }
 
 // validateRemittanceOriginator validates TagRemittanceOriginator within a FEDWireMessage
 // Must be present if BusinessFunctionCode is CustomerTransferPlus and LocalInstrument code
 //
 //	is RemittanceInformationStructured; otherwise not permitted.
-func (fwm *FEDWireMessage) validateRemittanceOriginator() error {
+func (fwm *FEDWireMessage) validateRemittanceOriginator() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus && fwm.LocalInstrument != nil &&
 		fwm.LocalInstrument.LocalInstrumentCode == RemittanceInformationStructured {
 		if fwm.RemittanceOriginator == nil {
-			return fieldError("RemittanceOriginator", ErrFieldRequired)
+			addFieldError(&errs, TagRemittanceOriginator, fieldError("RemittanceOriginator", ErrFieldRequired))
+		} else {
+			addFieldErrors(&errs, fwm.RemittanceOriginator.ValidateAll())
 		}
-		return fwm.RemittanceOriginator.Validate()
 	} else {
 		if fwm.RemittanceOriginator != nil {
-			return fieldError("RemittanceOriginator", ErrNotPermitted)
+			addFieldError(&errs, TagRemittanceOriginator, fieldError("RemittanceOriginator", ErrNotPermitted))
 		}
 	}
 
-	return nil
+	return errs
 }
 
 // validateRemittanceBeneficiary validates TagRemittanceBeneficiary within a FEDWireMessage
 // Must be present if BusinessFunctionCode is CustomerTransferPlus and LocalInstrument code
 //
 //	is RemittanceInformationStructured; otherwise not permitted.
-func (fwm *FEDWireMessage) validateRemittanceBeneficiary() error {
+func (fwm *FEDWireMessage) validateRemittanceBeneficiary() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus && fwm.LocalInstrument != nil &&
 		fwm.LocalInstrument.LocalInstrumentCode == RemittanceInformationStructured {
 		if fwm.RemittanceBeneficiary == nil {
-			return fieldError("RemittanceBeneficiary", ErrFieldRequired)
+			addFieldError(&errs, TagRemittanceBeneficiary, fieldError("RemittanceBeneficiary", ErrFieldRequired))
+		} else {
+			addFieldErrors(&errs, fwm.RemittanceBeneficiary.ValidateAll())
 		}
-		return fwm.RemittanceBeneficiary.Validate()
 	} else {
 		if fwm.RemittanceBeneficiary != nil {
-			return fieldError("RemittanceBeneficiary", ErrNotPermitted)
+			addFieldError(&errs, TagRemittanceBeneficiary, fieldError("RemittanceBeneficiary", ErrNotPermitted))
 		}
 	}
 
-	return nil
+	return errs
 }
 
 // PrimaryRemittanceDocument validates TagPrimaryRemittanceDocument within a FEDWireMessage
 // Must be present if BusinessFunctionCode is CustomerTransferPlus and LocalInstrument code
 //
 //	is RemittanceInformationStructured; otherwise not permitted.
-func (fwm *FEDWireMessage) validatePrimaryRemittanceDocument() error {
+func (fwm *FEDWireMessage) validatePrimaryRemittanceDocument() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus && fwm.LocalInstrument != nil &&
 		fwm.LocalInstrument.LocalInstrumentCode == RemittanceInformationStructured {
 		if fwm.PrimaryRemittanceDocument == nil {
-			return fieldError("PrimaryRemittanceDocument", ErrFieldRequired)
+			addFieldError(&errs, TagPrimaryRemittanceDocument, fieldError("PrimaryRemittanceDocument", ErrFieldRequired))
+		} else {
+			addFieldErrors(&errs, fwm.PrimaryRemittanceDocument.ValidateAll())
 		}
-		return fwm.PrimaryRemittanceDocument.Validate()
 	} else {
 		if fwm.PrimaryRemittanceDocument != nil {
-			return fieldError("PrimaryRemittanceDocument", ErrNotPermitted)
+			addFieldError(&errs, TagPrimaryRemittanceDocument, fieldError("PrimaryRemittanceDocument", ErrNotPermitted))
 		}
 	}
 
-	return nil
+	return errs
 }
 
 // validateActualAmountPaid validates TagActualAmountPaid within a FEDWireMessage
 // Must be present if BusinessFunctionCode is CustomerTransferPlus and LocalInstrument code
 //
 //	is RemittanceInformationStructured; otherwise not permitted.
-func (fwm *FEDWireMessage) validateActualAmountPaid() error {
+func (fwm *FEDWireMessage) validateActualAmountPaid() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus && fwm.LocalInstrument != nil &&
 		fwm.LocalInstrument.LocalInstrumentCode == RemittanceInformationStructured {
 		if fwm.ActualAmountPaid == nil {
-			return fieldError("ActualAmountPaid", ErrFieldRequired)
+			addFieldError(&errs, TagActualAmountPaid, fieldError("ActualAmountPaid", ErrFieldRequired))
+		} else {
+			addFieldErrors(&errs, fwm.ActualAmountPaid.ValidateAll())
 		}
-		return fwm.ActualAmountPaid.Validate()
 	} else {
 		if fwm.ActualAmountPaid != nil {
-			return fieldError("ActualAmountPaid", ErrNotPermitted)
+			addFieldError(&errs, TagActualAmountPaid, fieldError("ActualAmountPaid", ErrNotPermitted))
 		}
 	}
 
-	return nil
+	return errs
 }
 
 // validateGrossAmountRemittanceDocument validates TagGrossAmountRemittanceDocument within a FEDWireMessage
 // Must be present if BusinessFunctionCode is CustomerTransferPlus and LocalInstrument code
 //
 //	is RemittanceInformationStructured; otherwise not permitted.
-func (fwm *FEDWireMessage) validateGrossAmountRemittanceDocument() error {
+func (fwm *FEDWireMessage) validateGrossAmountRemittanceDocument() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus && fwm.LocalInstrument != nil &&
 		fwm.LocalInstrument.LocalInstrumentCode == RemittanceInformationStructured {
 		if fwm.GrossAmountRemittanceDocument == nil {
-			return fieldError("GrossAmountRemittanceDocument", ErrFieldRequired)
+			addFieldError(&errs, TagGrossAmountRemittanceDocument, fieldError("GrossAmountRemittanceDocument", ErrFieldRequired))
+		} else {
+			addFieldErrors(&errs, fwm.GrossAmountRemittanceDocument.ValidateAll())
 		}
-		return fwm.GrossAmountRemittanceDocument.Validate()
 	} else {
 		if fwm.GrossAmountRemittanceDocument != nil {
-			return fieldError("GrossAmountRemittanceDocument", ErrNotPermitted)
+			addFieldError(&errs, TagGrossAmountRemittanceDocument, fieldError("GrossAmountRemittanceDocument", ErrNotPermitted))
 		}
 	}
 
-	return nil
+	return errs
 }
 
 // validateAdjustment validates TagAdjustment within a FEDWireMessage
 // Must be present if BusinessFunctionCode is CustomerTransferPlus and LocalInstrument code
 //
 //	is RemittanceInformationStructured; otherwise not permitted.
-func (fwm *FEDWireMessage) validateAdjustment() error {
+func (fwm *FEDWireMessage) validateAdjustment() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus && fwm.LocalInstrument != nil &&
 		fwm.LocalInstrument.LocalInstrumentCode == RemittanceInformationStructured {
 		if fwm.Adjustment == nil {
-			return fieldError("Adjustment", ErrFieldRequired)
+			addFieldError(&errs, TagAdjustment, fieldError("Adjustment", ErrFieldRequired))
+		} else {
+			addFieldErrors(&errs, fwm.Adjustment.ValidateAll())
 		}
-		return fwm.Adjustment.Validate()
 	} else {
 		if fwm.Adjustment != nil {
-			return fieldError("Adjustment", ErrNotPermitted)
+			addFieldError(&errs, TagAdjustment, fieldError("Adjustment", ErrNotPermitted))
 		}
 	}
 
-	return nil
+	return errs
 }
 
 // validateDateRemittanceDocument validates TagDateRemittanceDocument within a FEDWireMessage
 // Must be present if BusinessFunctionCode is CustomerTransferPlus and LocalInstrument code
 //
 //	is RemittanceInformationStructured; otherwise not permitted.
-func (fwm *FEDWireMessage) validateDateRemittanceDocument() error {
+func (fwm *FEDWireMessage) validateDateRemittanceDocument() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus && fwm.LocalInstrument != nil &&
 		fwm.LocalInstrument.LocalInstrumentCode == RemittanceInformationStructured {
 		if fwm.DateRemittanceDocument == nil {
-			return fieldError("DateRemittanceDocument", ErrFieldRequired)
+			addFieldError(&errs, TagDateRemittanceDocument, fieldError("DateRemittanceDocument", ErrFieldRequired))
+		} else {
+			addFieldErrors(&errs, fwm.DateRemittanceDocument.ValidateAll())
 		}
-		return fwm.DateRemittanceDocument.Validate()
 	} else {
 		if fwm.DateRemittanceDocument != nil {
-			return fieldError("DateRemittanceDocument", ErrNotPermitted)
+			addFieldError(&errs, TagDateRemittanceDocument, fieldError("DateRemittanceDocument", ErrNotPermitted))
 		}
 	}
 
-	return nil
+	return errs
 }
 
 // validateSecondaryRemittanceDocument validates a TagSecondaryRemittanceDocument within a FEDWireMessage
 // Must be present if BusinessFunctionCode is CustomerTransferPlus and LocalInstrument code
 //
 //	is RemittanceInformationStructured; otherwise not permitted.
-func (fwm *FEDWireMessage) validateSecondaryRemittanceDocument() error {
+func (fwm *FEDWireMessage) validateSecondaryRemittanceDocument() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus && fwm.LocalInstrument != nil &&
 		fwm.LocalInstrument.LocalInstrumentCode == RemittanceInformationStructured {
 		if fwm.SecondaryRemittanceDocument == nil {
-			return fieldError("SecondaryRemittanceDocument", ErrFieldRequired)
+			addFieldError(&errs, TagSecondaryRemittanceDocument, fieldError("SecondaryRemittanceDocument", ErrFieldRequired))
 		}
 	} else {
 		if fwm.SecondaryRemittanceDocument != nil {
-			return fieldError("SecondaryRemittanceDocument", ErrNotPermitted)
+			addFieldError(&errs, TagSecondaryRemittanceDocument, fieldError("SecondaryRemittanceDocument", ErrNotPermitted))
 		}
 	}
 
-	return nil
+	return errs
 }
 
 // validateRemittanceFreeText validates a TagRemittanceFreeText within a FEDWireMessage
 // Must be present if BusinessFunctionCode is CustomerTransferPlus and LocalInstrument code
 //
 //	is RemittanceInformationStructured; otherwise not permitted.
-func (fwm *FEDWireMessage) validateRemittanceFreeText() error {
+func (fwm *FEDWireMessage) validateRemittanceFreeText() base.ErrorList {
+	var errs base.ErrorList
 	if fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus && fwm.LocalInstrument != nil &&
 		fwm.LocalInstrument.LocalInstrumentCode == RemittanceInformationStructured {
 		if fwm.RemittanceFreeText == nil {
-			return fieldError("RemittanceFreeText", ErrFieldRequired)
+			addFieldError(&errs, TagRemittanceFreeText, fieldError("RemittanceFreeText", ErrFieldRequired))
+		} else {
+			addFieldErrors(&errs, fwm.RemittanceFreeText.ValidateAll())
 		}
-		return fwm.RemittanceFreeText.Validate()
 	} else {
 		if fwm.RemittanceFreeText != nil {
-			return fieldError("RemittanceFreeText", ErrNotPermitted)
+			addFieldError(&errs, TagRemittanceFreeText, fieldError("RemittanceFreeText", ErrNotPermitted))
 		}
 	}
 
-	return nil
+	return errs
 }
 
-func (fwm *FEDWireMessage) otherTransferInformation() error {
-	if err := fwm.validateLocalInstrumentCode(); err != nil {
-		return err
-	}
-	if err := fwm.validateCharges(); err != nil {
-		return err
-	}
-	if err := fwm.validateInstructedAmount(); err != nil {
-		return err
-	}
-	if err := fwm.validateExchangeRate(); err != nil {
-		return err
-	}
-	return nil
+func (fwm *FEDWireMessage) otherTransferInformation() base.ErrorList {
+	var errs base.ErrorList
+	addFieldErrors(&errs, fwm.validateLocalInstrumentCode())
+	addFieldErrors(&errs, fwm.validateCharges())
+	addFieldErrors(&errs, fwm.validateInstructedAmount())
+	addFieldErrors(&errs, fwm.validateExchangeRate())
+	return errs
 }
 
-func (fwm *FEDWireMessage) isRemittanceValid() error {
-	if err := fwm.validateRemittanceOriginator(); err != nil {
-		return err
-	}
-	if err := fwm.validateRemittanceBeneficiary(); err != nil {
-		return err
-	}
-	if err := fwm.validatePrimaryRemittanceDocument(); err != nil {
-		return err
-	}
-	if err := fwm.validateActualAmountPaid(); err != nil {
-		return err
-	}
-	if err := fwm.validateGrossAmountRemittanceDocument(); err != nil {
-		return err
-	}
-	if err := fwm.validateAdjustment(); err != nil {
-		return err
-	}
-	if err := fwm.validateDateRemittanceDocument(); err != nil {
-		return err
-	}
-	if err := fwm.validateRemittanceFreeText(); err != nil {
-		return err
-	}
-	return nil
+func (fwm *FEDWireMessage) isRemittanceValid() base.ErrorList {
+	var errs base.ErrorList
+	addFieldErrors(&errs, fwm.validateRemittanceOriginator())
+	addFieldErrors(&errs, fwm.validateRemittanceBeneficiary())
+	addFieldErrors(&errs, fwm.validatePrimaryRemittanceDocument())
+	addFieldErrors(&errs, fwm.validateActualAmountPaid())
+	addFieldErrors(&errs, fwm.validateGrossAmountRemittanceDocument())
+	addFieldErrors(&errs, fwm.validateAdjustment())
+	addFieldErrors(&errs, fwm.validateDateRemittanceDocument())
+	addFieldErrors(&errs, fwm.validateRemittanceFreeText())
+	return errs
 }
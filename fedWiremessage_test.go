@@ -42,17 +42,98 @@ func TestFEDWireMessage_invalidAmount(t *testing.T) {
 	// Validate File
 	err := file.Validate()
 
-	expected := NewErrInvalidPropertyForProperty("Amount", fwm.Amount.Amount, "SubTypeCode", fwm.TypeSubType.SubTypeCode).Error()
+	expected := NewFEDWireMessageError(0, NewErrInvalidPropertyForProperty("Amount", fwm.Amount.Amount, "SubTypeCode", fwm.TypeSubType.SubTypeCode)).Error()
 	require.EqualError(t, err, expected)
 }
 
+func TestFEDWireMessage_ValidateAll(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Amount.Amount = "00000012345Z"
+	fwm.SenderDepositoryInstitution.SenderABANumber = "12345678Z"
+	fwm.ReceiverDepositoryInstitution = nil
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = nil
+
+	errs := fwm.ValidateAll()
+
+	require.Len(t, errs, 4)
+	require.EqualError(t, fwm.verify(), errs[0].Error())
+
+	expected := []struct {
+		tag       string
+		fieldName string
+		err       error
+	}{
+		{TagAmount, "Amount", ErrNonAmount},
+		{TagSenderDepositoryInstitution, "SenderABANumber", ErrNonNumeric},
+		{TagReceiverDepositoryInstitution, "ReceiverDepositoryInstitution", ErrFieldRequired},
+		{TagOriginator, "Originator", ErrFieldRequired},
+	}
+	for i := range expected {
+		var fe *FieldError
+		require.ErrorAs(t, errs[i], &fe)
+		require.Equal(t, expected[i].tag, fe.Tag)
+		require.Equal(t, expected[i].fieldName, fe.FieldName)
+		require.ErrorIs(t, errs[i], expected[i].err)
+	}
+
+	fwm = mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	require.Empty(t, fwm.ValidateAll())
+}
+
+func TestFEDWireMessage_ValidateAllMissingTags(t *testing.T) {
+	fwm := FEDWireMessage{}
+
+	errs := fwm.ValidateAll()
+
+	require.Len(t, errs, 7)
+	for _, err := range errs {
+		require.ErrorIs(t, err, ErrFieldRequired)
+	}
+	require.EqualError(t, fwm.verify(), fieldError("SenderSupplied", ErrFieldRequired).Error())
+}
+
+func TestFEDWireMessage_CheckBusinessDay(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	// Independence Day
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20230704"
+	require.Empty(t, fwm.ValidateAll())
+
+	fwm.ValidateOptions = &ValidateOpts{CheckBusinessDay: true}
+	errs := fwm.ValidateAll()
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs[0], ErrNonBusinessDay)
+	var fe *FieldError
+	require.ErrorAs(t, errs[0], &fe)
+	require.Equal(t, TagInputMessageAccountabilityData, fe.Tag)
+	require.Equal(t, "InputCycleDate", fe.FieldName)
+
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20230705"
+	require.Empty(t, fwm.ValidateAll())
+
+	// an invalid date is only reported once
+	fwm.InputMessageAccountabilityData.InputCycleDate = "2023070A"
+	errs = fwm.ValidateAll()
+	require.Len(t, errs, 1)
+	require.NotErrorIs(t, errs[0], ErrNonBusinessDay)
+
+	// the cycle date is checked when the IMAD is not mandatory
+	fwm.ValidateOptions.SkipMandatoryIMAD = true
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20230708"
+	require.ErrorIs(t, fwm.ValidateAll().Err(), ErrNonBusinessDay)
+}
+
 func TestFEDWireMessage_previousMessageIdentifierInvalid(t *testing.T) {
 	fwm := mockCustomerTransferData()
 	// Override to trigger error
 	fwm.TypeSubType.SubTypeCode = ReversalTransfer
 	fwm.PreviousMessageIdentifier = nil // required when SubTypeCode is ReversalTransfer
 
-	err := fwm.checkPreviousMessageIdentifier()
+	err := fwm.checkPreviousMessageIdentifier().Err()
 
 	expected := fieldError("PreviousMessageIdentifier", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -65,7 +146,7 @@ func TestFEDWireMessage_invalidLocalInstrument(t *testing.T) {
 	fwm.LocalInstrument = li
 	fwm.BusinessFunctionCode.BusinessFunctionCode = BankTransfer // local instrument only permitted for CTP
 
-	err := fwm.validateLocalInstrumentCode()
+	err := fwm.validateLocalInstrumentCode().Err()
 
 	expected := fieldError("LocalInstrument", ErrLocalInstrumentNotPermitted).Error()
 	require.EqualError(t, err, expected)
@@ -80,7 +161,7 @@ func TestFEDWireMessage_invalidCharges(t *testing.T) {
 	fwm.Charges = mockCharges()
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
 
-	err := fwm.validateCharges()
+	err := fwm.validateCharges().Err()
 
 	expected := NewErrInvalidPropertyForProperty("LocalInstrumentCode", fwm.LocalInstrument.LocalInstrumentCode, "Charges", fwm.Charges.String()).Error()
 	require.EqualError(t, err, expected)
@@ -95,7 +176,7 @@ func TestFEDWireMessage_invalidInstructedAmount(t *testing.T) {
 	fwm.InstructedAmount = mockInstructedAmount()
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
 
-	err := fwm.validateInstructedAmount()
+	err := fwm.validateInstructedAmount().Err()
 
 	expected := NewErrInvalidPropertyForProperty("LocalInstrumentCode", fwm.LocalInstrument.LocalInstrumentCode, "Instructed Amount", fwm.InstructedAmount.String()).Error()
 	require.EqualError(t, err, expected)
@@ -107,7 +188,7 @@ func TestFEDWireMessage_validateExchangeRate_missingInstructedAmount(t *testing.
 	fwm.ExchangeRate = mockExchangeRate()
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
 
-	err := fwm.validateExchangeRate()
+	err := fwm.validateExchangeRate().Err()
 
 	expected := fieldError("InstructedAmount", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -123,7 +204,7 @@ func TestFEDWireMessage_isExchangeRateValid_missingLocalInstrumentCode(t *testin
 	fwm.InstructedAmount = mockInstructedAmount()
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
 
-	err := fwm.validateExchangeRate()
+	err := fwm.validateExchangeRate().Err()
 
 	expected := NewErrInvalidPropertyForProperty("LocalInstrumentCode", fwm.LocalInstrument.LocalInstrumentCode, "ExchangeRate", fwm.ExchangeRate.ExchangeRate).Error()
 	require.EqualError(t, err, expected)
@@ -135,14 +216,14 @@ func TestFEDWireMessage_validateBeneficiaryIntermediaryFI(t *testing.T) {
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransfer
 
 	// BeneficiaryFI required field check
-	err := fwm.validateBeneficiaryIntermediaryFI()
+	err := fwm.validateBeneficiaryIntermediaryFI().Err()
 
 	require.EqualError(t, err, fieldError("BeneficiaryFI", ErrFieldRequired).Error())
 
 	fwm.BeneficiaryFI = mockBeneficiaryFI()
 
 	// Beneficiary required field check
-	err = fwm.validateBeneficiaryIntermediaryFI()
+	err = fwm.validateBeneficiaryIntermediaryFI().Err()
 
 	require.EqualError(t, err, fieldError("Beneficiary", ErrFieldRequired).Error())
 }
@@ -153,7 +234,7 @@ func TestFEDWireMessage_validateBeneficiaryFI(t *testing.T) {
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransfer
 
 	// Beneficiary required field check
-	err := fwm.validateBeneficiaryFI()
+	err := fwm.validateBeneficiaryFI().Err()
 
 	require.EqualError(t, err, fieldError("Beneficiary", ErrFieldRequired).Error())
 }
@@ -164,23 +245,23 @@ func TestFEDWireMessage_validateOriginatorFI(t *testing.T) {
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransfer
 
 	// Originator required field check
-	err := fwm.validateOriginatorFI()
+	err := fwm.validateOriginatorFI().Err()
 
 	require.EqualError(t, err, fieldError("Originator", ErrFieldRequired).Error())
 
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
-	err = fwm.validateOriginatorFI()
+	err = fwm.validateOriginatorFI().Err()
 
 	require.EqualError(t, err, fieldError("Originator or OriginatorOptionF", ErrFieldRequired).Error())
 
 	fwm.Originator = mockOriginator()
-	err = fwm.validateOriginatorFI()
+	err = fwm.validateOriginatorFI().Err()
 
 	require.NoError(t, err)
 
 	fwm.Originator = nil
 	fwm.OriginatorOptionF = mockOriginatorOptionF()
-	err = fwm.validateOriginatorFI()
+	err = fwm.validateOriginatorFI().Err()
 
 	require.NoError(t, err)
 }
@@ -191,7 +272,7 @@ func TestFEDWireMessage_validateInstructingFI(t *testing.T) {
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransfer
 
 	// Originator required field check
-	err := fwm.validateInstructingFI()
+	err := fwm.validateInstructingFI().Err()
 
 	expected := fieldError("Originator", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -199,13 +280,13 @@ func TestFEDWireMessage_validateInstructingFI(t *testing.T) {
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
 
 	// OriginatorOptionF required field check
-	err = fwm.validateInstructingFI()
+	err = fwm.validateInstructingFI().Err()
 
 	expected = fieldError("OriginatorOptionF", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
 
 	fwm.Originator = mockOriginator()
-	err = fwm.validateInstructingFI()
+	err = fwm.validateInstructingFI().Err()
 	expected = fieldError("OriginatorFI", ErrFieldRequired).Error()
 
 	require.EqualError(t, err, expected)
@@ -217,7 +298,7 @@ func TestNewFEDWireMessage_validateOriginatorToBeneficiary(t *testing.T) {
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransfer
 
 	// Beneficiary required field check
-	err := fwm.validateOriginatorToBeneficiary()
+	err := fwm.validateOriginatorToBeneficiary().Err()
 
 	expected := fieldError("Beneficiary", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -225,7 +306,7 @@ func TestNewFEDWireMessage_validateOriginatorToBeneficiary(t *testing.T) {
 	fwm.Beneficiary = mockBeneficiary()
 
 	// Originator required Field check
-	err = fwm.validateOriginatorToBeneficiary()
+	err = fwm.validateOriginatorToBeneficiary().Err()
 
 	expected = fieldError("Originator", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -233,7 +314,7 @@ func TestNewFEDWireMessage_validateOriginatorToBeneficiary(t *testing.T) {
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
 
 	// OriginatorOptionF required Field check
-	err = fwm.validateOriginatorToBeneficiary()
+	err = fwm.validateOriginatorToBeneficiary().Err()
 
 	expected = fieldError("Originator or OriginatorOptionF", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -241,7 +322,7 @@ func TestNewFEDWireMessage_validateOriginatorToBeneficiary(t *testing.T) {
 	fwm.Originator = mockOriginator()
 
 	// OriginatorOptionF required Field check
-	err = fwm.validateOriginatorToBeneficiary()
+	err = fwm.validateOriginatorToBeneficiary().Err()
 
 	require.NoError(t, err)
 
@@ -249,14 +330,14 @@ func TestNewFEDWireMessage_validateOriginatorToBeneficiary(t *testing.T) {
 	fwm.OriginatorOptionF = mockOriginatorOptionF()
 
 	// OriginatorOptionF required Field check
-	err = fwm.validateOriginatorToBeneficiary()
+	err = fwm.validateOriginatorToBeneficiary().Err()
 
 	require.NoError(t, err)
 
 	// check beneficiary still required
 	fwm.Beneficiary = nil
 
-	err = fwm.validateOriginatorToBeneficiary()
+	err = fwm.validateOriginatorToBeneficiary().Err()
 
 	expected = fieldError("Beneficiary", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -268,7 +349,7 @@ func TestFEDWireMessage_validateFIIntermediaryFI(t *testing.T) {
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransfer
 
 	// BeneficiaryIntermediaryFI required field check
-	err := fwm.validateFIIntermediaryFI()
+	err := fwm.validateFIIntermediaryFI().Err()
 
 	expected := fieldError("BeneficiaryIntermediaryFI", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -276,7 +357,7 @@ func TestFEDWireMessage_validateFIIntermediaryFI(t *testing.T) {
 	fwm.BeneficiaryIntermediaryFI = mockBeneficiaryIntermediaryFI()
 
 	// BeneficiaryFI required field check
-	err = fwm.validateFIIntermediaryFI()
+	err = fwm.validateFIIntermediaryFI().Err()
 
 	expected = fieldError("BeneficiaryFI", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -284,7 +365,7 @@ func TestFEDWireMessage_validateFIIntermediaryFI(t *testing.T) {
 	fwm.BeneficiaryFI = mockBeneficiaryFI()
 
 	// Beneficiary required field check
-	err = fwm.validateFIIntermediaryFI()
+	err = fwm.validateFIIntermediaryFI().Err()
 
 	expected = fieldError("Beneficiary", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -296,14 +377,14 @@ func TestFEDWireMessage_validateFIIntermediaryFIAdvice(t *testing.T) {
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransfer
 
 	// BeneficiaryIntermediaryFI required field check
-	err := fwm.validateFIIntermediaryFIAdvice()
+	err := fwm.validateFIIntermediaryFIAdvice().Err()
 
 	expected := fieldError("BeneficiaryIntermediaryFI", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
 
 	fwm.BeneficiaryIntermediaryFI = mockBeneficiaryIntermediaryFI()
 	// BeneficiaryFI required field check
-	err = fwm.validateFIIntermediaryFIAdvice()
+	err = fwm.validateFIIntermediaryFIAdvice().Err()
 
 	expected = fieldError("BeneficiaryFI", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -311,7 +392,7 @@ func TestFEDWireMessage_validateFIIntermediaryFIAdvice(t *testing.T) {
 	fwm.BeneficiaryFI = mockBeneficiaryFI()
 
 	// Beneficiary required field check
-	err = fwm.validateFIIntermediaryFIAdvice()
+	err = fwm.validateFIIntermediaryFIAdvice().Err()
 
 	expected = fieldError("Beneficiary", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -323,7 +404,7 @@ func TestFEDWireMessage_validateFIBeneficiaryFI(t *testing.T) {
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransfer
 
 	// BeneficiaryFI required field check
-	err := fwm.validateFIBeneficiaryFI()
+	err := fwm.validateFIBeneficiaryFI().Err()
 
 	expected := fieldError("BeneficiaryFI", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -331,7 +412,7 @@ func TestFEDWireMessage_validateFIBeneficiaryFI(t *testing.T) {
 	fwm.BeneficiaryFI = mockBeneficiaryFI()
 
 	// Beneficiary required field check
-	err = fwm.validateFIBeneficiaryFI()
+	err = fwm.validateFIBeneficiaryFI().Err()
 
 	expected = fieldError("Beneficiary", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -343,7 +424,7 @@ func TestFEDWireMessage_validateFIBeneficiaryFIAdvice(t *testing.T) {
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransfer
 
 	// BeneficiaryFI required field check
-	err := fwm.validateFIBeneficiaryFIAdvice()
+	err := fwm.validateFIBeneficiaryFIAdvice().Err()
 
 	expected := fieldError("BeneficiaryFI", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -351,7 +432,7 @@ func TestFEDWireMessage_validateFIBeneficiaryFIAdvice(t *testing.T) {
 	fwm.BeneficiaryFI = mockBeneficiaryFI()
 
 	// Beneficiary required field check
-	err = fwm.validateFIBeneficiaryFIAdvice()
+	err = fwm.validateFIBeneficiaryFIAdvice().Err()
 
 	expected = fieldError("Beneficiary", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -363,7 +444,7 @@ func TestFEDWireMessage_validateFIBeneficiary(t *testing.T) {
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransfer
 
 	// Beneficiary required field check
-	err := fwm.validateFIBeneficiary()
+	err := fwm.validateFIBeneficiary().Err()
 
 	expected := fieldError("Beneficiary", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -375,7 +456,7 @@ func TestFEDWireMessage_validateFIBeneficiaryAdvice(t *testing.T) {
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransfer
 
 	// Beneficiary required field check
-	err := fwm.validateFIBeneficiaryAdvice()
+	err := fwm.validateFIBeneficiaryAdvice().Err()
 
 	expected := fieldError("Beneficiary", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -390,7 +471,7 @@ func TestFEDWireMessage_validateUnstructuredAddenda(t *testing.T) {
 	fwm.UnstructuredAddenda = mockUnstructuredAddenda()
 
 	// UnstructuredAddenda Invalid Property
-	err := fwm.validateUnstructuredAddenda()
+	err := fwm.validateUnstructuredAddenda().Err()
 
 	expected := NewErrInvalidPropertyForProperty("UnstructuredAddenda", fwm.UnstructuredAddenda.String(),
 		"LocalInstrumentCode", fwm.LocalInstrument.LocalInstrumentCode).Error()
@@ -406,7 +487,7 @@ func TestFEDWireMessage_validateRelatedRemittance(t *testing.T) {
 	fwm.RelatedRemittance = mockRelatedRemittance()
 
 	// RelatedRemittance Invalid Property
-	err := fwm.validateRelatedRemittance()
+	err := fwm.validateRelatedRemittance().Err()
 
 	expected := fieldError("RelatedRemittance", ErrNotPermitted).Error()
 	require.EqualError(t, err, expected)
@@ -421,7 +502,7 @@ func TestFEDWireMessage_validateRemittanceOriginator(t *testing.T) {
 	fwm.RemittanceOriginator = mockRemittanceOriginator()
 
 	// RemittanceOriginator Invalid Property
-	err := fwm.validateRemittanceOriginator()
+	err := fwm.validateRemittanceOriginator().Err()
 
 	expected := fieldError("RemittanceOriginator", ErrNotPermitted).Error()
 	require.EqualError(t, err, expected)
@@ -436,7 +517,7 @@ func TestFEDWireMessage_validateRemittanceBeneficiary(t *testing.T) {
 	fwm.RemittanceBeneficiary = mockRemittanceBeneficiary()
 
 	// RemittanceBeneficiary Invalid Property
-	err := fwm.validateRemittanceBeneficiary()
+	err := fwm.validateRemittanceBeneficiary().Err()
 
 	expected := fieldError("RemittanceBeneficiary", ErrNotPermitted).Error()
 	require.EqualError(t, err, expected)
@@ -445,7 +526,7 @@ func TestFEDWireMessage_validateRemittanceBeneficiary(t *testing.T) {
 	fwm.LocalInstrument.LocalInstrumentCode = RemittanceInformationStructured
 
 	// RemittanceBeneficiary Invalid Property
-	err = fwm.validateRemittanceBeneficiary()
+	err = fwm.validateRemittanceBeneficiary().Err()
 
 	expected = fieldError("RemittanceBeneficiary", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -460,7 +541,7 @@ func TestFEDWireMessage_validatePrimaryRemittanceDocument(t *testing.T) {
 	fwm.PrimaryRemittanceDocument = mockPrimaryRemittanceDocument()
 
 	// PrimaryRemittanceDocument Invalid Property
-	err := fwm.validatePrimaryRemittanceDocument()
+	err := fwm.validatePrimaryRemittanceDocument().Err()
 
 	expected := fieldError("PrimaryRemittanceDocument", ErrNotPermitted).Error()
 	require.EqualError(t, err, expected)
@@ -475,7 +556,7 @@ func TestFEDWireMessage_validateActualAmountPaid(t *testing.T) {
 	fwm.ActualAmountPaid = mockActualAmountPaid()
 
 	// ActualAmountPaid only permitted for CTP and RMTS
-	err := fwm.validateActualAmountPaid()
+	err := fwm.validateActualAmountPaid().Err()
 
 	expected := fieldError("ActualAmountPaid", ErrNotPermitted).Error()
 	require.EqualError(t, err, expected)
@@ -491,7 +572,7 @@ func TestFEDWireMessage_validateGrossAmountRemittanceDocument(t *testing.T) {
 	fwm.GrossAmountRemittanceDocument = mockGrossAmountRemittanceDocument()
 
 	// GrossAmountRemittanceDocument only permitted for CTP and RMTS
-	err := fwm.validateGrossAmountRemittanceDocument()
+	err := fwm.validateGrossAmountRemittanceDocument().Err()
 
 	expected := fieldError("GrossAmountRemittanceDocument", ErrNotPermitted).Error()
 	require.EqualError(t, err, expected)
@@ -506,7 +587,7 @@ func TestFEDWireMessage_validateAdjustment(t *testing.T) {
 	fwm.Adjustment = mockAdjustment()
 
 	// Adjustment Invalid Property
-	err := fwm.validateAdjustment()
+	err := fwm.validateAdjustment().Err()
 
 	expected := fieldError("Adjustment", ErrNotPermitted).Error()
 	require.EqualError(t, err, expected)
@@ -522,7 +603,7 @@ func TestFEDWireMessage_validateDateRemittanceDocument(t *testing.T) {
 	fwm.DateRemittanceDocument = mockDateRemittanceDocument()
 
 	// DateRemittanceDocument Invalid Property
-	err := fwm.validateDateRemittanceDocument()
+	err := fwm.validateDateRemittanceDocument().Err()
 
 	expected := fieldError("DateRemittanceDocument", ErrNotPermitted).Error()
 	require.EqualError(t, err, expected)
@@ -537,7 +618,7 @@ func TestFEDWireMessage_validateSecondaryRemittanceDocument(t *testing.T) {
 	fwm.SecondaryRemittanceDocument = mockSecondaryRemittanceDocument()
 
 	// SecondaryRemittanceDocument Invalid Property
-	err := fwm.validateSecondaryRemittanceDocument()
+	err := fwm.validateSecondaryRemittanceDocument().Err()
 
 	expected := fieldError("SecondaryRemittanceDocument", ErrNotPermitted).Error()
 	require.EqualError(t, err, expected)
@@ -552,7 +633,7 @@ func TestFEDWireMessage_isRemittanceFreeTextValid(t *testing.T) {
 	fwm.RemittanceFreeText = mockRemittanceFreeText()
 
 	// RemittanceFreeTextValid Invalid Property
-	err := fwm.validateRemittanceFreeText()
+	err := fwm.validateRemittanceFreeText().Err()
 
 	expected := fieldError("RemittanceFreeText", ErrNotPermitted).Error()
 	require.EqualError(t, err, expected)
@@ -569,7 +650,7 @@ func TestFEDWireMessage_validateBankTransfer(t *testing.T) {
 	tst.SubTypeCode = RequestCredit
 	fwm.TypeSubType = tst
 
-	err := fwm.validateBankTransfer()
+	err := fwm.validateBankTransfer().Err()
 
 	expected := NewErrBusinessFunctionCodeProperty("TypeSubType", tst.TypeCode+tst.SubTypeCode,
 		fwm.BusinessFunctionCode.BusinessFunctionCode).Error()
@@ -584,7 +665,7 @@ func TestFEDWireMessage_invalidTransTypeCodeBankTransfer(t *testing.T) {
 	bfc.TransactionTypeCode = "COV"
 	fwm.BusinessFunctionCode = bfc
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedBankTransferTags().Err()
 
 	expected := fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode,
 		fwm.BusinessFunctionCode.TransactionTypeCode).Error()
@@ -599,7 +680,7 @@ func TestInvalidLocalInstrumentForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.LocalInstrument = mockLocalInstrument()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedBankTransferTags().Err()
 
 	expected := fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument).Error()
 	require.EqualError(t, err, expected)
@@ -613,7 +694,7 @@ func TestInvalidPaymentNotificationForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.PaymentNotification = mockPaymentNotification()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedBankTransferTags().Err()
 
 	expected := fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification).Error()
 	require.EqualError(t, err, expected)
@@ -627,7 +708,7 @@ func TestInvalidChargesForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.Charges = mockCharges()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedBankTransferTags().Err()
 
 	expected := fieldError("Charges", ErrInvalidProperty, fwm.Charges).Error()
 	require.EqualError(t, err, expected)
@@ -641,7 +722,7 @@ func TestInvalidInstructedAmountForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.InstructedAmount = mockInstructedAmount()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedBankTransferTags().Err()
 
 	expected := fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount).Error()
 	require.EqualError(t, err, expected)
@@ -655,7 +736,7 @@ func TestInvalidExchangeRateForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.ExchangeRate = mockExchangeRate()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedBankTransferTags().Err()
 
 	expected := fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate).Error()
 	require.EqualError(t, err, expected)
@@ -671,7 +752,7 @@ func TestInvalidBeneficiaryIdentificationCodeForBankTransfer(t *testing.T) {
 	ben.Personal.IdentificationCode = SWIFTBICORBEIANDAccountNumber
 	fwm.Beneficiary = ben
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedBankTransferTags().Err()
 
 	expected := fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty,
 		fwm.Beneficiary.Personal.IdentificationCode).Error()
@@ -686,7 +767,7 @@ func TestInvalidAccountDebitedDrawdownForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.AccountDebitedDrawdown = mockAccountDebitedDrawdown()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedBankTransferTags().Err()
 
 	expected := fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown).Error()
 	require.EqualError(t, err, expected)
@@ -702,7 +783,7 @@ func TestInvalidOriginatorIdentificationCodeForBankTransfer(t *testing.T) {
 	o.Personal.IdentificationCode = SWIFTBICORBEIANDAccountNumber
 	fwm.Originator = o
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedBankTransferTags().Err()
 
 	expected := fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty,
 		fwm.Originator.Personal.IdentificationCode).Error()
@@ -717,7 +798,7 @@ func TestInvalidOriginatorOptionFForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.OriginatorOptionF = mockOriginatorOptionF()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedBankTransferTags().Err()
 
 	expected := fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF).Error()
 	require.EqualError(t, err, expected)
@@ -731,7 +812,7 @@ func TestInvalidAccountCreditedDrawdownForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.AccountCreditedDrawdown = mockAccountCreditedDrawdown()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedBankTransferTags().Err()
 
 	expected := fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown).Error()
 	require.EqualError(t, err, expected)
@@ -745,7 +826,7 @@ func TestInvalidFIDrawdownDebitAccountAdviceForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.FIDrawdownDebitAccountAdvice = mockFIDrawdownDebitAccountAdvice()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedBankTransferTags().Err()
 
 	expected := fieldError("FIDrawdownDebitAccountAdvice", ErrInvalidProperty, fwm.FIDrawdownDebitAccountAdvice).Error()
 	require.EqualError(t, err, expected)
@@ -759,7 +840,7 @@ func TestInvalidServiceMessageForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.ServiceMessage = mockServiceMessage()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedBankTransferTags().Err()
 
 	expected := fieldError("ServiceMessage", ErrInvalidProperty, fwm.ServiceMessage).Error()
 	require.EqualError(t, err, expected)
@@ -773,7 +854,7 @@ func TestInvalidUnstructuredAddendaForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.UnstructuredAddenda = mockUnstructuredAddenda()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedBankTransferTags().Err()
 
 	expected := fieldError("UnstructuredAddenda", ErrInvalidProperty, fwm.UnstructuredAddenda).Error()
 	require.EqualError(t, err, expected)
@@ -787,7 +868,7 @@ func TestInvalidCurrencyInstructedAmountForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.CurrencyInstructedAmount = mockCurrencyInstructedAmount()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedBankTransferTags().Err()
 
 	expected := fieldError("CurrencyInstructedAmount", ErrInvalidProperty, fwm.CurrencyInstructedAmount).Error()
 	require.EqualError(t, err, expected)
@@ -801,7 +882,7 @@ func TestInvalidRelatedRemittanceForBankTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.RelatedRemittance = mockRelatedRemittance()
 
-	err := fwm.checkProhibitedBankTransferTags()
+	err := fwm.checkProhibitedBankTransferTags().Err()
 
 	expected := fieldError("RelatedRemittance", ErrInvalidProperty, fwm.RelatedRemittance).Error()
 	require.EqualError(t, err, expected)
@@ -815,7 +896,7 @@ func TestInvalidTransactionTypeCodeForCustomerTransfer(t *testing.T) {
 	bfc.TransactionTypeCode = "COV"
 	fwm.BusinessFunctionCode = bfc
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedCustomerTransferTags().Err()
 
 	expected := fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode,
 		fwm.BusinessFunctionCode.TransactionTypeCode).Error()
@@ -830,7 +911,7 @@ func TestInvalidLocalInstrumentForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.LocalInstrument = mockLocalInstrument()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedCustomerTransferTags().Err()
 
 	expected := fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument).Error()
 	require.EqualError(t, err, expected)
@@ -844,7 +925,7 @@ func TestInvalidPaymentNotificationForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.PaymentNotification = mockPaymentNotification()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedCustomerTransferTags().Err()
 
 	expected := fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification).Error()
 	require.EqualError(t, err, expected)
@@ -858,7 +939,7 @@ func TestInvalidAccountDebitedDrawdownForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.AccountDebitedDrawdown = mockAccountDebitedDrawdown()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedCustomerTransferTags().Err()
 
 	expected := fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown).Error()
 	require.EqualError(t, err, expected)
@@ -872,7 +953,7 @@ func TestInvalidOriginatorOptionFForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.OriginatorOptionF = mockOriginatorOptionF()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedCustomerTransferTags().Err()
 
 	expected := fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF).Error()
 	require.EqualError(t, err, expected)
@@ -886,7 +967,7 @@ func TestInvalidAccountCreditedDrawdownForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.AccountCreditedDrawdown = mockAccountCreditedDrawdown()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedCustomerTransferTags().Err()
 
 	expected := fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown).Error()
 	require.EqualError(t, err, expected)
@@ -900,7 +981,7 @@ func TestInvalidFIDrawdownDebitAccountAdviceForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.FIDrawdownDebitAccountAdvice = mockFIDrawdownDebitAccountAdvice()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedCustomerTransferTags().Err()
 
 	expected := fieldError("FIDrawdownDebitAccountAdvice", ErrInvalidProperty, fwm.FIDrawdownDebitAccountAdvice).Error()
 	require.EqualError(t, err, expected)
@@ -914,7 +995,7 @@ func TestInvalidServiceMessageForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.ServiceMessage = mockServiceMessage()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedCustomerTransferTags().Err()
 
 	expected := fieldError("ServiceMessage", ErrInvalidProperty, fwm.ServiceMessage).Error()
 	require.EqualError(t, err, expected)
@@ -928,7 +1009,7 @@ func TestInvalidUnstructuredAddendaForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.UnstructuredAddenda = mockUnstructuredAddenda()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedCustomerTransferTags().Err()
 
 	expected := fieldError("UnstructuredAddenda", ErrInvalidProperty, fwm.UnstructuredAddenda).Error()
 	require.EqualError(t, err, expected)
@@ -942,7 +1023,7 @@ func TestInvalidCurrencyInstructedAmountForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.CurrencyInstructedAmount = mockCurrencyInstructedAmount()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedCustomerTransferTags().Err()
 
 	expected := fieldError("CurrencyInstructedAmount", ErrInvalidProperty, fwm.CurrencyInstructedAmount).Error()
 	require.EqualError(t, err, expected)
@@ -956,7 +1037,7 @@ func TestInvalidRelatedRemittanceForCustomerTransfer(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.RelatedRemittance = mockRelatedRemittance()
 
-	err := fwm.checkProhibitedCustomerTransferTags()
+	err := fwm.checkProhibitedCustomerTransferTags().Err()
 
 	expected := fieldError("RelatedRemittance", ErrInvalidProperty, fwm.RelatedRemittance).Error()
 	require.EqualError(t, err, expected)
@@ -973,7 +1054,7 @@ func TestLocalInstrumentUnstructuredAddendaForCustomerTransferPlus(t *testing.T)
 	fwm.Originator = mockOriginator()
 	fwm.LocalInstrument = mockLocalInstrument()
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	err := fwm.checkMandatoryCustomerTransferPlusTags().Err()
 
 	expected := fieldError("UnstructuredAddenda", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -992,7 +1073,7 @@ func TestLocalInstrumentRelatedRemittanceForCustomerTransferPlus(t *testing.T) {
 	li.LocalInstrumentCode = RelatedRemittanceInformation
 	fwm.LocalInstrument = li
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	err := fwm.checkMandatoryCustomerTransferPlusTags().Err()
 
 	expected := fieldError("RelatedRemittance", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -1011,7 +1092,7 @@ func TestLocalInstrumentBeneficiaryReferenceForCustomerTransferPlus(t *testing.T
 	li.LocalInstrumentCode = SequenceBCoverPaymentStructured
 	fwm.LocalInstrument = li
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	err := fwm.checkMandatoryCustomerTransferPlusTags().Err()
 
 	expected := fieldError("BeneficiaryReference", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -1031,7 +1112,7 @@ func TestLocalInstrumentOrderingCustomerForCustomerTransferPlus(t *testing.T) {
 	fwm.LocalInstrument = li
 	fwm.BeneficiaryReference = mockBeneficiaryReference()
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	err := fwm.checkMandatoryCustomerTransferPlusTags().Err()
 
 	expected := fieldError("OrderingCustomer", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -1052,7 +1133,7 @@ func TestLocalInstrumentBeneficiaryCustomerForCustomerTransferPlus(t *testing.T)
 	fwm.BeneficiaryReference = mockBeneficiaryReference()
 	fwm.OrderingCustomer = mockOrderingCustomer()
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	err := fwm.checkMandatoryCustomerTransferPlusTags().Err()
 
 	expected := fieldError("BeneficiaryCustomer", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -1071,7 +1152,7 @@ func TestLocalInstrumentProprietaryCodeForCustomerTransferPlus(t *testing.T) {
 	li.LocalInstrumentCode = ProprietaryLocalInstrumentCode
 	fwm.LocalInstrument = li
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	err := fwm.checkMandatoryCustomerTransferPlusTags().Err()
 
 	expected := fieldError("ProprietaryCode", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -1090,7 +1171,7 @@ func TestLocalInstrumentRemittanceOriginatorForCustomerTransferPlus(t *testing.T
 	li.LocalInstrumentCode = RemittanceInformationStructured
 	fwm.LocalInstrument = li
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	err := fwm.checkMandatoryCustomerTransferPlusTags().Err()
 
 	expected := fieldError("RemittanceOriginator", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -1110,7 +1191,7 @@ func TestLocalInstrumentRemittanceBeneficiaryForCustomerTransferPlus(t *testing.
 	fwm.LocalInstrument = li
 	fwm.RemittanceOriginator = mockRemittanceOriginator()
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	err := fwm.checkMandatoryCustomerTransferPlusTags().Err()
 
 	expected := fieldError("RemittanceBeneficiary", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -1131,7 +1212,7 @@ func TestLocalInstrumentPrimaryRemittanceDocumentForCustomerTransferPlus(t *test
 	fwm.RemittanceOriginator = mockRemittanceOriginator()
 	fwm.RemittanceBeneficiary = mockRemittanceBeneficiary()
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	err := fwm.checkMandatoryCustomerTransferPlusTags().Err()
 
 	expected := fieldError("PrimaryRemittanceDocument", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -1153,7 +1234,7 @@ func TestLocalInstrumentActualAmountPaidForCustomerTransferPlus(t *testing.T) {
 	fwm.RemittanceBeneficiary = mockRemittanceBeneficiary()
 	fwm.PrimaryRemittanceDocument = mockPrimaryRemittanceDocument()
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	err := fwm.checkMandatoryCustomerTransferPlusTags().Err()
 
 	expected := fieldError("ActualAmountPaid", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -1166,7 +1247,7 @@ func TestBeneficiaryIdentificationCodeForCustomerTransferPlus(t *testing.T) {
 	bfc.BusinessFunctionCode = CustomerTransferPlus
 	fwm.BusinessFunctionCode = bfc
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	err := fwm.checkMandatoryCustomerTransferPlusTags().Err()
 
 	expected := fieldError("Beneficiary", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -1180,7 +1261,7 @@ func TestOriginatorIdentificationCodeForCustomerTransferPlus(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.Beneficiary = mockBeneficiary()
 
-	err := fwm.checkMandatoryCustomerTransferPlusTags()
+	err := fwm.checkMandatoryCustomerTransferPlusTags().Err()
 
 	expected := fieldError("Originator OR OriginatorOptionF", ErrFieldRequired).Error()
 	require.EqualError(t, err, expected)
@@ -1194,7 +1275,7 @@ func TestInvalidAccountDebitedDrawdownForCustomerTransferPlus(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.AccountDebitedDrawdown = mockAccountDebitedDrawdown()
 
-	err := fwm.checkProhibitedCustomerTransferPlusTags()
+	err := fwm.checkProhibitedCustomerTransferPlusTags().Err()
 
 	expected := fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown).Error()
 	require.EqualError(t, err, expected)
@@ -1208,7 +1289,7 @@ func TestInvalidAccountCreditedDrawdownForCustomerTransferPlus(t *testing.T) {
 	fwm.BusinessFunctionCode = bfc
 	fwm.AccountCreditedDrawdown = mockAccountCreditedDrawdown()
 
-	err := fwm.checkProhibitedCustomerTransferPlusTags()
+	err := fwm.checkProhibitedCustomerTransferPlusTags().Err()
 
 	expected := fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown).Error()
 	require.EqualError(t, err, expected)
@@ -1230,10 +1311,10 @@ func TestFEDWireMessage_skipIMAD(t *testing.T) {
 	err := file.Validate()
 	require.NoError(t, err)
 
-	file.FEDWireMessage.InputMessageAccountabilityData = nil
+	file.FEDWireMessages[0].InputMessageAccountabilityData = nil
 
 	err = file.Validate()
-	expected := fieldError("InputMessageAccountabilityData", ErrFieldRequired).Error()
+	expected := NewFEDWireMessageError(0, fieldError("InputMessageAccountabilityData", ErrFieldRequired)).Error()
 	require.EqualError(t, err, expected)
 
 	file.SetValidation(&ValidateOpts{SkipMandatoryIMAD: true})
@@ -1246,7 +1327,7 @@ func TestFEDWireMessage_skipIMAD(t *testing.T) {
 	newFile, err := FileFromJSON(bs)
 	require.NoError(t, err)
 	require.NotNil(t, newFile, "Created file shouldn't be nil")
-	require.Nil(t, newFile.FEDWireMessage.InputMessageAccountabilityData)
+	require.Nil(t, newFile.FEDWireMessages[0].InputMessageAccountabilityData)
 
 	err = newFile.Validate()
 	require.NoError(t, err)
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIAdditionalFIToFI is the financial institution beneficiary financial institution
@@ -45,42 +47,42 @@ func (fifi *FIAdditionalFIToFI) Parse(record string) error {
 
 	value, read, err := fifi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineOne", err)
+		return fieldErrorAt(length, "LineOne", err)
 	}
 	fifi.AdditionalFIToFI.LineOne = value
 	length += read
 
 	value, read, err = fifi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineTwo", err)
+		return fieldErrorAt(length, "LineTwo", err)
 	}
 	fifi.AdditionalFIToFI.LineTwo = value
 	length += read
 
 	value, read, err = fifi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineThree", err)
+		return fieldErrorAt(length, "LineThree", err)
 	}
 	fifi.AdditionalFIToFI.LineThree = value
 	length += read
 
 	value, read, err = fifi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineFour", err)
+		return fieldErrorAt(length, "LineFour", err)
 	}
 	fifi.AdditionalFIToFI.LineFour = value
 	length += read
 
 	value, read, err = fifi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineFive", err)
+		return fieldErrorAt(length, "LineFive", err)
 	}
 	fifi.AdditionalFIToFI.LineFive = value
 	length += read
 
 	value, read, err = fifi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineSix", err)
+		return fieldErrorAt(length, "LineSix", err)
 	}
 	fifi.AdditionalFIToFI.LineSix = value
 	length += read
@@ -134,30 +136,36 @@ func (fifi *FIAdditionalFIToFI) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on FIAdditionalFIToFI and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (fifi *FIAdditionalFIToFI) Validate() error {
+	return fifi.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on FIAdditionalFIToFI and returns every error found.
+// Only the first error for each field is returned.
+func (fifi *FIAdditionalFIToFI) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if fifi.tag != TagFIAdditionalFIToFI {
-		return fieldError("tag", ErrValidTagForType, fifi.tag)
+		addFieldError(&errs, TagFIAdditionalFIToFI, fieldError("tag", ErrValidTagForType, fifi.tag))
 	}
 	if err := fifi.isAlphanumeric(fifi.AdditionalFIToFI.LineOne); err != nil {
-		return fieldError("LineOne", err, fifi.AdditionalFIToFI.LineOne)
+		addFieldError(&errs, TagFIAdditionalFIToFI, fieldError("LineOne", err, fifi.AdditionalFIToFI.LineOne))
 	}
 	if err := fifi.isAlphanumeric(fifi.AdditionalFIToFI.LineTwo); err != nil {
-		return fieldError("LineTwo", err, fifi.AdditionalFIToFI.LineTwo)
+		addFieldError(&errs, TagFIAdditionalFIToFI, fieldError("LineTwo", err, fifi.AdditionalFIToFI.LineTwo))
 	}
 	if err := fifi.isAlphanumeric(fifi.AdditionalFIToFI.LineThree); err != nil {
-		return fieldError("LineThree", err, fifi.AdditionalFIToFI.LineThree)
+		addFieldError(&errs, TagFIAdditionalFIToFI, fieldError("LineThree", err, fifi.AdditionalFIToFI.LineThree))
 	}
 	if err := fifi.isAlphanumeric(fifi.AdditionalFIToFI.LineFour); err != nil {
-		return fieldError("LineFour", err, fifi.AdditionalFIToFI.LineFour)
+		addFieldError(&errs, TagFIAdditionalFIToFI, fieldError("LineFour", err, fifi.AdditionalFIToFI.LineFour))
 	}
 	if err := fifi.isAlphanumeric(fifi.AdditionalFIToFI.LineFive); err != nil {
-		return fieldError("LineFive", err, fifi.AdditionalFIToFI.LineFive)
+		addFieldError(&errs, TagFIAdditionalFIToFI, fieldError("LineFive", err, fifi.AdditionalFIToFI.LineFive))
 	}
 	if err := fifi.isAlphanumeric(fifi.AdditionalFIToFI.LineSix); err != nil {
-		return fieldError("LineSix", err, fifi.AdditionalFIToFI.LineSix)
+		addFieldError(&errs, TagFIAdditionalFIToFI, fieldError("LineSix", err, fifi.AdditionalFIToFI.LineSix))
 	}
-	return nil
+	return errs
 }
 
 // LineOneField gets a string of the LineOne field
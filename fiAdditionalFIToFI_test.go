@@ -94,7 +94,7 @@ func TestParseFIAdditionalFIToFIWrongLength(t *testing.T) {
 	r.line = line
 
 	err := r.parseFIAdditionalFIToFI()
-	require.EqualError(t, err, r.parseError(fieldError("LineOne", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "LineOne", ErrRequireDelimiter)).Error())
 }
 
 // TestParseFIAdditionalFIToFIReaderParseError parses a wrong FIAdditionalFIToFI reader parse error
@@ -110,7 +110,7 @@ func TestParseFIAdditionalFIToFIReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("LineOne", ErrNonAlphanumeric, "®ine One")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("LineOne", ErrNonAlphanumeric, "®ine One"))).Error()
 	require.EqualError(t, err, expected)
 }
 
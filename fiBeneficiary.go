@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIBeneficiary is the financial institution beneficiary
@@ -45,42 +47,42 @@ func (fib *FIBeneficiary) Parse(record string) error {
 
 	value, read, err := fib.parseVariableStringField(record[length:], 30)
 	if err != nil {
-		return fieldError("LineOne", err)
+		return fieldErrorAt(length, "LineOne", err)
 	}
 	fib.FIToFI.LineOne = value
 	length += read
 
 	value, read, err = fib.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineTwo", err)
+		return fieldErrorAt(length, "LineTwo", err)
 	}
 	fib.FIToFI.LineTwo = value
 	length += read
 
 	value, read, err = fib.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineThree", err)
+		return fieldErrorAt(length, "LineThree", err)
 	}
 	fib.FIToFI.LineThree = value
 	length += read
 
 	value, read, err = fib.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineFour", err)
+		return fieldErrorAt(length, "LineFour", err)
 	}
 	fib.FIToFI.LineFour = value
 	length += read
 
 	value, read, err = fib.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineFive", err)
+		return fieldErrorAt(length, "LineFive", err)
 	}
 	fib.FIToFI.LineFive = value
 	length += read
 
 	value, read, err = fib.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineSix", err)
+		return fieldErrorAt(length, "LineSix", err)
 	}
 	fib.FIToFI.LineSix = value
 	length += read
@@ -134,30 +136,36 @@ func (fib *FIBeneficiary) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on FIBeneficiary and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (fib *FIBeneficiary) Validate() error {
+	return fib.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on FIBeneficiary and returns every error found.
+// Only the first error for each field is returned.
+func (fib *FIBeneficiary) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if fib.tag != TagFIBeneficiary {
-		return fieldError("tag", ErrValidTagForType, fib.tag)
+		addFieldError(&errs, TagFIBeneficiary, fieldError("tag", ErrValidTagForType, fib.tag))
 	}
 	if err := fib.isAlphanumeric(fib.FIToFI.LineOne); err != nil {
-		return fieldError("LineOne", err, fib.FIToFI.LineOne)
+		addFieldError(&errs, TagFIBeneficiary, fieldError("LineOne", err, fib.FIToFI.LineOne))
 	}
 	if err := fib.isAlphanumeric(fib.FIToFI.LineTwo); err != nil {
-		return fieldError("LineTwo", err, fib.FIToFI.LineTwo)
+		addFieldError(&errs, TagFIBeneficiary, fieldError("LineTwo", err, fib.FIToFI.LineTwo))
 	}
 	if err := fib.isAlphanumeric(fib.FIToFI.LineThree); err != nil {
-		return fieldError("LineThree", err, fib.FIToFI.LineThree)
+		addFieldError(&errs, TagFIBeneficiary, fieldError("LineThree", err, fib.FIToFI.LineThree))
 	}
 	if err := fib.isAlphanumeric(fib.FIToFI.LineFour); err != nil {
-		return fieldError("LineFour", err, fib.FIToFI.LineFour)
+		addFieldError(&errs, TagFIBeneficiary, fieldError("LineFour", err, fib.FIToFI.LineFour))
 	}
 	if err := fib.isAlphanumeric(fib.FIToFI.LineFive); err != nil {
-		return fieldError("LineFive", err, fib.FIToFI.LineFive)
+		addFieldError(&errs, TagFIBeneficiary, fieldError("LineFive", err, fib.FIToFI.LineFive))
 	}
 	if err := fib.isAlphanumeric(fib.FIToFI.LineSix); err != nil {
-		return fieldError("LineSix", err, fib.FIToFI.LineSix)
+		addFieldError(&errs, TagFIBeneficiary, fieldError("LineSix", err, fib.FIToFI.LineSix))
 	}
-	return nil
+	return errs
 }
 
 // LineOneField gets a string of the LineOne field
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIBeneficiaryAdvice is the financial institution beneficiary advice
@@ -46,42 +48,42 @@ func (fiba *FIBeneficiaryAdvice) Parse(record string) error {
 
 	value, read, err := fiba.parseVariableStringField(record[length:], 26)
 	if err != nil {
-		return fieldError("LineOne", err)
+		return fieldErrorAt(length, "LineOne", err)
 	}
 	fiba.Advice.LineOne = value
 	length += read
 
 	value, read, err = fiba.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineTwo", err)
+		return fieldErrorAt(length, "LineTwo", err)
 	}
 	fiba.Advice.LineTwo = value
 	length += read
 
 	value, read, err = fiba.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineThree", err)
+		return fieldErrorAt(length, "LineThree", err)
 	}
 	fiba.Advice.LineThree = value
 	length += read
 
 	value, read, err = fiba.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineFour", err)
+		return fieldErrorAt(length, "LineFour", err)
 	}
 	fiba.Advice.LineFour = value
 	length += read
 
 	value, read, err = fiba.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineFive", err)
+		return fieldErrorAt(length, "LineFive", err)
 	}
 	fiba.Advice.LineFive = value
 	length += read
 
 	value, read, err = fiba.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineSix", err)
+		return fieldErrorAt(length, "LineSix", err)
 	}
 	fiba.Advice.LineSix = value
 	length += read
@@ -136,33 +138,39 @@ func (fiba *FIBeneficiaryAdvice) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on FIBeneficiaryAdvice and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (fiba *FIBeneficiaryAdvice) Validate() error {
+	return fiba.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on FIBeneficiaryAdvice and returns every error found.
+// Only the first error for each field is returned.
+func (fiba *FIBeneficiaryAdvice) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if fiba.tag != TagFIBeneficiaryAdvice {
-		return fieldError("tag", ErrValidTagForType, fiba.tag)
+		addFieldError(&errs, TagFIBeneficiaryAdvice, fieldError("tag", ErrValidTagForType, fiba.tag))
 	}
 	if err := fiba.isAdviceCode(fiba.Advice.AdviceCode); err != nil {
-		return fieldError("AdviceCode", err, fiba.Advice.AdviceCode)
+		addFieldError(&errs, TagFIBeneficiaryAdvice, fieldError("AdviceCode", err, fiba.Advice.AdviceCode))
 	}
 	if err := fiba.isAlphanumeric(fiba.Advice.LineOne); err != nil {
-		return fieldError("LineOne", err, fiba.Advice.LineOne)
+		addFieldError(&errs, TagFIBeneficiaryAdvice, fieldError("LineOne", err, fiba.Advice.LineOne))
 	}
 	if err := fiba.isAlphanumeric(fiba.Advice.LineTwo); err != nil {
-		return fieldError("LineTwo", err, fiba.Advice.LineTwo)
+		addFieldError(&errs, TagFIBeneficiaryAdvice, fieldError("LineTwo", err, fiba.Advice.LineTwo))
 	}
 	if err := fiba.isAlphanumeric(fiba.Advice.LineThree); err != nil {
-		return fieldError("LineThree", err, fiba.Advice.LineThree)
+		addFieldError(&errs, TagFIBeneficiaryAdvice, fieldError("LineThree", err, fiba.Advice.LineThree))
 	}
 	if err := fiba.isAlphanumeric(fiba.Advice.LineFour); err != nil {
-		return fieldError("LineFour", err, fiba.Advice.LineFour)
+		addFieldError(&errs, TagFIBeneficiaryAdvice, fieldError("LineFour", err, fiba.Advice.LineFour))
 	}
 	if err := fiba.isAlphanumeric(fiba.Advice.LineFive); err != nil {
-		return fieldError("LineFive", err, fiba.Advice.LineFive)
+		addFieldError(&errs, TagFIBeneficiaryAdvice, fieldError("LineFive", err, fiba.Advice.LineFive))
 	}
 	if err := fiba.isAlphanumeric(fiba.Advice.LineSix); err != nil {
-		return fieldError("LineSix", err, fiba.Advice.LineSix)
+		addFieldError(&errs, TagFIBeneficiaryAdvice, fieldError("LineSix", err, fiba.Advice.LineSix))
 	}
-	return nil
+	return errs
 }
 
 // AdviceCodeField gets a string of the AdviceCode field
@@ -99,7 +99,7 @@ func TestParseFIBeneficiaryAdviceWrongLength(t *testing.T) {
 
 	err := r.parseFIBeneficiaryAdvice()
 
-	require.EqualError(t, err, r.parseError(fieldError("LineOne", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(9, "LineOne", ErrRequireDelimiter)).Error())
 }
 
 // TestParseFIBeneficiaryAdviceReaderParseError parses a wrong FIBeneficiaryAdvice reader parse error
@@ -115,7 +115,7 @@ func TestParseFIBeneficiaryAdviceReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("LineOne", ErrNonAlphanumeric, "Line ®ne")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("LineOne", ErrNonAlphanumeric, "Line ®ne"))).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIBeneficiaryFI is the financial institution beneficiary financial institution
@@ -45,42 +47,42 @@ func (fibfi *FIBeneficiaryFI) Parse(record string) error {
 
 	value, read, err := fibfi.parseVariableStringField(record[length:], 30)
 	if err != nil {
-		return fieldError("LineOne", err)
+		return fieldErrorAt(length, "LineOne", err)
 	}
 	fibfi.FIToFI.LineOne = value
 	length += read
 
 	value, read, err = fibfi.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineTwo", err)
+		return fieldErrorAt(length, "LineTwo", err)
 	}
 	fibfi.FIToFI.LineTwo = value
 	length += read
 
 	value, read, err = fibfi.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineThree", err)
+		return fieldErrorAt(length, "LineThree", err)
 	}
 	fibfi.FIToFI.LineThree = value
 	length += read
 
 	value, read, err = fibfi.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineFour", err)
+		return fieldErrorAt(length, "LineFour", err)
 	}
 	fibfi.FIToFI.LineFour = value
 	length += read
 
 	value, read, err = fibfi.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineFive", err)
+		return fieldErrorAt(length, "LineFive", err)
 	}
 	fibfi.FIToFI.LineFive = value
 	length += read
 
 	value, read, err = fibfi.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineSix", err)
+		return fieldErrorAt(length, "LineSix", err)
 	}
 	fibfi.FIToFI.LineSix = value
 	length += read
@@ -134,30 +136,36 @@ func (fibfi *FIBeneficiaryFI) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on FIBeneficiaryFI and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (fibfi *FIBeneficiaryFI) Validate() error {
+	return fibfi.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on FIBeneficiaryFI and returns every error found.
+// Only the first error for each field is returned.
+func (fibfi *FIBeneficiaryFI) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if fibfi.tag != TagFIBeneficiaryFI {
-		return fieldError("tag", ErrValidTagForType, fibfi.tag)
+		addFieldError(&errs, TagFIBeneficiaryFI, fieldError("tag", ErrValidTagForType, fibfi.tag))
 	}
 	if err := fibfi.isAlphanumeric(fibfi.FIToFI.LineOne); err != nil {
-		return fieldError("LineOne", err, fibfi.FIToFI.LineOne)
+		addFieldError(&errs, TagFIBeneficiaryFI, fieldError("LineOne", err, fibfi.FIToFI.LineOne))
 	}
 	if err := fibfi.isAlphanumeric(fibfi.FIToFI.LineTwo); err != nil {
-		return fieldError("LineTwo", err, fibfi.FIToFI.LineTwo)
+		addFieldError(&errs, TagFIBeneficiaryFI, fieldError("LineTwo", err, fibfi.FIToFI.LineTwo))
 	}
 	if err := fibfi.isAlphanumeric(fibfi.FIToFI.LineThree); err != nil {
-		return fieldError("LineThree", err, fibfi.FIToFI.LineThree)
+		addFieldError(&errs, TagFIBeneficiaryFI, fieldError("LineThree", err, fibfi.FIToFI.LineThree))
 	}
 	if err := fibfi.isAlphanumeric(fibfi.FIToFI.LineFour); err != nil {
-		return fieldError("LineFour", err, fibfi.FIToFI.LineFour)
+		addFieldError(&errs, TagFIBeneficiaryFI, fieldError("LineFour", err, fibfi.FIToFI.LineFour))
 	}
 	if err := fibfi.isAlphanumeric(fibfi.FIToFI.LineFive); err != nil {
-		return fieldError("LineFive", err, fibfi.FIToFI.LineFive)
+		addFieldError(&errs, TagFIBeneficiaryFI, fieldError("LineFive", err, fibfi.FIToFI.LineFive))
 	}
 	if err := fibfi.isAlphanumeric(fibfi.FIToFI.LineSix); err != nil {
-		return fieldError("LineSix", err, fibfi.FIToFI.LineSix)
+		addFieldError(&errs, TagFIBeneficiaryFI, fieldError("LineSix", err, fibfi.FIToFI.LineSix))
 	}
-	return nil
+	return errs
 }
 
 // LineOneField gets a string of the LineOne field
@@ -95,7 +95,7 @@ func TestParseFIBeneficiaryFIWrongLength(t *testing.T) {
 
 	err := r.parseFIBeneficiaryFI()
 
-	require.EqualError(t, err, r.parseError(fieldError("LineOne", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "LineOne", ErrRequireDelimiter)).Error())
 }
 
 // TestParseFIBeneficiaryFIReaderParseError parses a wrong FIBeneficiaryFI reader parse error
@@ -111,7 +111,7 @@ func TestParseFIBeneficiaryFIReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("LineOne", ErrNonAlphanumeric, "Line ®ne")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("LineOne", ErrNonAlphanumeric, "Line ®ne"))).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -94,7 +94,7 @@ func TestParseFIBeneficiaryWrongLength(t *testing.T) {
 	r.line = line
 
 	err := r.parseFIBeneficiary()
-	require.EqualError(t, err, r.parseError(fieldError("LineOne", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "LineOne", ErrRequireDelimiter)).Error())
 }
 
 // TestParseFIBeneficiaryReaderParseError parses a wrong FIBeneficiary reader parse error
@@ -110,7 +110,7 @@ func TestParseFIBeneficiaryReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("LineOne", ErrNonAlphanumeric, "Line Si®")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("LineOne", ErrNonAlphanumeric, "Line Si®"))).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIDrawdownDebitAccountAdvice is the financial institution drawdown debit account advice
@@ -46,42 +48,42 @@ func (debitDDAdvice *FIDrawdownDebitAccountAdvice) Parse(record string) error {
 
 	value, read, err := debitDDAdvice.parseVariableStringField(record[length:], 26)
 	if err != nil {
-		return fieldError("LineOne", err)
+		return fieldErrorAt(length, "LineOne", err)
 	}
 	debitDDAdvice.Advice.LineOne = value
 	length += read
 
 	value, read, err = debitDDAdvice.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineTwo", err)
+		return fieldErrorAt(length, "LineTwo", err)
 	}
 	debitDDAdvice.Advice.LineTwo = value
 	length += read
 
 	value, read, err = debitDDAdvice.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineThree", err)
+		return fieldErrorAt(length, "LineThree", err)
 	}
 	debitDDAdvice.Advice.LineThree = value
 	length += read
 
 	value, read, err = debitDDAdvice.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineFour", err)
+		return fieldErrorAt(length, "LineFour", err)
 	}
 	debitDDAdvice.Advice.LineFour = value
 	length += read
 
 	value, read, err = debitDDAdvice.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineFive", err)
+		return fieldErrorAt(length, "LineFive", err)
 	}
 	debitDDAdvice.Advice.LineFive = value
 	length += read
 
 	value, read, err = debitDDAdvice.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineSix", err)
+		return fieldErrorAt(length, "LineSix", err)
 	}
 	debitDDAdvice.Advice.LineSix = value
 	length += read
@@ -136,33 +138,39 @@ func (debitDDAdvice *FIDrawdownDebitAccountAdvice) Format(options FormatOptions)
 }
 
 // Validate performs WIRE format rule checks on FIDrawdownDebitAccountAdvice and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (debitDDAdvice *FIDrawdownDebitAccountAdvice) Validate() error {
+	return debitDDAdvice.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on FIDrawdownDebitAccountAdvice and returns every error found.
+// Only the first error for each field is returned.
+func (debitDDAdvice *FIDrawdownDebitAccountAdvice) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if debitDDAdvice.tag != TagFIDrawdownDebitAccountAdvice {
-		return fieldError("tag", ErrValidTagForType, debitDDAdvice.tag)
+		addFieldError(&errs, TagFIDrawdownDebitAccountAdvice, fieldError("tag", ErrValidTagForType, debitDDAdvice.tag))
 	}
 	if err := debitDDAdvice.isAdviceCode(debitDDAdvice.Advice.AdviceCode); err != nil {
-		return fieldError("AdviceCode", err, debitDDAdvice.Advice.AdviceCode)
+		addFieldError(&errs, TagFIDrawdownDebitAccountAdvice, fieldError("AdviceCode", err, debitDDAdvice.Advice.AdviceCode))
 	}
 	if err := debitDDAdvice.isAlphanumeric(debitDDAdvice.Advice.LineOne); err != nil {
-		return fieldError("LineOne", err, debitDDAdvice.Advice.LineOne)
+		addFieldError(&errs, TagFIDrawdownDebitAccountAdvice, fieldError("LineOne", err, debitDDAdvice.Advice.LineOne))
 	}
 	if err := debitDDAdvice.isAlphanumeric(debitDDAdvice.Advice.LineTwo); err != nil {
-		return fieldError("LineTwo", err, debitDDAdvice.Advice.LineTwo)
+		addFieldError(&errs, TagFIDrawdownDebitAccountAdvice, fieldError("LineTwo", err, debitDDAdvice.Advice.LineTwo))
 	}
 	if err := debitDDAdvice.isAlphanumeric(debitDDAdvice.Advice.LineThree); err != nil {
-		return fieldError("LineThree", err, debitDDAdvice.Advice.LineThree)
+		addFieldError(&errs, TagFIDrawdownDebitAccountAdvice, fieldError("LineThree", err, debitDDAdvice.Advice.LineThree))
 	}
 	if err := debitDDAdvice.isAlphanumeric(debitDDAdvice.Advice.LineFour); err != nil {
-		return fieldError("LineFour", err, debitDDAdvice.Advice.LineFour)
+		addFieldError(&errs, TagFIDrawdownDebitAccountAdvice, fieldError("LineFour", err, debitDDAdvice.Advice.LineFour))
 	}
 	if err := debitDDAdvice.isAlphanumeric(debitDDAdvice.Advice.LineFive); err != nil {
-		return fieldError("LineFive", err, debitDDAdvice.Advice.LineFive)
+		addFieldError(&errs, TagFIDrawdownDebitAccountAdvice, fieldError("LineFive", err, debitDDAdvice.Advice.LineFive))
 	}
 	if err := debitDDAdvice.isAlphanumeric(debitDDAdvice.Advice.LineSix); err != nil {
-		return fieldError("LineSix", err, debitDDAdvice.Advice.LineSix)
+		addFieldError(&errs, TagFIDrawdownDebitAccountAdvice, fieldError("LineSix", err, debitDDAdvice.Advice.LineSix))
 	}
-	return nil
+	return errs
 }
 
 // AdviceCodeField gets a string of the AdviceCode field
@@ -106,7 +106,7 @@ func TestParseFIDrawdownDebitAccountAdviceWrongLength(t *testing.T) {
 
 	err := r.parseFIDrawdownDebitAccountAdvice()
 
-	require.EqualError(t, err, r.parseError(fieldError("LineOne", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(9, "LineOne", ErrRequireDelimiter)).Error())
 }
 
 // TestParseFIDrawdownDebitAccountAdviceReaderParseError parses a wrong FIDrawdownDebitAccountAdvice reader parse error
@@ -122,7 +122,7 @@ func TestParseFIDrawdownDebitAccountAdviceReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("LineOne", ErrNonAlphanumeric, "®ine One")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("LineOne", ErrNonAlphanumeric, "®ine One"))).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIIntermediaryFI is the financial institution intermediary financial institution
@@ -45,42 +47,42 @@ func (fiifi *FIIntermediaryFI) Parse(record string) error {
 
 	value, read, err := fiifi.parseVariableStringField(record[length:], 30)
 	if err != nil {
-		return fieldError("LineOne", err)
+		return fieldErrorAt(length, "LineOne", err)
 	}
 	fiifi.FIToFI.LineOne = value
 	length += read
 
 	value, read, err = fiifi.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineTwo", err)
+		return fieldErrorAt(length, "LineTwo", err)
 	}
 	fiifi.FIToFI.LineTwo = value
 	length += read
 
 	value, read, err = fiifi.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineThree", err)
+		return fieldErrorAt(length, "LineThree", err)
 	}
 	fiifi.FIToFI.LineThree = value
 	length += read
 
 	value, read, err = fiifi.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineFour", err)
+		return fieldErrorAt(length, "LineFour", err)
 	}
 	fiifi.FIToFI.LineFour = value
 	length += read
 
 	value, read, err = fiifi.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineFive", err)
+		return fieldErrorAt(length, "LineFive", err)
 	}
 	fiifi.FIToFI.LineFive = value
 	length += read
 
 	value, read, err = fiifi.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineSix", err)
+		return fieldErrorAt(length, "LineSix", err)
 	}
 	fiifi.FIToFI.LineSix = value
 	length += read
@@ -134,30 +136,36 @@ func (fiifi *FIIntermediaryFI) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on FIIntermediaryFI and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (fiifi *FIIntermediaryFI) Validate() error {
+	return fiifi.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on FIIntermediaryFI and returns every error found.
+// Only the first error for each field is returned.
+func (fiifi *FIIntermediaryFI) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if fiifi.tag != TagFIIntermediaryFI {
-		return fieldError("tag", ErrValidTagForType, fiifi.tag)
+		addFieldError(&errs, TagFIIntermediaryFI, fieldError("tag", ErrValidTagForType, fiifi.tag))
 	}
 	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineOne); err != nil {
-		return fieldError("LineOne", err, fiifi.FIToFI.LineOne)
+		addFieldError(&errs, TagFIIntermediaryFI, fieldError("LineOne", err, fiifi.FIToFI.LineOne))
 	}
 	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineTwo); err != nil {
-		return fieldError("LineTwo", err, fiifi.FIToFI.LineTwo)
+		addFieldError(&errs, TagFIIntermediaryFI, fieldError("LineTwo", err, fiifi.FIToFI.LineTwo))
 	}
 	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineThree); err != nil {
-		return fieldError("LineThree", err, fiifi.FIToFI.LineThree)
+		addFieldError(&errs, TagFIIntermediaryFI, fieldError("LineThree", err, fiifi.FIToFI.LineThree))
 	}
 	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineFour); err != nil {
-		return fieldError("LineFour", err, fiifi.FIToFI.LineFour)
+		addFieldError(&errs, TagFIIntermediaryFI, fieldError("LineFour", err, fiifi.FIToFI.LineFour))
 	}
 	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineFive); err != nil {
-		return fieldError("LineFive", err, fiifi.FIToFI.LineFive)
+		addFieldError(&errs, TagFIIntermediaryFI, fieldError("LineFive", err, fiifi.FIToFI.LineFive))
 	}
 	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineSix); err != nil {
-		return fieldError("LineSix", err, fiifi.FIToFI.LineSix)
+		addFieldError(&errs, TagFIIntermediaryFI, fieldError("LineSix", err, fiifi.FIToFI.LineSix))
 	}
-	return nil
+	return errs
 }
 
 // LineOneField gets a string of the LineOne field
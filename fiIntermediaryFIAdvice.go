@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIIntermediaryFIAdvice is the financial institution intermediary financial institution
@@ -46,42 +48,42 @@ func (fiifia *FIIntermediaryFIAdvice) Parse(record string) error {
 
 	value, read, err := fiifia.parseVariableStringField(record[length:], 26)
 	if err != nil {
-		return fieldError("LineOne", err)
+		return fieldErrorAt(length, "LineOne", err)
 	}
 	fiifia.Advice.LineOne = value
 	length += read
 
 	value, read, err = fiifia.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineTwo", err)
+		return fieldErrorAt(length, "LineTwo", err)
 	}
 	fiifia.Advice.LineTwo = value
 	length += read
 
 	value, read, err = fiifia.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineThree", err)
+		return fieldErrorAt(length, "LineThree", err)
 	}
 	fiifia.Advice.LineThree = value
 	length += read
 
 	value, read, err = fiifia.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineFour", err)
+		return fieldErrorAt(length, "LineFour", err)
 	}
 	fiifia.Advice.LineFour = value
 	length += read
 
 	value, read, err = fiifia.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineFive", err)
+		return fieldErrorAt(length, "LineFive", err)
 	}
 	fiifia.Advice.LineFive = value
 	length += read
 
 	value, read, err = fiifia.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineSix", err)
+		return fieldErrorAt(length, "LineSix", err)
 	}
 	fiifia.Advice.LineSix = value
 	length += read
@@ -136,33 +138,39 @@ func (fiifia *FIIntermediaryFIAdvice) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on FIIntermediaryFIAdvice and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (fiifia *FIIntermediaryFIAdvice) Validate() error {
+	return fiifia.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on FIIntermediaryFIAdvice and returns every error found.
+// Only the first error for each field is returned.
+func (fiifia *FIIntermediaryFIAdvice) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if fiifia.tag != TagFIIntermediaryFIAdvice {
-		return fieldError("tag", ErrValidTagForType, fiifia.tag)
+		addFieldError(&errs, TagFIIntermediaryFIAdvice, fieldError("tag", ErrValidTagForType, fiifia.tag))
 	}
 	if err := fiifia.isAdviceCode(fiifia.Advice.AdviceCode); err != nil {
-		return fieldError("AdviceCode", err, fiifia.Advice.AdviceCode)
+		addFieldError(&errs, TagFIIntermediaryFIAdvice, fieldError("AdviceCode", err, fiifia.Advice.AdviceCode))
 	}
 	if err := fiifia.isAlphanumeric(fiifia.Advice.LineOne); err != nil {
-		return fieldError("LineOne", err, fiifia.Advice.LineOne)
+		addFieldError(&errs, TagFIIntermediaryFIAdvice, fieldError("LineOne", err, fiifia.Advice.LineOne))
 	}
 	if err := fiifia.isAlphanumeric(fiifia.Advice.LineTwo); err != nil {
-		return fieldError("LineTwo", err, fiifia.Advice.LineTwo)
+		addFieldError(&errs, TagFIIntermediaryFIAdvice, fieldError("LineTwo", err, fiifia.Advice.LineTwo))
 	}
 	if err := fiifia.isAlphanumeric(fiifia.Advice.LineThree); err != nil {
-		return fieldError("LineThree", err, fiifia.Advice.LineThree)
+		addFieldError(&errs, TagFIIntermediaryFIAdvice, fieldError("LineThree", err, fiifia.Advice.LineThree))
 	}
 	if err := fiifia.isAlphanumeric(fiifia.Advice.LineFour); err != nil {
-		return fieldError("LineFour", err, fiifia.Advice.LineFour)
+		addFieldError(&errs, TagFIIntermediaryFIAdvice, fieldError("LineFour", err, fiifia.Advice.LineFour))
 	}
 	if err := fiifia.isAlphanumeric(fiifia.Advice.LineFive); err != nil {
-		return fieldError("LineFive", err, fiifia.Advice.LineFive)
+		addFieldError(&errs, TagFIIntermediaryFIAdvice, fieldError("LineFive", err, fiifia.Advice.LineFive))
 	}
 	if err := fiifia.isAlphanumeric(fiifia.Advice.LineSix); err != nil {
-		return fieldError("LineSix", err, fiifia.Advice.LineSix)
+		addFieldError(&errs, TagFIIntermediaryFIAdvice, fieldError("LineSix", err, fiifia.Advice.LineSix))
 	}
-	return nil
+	return errs
 }
 
 // AdviceCodeField gets a string of the AdviceCode field
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIPaymentMethodToBeneficiary is the financial institution payment method to beneficiary
@@ -49,7 +51,7 @@ func (pm *FIPaymentMethodToBeneficiary) Parse(record string) error {
 
 	value, read, err := pm.parseVariableStringField(record[length:], 30)
 	if err != nil {
-		return fieldError("AdditionalInformation", err)
+		return fieldErrorAt(length, "AdditionalInformation", err)
 	}
 	pm.AdditionalInformation = value
 	length += read
@@ -95,27 +97,31 @@ func (pm *FIPaymentMethodToBeneficiary) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on FIPaymentMethodToBeneficiary and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (pm *FIPaymentMethodToBeneficiary) Validate() error {
-	if err := pm.fieldInclusion(); err != nil {
-		return err
-	}
+	return pm.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on FIPaymentMethodToBeneficiary and returns every error found.
+// Only the first error for each field is returned.
+func (pm *FIPaymentMethodToBeneficiary) ValidateAll() base.ErrorList {
+	errs := pm.fieldInclusion()
 	if pm.tag != TagFIPaymentMethodToBeneficiary {
-		return fieldError("tag", ErrValidTagForType, pm.tag)
+		addFieldError(&errs, TagFIPaymentMethodToBeneficiary, fieldError("tag", ErrValidTagForType, pm.tag))
 	}
 	if err := pm.isAlphanumeric(pm.AdditionalInformation); err != nil {
-		return fieldError("AdditionalInformation", err, pm.AdditionalInformation)
+		addFieldError(&errs, TagFIPaymentMethodToBeneficiary, fieldError("AdditionalInformation", err, pm.AdditionalInformation))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (pm *FIPaymentMethodToBeneficiary) fieldInclusion() error {
+func (pm *FIPaymentMethodToBeneficiary) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if pm.PaymentMethod != PaymentMethod {
-		return fieldError("PaymentMethod", ErrFieldInclusion, pm.PaymentMethod)
+		addFieldError(&errs, TagFIPaymentMethodToBeneficiary, fieldError("PaymentMethod", ErrFieldInclusion, pm.PaymentMethod))
 	}
-	return nil
+	return errs
 }
 
 // PaymentMethodField gets a string of the PaymentMethod field
@@ -50,7 +50,7 @@ func TestParseFIPaymentMethodToBeneficiaryWrongLength(t *testing.T) {
 	r.line = line
 
 	err := r.parseFIPaymentMethodToBeneficiary()
-	require.EqualError(t, err, r.parseError(fieldError("AdditionalInformation", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(11, "AdditionalInformation", ErrRequireDelimiter)).Error())
 }
 
 // TestParseFIPaymentMethodToBeneficiaryReaderParseError parses a wrong FIPaymentMethodToBeneficiary reader parse error
@@ -66,7 +66,7 @@ func TestParseFIPaymentMethodToBeneficiaryReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("AdditionalInformation", ErrNonAlphanumeric, "®dditional Information")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("AdditionalInformation", ErrNonAlphanumeric, "®dditional Information"))).Error()
 	require.EqualError(t, err, expected)
 }
 
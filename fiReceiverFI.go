@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIReceiverFI is the financial institution receiver financial institution
@@ -45,42 +47,42 @@ func (firfi *FIReceiverFI) Parse(record string) error {
 
 	value, read, err := firfi.parseVariableStringField(record[length:], 30)
 	if err != nil {
-		return fieldError("LineOne", err)
+		return fieldErrorAt(length, "LineOne", err)
 	}
 	firfi.FIToFI.LineOne = value
 	length += read
 
 	value, read, err = firfi.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineTwo", err)
+		return fieldErrorAt(length, "LineTwo", err)
 	}
 	firfi.FIToFI.LineTwo = value
 	length += read
 
 	value, read, err = firfi.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineThree", err)
+		return fieldErrorAt(length, "LineThree", err)
 	}
 	firfi.FIToFI.LineThree = value
 	length += read
 
 	value, read, err = firfi.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineFour", err)
+		return fieldErrorAt(length, "LineFour", err)
 	}
 	firfi.FIToFI.LineFour = value
 	length += read
 
 	value, read, err = firfi.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineFive", err)
+		return fieldErrorAt(length, "LineFive", err)
 	}
 	firfi.FIToFI.LineFive = value
 	length += read
 
 	value, read, err = firfi.parseVariableStringField(record[length:], 33)
 	if err != nil {
-		return fieldError("LineSix", err)
+		return fieldErrorAt(length, "LineSix", err)
 	}
 	firfi.FIToFI.LineSix = value
 	length += read
@@ -134,30 +136,36 @@ func (firfi *FIReceiverFI) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on FIReceiverFI and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (firfi *FIReceiverFI) Validate() error {
+	return firfi.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on FIReceiverFI and returns every error found.
+// Only the first error for each field is returned.
+func (firfi *FIReceiverFI) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if firfi.tag != TagFIReceiverFI {
-		return fieldError("tag", ErrValidTagForType, firfi.tag)
+		addFieldError(&errs, TagFIReceiverFI, fieldError("tag", ErrValidTagForType, firfi.tag))
 	}
 	if err := firfi.isAlphanumeric(firfi.FIToFI.LineOne); err != nil {
-		return fieldError("LineOne", err, firfi.FIToFI.LineOne)
+		addFieldError(&errs, TagFIReceiverFI, fieldError("LineOne", err, firfi.FIToFI.LineOne))
 	}
 	if err := firfi.isAlphanumeric(firfi.FIToFI.LineTwo); err != nil {
-		return fieldError("LineTwo", err, firfi.FIToFI.LineTwo)
+		addFieldError(&errs, TagFIReceiverFI, fieldError("LineTwo", err, firfi.FIToFI.LineTwo))
 	}
 	if err := firfi.isAlphanumeric(firfi.FIToFI.LineThree); err != nil {
-		return fieldError("LineThree", err, firfi.FIToFI.LineThree)
+		addFieldError(&errs, TagFIReceiverFI, fieldError("LineThree", err, firfi.FIToFI.LineThree))
 	}
 	if err := firfi.isAlphanumeric(firfi.FIToFI.LineFour); err != nil {
-		return fieldError("LineFour", err, firfi.FIToFI.LineFour)
+		addFieldError(&errs, TagFIReceiverFI, fieldError("LineFour", err, firfi.FIToFI.LineFour))
 	}
 	if err := firfi.isAlphanumeric(firfi.FIToFI.LineFive); err != nil {
-		return fieldError("LineFive", err, firfi.FIToFI.LineFive)
+		addFieldError(&errs, TagFIReceiverFI, fieldError("LineFive", err, firfi.FIToFI.LineFive))
 	}
 	if err := firfi.isAlphanumeric(firfi.FIToFI.LineSix); err != nil {
-		return fieldError("LineSix", err, firfi.FIToFI.LineSix)
+		addFieldError(&errs, TagFIReceiverFI, fieldError("LineSix", err, firfi.FIToFI.LineSix))
 	}
-	return nil
+	return errs
 }
 
 // LineOneField gets a string of the LineOne field
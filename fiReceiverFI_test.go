@@ -94,7 +94,7 @@ func TestParseFIReceiverFIWrongLength(t *testing.T) {
 	r.line = line
 
 	err := r.parseFIReceiverFI()
-	require.EqualError(t, err, r.parseError(fieldError("LineOne", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "LineOne", ErrRequireDelimiter)).Error())
 }
 
 // TestParseFIReceiverFIReaderParseError parses a wrong FIReceiverFI reader parse error
@@ -110,7 +110,7 @@ func TestParseFIReceiverFIReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("LineOne", ErrNonAlphanumeric, "Line Si®")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("LineOne", ErrNonAlphanumeric, "Line Si®"))).Error()
 	require.EqualError(t, err, expected)
 }
 
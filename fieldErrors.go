@@ -7,6 +7,8 @@ package wire
 import (
 	"errors"
 	"fmt"
+
+	"github.com/moov-io/base"
 )
 
 var (
@@ -42,6 +44,17 @@ var (
 	ErrValidCentury = errors.New("is an invalid century")
 	// ErrValidDate is returned for an invalid date
 	ErrValidDate = errors.New("is an invalid date format")
+	// ErrNonBIC is returned for an identifier which is not an ISO 9362 SWIFT BIC
+	ErrNonBIC = errors.New("is not a valid BIC")
+	// ErrIBANCheckDigit is returned for an account identifier with the format of an IBAN whose check digits are
+	// invalid
+	ErrIBANCheckDigit = errors.New("has invalid IBAN check digits")
+	// ErrCHIPSParticipant is returned for a CHIPS participant identifier which is not 4 digits
+	ErrCHIPSParticipant = errors.New("is not a 4 digit CHIPS participant number")
+	// ErrCHIPSIdentifier is returned for a CHIPS identifier which is not a 6 digit universal identifier
+	ErrCHIPSIdentifier = errors.New("is not a 6 digit CHIPS universal identifier")
+	// ErrNonBusinessDay is returned for a cycle date which is not a Fedwire Funds Service business day
+	ErrNonBusinessDay = errors.New("is not a business day")
 	// ErrInvalidProperty is returned for an invalid type property
 	ErrInvalidProperty = errors.New("is an invalid property")
 
@@ -53,6 +66,8 @@ var (
 	ErrTestProductionCode = errors.New("is an invalid test production code")
 	// ErrMessageDuplicationCode is returned for an invalid MessageDuplicationCode
 	ErrMessageDuplicationCode = errors.New("is an invalid message duplication code")
+	// ErrErrorCategory is returned for an ErrorCategory which is not a Fedwire reject error category
+	ErrErrorCategory = errors.New("is an invalid error category")
 
 	// TypeSubType Tag {1510}
 
@@ -132,7 +147,9 @@ var (
 
 // FieldError is returned for errors at a field level in a tag
 type FieldError struct {
+	Tag       string      // tag of the field where error happened, such as {2000}
 	FieldName string      // field name where error happened
+	Offset    int         // byte offset of the field within its tag, when the error happened parsing the tag
 	Value     interface{} // value that cause error
 	Err       error       // context of the error.
 	Msg       string      // deprecated
@@ -170,6 +187,45 @@ func fieldError(field string, err error, values ...interface{}) error {
 	return &fe
 }
 
+// fieldErrorAt returns fieldError for the field found at offset bytes into its tag
+func fieldErrorAt(offset int, field string, err error, values ...interface{}) error {
+	err = fieldError(field, err, values...)
+	if fe, ok := err.(*FieldError); ok && fe.Offset == 0 {
+		fe.Offset = offset
+	}
+	return err
+}
+
+// addFieldError appends err to errs, recording tag on a *FieldError that does not have one.
+// Only the first error for each field of a tag is kept, and other errors are not repeated.
+func addFieldError(errs *base.ErrorList, tag string, err error) {
+	if err == nil {
+		return
+	}
+	var fe *FieldError
+	if errors.As(err, &fe) && fe.Tag == "" {
+		fe.Tag = tag
+	}
+	for _, e := range *errs {
+		var other *FieldError
+		if fe != nil && errors.As(e, &other) {
+			if other.Tag == fe.Tag && other.FieldName == fe.FieldName {
+				return
+			}
+		} else if e.Error() == err.Error() {
+			return
+		}
+	}
+	errs.Add(err)
+}
+
+// addFieldErrors appends each error in list to errs with addFieldError
+func addFieldErrors(errs *base.ErrorList, list base.ErrorList) {
+	for _, err := range list {
+		addFieldError(errs, "", err)
+	}
+}
+
 // ErrBusinessFunctionCodeProperty is the error given when the observed check digit does not match the calculated one
 type ErrBusinessFunctionCodeProperty struct {
 	Message              string
@@ -8,12 +8,23 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+
+	"github.com/moov-io/base"
 )
 
 // File contains the structures of a parsed WIRE File.
 type File struct {
-	ID             string         `json:"id"`
-	FEDWireMessage FEDWireMessage `json:"fedWireMessage"`
+	ID string `json:"id"`
+	// InterfaceHeader is the interface data read before the first FEDWireMessage
+	InterfaceHeader *InterfaceHeader `json:"interfaceHeader,omitempty"`
+	FEDWireMessages []FEDWireMessage `json:"fedWireMessages"`
+	// SourceFormat is how the File was encoded when it was read
+	SourceFormat *SourceFormat `json:"sourceFormat,omitempty"`
+
+	// headerSource is the InterfaceHeader as it was read
+	headerSource *sourceSegment
+	// validateOpts are applied to FEDWireMessages added to the File without their own ValidateOptions
+	validateOpts *ValidateOpts
 }
 
 // NewFile constructs a file template
@@ -27,26 +38,57 @@ func NewFile(opts ...FilePropertyFunc) *File {
 	return f
 }
 
-// SetValidation stores ValidateOpts on the FEDWireMessage's validation rules
+// UnmarshalJSON reads a File from JSON. Files encoded with a single "fedWireMessage" object are accepted
+// and read as the first of the File's FEDWireMessages.
+func (f *File) UnmarshalJSON(data []byte) error {
+	type Alias File
+	aux := struct {
+		*Alias
+		FEDWireMessage *FEDWireMessage `json:"fedWireMessage"`
+	}{
+		Alias: (*Alias)(f),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if aux.FEDWireMessage != nil {
+		f.FEDWireMessages = append([]FEDWireMessage{*aux.FEDWireMessage}, f.FEDWireMessages...)
+	}
+	return nil
+}
+
+// SetValidation stores ValidateOpts on each FEDWireMessage's validation rules
 func (f *File) SetValidation(opts *ValidateOpts) {
 	if f == nil || opts == nil {
 		return
 	}
-	f.FEDWireMessage.ValidateOptions = opts
+	f.validateOpts = opts
+	for i := range f.FEDWireMessages {
+		f.FEDWireMessages[i].ValidateOptions = opts
+	}
 }
 
-// GetValidation returns validation rules of FEDWireMessage
+// GetValidation returns validation rules of the File, or of its first FEDWireMessage when none were set
 func (f *File) GetValidation() *ValidateOpts {
-	if f == nil || f.FEDWireMessage.ValidateOptions == nil {
+	if f == nil {
 		return nil
 	}
-	return f.FEDWireMessage.ValidateOptions
+	if f.validateOpts != nil {
+		return f.validateOpts
+	}
+	if len(f.FEDWireMessages) == 0 {
+		return nil
+	}
+	return f.FEDWireMessages[0].ValidateOptions
 }
 
 // AddFEDWireMessage appends a FEDWireMessage to the File
 func (f *File) AddFEDWireMessage(fwm FEDWireMessage) FEDWireMessage {
-	f.FEDWireMessage = fwm
-	return f.FEDWireMessage
+	if fwm.ValidateOptions == nil && f.validateOpts != nil {
+		fwm.ValidateOptions = f.validateOpts
+	}
+	f.FEDWireMessages = append(f.FEDWireMessages, fwm)
+	return fwm
 }
 
 // Create will tabulate and assemble an WIRE file into a valid state.
@@ -58,11 +100,48 @@ func (f *File) Create() error {
 }
 
 // Validate will never modify the file.
+//
+// Each FEDWireMessage is validated on its own and every invalid message is reported
+// with its index in FEDWireMessages.
 func (f *File) Validate() error {
-	if err := f.FEDWireMessage.verify(); err != nil {
-		return err
+	if len(f.FEDWireMessages) == 0 {
+		return ErrFileNoFEDWireMessages
 	}
-	return nil
+	var errs base.ErrorList
+	if f.InterfaceHeader != nil {
+		if err := f.InterfaceHeader.Validate(); err != nil {
+			errs.Add(err)
+		}
+	}
+	for i := range f.FEDWireMessages {
+		if err := f.FEDWireMessages[i].verify(); err != nil {
+			errs.Add(NewFEDWireMessageError(i, err))
+		}
+	}
+	if errs.Empty() {
+		return nil
+	}
+	return errs
+}
+
+// ValidateAll will never modify the file.
+//
+// Every FEDWireMessage is validated with ValidateAll and all errors found are returned,
+// each with the index of its message in FEDWireMessages.
+func (f *File) ValidateAll() base.ErrorList {
+	if len(f.FEDWireMessages) == 0 {
+		return base.ErrorList{ErrFileNoFEDWireMessages}
+	}
+	var errs base.ErrorList
+	if f.InterfaceHeader != nil {
+		errs = append(errs, f.InterfaceHeader.ValidateAll()...)
+	}
+	for i := range f.FEDWireMessages {
+		for _, err := range f.FEDWireMessages[i].ValidateAll() {
+			errs.Add(NewFEDWireMessageError(i, err))
+		}
+	}
+	return errs
 }
 
 // FileFromJSON attempts to return a *File object assuming the input is valid JSON.
@@ -90,10 +169,7 @@ type FilePropertyFunc func(*File)
 func OutgoingFile() FilePropertyFunc {
 	return func(f *File) {
 		if f != nil {
-			if f.FEDWireMessage.ValidateOptions == nil {
-				f.FEDWireMessage.ValidateOptions = &ValidateOpts{}
-			}
-			f.FEDWireMessage.ValidateOptions.AllowMissingSenderSupplied = false
+			f.setAllowMissingSenderSupplied(false)
 		}
 	}
 }
@@ -102,10 +178,21 @@ func OutgoingFile() FilePropertyFunc {
 func IncomingFile() FilePropertyFunc {
 	return func(f *File) {
 		if f != nil {
-			if f.FEDWireMessage.ValidateOptions == nil {
-				f.FEDWireMessage.ValidateOptions = &ValidateOpts{}
-			}
-			f.FEDWireMessage.ValidateOptions.AllowMissingSenderSupplied = true
+			f.setAllowMissingSenderSupplied(true)
+		}
+	}
+}
+
+func (f *File) setAllowMissingSenderSupplied(allow bool) {
+	if f.validateOpts == nil {
+		f.validateOpts = &ValidateOpts{}
+	}
+	f.validateOpts.AllowMissingSenderSupplied = allow
+
+	for i := range f.FEDWireMessages {
+		if f.FEDWireMessages[i].ValidateOptions == nil {
+			f.FEDWireMessages[i].ValidateOptions = &ValidateOpts{}
 		}
+		f.FEDWireMessages[i].ValidateOptions.AllowMissingSenderSupplied = allow
 	}
 }
@@ -7,11 +7,17 @@ package wire
 import (
 	"errors"
 	"fmt"
+
+	"github.com/moov-io/base"
 )
 
 var (
 	// ErrFileTooLong is the error given when a file exceeds the maximum possible length
 	ErrFileTooLong = errors.New("file exceeds maximum possible number of lines")
+	// ErrFileNoFEDWireMessages is the error given when a file contains no FEDWireMessages
+	ErrFileNoFEDWireMessages = errors.New("file contains no FEDWireMessages")
+	// ErrInterfaceHeaderRepeated is the error given when a FEDWireMessage is preceded by more than one interface header
+	ErrInterfaceHeaderRepeated = errors.New("interface header is repeated")
 )
 
 // TagWrongLengthErr is the error given when a Tag is the wrong length
@@ -50,6 +56,43 @@ func (e TagWrongLengthErr) Error() string {
 	return e.Message
 }
 
+// ParseError is the error given when a file can't be read, with the position in the input of the
+// tag in error.
+type ParseError struct {
+	Line          int    // physical line of the input where the tag begins, starting at 1
+	Offset        int    // byte offset of the tag in the input
+	ElementOffset int    // byte offset of the element in error within the tag, or 0 for the tag as a whole
+	Record        string // name of the record type being parsed
+	Err           error  // the actual error
+}
+
+func (e *ParseError) Error() string {
+	if e.Record == "" {
+		return fmt.Sprintf("line:%d offset:%d %T %s", e.Line, e.Offset, e.Err, e.Err)
+	}
+	return fmt.Sprintf("line:%d offset:%d element:%d record:%s %T %s", e.Line, e.Offset, e.ElementOffset, e.Record, e.Err, e.Err)
+}
+
+// Unwrap returns the underlying error of the ParseError
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// As sets target to the base.ParseError the ParseError replaces, so callers matching it with errors.As
+// continue to find the line, record and error.
+func (e *ParseError) As(target interface{}) bool {
+	pe := base.ParseError{Line: e.Line, Record: e.Record, Err: e.Err}
+	switch t := target.(type) {
+	case **base.ParseError:
+		*t = &pe
+	case *base.ParseError:
+		*t = pe
+	default:
+		return false
+	}
+	return true
+}
+
 // ErrInvalidTag is the error given when a tag is invalid
 type ErrInvalidTag struct {
 	Message string
@@ -67,3 +110,66 @@ func NewErrInvalidTag(tag string) ErrInvalidTag {
 func (e ErrInvalidTag) Error() string {
 	return e.Message
 }
+
+// ErrDuplicateTag is the error given when a tag is repeated within a FEDWireMessage
+type ErrDuplicateTag struct {
+	Message string
+	Type    string
+}
+
+// NewErrDuplicateTag creates a new error of the ErrDuplicateTag type
+func NewErrDuplicateTag(tag string) ErrDuplicateTag {
+	return ErrDuplicateTag{
+		Message: fmt.Sprintf("%s is a duplicate tag", tag),
+		Type:    tag,
+	}
+}
+
+func (e ErrDuplicateTag) Error() string {
+	return e.Message
+}
+
+// ErrTagOutOfOrder is the error given when a tag is read after a tag which must follow it
+type ErrTagOutOfOrder struct {
+	Message  string
+	Type     string
+	Previous string
+}
+
+// NewErrTagOutOfOrder creates a new error of the ErrTagOutOfOrder type
+func NewErrTagOutOfOrder(tag, previous string) ErrTagOutOfOrder {
+	return ErrTagOutOfOrder{
+		Message:  fmt.Sprintf("%s is out of order after %s", tag, previous),
+		Type:     tag,
+		Previous: previous,
+	}
+}
+
+func (e ErrTagOutOfOrder) Error() string {
+	return e.Message
+}
+
+// FEDWireMessageError is the error given when a FEDWireMessage within a File is invalid
+type FEDWireMessageError struct {
+	Message string
+	Index   int
+	Err     error
+}
+
+// NewFEDWireMessageError creates a new error of the FEDWireMessageError type
+func NewFEDWireMessageError(index int, err error) FEDWireMessageError {
+	return FEDWireMessageError{
+		Message: fmt.Sprintf("FEDWireMessages[%d]: %v", index, err),
+		Index:   index,
+		Err:     err,
+	}
+}
+
+func (e FEDWireMessageError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the underlying error of the invalid FEDWireMessage
+func (e FEDWireMessageError) Unwrap() error {
+	return e.Err
+}
@@ -5,10 +5,12 @@
 package wire
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/moov-io/base"
 	"github.com/stretchr/testify/require"
 )
 
@@ -25,5 +27,64 @@ func TestFile__FileFromJSON(t *testing.T) {
 
 	require.NoError(t, err)
 	require.Empty(t, file.ID, "id should not have been set")
-	require.NotNil(t, file.FEDWireMessage.FIAdditionalFIToFI, "FIAdditionalFIToFI shouldn't be nil")
+	require.NotNil(t, file.FEDWireMessages[0].FIAdditionalFIToFI, "FIAdditionalFIToFI shouldn't be nil")
+}
+
+func TestFile__FileFromJSONLegacyFEDWireMessage(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.json"))
+	require.NoError(t, err)
+
+	file, err := FileFromJSON(bs)
+	require.NoError(t, err)
+	require.Len(t, file.FEDWireMessages, 1)
+
+	// files encoded before a File held multiple messages used the "fedWireMessage" key
+	legacy, err := json.Marshal(map[string]interface{}{
+		"id":             "legacy",
+		"fedWireMessage": file.FEDWireMessages[0],
+	})
+	require.NoError(t, err)
+
+	legacyFile, err := FileFromJSON(legacy)
+	require.NoError(t, err)
+	require.Equal(t, "legacy", legacyFile.ID)
+	require.Equal(t, file.FEDWireMessages, legacyFile.FEDWireMessages)
+	require.NoError(t, legacyFile.Validate())
+}
+
+func TestFile__AddFEDWireMessage(t *testing.T) {
+	file := NewFile(IncomingFile())
+	require.ErrorIs(t, file.Validate(), ErrFileNoFEDWireMessages)
+
+	file.AddFEDWireMessage(FEDWireMessage{})
+	file.AddFEDWireMessage(FEDWireMessage{ValidateOptions: &ValidateOpts{SkipMandatoryIMAD: true}})
+	require.Len(t, file.FEDWireMessages, 2)
+	require.True(t, file.FEDWireMessages[0].ValidateOptions.AllowMissingSenderSupplied)
+	require.False(t, file.FEDWireMessages[1].ValidateOptions.AllowMissingSenderSupplied)
+
+	err := file.Validate()
+	errs, ok := err.(base.ErrorList)
+	require.True(t, ok)
+	require.Len(t, errs, 2)
+	require.EqualError(t, errs[0], NewFEDWireMessageError(0, fieldError("TypeSubType", ErrFieldRequired)).Error())
+	require.EqualError(t, errs[1], NewFEDWireMessageError(1, fieldError("SenderSupplied", ErrFieldRequired)).Error())
+}
+
+func TestFile__ValidateAll(t *testing.T) {
+	file := NewFile(IncomingFile())
+	require.Equal(t, base.ErrorList{ErrFileNoFEDWireMessages}, file.ValidateAll())
+
+	file.AddFEDWireMessage(FEDWireMessage{})
+	file.AddFEDWireMessage(FEDWireMessage{ValidateOptions: &ValidateOpts{SkipMandatoryIMAD: true}})
+
+	// each message is missing six of its mandatory tags
+	errs := file.ValidateAll()
+	require.Len(t, errs, 12)
+	for i, err := range errs {
+		var msgErr FEDWireMessageError
+		require.ErrorAs(t, err, &msgErr)
+		require.Equal(t, i/6, msgErr.Index)
+		require.ErrorIs(t, err, ErrFieldRequired)
+	}
+	require.EqualError(t, errs[6], NewFEDWireMessageError(1, fieldError("SenderSupplied", ErrFieldRequired)).Error())
 }
@@ -8,6 +8,7 @@ require (
 	github.com/gorilla/mux v1.8.1
 	github.com/moov-io/base v0.48.3
 	github.com/prometheus/client_golang v1.17.0
+	github.com/rickar/cal/v2 v2.1.13
 	github.com/stretchr/testify v1.8.4
 	golang.org/x/oauth2 v0.15.0
 	golang.org/x/text v0.14.0
@@ -26,7 +27,6 @@ require (
 	github.com/prometheus/client_model v0.5.0 // indirect
 	github.com/prometheus/common v0.45.0 // indirect
 	github.com/prometheus/procfs v0.12.0 // indirect
-	github.com/rickar/cal/v2 v2.1.13 // indirect
 	golang.org/x/sys v0.15.0 // indirect
 	google.golang.org/appengine v1.6.8 // indirect
 	google.golang.org/protobuf v1.31.0 // indirect
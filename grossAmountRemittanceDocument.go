@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // GrossAmountRemittanceDocument is the gross amount remittance document
@@ -45,14 +47,14 @@ func (gard *GrossAmountRemittanceDocument) Parse(record string) error {
 
 	value, read, err := gard.parseFixedStringField(record[length:], 3)
 	if err != nil {
-		return fieldError("CurrencyCode", err)
+		return fieldErrorAt(length, "CurrencyCode", err)
 	}
 	gard.RemittanceAmount.CurrencyCode = value
 	length += read
 
 	value, read, err = gard.parseVariableStringField(record[length:], 19)
 	if err != nil {
-		return fieldError("Amount", err)
+		return fieldErrorAt(length, "Amount", err)
 	}
 	gard.RemittanceAmount.Amount = value
 	length += read
@@ -102,33 +104,37 @@ func (gard *GrossAmountRemittanceDocument) Format(options FormatOptions) string
 }
 
 // Validate performs WIRE format rule checks on GrossAmountRemittanceDocument and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (gard *GrossAmountRemittanceDocument) Validate() error {
-	if err := gard.fieldInclusion(); err != nil {
-		return err
-	}
+	return gard.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on GrossAmountRemittanceDocument and returns every error found.
+// Only the first error for each field is returned.
+func (gard *GrossAmountRemittanceDocument) ValidateAll() base.ErrorList {
+	errs := gard.fieldInclusion()
 	if gard.tag != TagGrossAmountRemittanceDocument {
-		return fieldError("tag", ErrValidTagForType, gard.tag)
+		addFieldError(&errs, TagGrossAmountRemittanceDocument, fieldError("tag", ErrValidTagForType, gard.tag))
 	}
 	if err := gard.isCurrencyCode(gard.RemittanceAmount.CurrencyCode); err != nil {
-		return fieldError("CurrencyCode", err, gard.RemittanceAmount.CurrencyCode)
+		addFieldError(&errs, TagGrossAmountRemittanceDocument, fieldError("CurrencyCode", err, gard.RemittanceAmount.CurrencyCode))
 	}
 	if err := gard.isAmount(gard.RemittanceAmount.Amount); err != nil {
-		return fieldError("Amount", err, gard.RemittanceAmount.Amount)
+		addFieldError(&errs, TagGrossAmountRemittanceDocument, fieldError("Amount", err, gard.RemittanceAmount.Amount))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (gard *GrossAmountRemittanceDocument) fieldInclusion() error {
+func (gard *GrossAmountRemittanceDocument) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if gard.RemittanceAmount.Amount == "" {
-		return fieldError("Amount", ErrFieldRequired)
+		addFieldError(&errs, TagGrossAmountRemittanceDocument, fieldError("Amount", ErrFieldRequired))
 	}
 	if gard.RemittanceAmount.CurrencyCode == "" {
-		return fieldError("CurrencyCode", ErrFieldRequired)
+		addFieldError(&errs, TagGrossAmountRemittanceDocument, fieldError("CurrencyCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // CurrencyCodeField gets a string of the CurrencyCode field
@@ -63,7 +63,7 @@ func TestParseGrossAmountRemittanceWrongLength(t *testing.T) {
 
 	err := r.parseGrossAmountRemittanceDocument()
 
-	require.EqualError(t, err, r.parseError(fieldError("Amount", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(9, "Amount", ErrRequireDelimiter)).Error())
 }
 
 // TestParseGrossAmountRemittanceReaderParseError parses a wrong GrossAmountRemittance reader parse error
@@ -79,7 +79,7 @@ func TestParseGrossAmountRemittanceReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	expected = r.parseError(fieldError("Amount", ErrNonAmount, "1234.56Z")).Error()
+	expected = NewFEDWireMessageError(0, r.parseError(fieldError("Amount", ErrNonAmount, "1234.56Z"))).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -0,0 +1,214 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/moov-io/wire/calendar"
+)
+
+var (
+	// ErrIMADSequenceExhausted is returned when every input sequence number of an input source's cycle date is
+	// allocated
+	ErrIMADSequenceExhausted = errors.New("has no input sequence numbers left for the cycle date")
+)
+
+// maxInputSequenceNumber is the largest six digit InputSequenceNumber
+const maxInputSequenceNumber = 999999
+
+// IMADStore holds the last InputSequenceNumber allocated by an IMADGenerator for each input source and cycle date
+type IMADStore interface {
+	// NextSequence allocates and returns the next InputSequenceNumber of the input source on the CCYYMMDD cycle
+	// date. Sequences start at 1 on each cycle date.
+	NextSequence(cycleDate, inputSource string) (int, error)
+}
+
+// IMADGenerator allocates unique InputMessageAccountabilityData for outgoing messages, with a separate sequence of
+// InputSequenceNumbers for each input source which starts again on each Fedwire Funds Service business day
+type IMADGenerator struct {
+	store IMADStore
+	// cycleDate returns the cycle date of a message sent at a time
+	cycleDate func(time.Time) time.Time
+	// now returns the current time
+	now func() time.Time
+}
+
+// NewIMADGenerator returns an IMADGenerator which keeps its sequences in store
+func NewIMADGenerator(store IMADStore) *IMADGenerator {
+	return &IMADGenerator{
+		store:     store,
+		cycleDate: cycleDateOf,
+		now:       time.Now,
+	}
+}
+
+// Next returns new InputMessageAccountabilityData of the input source for the current cycle date
+func (g *IMADGenerator) Next(inputSource string) (*InputMessageAccountabilityData, error) {
+	return g.NextAt(inputSource, g.now())
+}
+
+// NextAt returns new InputMessageAccountabilityData of the input source for the cycle date of a message sent at t
+func (g *IMADGenerator) NextAt(inputSource string, t time.Time) (*InputMessageAccountabilityData, error) {
+	imad := NewInputMessageAccountabilityData()
+	if inputSource == "" || utf8.RuneCountInString(inputSource) > 8 {
+		return nil, fieldError("InputSource", ErrValidLength, inputSource)
+	}
+	if err := imad.isAlphanumeric(inputSource); err != nil {
+		return nil, fieldError("InputSource", err, inputSource)
+	}
+	imad.SetCycleDate(g.cycleDate(t))
+	imad.InputSource = inputSource
+
+	sequence, err := g.store.NextSequence(imad.InputCycleDate, inputSource)
+	if err != nil {
+		return nil, err
+	}
+	imad.InputSequenceNumber = fmt.Sprintf("%06d", sequence)
+	return imad, nil
+}
+
+// cycleDateOf returns the cycle date of a message sent at t. Messages sent after the Fedwire Funds Service closes
+// are for the next business day.
+func cycleDateOf(t time.Time) time.Time {
+	return calendar.CycleDate(t, "")
+}
+
+// imadSequences are the last InputSequenceNumbers allocated, by input source and then CCYYMMDD cycle date
+type imadSequences map[string]map[string]int
+
+// next allocates the next InputSequenceNumber of the input source on the cycle date
+func (sequences imadSequences) next(cycleDate, inputSource string) (int, error) {
+	dates := sequences[inputSource]
+	if dates == nil {
+		dates = make(map[string]int)
+		sequences[inputSource] = dates
+	}
+	if dates[cycleDate] >= maxInputSequenceNumber {
+		return 0, fieldError("InputSequenceNumber", ErrIMADSequenceExhausted, inputSource)
+	}
+	dates[cycleDate]++
+	return dates[cycleDate], nil
+}
+
+// prune drops the sequences of all but the last two cycle dates of each input source. The cycle date before the
+// last is kept, as messages of the previous business day can still be allocated around the cycle change.
+func (sequences imadSequences) prune() {
+	for _, dates := range sequences {
+		if len(dates) <= 2 {
+			continue
+		}
+		cycleDates := make([]string, 0, len(dates))
+		for date := range dates {
+			cycleDates = append(cycleDates, date)
+		}
+		sort.Strings(cycleDates)
+		for _, date := range cycleDates[:len(cycleDates)-2] {
+			delete(dates, date)
+		}
+	}
+}
+
+// MemoryIMADStore is an IMADStore which keeps its sequences in memory, so they start again when it is recreated
+type MemoryIMADStore struct {
+	mu        sync.Mutex
+	sequences imadSequences
+}
+
+// NewMemoryIMADStore returns a new MemoryIMADStore
+func NewMemoryIMADStore() *MemoryIMADStore {
+	return &MemoryIMADStore{
+		sequences: make(imadSequences),
+	}
+}
+
+// NextSequence allocates and returns the next InputSequenceNumber of the input source on the cycle date
+func (s *MemoryIMADStore) NextSequence(cycleDate, inputSource string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.sequences.next(cycleDate, inputSource)
+}
+
+// FileIMADStore is an IMADStore which keeps its sequences in a JSON file, so they continue when the process is
+// restarted. The file is read before and replaced after each allocation, so it is safe for one process at a time.
+type FileIMADStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileIMADStore returns a FileIMADStore which keeps its sequences in the file at path, which is created on the
+// first allocation
+func NewFileIMADStore(path string) *FileIMADStore {
+	return &FileIMADStore{
+		path: path,
+	}
+}
+
+// NextSequence allocates and returns the next InputSequenceNumber of the input source on the cycle date, and
+// saves it before returning. Only the last two cycle dates of each input source are kept in the file.
+func (s *FileIMADStore) NextSequence(cycleDate, inputSource string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sequences := make(imadSequences)
+	bs, err := os.ReadFile(s.path)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(bs, &sequences); err != nil {
+			return 0, fmt.Errorf("reading IMAD sequences from %s: %w", s.path, err)
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return 0, fmt.Errorf("reading IMAD sequences: %w", err)
+	}
+
+	sequence, err := sequences.next(cycleDate, inputSource)
+	if err != nil {
+		return 0, err
+	}
+	sequences.prune()
+	if err := s.save(sequences); err != nil {
+		return 0, err
+	}
+	return sequence, nil
+}
+
+// save replaces the file with sequences, writing them to a temporary file first so the file is never left
+// partially written
+func (s *FileIMADStore) save(sequences imadSequences) error {
+	bs, err := json.MarshalIndent(sequences, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("saving IMAD sequences: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(bs); err != nil {
+		tmp.Close()
+		return fmt.Errorf("saving IMAD sequences: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("saving IMAD sequences: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("saving IMAD sequences: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("saving IMAD sequences: %w", err)
+	}
+	return nil
+}
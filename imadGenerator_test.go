@@ -0,0 +1,180 @@
+package wire
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIMADGenerator_Next(t *testing.T) {
+	g := NewIMADGenerator(NewMemoryIMADStore())
+	// Wednesday, April 10 2019 at 10:00 a.m. Eastern time
+	now := time.Date(2019, time.April, 10, 14, 0, 0, 0, time.UTC)
+	g.now = func() time.Time { return now }
+
+	imad, err := g.Next("Source08")
+	require.NoError(t, err)
+	require.Equal(t, "20190410", imad.InputCycleDate)
+	require.Equal(t, "Source08", imad.InputSource)
+	require.Equal(t, "000001", imad.InputSequenceNumber)
+	require.NoError(t, imad.Validate())
+
+	imad, err = g.Next("Source08")
+	require.NoError(t, err)
+	require.Equal(t, "000002", imad.InputSequenceNumber)
+
+	// each input source has its own sequence
+	imad, err = g.Next("Source09")
+	require.NoError(t, err)
+	require.Equal(t, "000001", imad.InputSequenceNumber)
+
+	// the business day changes at 9:00 p.m. Eastern time
+	now = time.Date(2019, time.April, 11, 1, 0, 0, 0, time.UTC)
+	imad, err = g.Next("Source08")
+	require.NoError(t, err)
+	require.Equal(t, "20190411", imad.InputCycleDate)
+	require.Equal(t, "000001", imad.InputSequenceNumber)
+
+	// an earlier cycle date continues its own sequence
+	imad, err = g.NextAt("Source08", time.Date(2019, time.April, 10, 12, 0, 0, 0, EasternTime))
+	require.NoError(t, err)
+	require.Equal(t, "20190410", imad.InputCycleDate)
+	require.Equal(t, "000003", imad.InputSequenceNumber)
+
+	_, err = g.Next("")
+	require.ErrorIs(t, err, ErrValidLength)
+	_, err = g.Next("Source080")
+	require.ErrorIs(t, err, ErrValidLength)
+	_, err = g.Next("Source{}")
+	require.ErrorIs(t, err, ErrNonAlphanumeric)
+}
+
+func TestIMADGenerator_Concurrent(t *testing.T) {
+	g := NewIMADGenerator(NewMemoryIMADStore())
+
+	var mu sync.Mutex
+	seen := make(map[string]bool)
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			imad, err := g.Next("Source08")
+			require.NoError(t, err)
+
+			mu.Lock()
+			defer mu.Unlock()
+			seen[imad.InputCycleDate+imad.InputSequenceNumber] = true
+		}()
+	}
+	wg.Wait()
+	require.Len(t, seen, 50)
+}
+
+func TestCycleDateOf(t *testing.T) {
+	tests := []struct {
+		sent time.Time
+		want string
+	}{
+		{time.Date(2019, time.April, 10, 19, 0, 0, 0, EasternTime), "20190410"},
+		// after the Funds Service closes at 7:00 p.m. is the next business day
+		{time.Date(2019, time.April, 10, 19, 1, 0, 0, EasternTime), "20190411"},
+		{time.Date(2019, time.April, 10, 21, 0, 0, 0, EasternTime), "20190411"},
+		// Friday evening and the weekend are the following Monday
+		{time.Date(2019, time.April, 12, 21, 30, 0, 0, EasternTime), "20190415"},
+		{time.Date(2019, time.April, 13, 12, 0, 0, 0, EasternTime), "20190415"},
+		{time.Date(2019, time.April, 14, 22, 0, 0, 0, EasternTime), "20190415"},
+		// Independence Day is not a business day
+		{time.Date(2019, time.July, 3, 21, 0, 0, 0, EasternTime), "20190705"},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, formatDate(cycleDateOf(tt.sent)), tt.sent.String())
+	}
+}
+
+func TestMemoryIMADStore(t *testing.T) {
+	s := NewMemoryIMADStore()
+	s.sequences["Source08"] = map[string]int{"20190410": maxInputSequenceNumber - 1}
+
+	sequence, err := s.NextSequence("20190410", "Source08")
+	require.NoError(t, err)
+	require.Equal(t, maxInputSequenceNumber, sequence)
+
+	_, err = s.NextSequence("20190410", "Source08")
+	require.ErrorIs(t, err, ErrIMADSequenceExhausted)
+
+	sequence, err = s.NextSequence("20190411", "Source08")
+	require.NoError(t, err)
+	require.Equal(t, 1, sequence)
+}
+
+func TestMemoryIMADStore_cycleDates(t *testing.T) {
+	s := NewMemoryIMADStore()
+
+	// alternating cycle dates each continue their own sequence
+	for want := 1; want <= 3; want++ {
+		for _, cycleDate := range []string{"20190410", "20190411"} {
+			sequence, err := s.NextSequence(cycleDate, "Source08")
+			require.NoError(t, err)
+			require.Equal(t, want, sequence, cycleDate)
+		}
+	}
+
+	sequence, err := s.NextSequence("20190410", "Source09")
+	require.NoError(t, err)
+	require.Equal(t, 1, sequence)
+}
+
+func TestFileIMADStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "imad.json")
+
+	s := NewFileIMADStore(path)
+	for want := 1; want <= 3; want++ {
+		sequence, err := s.NextSequence("20190410", "Source08")
+		require.NoError(t, err)
+		require.Equal(t, want, sequence)
+	}
+
+	// the sequences continue in a new store of the file
+	s = NewFileIMADStore(path)
+	sequence, err := s.NextSequence("20190410", "Source08")
+	require.NoError(t, err)
+	require.Equal(t, 4, sequence)
+
+	sequence, err = s.NextSequence("20190411", "Source08")
+	require.NoError(t, err)
+	require.Equal(t, 1, sequence)
+
+	// each cycle date keeps its sequence in the file
+	s = NewFileIMADStore(path)
+	sequence, err = s.NextSequence("20190410", "Source08")
+	require.NoError(t, err)
+	require.Equal(t, 5, sequence)
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	// cycle dates before the last two are dropped
+	sequence, err = s.NextSequence("20190412", "Source08")
+	require.NoError(t, err)
+	require.Equal(t, 1, sequence)
+	bs, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var sequences imadSequences
+	require.NoError(t, json.Unmarshal(bs, &sequences))
+	require.Equal(t, imadSequences{"Source08": {"20190411": 1, "20190412": 1}}, sequences)
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0600))
+	_, err = s.NextSequence("20190411", "Source08")
+	require.Error(t, err)
+
+	s = NewFileIMADStore(filepath.Join(t.TempDir(), "missing", "imad.json"))
+	_, err = s.NextSequence("20190411", "Source08")
+	require.Error(t, err)
+}
@@ -7,7 +7,10 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"time"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // InputMessageAccountabilityData (IMAD) {1520}
@@ -76,39 +79,43 @@ func (imad *InputMessageAccountabilityData) String() string {
 }
 
 // Validate performs WIRE format rule checks on InputMessageAccountabilityData and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (imad *InputMessageAccountabilityData) Validate() error {
-	if err := imad.fieldInclusion(); err != nil {
-		return err
-	}
+	return imad.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on InputMessageAccountabilityData and returns every error found.
+// Only the first error for each field is returned.
+func (imad *InputMessageAccountabilityData) ValidateAll() base.ErrorList {
+	errs := imad.fieldInclusion()
 	if imad.tag != TagInputMessageAccountabilityData {
-		return fieldError("tag", ErrValidTagForType, imad.tag)
+		addFieldError(&errs, TagInputMessageAccountabilityData, fieldError("tag", ErrValidTagForType, imad.tag))
 	}
 	if err := imad.validateDate(imad.InputCycleDate); err != nil {
-		return fieldError("InputCycleDate", err, imad.InputCycleDate)
+		addFieldError(&errs, TagInputMessageAccountabilityData, fieldError("InputCycleDate", err, imad.InputCycleDate))
 	}
 	if err := imad.isAlphanumeric(imad.InputSource); err != nil {
-		return fieldError("InputSource", err, imad.InputSource)
+		addFieldError(&errs, TagInputMessageAccountabilityData, fieldError("InputSource", err, imad.InputSource))
 	}
 	if err := imad.isNumeric(imad.InputSequenceNumber); err != nil {
-		return fieldError("InputSequenceNumber", err, imad.InputSequenceNumber)
+		addFieldError(&errs, TagInputMessageAccountabilityData, fieldError("InputSequenceNumber", err, imad.InputSequenceNumber))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (imad *InputMessageAccountabilityData) fieldInclusion() error {
+func (imad *InputMessageAccountabilityData) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if imad.InputCycleDate == "" {
-		return fieldError("InputCycleDate", ErrFieldRequired, imad.InputCycleDate)
+		addFieldError(&errs, TagInputMessageAccountabilityData, fieldError("InputCycleDate", ErrFieldRequired, imad.InputCycleDate))
 	}
 	if imad.InputSource == "" {
-		return fieldError("InputSource", ErrFieldRequired, imad.InputSource)
+		addFieldError(&errs, TagInputMessageAccountabilityData, fieldError("InputSource", ErrFieldRequired, imad.InputSource))
 	}
 	if imad.InputSequenceNumber == "" {
-		return fieldError("InputSequenceNumber", ErrFieldRequired, imad.InputSequenceNumber)
+		addFieldError(&errs, TagInputMessageAccountabilityData, fieldError("InputSequenceNumber", ErrFieldRequired, imad.InputSequenceNumber))
 	}
-	return nil
+	return errs
 }
 
 // InputCycleDateField gets a string of the InputCycleDate field
@@ -125,3 +132,13 @@ func (imad *InputMessageAccountabilityData) InputSourceField() string {
 func (imad *InputMessageAccountabilityData) InputSequenceNumberField() string {
 	return imad.alphaField(imad.InputSequenceNumber, 6)
 }
+
+// CycleDate returns the InputCycleDate at midnight Eastern time
+func (imad *InputMessageAccountabilityData) CycleDate() (time.Time, error) {
+	return parseDate("InputCycleDate", imad.InputCycleDate)
+}
+
+// SetCycleDate sets the InputCycleDate to the date of t in its location
+func (imad *InputMessageAccountabilityData) SetCycleDate(t time.Time) {
+	imad.InputCycleDate = formatDate(t)
+}
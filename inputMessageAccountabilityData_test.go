@@ -90,7 +90,7 @@ func TestParseInputMessageAccountabilityDataReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("InputSequenceNumber", ErrNonNumeric, "00000Z")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("InputSequenceNumber", ErrNonNumeric, "00000Z"))).Error())
 }
 
 // TestInputMessageAccountabilityDataTagError validates a InputMessageAccountabilityData tag
@@ -108,3 +108,17 @@ func TestInputMessageAccountabilityDataInputCycleDateError(t *testing.T) {
 
 	require.EqualError(t, imad.Validate(), fieldError("InputCycleDate", ErrValidDate, imad.InputCycleDate).Error())
 }
+
+func TestInputMessageAccountabilityData_CycleDate(t *testing.T) {
+	imad := NewInputMessageAccountabilityData()
+	imad.SetCycleDate(time.Date(2019, time.April, 10, 22, 0, 0, 0, time.UTC))
+	require.Equal(t, "20190410", imad.InputCycleDate)
+
+	date, err := imad.CycleDate()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.April, 10, 0, 0, 0, 0, EasternTime), date)
+
+	imad.InputCycleDate = "20191310"
+	_, err = imad.CycleDate()
+	require.ErrorIs(t, err, ErrValidDate)
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // InstitutionAccount is the institution account
@@ -45,42 +47,42 @@ func (iAccount *InstitutionAccount) Parse(record string) error {
 
 	value, read, err := iAccount.parseVariableStringField(record[length:], 5)
 	if err != nil {
-		return fieldError("SwiftFieldTag", err)
+		return fieldErrorAt(length, "SwiftFieldTag", err)
 	}
 	iAccount.CoverPayment.SwiftFieldTag = value
 	length += read
 
 	value, read, err = iAccount.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineOne", err)
+		return fieldErrorAt(length, "SwiftLineOne", err)
 	}
 	iAccount.CoverPayment.SwiftLineOne = value
 	length += read
 
 	value, read, err = iAccount.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineTwo", err)
+		return fieldErrorAt(length, "SwiftLineTwo", err)
 	}
 	iAccount.CoverPayment.SwiftLineTwo = value
 	length += read
 
 	value, read, err = iAccount.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineThree", err)
+		return fieldErrorAt(length, "SwiftLineThree", err)
 	}
 	iAccount.CoverPayment.SwiftLineThree = value
 	length += read
 
 	value, read, err = iAccount.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineFour", err)
+		return fieldErrorAt(length, "SwiftLineFour", err)
 	}
 	iAccount.CoverPayment.SwiftLineFour = value
 	length += read
 
 	value, read, err = iAccount.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineFive", err)
+		return fieldErrorAt(length, "SwiftLineFive", err)
 	}
 	iAccount.CoverPayment.SwiftLineFive = value
 	length += read
@@ -134,42 +136,49 @@ func (iAccount *InstitutionAccount) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on InstitutionAccount and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (iAccount *InstitutionAccount) Validate() error {
-	if err := iAccount.fieldInclusion(); err != nil {
-		return err
-	}
+	return iAccount.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on InstitutionAccount and returns every error found.
+// Only the first error for each field is returned.
+func (iAccount *InstitutionAccount) ValidateAll() base.ErrorList {
+	errs := iAccount.fieldInclusion()
 	if iAccount.tag != TagInstitutionAccount {
-		return fieldError("tag", ErrValidTagForType, iAccount.tag)
+		addFieldError(&errs, TagInstitutionAccount, fieldError("tag", ErrValidTagForType, iAccount.tag))
 	}
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, iAccount.CoverPayment.SwiftFieldTag)
+		addFieldError(&errs, TagInstitutionAccount, fieldError("SwiftFieldTag", err, iAccount.CoverPayment.SwiftFieldTag))
 	}
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, iAccount.CoverPayment.SwiftLineOne)
+		addFieldError(&errs, TagInstitutionAccount, fieldError("SwiftLineOne", err, iAccount.CoverPayment.SwiftLineOne))
 	}
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, iAccount.CoverPayment.SwiftLineTwo)
+		addFieldError(&errs, TagInstitutionAccount, fieldError("SwiftLineTwo", err, iAccount.CoverPayment.SwiftLineTwo))
 	}
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, iAccount.CoverPayment.SwiftLineThree)
+		addFieldError(&errs, TagInstitutionAccount, fieldError("SwiftLineThree", err, iAccount.CoverPayment.SwiftLineThree))
 	}
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, iAccount.CoverPayment.SwiftLineFour)
+		addFieldError(&errs, TagInstitutionAccount, fieldError("SwiftLineFour", err, iAccount.CoverPayment.SwiftLineFour))
 	}
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineFive); err != nil {
-		return fieldError("SwiftLineFive", err, iAccount.CoverPayment.SwiftLineFive)
+		addFieldError(&errs, TagInstitutionAccount, fieldError("SwiftLineFive", err, iAccount.CoverPayment.SwiftLineFive))
 	}
-	return nil
+	if i, err := iAccount.validateCoverPaymentParty(iAccount.CoverPayment); err != nil {
+		addFieldError(&errs, TagInstitutionAccount, fieldError(swiftLineNames[i], err, iAccount.CoverPayment.lines()[i]))
+	}
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (iAccount *InstitutionAccount) fieldInclusion() error {
+func (iAccount *InstitutionAccount) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if iAccount.CoverPayment.SwiftLineSix != "" {
-		return fieldError("SwiftLineSix", ErrInvalidProperty, iAccount.CoverPayment.SwiftLineSix)
+		addFieldError(&errs, TagInstitutionAccount, fieldError("SwiftLineSix", ErrInvalidProperty, iAccount.CoverPayment.SwiftLineSix))
 	}
-	return nil
+	return errs
 }
 
 // SwiftFieldTagField gets a string of the SwiftFieldTag field
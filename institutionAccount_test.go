@@ -105,7 +105,7 @@ func TestParseInstitutionAccountWrongLength(t *testing.T) {
 
 	err := r.parseInstitutionAccount()
 
-	require.EqualError(t, err, r.parseError(fieldError("SwiftFieldTag", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "SwiftFieldTag", ErrRequireDelimiter)).Error())
 }
 
 // TestParseInstitutionAccountReaderParseError parses a wrong InstitutionAccount reader parse error
@@ -120,7 +120,7 @@ func TestParseInstitutionAccountReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("SwiftLineOne", ErrNonAlphanumeric, "Swift ®ine One")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("SwiftLineOne", ErrNonAlphanumeric, "Swift ®ine One"))).Error())
 }
 
 // TestInstitutionAccountTagError validates a InstitutionAccount tag
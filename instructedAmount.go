@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // InstructedAmount is the InstructedAmount of the wire
@@ -48,14 +50,14 @@ func (ia *InstructedAmount) Parse(record string) error {
 
 	value, read, err := ia.parseFixedStringField(record[length:], 3)
 	if err != nil {
-		return fieldError("SwiftFieldTag", err)
+		return fieldErrorAt(length, "SwiftFieldTag", err)
 	}
 	ia.CurrencyCode = value
 	length += read
 
 	value, read, err = ia.parseVariableStringField(record[length:], 15)
 	if err != nil {
-		return fieldError("Amount", err)
+		return fieldErrorAt(length, "Amount", err)
 	}
 	ia.Amount = value
 	length += read
@@ -101,34 +103,38 @@ func (ia *InstructedAmount) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on InstructedAmount and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (ia *InstructedAmount) Validate() error {
-	if err := ia.fieldInclusion(); err != nil {
-		return err
-	}
+	return ia.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on InstructedAmount and returns every error found.
+// Only the first error for each field is returned.
+func (ia *InstructedAmount) ValidateAll() base.ErrorList {
+	errs := ia.fieldInclusion()
 	if ia.tag != TagInstructedAmount {
-		return fieldError("tag", ErrValidTagForType, ia.tag)
+		addFieldError(&errs, TagInstructedAmount, fieldError("tag", ErrValidTagForType, ia.tag))
 	}
 	if err := ia.isCurrencyCode(ia.CurrencyCode); err != nil {
-		return fieldError("CurrencyCode", err, ia.CurrencyCode)
+		addFieldError(&errs, TagInstructedAmount, fieldError("CurrencyCode", err, ia.CurrencyCode))
 	}
 	if err := ia.isAmount(ia.Amount); err != nil {
-		return fieldError("Amount", err, ia.Amount)
+		addFieldError(&errs, TagInstructedAmount, fieldError("Amount", err, ia.Amount))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (ia *InstructedAmount) fieldInclusion() error {
+func (ia *InstructedAmount) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if ia.Amount == "" {
-		return fieldError("Amount", ErrFieldRequired)
+		addFieldError(&errs, TagInstructedAmount, fieldError("Amount", ErrFieldRequired))
 	}
 	if ia.CurrencyCode == "" {
-		return fieldError("CurrencyCode", ErrFieldRequired)
+		addFieldError(&errs, TagInstructedAmount, fieldError("CurrencyCode", ErrFieldRequired))
 
 	}
-	return nil
+	return errs
 }
 
 // CurrencyCodeField gets a string of the CurrencyCode field
@@ -150,3 +156,23 @@ func (ia *InstructedAmount) FormatCurrencyCode(options FormatOptions) string {
 func (ia *InstructedAmount) FormatAmount(options FormatOptions) string {
 	return ia.formatAlphaField(ia.Amount, 15, options)
 }
+
+// Money returns the CurrencyCode and Amount as Money
+func (ia *InstructedAmount) Money() (Money, error) {
+	return ParseMoney(ia.CurrencyCode, ia.Amount)
+}
+
+// SetMoney sets the CurrencyCode and Amount to m, writing the Amount with a decimal comma
+func (ia *InstructedAmount) SetMoney(m Money) error {
+	m, err := NewMoney(m.CurrencyCode, m.MinorUnits)
+	if err != nil {
+		return err
+	}
+	amount, err := formatMoney(m, ",", 15)
+	if err != nil {
+		return err
+	}
+	ia.CurrencyCode = m.CurrencyCode
+	ia.Amount = amount
+	return nil
+}
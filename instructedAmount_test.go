@@ -71,7 +71,7 @@ func TestParseInstructedAmountWrongLength(t *testing.T) {
 
 	err := r.parseInstructedAmount()
 
-	require.EqualError(t, err, r.parseError(fieldError("Amount", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(9, "Amount", ErrRequireDelimiter)).Error())
 }
 
 // TestParseInstructedAmountReaderParseError parses a wrong InstructedAmount reader parse error
@@ -86,7 +86,7 @@ func TestParseInstructedAmountReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("Amount", ErrNonAmount, "000000004567Z89")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("Amount", ErrNonAmount, "000000004567Z89"))).Error())
 }
 
 // TestInstructedAmountTagError validates a InstructedAmount tag
@@ -142,3 +142,21 @@ func TestStringInstructedAmountOptions(t *testing.T) {
 	require.Equal(t, record.Format(FormatOptions{VariableLengthFields: true}), "{3710}USD4567,89*")
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
+
+func TestInstructedAmount_Money(t *testing.T) {
+	m, err := mockInstructedAmount().Money()
+	require.NoError(t, err)
+	require.Equal(t, Money{CurrencyCode: "USD", MinorUnits: 456789}, m)
+
+	ia := NewInstructedAmount()
+	require.NoError(t, ia.SetMoney(Money{CurrencyCode: "JPY", MinorUnits: 4567}))
+	require.Equal(t, "JPY", ia.CurrencyCode)
+	require.Equal(t, "4567,", ia.Amount)
+	require.NoError(t, ia.Validate())
+
+	require.NoError(t, ia.SetMoney(Money{CurrencyCode: "USD", MinorUnits: 99}))
+	require.Equal(t, "0,99", ia.Amount)
+
+	require.ErrorIs(t, ia.SetMoney(Money{CurrencyCode: "USD", MinorUnits: 100000000000000}), ErrAmountRange)
+	require.ErrorIs(t, ia.SetMoney(Money{CurrencyCode: "ZZZ", MinorUnits: 1}), ErrNonCurrencyCode)
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // InstructingFI is the instructing financial institution
@@ -46,35 +48,35 @@ func (ifi *InstructingFI) Parse(record string) error {
 
 	value, read, err := ifi.parseVariableStringField(record[length:], 34)
 	if err != nil {
-		return fieldError("Identifier", err)
+		return fieldErrorAt(length, "Identifier", err)
 	}
 	ifi.FinancialInstitution.Identifier = value
 	length += read
 
 	value, read, err = ifi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("Name", err)
+		return fieldErrorAt(length, "Name", err)
 	}
 	ifi.FinancialInstitution.Name = value
 	length += read
 
 	value, read, err = ifi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineOne", err)
+		return fieldErrorAt(length, "AddressLineOne", err)
 	}
 	ifi.FinancialInstitution.Address.AddressLineOne = value
 	length += read
 
 	value, read, err = ifi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineTwo", err)
+		return fieldErrorAt(length, "AddressLineTwo", err)
 	}
 	ifi.FinancialInstitution.Address.AddressLineTwo = value
 	length += read
 
 	value, read, err = ifi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineThree", err)
+		return fieldErrorAt(length, "AddressLineThree", err)
 	}
 	ifi.FinancialInstitution.Address.AddressLineThree = value
 	length += read
@@ -128,53 +130,63 @@ func (ifi *InstructingFI) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on InstructingFI and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 // If ID Code is present, Identifier is mandatory and vice versa.
 func (ifi *InstructingFI) Validate() error {
-	if err := ifi.fieldInclusion(); err != nil {
-		return err
-	}
+	return ifi.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on InstructingFI and returns every error found.
+// Only the first error for each field is returned.
+func (ifi *InstructingFI) ValidateAll() base.ErrorList {
+	errs := ifi.fieldInclusion()
 	if ifi.tag != TagInstructingFI {
-		return fieldError("tag", ErrValidTagForType, ifi.tag)
+		addFieldError(&errs, TagInstructingFI, fieldError("tag", ErrValidTagForType, ifi.tag))
 	}
 	if err := ifi.isIdentificationCode(ifi.FinancialInstitution.IdentificationCode); err != nil {
-		return fieldError("IdentificationCode", err, ifi.FinancialInstitution.IdentificationCode)
+		addFieldError(&errs, TagInstructingFI, fieldError("IdentificationCode", err, ifi.FinancialInstitution.IdentificationCode))
 	}
 	// Can only be these Identification Codes
 	switch ifi.FinancialInstitution.IdentificationCode {
 	case
 		"B", "C", "D", "F", "U":
 	default:
-		return fieldError("IdentificationCode", ErrIdentificationCode, ifi.FinancialInstitution.IdentificationCode)
+		addFieldError(&errs, TagInstructingFI, fieldError("IdentificationCode", ErrIdentificationCode, ifi.FinancialInstitution.IdentificationCode))
 	}
 	if err := ifi.isAlphanumeric(ifi.FinancialInstitution.Identifier); err != nil {
-		return fieldError("Identifier", err, ifi.FinancialInstitution.Identifier)
+		addFieldError(&errs, TagInstructingFI, fieldError("Identifier", err, ifi.FinancialInstitution.Identifier))
+	}
+	if err := ifi.validateIdentifier(ifi.FinancialInstitution.IdentificationCode, ifi.FinancialInstitution.Identifier); err != nil {
+		addFieldError(&errs, TagInstructingFI, fieldError("Identifier", err, ifi.FinancialInstitution.Identifier))
 	}
 	if err := ifi.isAlphanumeric(ifi.FinancialInstitution.Name); err != nil {
-		return fieldError("Name", err, ifi.FinancialInstitution.Name)
+		addFieldError(&errs, TagInstructingFI, fieldError("Name", err, ifi.FinancialInstitution.Name))
+	}
+	if err := ifi.validateIdentifierName(ifi.FinancialInstitution.IdentificationCode, ifi.FinancialInstitution.Name); err != nil {
+		addFieldError(&errs, TagInstructingFI, fieldError("Name", err, ifi.FinancialInstitution.Name))
 	}
 	if err := ifi.isAlphanumeric(ifi.FinancialInstitution.Address.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, ifi.FinancialInstitution.Address.AddressLineOne)
+		addFieldError(&errs, TagInstructingFI, fieldError("AddressLineOne", err, ifi.FinancialInstitution.Address.AddressLineOne))
 	}
 	if err := ifi.isAlphanumeric(ifi.FinancialInstitution.Address.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, ifi.FinancialInstitution.Address.AddressLineTwo)
+		addFieldError(&errs, TagInstructingFI, fieldError("AddressLineTwo", err, ifi.FinancialInstitution.Address.AddressLineTwo))
 	}
 	if err := ifi.isAlphanumeric(ifi.FinancialInstitution.Address.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, ifi.FinancialInstitution.Address.AddressLineThree)
+		addFieldError(&errs, TagInstructingFI, fieldError("AddressLineThree", err, ifi.FinancialInstitution.Address.AddressLineThree))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (ifi *InstructingFI) fieldInclusion() error {
+func (ifi *InstructingFI) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if ifi.FinancialInstitution.IdentificationCode != "" && ifi.FinancialInstitution.Identifier == "" {
-		return fieldError("Identifier", ErrFieldRequired)
+		addFieldError(&errs, TagInstructingFI, fieldError("Identifier", ErrFieldRequired))
 	}
 	if ifi.FinancialInstitution.IdentificationCode == "" && ifi.FinancialInstitution.Identifier != "" {
-		return fieldError("IdentificationCode", ErrFieldRequired)
+		addFieldError(&errs, TagInstructingFI, fieldError("IdentificationCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // IdentificationCodeField gets a string of the IdentificationCode field
@@ -125,7 +125,7 @@ func TestParseInstructingFIWrongLength(t *testing.T) {
 
 	err := r.parseInstructingFI()
 
-	require.EqualError(t, err, r.parseError(fieldError("Identifier", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(7, "Identifier", ErrRequireDelimiter)).Error())
 }
 
 // TestParseInstructingFIReaderParseError parses a wrong InstructingFI reader parse error
@@ -140,7 +140,7 @@ func TestParseInstructingFIReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("Name", ErrNonAlphanumeric, "®I Name")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("Name", ErrNonAlphanumeric, "®I Name"))).Error())
 }
 
 // TestInstructingFITagError validates a InstructingFI tag
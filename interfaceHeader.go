@@ -0,0 +1,179 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/moov-io/base"
+)
+
+const (
+	// InterfaceHeaderFAIM is the FAIM interface data layout, such as "FTI0811 XFT811  ", of a 3 character
+	// ApplicationID, a 4 digit SequenceNumber, a space and an 8 character TerminalID
+	InterfaceHeaderFAIM = "FAIM"
+	// InterfaceHeaderVendor is a vendor layout of Fields separated by Delimiter
+	InterfaceHeaderVendor = "VENDOR"
+	// InterfaceHeaderRaw is a header of an unknown layout, which is kept as read in Data
+	InterfaceHeaderRaw = "RAW"
+)
+
+var (
+	faimInterfaceHeaderRegex = regexp.MustCompile(`^[A-Z]{3}[0-9]{4} [A-Z0-9]{1,8} *$`)
+)
+
+// InterfaceHeader is the interface data read ahead of the tags of a message, such as the routing and
+// control data added by FedLine or a vendor's interface.
+type InterfaceHeader struct {
+	// Layout is InterfaceHeaderFAIM, InterfaceHeaderVendor or InterfaceHeaderRaw
+	Layout string `json:"layout"`
+	// ApplicationID of the FAIM layout, such as FTI
+	ApplicationID string `json:"applicationID,omitempty"`
+	// SequenceNumber of the FAIM layout
+	SequenceNumber string `json:"sequenceNumber,omitempty"`
+	// TerminalID of the FAIM layout
+	TerminalID string `json:"terminalID,omitempty"`
+	// Fields of the vendor layout
+	Fields []string `json:"fields,omitempty"`
+	// Data of the raw layout
+	Data string `json:"data,omitempty"`
+
+	// validator is composed for data validation
+	validator
+	// converters is composed for WIRE to GoLang Converters
+	converters
+}
+
+// NewInterfaceHeader returns a new InterfaceHeader
+func NewInterfaceHeader() *InterfaceHeader {
+	ih := &InterfaceHeader{
+		Layout: InterfaceHeaderFAIM,
+	}
+	return ih
+}
+
+// Parse takes the input string and parses the InterfaceHeader values. The layout is chosen from the
+// contents of record: the FAIM layout is tried first, followed by the vendor layout when record holds
+// a Delimiter. Any other record is kept as raw Data.
+func (ih *InterfaceHeader) Parse(record string) error {
+	*ih = InterfaceHeader{}
+	switch {
+	case faimInterfaceHeaderRegex.MatchString(record):
+		ih.Layout = InterfaceHeaderFAIM
+		ih.ApplicationID = record[:3]
+		ih.SequenceNumber = record[3:7]
+		ih.TerminalID = ih.parseStringField(record[8:])
+	case strings.Contains(record, Delimiter):
+		ih.Layout = InterfaceHeaderVendor
+		ih.Fields = strings.Split(record, Delimiter)
+	default:
+		ih.Layout = InterfaceHeaderRaw
+		ih.Data = record
+	}
+	return nil
+}
+
+// String returns a fixed-width InterfaceHeader record
+func (ih *InterfaceHeader) String() string {
+	return ih.Format(FormatOptions{
+		VariableLengthFields: false,
+	})
+}
+
+// Format returns an InterfaceHeader record formatted according to the FormatOptions
+func (ih *InterfaceHeader) Format(options FormatOptions) string {
+	switch ih.Layout {
+	case InterfaceHeaderVendor:
+		return strings.Join(ih.Fields, Delimiter)
+	case InterfaceHeaderRaw:
+		return ih.Data
+	}
+
+	var buf strings.Builder
+	buf.Grow(16)
+
+	buf.WriteString(ih.ApplicationIDField())
+	buf.WriteString(ih.SequenceNumberField())
+	buf.WriteString(" ")
+	buf.WriteString(ih.FormatTerminalID(options))
+
+	return buf.String()
+}
+
+// Validate performs WIRE format rule checks on InterfaceHeader and returns an error if not Validated
+func (ih *InterfaceHeader) Validate() error {
+	return ih.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on InterfaceHeader and returns every error found.
+// Only the first error for each field is returned.
+func (ih *InterfaceHeader) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
+	switch ih.Layout {
+	case InterfaceHeaderFAIM:
+		if len(ih.ApplicationID) != 3 {
+			addFieldError(&errs, "", fieldError("ApplicationID", NewFieldWrongLengthErr(3, len(ih.ApplicationID)), ih.ApplicationID))
+		} else if err := ih.isAlphanumeric(ih.ApplicationID); err != nil {
+			addFieldError(&errs, "", fieldError("ApplicationID", err, ih.ApplicationID))
+		}
+		if len(ih.SequenceNumber) != 4 {
+			addFieldError(&errs, "", fieldError("SequenceNumber", NewFieldWrongLengthErr(4, len(ih.SequenceNumber)), ih.SequenceNumber))
+		} else if err := ih.isNumeric(ih.SequenceNumber); err != nil {
+			addFieldError(&errs, "", fieldError("SequenceNumber", err, ih.SequenceNumber))
+		}
+		if ih.TerminalID == "" || len(ih.TerminalID) > 8 {
+			addFieldError(&errs, "", fieldError("TerminalID", NewFieldWrongLengthErr(8, len(ih.TerminalID)), ih.TerminalID))
+		} else if err := ih.isAlphanumeric(ih.TerminalID); err != nil {
+			addFieldError(&errs, "", fieldError("TerminalID", err, ih.TerminalID))
+		}
+	case InterfaceHeaderVendor:
+		if len(ih.Fields) < 2 {
+			addFieldError(&errs, "", fieldError("Fields", ErrFieldRequired))
+		}
+		for _, field := range ih.Fields {
+			if err := ih.isHeaderData(field); err != nil {
+				addFieldError(&errs, "", fieldError("Fields", err, field))
+			}
+		}
+	case InterfaceHeaderRaw:
+		if ih.Data == "" {
+			addFieldError(&errs, "", fieldError("Data", ErrFieldRequired))
+		} else if err := ih.isHeaderData(ih.Data); err != nil {
+			addFieldError(&errs, "", fieldError("Data", err, ih.Data))
+		}
+	default:
+		addFieldError(&errs, "", fieldError("Layout", ErrInvalidProperty, ih.Layout))
+	}
+	return errs
+}
+
+// isHeaderData checks s can be written on a header line, which must not hold line breaks or tags
+func (ih *InterfaceHeader) isHeaderData(s string) error {
+	if strings.ContainsAny(s, "\r\n") || tagRegex.MatchString(s) {
+		return ErrInvalidProperty
+	}
+	return nil
+}
+
+// ApplicationIDField gets a string of the ApplicationID field
+func (ih *InterfaceHeader) ApplicationIDField() string {
+	return ih.alphaField(ih.ApplicationID, 3)
+}
+
+// SequenceNumberField gets a string of the SequenceNumber field
+func (ih *InterfaceHeader) SequenceNumberField() string {
+	return ih.numericStringField(ih.SequenceNumber, 4)
+}
+
+// TerminalIDField gets a string of the TerminalID field
+func (ih *InterfaceHeader) TerminalIDField() string {
+	return ih.alphaField(ih.TerminalID, 8)
+}
+
+// FormatTerminalID returns TerminalID formatted according to the FormatOptions
+func (ih *InterfaceHeader) FormatTerminalID(options FormatOptions) string {
+	return ih.formatAlphaField(ih.TerminalID, 8, options)
+}
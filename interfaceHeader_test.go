@@ -0,0 +1,62 @@
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterfaceHeader_Parse(t *testing.T) {
+	t.Run("FAIM", func(t *testing.T) {
+		ih := NewInterfaceHeader()
+		require.NoError(t, ih.Parse("FTI0811 XFT811"))
+		require.Equal(t, InterfaceHeaderFAIM, ih.Layout)
+		require.Equal(t, "FTI", ih.ApplicationID)
+		require.Equal(t, "0811", ih.SequenceNumber)
+		require.Equal(t, "XFT811", ih.TerminalID)
+		require.NoError(t, ih.Validate())
+		require.Equal(t, "FTI0811 XFT811  ", ih.String())
+		require.Equal(t, "FTI0811 XFT811", ih.Format(FormatOptions{VariableLengthFields: true}))
+	})
+
+	t.Run("Vendor", func(t *testing.T) {
+		ih := NewInterfaceHeader()
+		require.NoError(t, ih.Parse("HDR*ACME*20240102*"))
+		require.Equal(t, InterfaceHeaderVendor, ih.Layout)
+		require.Equal(t, []string{"HDR", "ACME", "20240102", ""}, ih.Fields)
+		require.NoError(t, ih.Validate())
+		require.Equal(t, "HDR*ACME*20240102*", ih.String())
+	})
+
+	t.Run("Raw", func(t *testing.T) {
+		ih := NewInterfaceHeader()
+		require.NoError(t, ih.Parse("  ROUTE 42 "))
+		require.Equal(t, InterfaceHeaderRaw, ih.Layout)
+		require.Equal(t, "  ROUTE 42 ", ih.Data)
+		require.NoError(t, ih.Validate())
+		require.Equal(t, "  ROUTE 42 ", ih.String())
+	})
+}
+
+func TestInterfaceHeader_ValidateAll(t *testing.T) {
+	ih := &InterfaceHeader{
+		Layout:         InterfaceHeaderFAIM,
+		ApplicationID:  "FT",
+		SequenceNumber: "08A1",
+		TerminalID:     "XFT811®",
+	}
+	errs := ih.ValidateAll()
+	require.Len(t, errs, 3)
+	require.EqualError(t, errs[0], fieldError("ApplicationID", NewFieldWrongLengthErr(3, 2), ih.ApplicationID).Error())
+	require.EqualError(t, errs[1], fieldError("SequenceNumber", ErrNonNumeric, ih.SequenceNumber).Error())
+	require.EqualError(t, errs[2], fieldError("TerminalID", ErrNonAlphanumeric, ih.TerminalID).Error())
+
+	ih = &InterfaceHeader{Layout: InterfaceHeaderVendor, Fields: []string{"HDR", "{1500}"}}
+	require.EqualError(t, ih.Validate(), fieldError("Fields", ErrInvalidProperty, "{1500}").Error())
+
+	ih = &InterfaceHeader{Layout: InterfaceHeaderRaw}
+	require.EqualError(t, ih.Validate(), fieldError("Data", ErrFieldRequired).Error())
+
+	ih = &InterfaceHeader{Layout: "SWIFT"}
+	require.EqualError(t, ih.Validate(), fieldError("Layout", ErrInvalidProperty, "SWIFT").Error())
+}
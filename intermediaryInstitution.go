@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // IntermediaryInstitution is the intermediary institution
@@ -45,42 +47,42 @@ func (ii *IntermediaryInstitution) Parse(record string) error {
 
 	value, read, err := ii.parseVariableStringField(record[length:], 5)
 	if err != nil {
-		return fieldError("SwiftFieldTag", err)
+		return fieldErrorAt(length, "SwiftFieldTag", err)
 	}
 	ii.CoverPayment.SwiftFieldTag = value
 	length += read
 
 	value, read, err = ii.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineOne", err)
+		return fieldErrorAt(length, "SwiftLineOne", err)
 	}
 	ii.CoverPayment.SwiftLineOne = value
 	length += read
 
 	value, read, err = ii.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineTwo", err)
+		return fieldErrorAt(length, "SwiftLineTwo", err)
 	}
 	ii.CoverPayment.SwiftLineTwo = value
 	length += read
 
 	value, read, err = ii.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineThree", err)
+		return fieldErrorAt(length, "SwiftLineThree", err)
 	}
 	ii.CoverPayment.SwiftLineThree = value
 	length += read
 
 	value, read, err = ii.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineFour", err)
+		return fieldErrorAt(length, "SwiftLineFour", err)
 	}
 	ii.CoverPayment.SwiftLineFour = value
 	length += read
 
 	value, read, err = ii.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineFive", err)
+		return fieldErrorAt(length, "SwiftLineFive", err)
 	}
 	ii.CoverPayment.SwiftLineFive = value
 	length += read
@@ -134,42 +136,49 @@ func (ii *IntermediaryInstitution) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on IntermediaryInstitution and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (ii *IntermediaryInstitution) Validate() error {
-	if err := ii.fieldInclusion(); err != nil {
-		return err
-	}
+	return ii.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on IntermediaryInstitution and returns every error found.
+// Only the first error for each field is returned.
+func (ii *IntermediaryInstitution) ValidateAll() base.ErrorList {
+	errs := ii.fieldInclusion()
 	if ii.tag != TagIntermediaryInstitution {
-		return fieldError("tag", ErrValidTagForType, ii.tag)
+		addFieldError(&errs, TagIntermediaryInstitution, fieldError("tag", ErrValidTagForType, ii.tag))
 	}
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, ii.CoverPayment.SwiftFieldTag)
+		addFieldError(&errs, TagIntermediaryInstitution, fieldError("SwiftFieldTag", err, ii.CoverPayment.SwiftFieldTag))
 	}
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, ii.CoverPayment.SwiftLineOne)
+		addFieldError(&errs, TagIntermediaryInstitution, fieldError("SwiftLineOne", err, ii.CoverPayment.SwiftLineOne))
 	}
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, ii.CoverPayment.SwiftLineTwo)
+		addFieldError(&errs, TagIntermediaryInstitution, fieldError("SwiftLineTwo", err, ii.CoverPayment.SwiftLineTwo))
 	}
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, ii.CoverPayment.SwiftLineThree)
+		addFieldError(&errs, TagIntermediaryInstitution, fieldError("SwiftLineThree", err, ii.CoverPayment.SwiftLineThree))
 	}
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, ii.CoverPayment.SwiftLineFour)
+		addFieldError(&errs, TagIntermediaryInstitution, fieldError("SwiftLineFour", err, ii.CoverPayment.SwiftLineFour))
 	}
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineFive); err != nil {
-		return fieldError("SwiftLineFive", err, ii.CoverPayment.SwiftLineFive)
+		addFieldError(&errs, TagIntermediaryInstitution, fieldError("SwiftLineFive", err, ii.CoverPayment.SwiftLineFive))
 	}
-	return nil
+	if i, err := ii.validateCoverPaymentParty(ii.CoverPayment); err != nil {
+		addFieldError(&errs, TagIntermediaryInstitution, fieldError(swiftLineNames[i], err, ii.CoverPayment.lines()[i]))
+	}
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (ii *IntermediaryInstitution) fieldInclusion() error {
+func (ii *IntermediaryInstitution) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if ii.CoverPayment.SwiftLineSix != "" {
-		return fieldError("SwiftLineSix", ErrInvalidProperty, ii.CoverPayment.SwiftLineSix)
+		addFieldError(&errs, TagIntermediaryInstitution, fieldError("SwiftLineSix", ErrInvalidProperty, ii.CoverPayment.SwiftLineSix))
 	}
-	return nil
+	return errs
 }
 
 // SwiftFieldTagField gets a string of the SwiftFieldTag field
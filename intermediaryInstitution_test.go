@@ -105,7 +105,7 @@ func TestParseIntermediaryInstitutionWrongLength(t *testing.T) {
 
 	err := r.parseIntermediaryInstitution()
 
-	require.EqualError(t, err, r.parseError(fieldError("SwiftFieldTag", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "SwiftFieldTag", ErrRequireDelimiter)).Error())
 }
 
 // TestParseIntermediaryInstitutionReaderParseError parses a wrong IntermediaryInstitution reader parse error
@@ -120,7 +120,7 @@ func TestParseIntermediaryInstitutionReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("SwiftLineOne", ErrNonAlphanumeric, "Swift ®ine One")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("SwiftLineOne", ErrNonAlphanumeric, "Swift ®ine One"))).Error())
 }
 
 // TestIntermediaryInstitutionTagError validates a IntermediaryInstitution tag
@@ -0,0 +1,237 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package convert holds the conversion reports shared by the swift and iso20022 packages, which convert
+// FEDWireMessages to and from other formats.
+package convert
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/wire"
+)
+
+// Warning describes data which could not be converted, or was changed to fit its new format
+type Warning struct {
+	// Tag of the FEDWireMessage holding the data, such as {5000}
+	Tag string `json:"tag,omitempty"`
+	// Field is the tag of the SWIFT field holding the data, such as 50K
+	Field string `json:"field,omitempty"`
+	// Element is the path of the ISO 20022 element holding the data, such as CdtTrfTxInf/Purp
+	Element string `json:"element,omitempty"`
+	// Reason the data was not converted as is
+	Reason string `json:"reason"`
+}
+
+func (w Warning) String() string {
+	var parts []string
+	if w.Tag != "" {
+		parts = append(parts, w.Tag)
+	}
+	if w.Field != "" {
+		return strings.Join(append(parts, ":"+w.Field+":", w.Reason), " ")
+	}
+	if w.Element != "" {
+		parts = append(parts, w.Element)
+	}
+	return strings.Join(parts, " ") + ": " + w.Reason
+}
+
+// Report lists the Warnings of a conversion
+type Report struct {
+	Warnings []Warning `json:"warnings,omitempty"`
+}
+
+// Empty returns true when the conversion had no Warnings
+func (r *Report) Empty() bool {
+	return r == nil || len(r.Warnings) == 0
+}
+
+// Tags returns the tags of the FEDWireMessage with Warnings
+func (r *Report) Tags() []string {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, w := range r.Warnings {
+		if w.Tag != "" && !seen[w.Tag] {
+			seen[w.Tag] = true
+			tags = append(tags, w.Tag)
+		}
+	}
+	return tags
+}
+
+// Unmapped records that the data of tag, or the named part of it, has nowhere to go in the other format, such
+// as a "SWIFT field"
+func (r *Report) Unmapped(tag, part, nowhere string) {
+	reason := "has no " + nowhere
+	if part != "" {
+		reason = part + " " + reason
+	}
+	r.Warnings = append(r.Warnings, Warning{Tag: tag, Reason: reason})
+}
+
+// NoTag records that the data at the Field or Element of at, or the named part of it, has no FEDWireMessage tag
+func (r *Report) NoTag(at Warning, part string) {
+	at.Reason = "has no FEDWireMessage tag"
+	if part != "" {
+		at.Reason = part + " " + at.Reason
+	}
+	r.Warnings = append(r.Warnings, at)
+}
+
+// Truncated records that the data at the Tag and Field or Element of at was truncated to fit its new format
+func (r *Report) Truncated(at Warning) {
+	at.Reason = "was truncated"
+	r.Warnings = append(r.Warnings, at)
+}
+
+// Fit returns value cut to max characters, recording when it was truncated
+func (r *Report) Fit(at Warning, value string, max int) string {
+	if utf8.RuneCountInString(value) <= max {
+		return value
+	}
+	r.Truncated(at)
+	return string([]rune(value)[:max])
+}
+
+// FitLines returns at most count of the lines which are not blank, each cut to max characters, recording when
+// they were truncated
+func (r *Report) FitLines(at Warning, lines []string, count, max int) []string {
+	var out []string
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if len(out) == count {
+			r.Truncated(at)
+			break
+		}
+		out = append(out, r.Fit(at, line, max))
+	}
+	return out
+}
+
+// FitLineSlots returns count lines of at most max characters from lines, recording when they were truncated.
+// Blank lines are returned empty, so each line keeps its position.
+func (r *Report) FitLineSlots(at Warning, lines []string, count, max int) []string {
+	out := make([]string, count)
+	if len(lines) > count {
+		r.Truncated(at)
+		lines = lines[:count]
+	}
+	for i, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			out[i] = r.Fit(at, line, max)
+		}
+	}
+	return out
+}
+
+// UnmappedTags records the tags of fwm which have nowhere to go in the other format, such as a "SWIFT field":
+// those of tags it holds and any unknown tags
+func (r *Report) UnmappedTags(fwm *wire.FEDWireMessage, tags []string, nowhere string) {
+	for _, tag := range tags {
+		if TagPresent(fwm, tag) {
+			r.Unmapped(tag, "", nowhere)
+		}
+	}
+	for _, tag := range fwm.UnknownTags {
+		r.Unmapped(tag.Tag, "", nowhere)
+	}
+}
+
+// TagPresent returns true when fwm holds tag
+func TagPresent(fwm *wire.FEDWireMessage, tag string) bool {
+	present := map[string]bool{
+		wire.TagMessageDisposition:              fwm.MessageDisposition != nil,
+		wire.TagReceiptTimeStamp:                fwm.ReceiptTimeStamp != nil,
+		wire.TagOutputMessageAccountabilityData: fwm.OutputMessageAccountabilityData != nil,
+		wire.TagErrorWire:                       fwm.ErrorWire != nil,
+		wire.TagSenderReference:                 fwm.SenderReference != nil,
+		wire.TagPreviousMessageIdentifier:       fwm.PreviousMessageIdentifier != nil,
+		wire.TagLocalInstrument:                 fwm.LocalInstrument != nil,
+		wire.TagPaymentNotification:             fwm.PaymentNotification != nil,
+		wire.TagCharges:                         fwm.Charges != nil,
+		wire.TagInstructedAmount:                fwm.InstructedAmount != nil,
+		wire.TagExchangeRate:                    fwm.ExchangeRate != nil,
+		wire.TagBeneficiaryIntermediaryFI:       fwm.BeneficiaryIntermediaryFI != nil,
+		wire.TagBeneficiaryFI:                   fwm.BeneficiaryFI != nil,
+		wire.TagBeneficiary:                     fwm.Beneficiary != nil,
+		wire.TagBeneficiaryReference:            fwm.BeneficiaryReference != nil,
+		wire.TagAccountDebitedDrawdown:          fwm.AccountDebitedDrawdown != nil,
+		wire.TagOriginator:                      fwm.Originator != nil,
+		wire.TagOriginatorOptionF:               fwm.OriginatorOptionF != nil,
+		wire.TagOriginatorFI:                    fwm.OriginatorFI != nil,
+		wire.TagInstructingFI:                   fwm.InstructingFI != nil,
+		wire.TagAccountCreditedDrawdown:         fwm.AccountCreditedDrawdown != nil,
+		wire.TagOriginatorToBeneficiary:         fwm.OriginatorToBeneficiary != nil,
+		wire.TagFIReceiverFI:                    fwm.FIReceiverFI != nil,
+		wire.TagFIDrawdownDebitAccountAdvice:    fwm.FIDrawdownDebitAccountAdvice != nil,
+		wire.TagFIIntermediaryFI:                fwm.FIIntermediaryFI != nil,
+		wire.TagFIIntermediaryFIAdvice:          fwm.FIIntermediaryFIAdvice != nil,
+		wire.TagFIBeneficiaryFI:                 fwm.FIBeneficiaryFI != nil,
+		wire.TagFIBeneficiaryFIAdvice:           fwm.FIBeneficiaryFIAdvice != nil,
+		wire.TagFIBeneficiary:                   fwm.FIBeneficiary != nil,
+		wire.TagFIBeneficiaryAdvice:             fwm.FIBeneficiaryAdvice != nil,
+		wire.TagFIPaymentMethodToBeneficiary:    fwm.FIPaymentMethodToBeneficiary != nil,
+		wire.TagFIAdditionalFIToFI:              fwm.FIAdditionalFIToFI != nil,
+		wire.TagCurrencyInstructedAmount:        fwm.CurrencyInstructedAmount != nil,
+		wire.TagOrderingCustomer:                fwm.OrderingCustomer != nil,
+		wire.TagOrderingInstitution:             fwm.OrderingInstitution != nil,
+		wire.TagIntermediaryInstitution:         fwm.IntermediaryInstitution != nil,
+		wire.TagInstitutionAccount:              fwm.InstitutionAccount != nil,
+		wire.TagBeneficiaryCustomer:             fwm.BeneficiaryCustomer != nil,
+		wire.TagRemittance:                      fwm.Remittance != nil,
+		wire.TagSenderToReceiver:                fwm.SenderToReceiver != nil,
+		wire.TagUnstructuredAddenda:             fwm.UnstructuredAddenda != nil,
+		wire.TagRelatedRemittance:               fwm.RelatedRemittance != nil,
+		wire.TagRemittanceOriginator:            fwm.RemittanceOriginator != nil,
+		wire.TagRemittanceBeneficiary:           fwm.RemittanceBeneficiary != nil,
+		wire.TagPrimaryRemittanceDocument:       fwm.PrimaryRemittanceDocument != nil,
+		wire.TagActualAmountPaid:                fwm.ActualAmountPaid != nil,
+		wire.TagGrossAmountRemittanceDocument:   fwm.GrossAmountRemittanceDocument != nil,
+		wire.TagAmountNegotiatedDiscount:        fwm.AmountNegotiatedDiscount != nil,
+		wire.TagAdjustment:                      fwm.Adjustment != nil,
+		wire.TagDateRemittanceDocument:          fwm.DateRemittanceDocument != nil,
+		wire.TagSecondaryRemittanceDocument:     fwm.SecondaryRemittanceDocument != nil,
+		wire.TagRemittanceFreeText:              fwm.RemittanceFreeText != nil,
+		wire.TagServiceMessage:                  fwm.ServiceMessage != nil,
+	}
+	return present[tag]
+}
+
+// AmountFromCents returns the 12 digit {2000} Amount in cents as a decimal amount with the separator, such as the
+// decimal comma of SWIFT
+func AmountFromCents(cents, separator string) string {
+	cents = strings.TrimLeft(cents, "0")
+	for len(cents) < 3 {
+		cents = "0" + cents
+	}
+	return cents[:len(cents)-2] + separator + cents[len(cents)-2:]
+}
+
+// AmountInCents returns a decimal amount with the separator as the 12 digit {2000} Amount in cents, or false when
+// it has fractions of a cent or does not fit
+func AmountInCents(amount, separator string) (string, bool) {
+	whole, frac, _ := strings.Cut(amount, separator)
+	if whole == "" || len(frac) > 2 || !digits(whole) || !digits(frac) {
+		return "", false
+	}
+	cents := strings.TrimLeft(whole+frac+strings.Repeat("0", 2-len(frac)), "0")
+	if len(cents) > 12 {
+		return "", false
+	}
+	return strings.Repeat("0", 12-len(cents)) + cents, true
+}
+
+// digits returns true when s holds only the digits 0 to 9
+func digits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
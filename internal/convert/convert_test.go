@@ -0,0 +1,116 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package convert
+
+import (
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarning_String(t *testing.T) {
+	require.Equal(t, "{5000} :50K: was truncated", Warning{Tag: wire.TagOriginator, Field: "50K", Reason: "was truncated"}.String())
+	require.Equal(t, ":77B: has no FEDWireMessage tag", Warning{Field: "77B", Reason: "has no FEDWireMessage tag"}.String())
+	require.Equal(t, "{5000} Dbtr/Nm: was truncated", Warning{Tag: wire.TagOriginator, Element: "Dbtr/Nm", Reason: "was truncated"}.String())
+	require.Equal(t, "CdtTrfTxInf/Purp: has no FEDWireMessage tag", Warning{Element: "CdtTrfTxInf/Purp", Reason: "has no FEDWireMessage tag"}.String())
+	require.Equal(t, "{1500}: has no SWIFT field", Warning{Tag: wire.TagSenderSupplied, Reason: "has no SWIFT field"}.String())
+}
+
+func TestReport(t *testing.T) {
+	var report *Report
+	require.True(t, report.Empty())
+
+	report = &Report{}
+	report.Unmapped(wire.TagPreviousMessageIdentifier, "", "SWIFT field")
+	report.Unmapped(wire.TagSenderSupplied, "UserRequestCorrelation", "ISO 20022 element")
+	report.Unmapped(wire.TagPreviousMessageIdentifier, "", "SWIFT field")
+	report.Truncated(Warning{Tag: wire.TagOriginator, Field: "50K"})
+	report.NoTag(Warning{Field: "77B"}, "")
+	report.NoTag(Warning{Field: "23B"}, "bank operation code SPAY")
+
+	require.False(t, report.Empty())
+	require.Equal(t, []string{wire.TagPreviousMessageIdentifier, wire.TagSenderSupplied, wire.TagOriginator}, report.Tags())
+	require.Equal(t, "{1500}: UserRequestCorrelation has no ISO 20022 element", report.Warnings[1].String())
+	require.Equal(t, "{5000} :50K: was truncated", report.Warnings[3].String())
+	require.Equal(t, ":77B: has no FEDWireMessage tag", report.Warnings[4].String())
+	require.Equal(t, ":23B: bank operation code SPAY has no FEDWireMessage tag", report.Warnings[5].String())
+}
+
+func TestReport_Fit(t *testing.T) {
+	report := &Report{}
+	require.Equal(t, "Short", report.Fit(Warning{Tag: wire.TagOriginator, Field: "50K"}, "Short", 35))
+	require.True(t, report.Empty())
+
+	require.Equal(t, "Sender Reference", report.Fit(Warning{Tag: wire.TagSenderReference, Field: "20"}, "Sender Reference 1", 16))
+	require.Equal(t, []string{wire.TagSenderReference}, report.Tags())
+}
+
+func TestReport_FitLines(t *testing.T) {
+	at := Warning{Tag: wire.TagBeneficiary, Field: "59"}
+
+	// Blank lines are dropped, as SWIFT fields have none
+	report := &Report{}
+	require.Equal(t, []string{"One", "Three"}, report.FitLines(at, []string{"One", " ", "Three"}, 3, 35))
+	require.True(t, report.Empty())
+
+	require.Equal(t, []string{"On", "Tw"}, report.FitLines(at, []string{"One", "Two", "Three"}, 2, 2))
+	require.Len(t, report.Warnings, 3)
+}
+
+func TestReport_FitLineSlots(t *testing.T) {
+	at := Warning{Tag: wire.TagBeneficiary, Element: "Cdtr/PstlAdr"}
+
+	report := &Report{}
+	require.Equal(t, []string{"One", "", "Three"}, report.FitLineSlots(at, []string{"One", " ", "Three"}, 3, 35))
+	require.True(t, report.Empty())
+
+	require.Equal(t, []string{"On", "Tw"}, report.FitLineSlots(at, []string{"One", "Two", "Three"}, 2, 2))
+	require.Len(t, report.Warnings, 3)
+}
+
+func TestReport_UnmappedTags(t *testing.T) {
+	fwm := &wire.FEDWireMessage{
+		SenderReference: wire.NewSenderReference(),
+		UnknownTags:     []wire.UnknownTag{{Tag: "{9999}"}},
+	}
+	require.True(t, TagPresent(fwm, wire.TagSenderReference))
+	require.False(t, TagPresent(fwm, wire.TagCharges))
+
+	report := &Report{}
+	report.UnmappedTags(fwm, []string{wire.TagSenderReference, wire.TagCharges}, "SWIFT field")
+	require.Equal(t, []Warning{
+		{Tag: wire.TagSenderReference, Reason: "has no SWIFT field"},
+		{Tag: "{9999}", Reason: "has no SWIFT field"},
+	}, report.Warnings)
+}
+
+func TestAmountFromCents(t *testing.T) {
+	require.Equal(t, "12345,67", AmountFromCents("000001234567", ","))
+	require.Equal(t, "0.05", AmountFromCents("000000000005", "."))
+	require.Equal(t, "0.00", AmountFromCents("000000000000", "."))
+}
+
+func TestAmountInCents(t *testing.T) {
+	for amount, cents := range map[string]string{
+		"12345.67": "000001234567",
+		"12345.":   "000001234500",
+		"5":        "000000000500",
+		"0.5":      "000000000050",
+	} {
+		got, ok := AmountInCents(amount, ".")
+		require.True(t, ok, amount)
+		require.Equal(t, cents, got, amount)
+	}
+
+	got, ok := AmountInCents("1,00", ",")
+	require.True(t, ok)
+	require.Equal(t, "000000000100", got)
+
+	for _, amount := range []string{"", ".50", "1.234", "1,23", "1.2a", "12345678901.00"} {
+		_, ok := AmountInCents(amount, ".")
+		require.False(t, ok, amount)
+	}
+}
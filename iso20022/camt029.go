@@ -0,0 +1,366 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/wire"
+)
+
+// InvestigationStatusRejected is the Conf and TxCxlSts of a refused request
+const InvestigationStatusRejected = "RJCR"
+
+// Camt029 is a ResolutionOfInvestigation message and its business application header
+type Camt029 struct {
+	XMLName  xml.Name                  `xml:"Envelope"`
+	AppHdr   BusinessApplicationHeader `xml:"urn:iso:std:iso:20022:tech:xsd:head.001.001.03 AppHdr"`
+	Document Camt029Document           `xml:"urn:iso:std:iso:20022:tech:xsd:camt.029.001.09 Document"`
+}
+
+// Camt029Document is the Document of a Camt029 message
+type Camt029Document struct {
+	ResolutionOfInvestigation ResolutionOfInvestigation `xml:"RsltnOfInvstgtn"`
+}
+
+// ResolutionOfInvestigation is the RsltnOfInvstgtn of a Camt029Document
+type ResolutionOfInvestigation struct {
+	Assignment          CaseAssignment                `xml:"Assgnmt"`
+	Status              InvestigationStatus           `xml:"Sts"`
+	CancellationDetails []UnderlyingTransactionStatus `xml:"CxlDtls,omitempty"`
+}
+
+// InvestigationStatus is the Sts of a ResolutionOfInvestigation
+type InvestigationStatus struct {
+	Confirmation string `xml:"Conf"`
+}
+
+// UnderlyingTransactionStatus is a CxlDtls of a ResolutionOfInvestigation, the transactions resolved
+type UnderlyingTransactionStatus struct {
+	TransactionInformationAndStatus []PaymentTransactionStatus `xml:"TxInfAndSts"`
+}
+
+// PaymentTransactionStatus is a TxInfAndSts of an UnderlyingTransactionStatus, the resolution of the request its
+// OrgnlGrpInf refers to
+type PaymentTransactionStatus struct {
+	CancellationStatusIdentification  string                        `xml:"CxlStsId,omitempty"`
+	OriginalGroupInformation          OriginalGroupInformation      `xml:"OrgnlGrpInf"`
+	OriginalInstructionIdentification string                        `xml:"OrgnlInstrId,omitempty"`
+	OriginalEndToEndIdentification    string                        `xml:"OrgnlEndToEndId,omitempty"`
+	OriginalUETR                      string                        `xml:"OrgnlUETR,omitempty"`
+	TransactionCancellationStatus     string                        `xml:"TxCxlSts,omitempty"`
+	CancellationStatusReason          []ReasonInformation           `xml:"CxlStsRsnInf,omitempty"`
+	OriginalInterbankSettlementAmount *ActiveCurrencyAndAmount      `xml:"OrgnlIntrBkSttlmAmt,omitempty"`
+	OriginalInterbankSettlementDate   string                        `xml:"OrgnlIntrBkSttlmDt,omitempty"`
+	OriginalTransactionReference      *OriginalTransactionReference `xml:"OrgnlTxRef,omitempty"`
+	UnknownElements                   []UnknownElement              `xml:",any"`
+}
+
+// Camt029FromFEDWireMessage converts a refusal of a drawdown request, a DRB, DRC or SVC message with the SubTypeCode
+// RefusalRequestCredit, to a Camt029 message rejecting the pain.013 request given by the {3500}
+// PreviousMessageIdentifier. The reason for the refusal is the {6000} OriginatorToBeneficiary of drawdowns and the
+// {9000} ServiceMessage of service messages, given by the {5000} Originator.
+//
+// Data of the FEDWireMessage which camt.029 has no element for is listed in the returned Report.
+func Camt029FromFEDWireMessage(fwm *wire.FEDWireMessage) (*Camt029, *Report, error) {
+	err := requireBusinessFunctionCode(fwm, wire.BankDrawDownRequest, wire.CustomerCorporateDrawdownRequest, wire.BFCServiceMessage)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := requireSubTypeCode(fwm, wire.RefusalRequestCredit); err != nil {
+		return nil, nil, err
+	}
+	report := &Report{}
+	id := messageIdentification(fwm.InputMessageAccountabilityData)
+	createdAt := created()
+	reportTypeSubType(fwm, typeCode(fwm.BusinessFunctionCode.BusinessFunctionCode)+wire.RefusalRequestCredit, report)
+
+	it := newInterbankTransfer(fwm, id)
+	tx := PaymentTransactionStatus{
+		CancellationStatusIdentification:  it.PaymentIdentification.InstructionIdentification,
+		OriginalGroupInformation:          originalGroupInformation(fwm, MessageDefinitionPain013),
+		OriginalEndToEndIdentification:    it.PaymentIdentification.EndToEndIdentification,
+		TransactionCancellationStatus:     InvestigationStatusRejected,
+		OriginalInterbankSettlementAmount: &it.InterbankSettlementAmount,
+		OriginalInterbankSettlementDate:   originalSettlementDate(fwm),
+		OriginalTransactionReference:      drawdownTransactionReference(fwm),
+	}
+	tx.OriginalUETR = originalUETR(tx.OriginalGroupInformation)
+	lines, unmapped := narrative(fwm)
+	tx.CancellationStatusReason = reasonInformation(lines)
+	if fwm.Originator != nil {
+		orgtr := identifiedParty(fwm.Originator.Personal)
+		if len(tx.CancellationStatusReason) == 0 {
+			tx.CancellationStatusReason = []ReasonInformation{{}}
+		}
+		tx.CancellationStatusReason[0].Originator = &orgtr
+	}
+	reportUnmappedTags(fwm, report, append(camt029Unmapped, unmapped))
+
+	msg := &Camt029{
+		AppHdr: newBusinessApplicationHeader(fwm, MessageDefinitionCamt029, id, createdAt, report),
+		Document: Camt029Document{
+			ResolutionOfInvestigation: ResolutionOfInvestigation{
+				Assignment:          newCaseAssignment(it, id, createdAt),
+				Status:              InvestigationStatus{Confirmation: InvestigationStatusRejected},
+				CancellationDetails: []UnderlyingTransactionStatus{{TransactionInformationAndStatus: []PaymentTransactionStatus{tx}}},
+			},
+		},
+	}
+	return msg, report, nil
+}
+
+// drawdownTransactionReference returns the OrgnlTxRef of the drawdown request a refusal refers to, holding its
+// PaymentTypeInformation, the {4100} BeneficiaryFI and {4200} Beneficiary, and the {4400} AccountDebitedDrawdown
+// and {5400} AccountCreditedDrawdown as they are in a pain.013
+func drawdownTransactionReference(fwm *wire.FEDWireMessage) *OriginalTransactionReference {
+	ref := &OriginalTransactionReference{PaymentTypeInformation: paymentTypeInformation(fwm)}
+	if fwm.BeneficiaryFI != nil {
+		agt := agent(fwm.BeneficiaryFI.FinancialInstitution)
+		ref.CreditorAgent = &agt
+	}
+	if fwm.Beneficiary != nil {
+		pty := identifiedParty(fwm.Beneficiary.Personal)
+		ref.Creditor = &Party40Choice{Party: &pty}
+	}
+	if acd := fwm.AccountCreditedDrawdown; acd != nil {
+		ref.CreditorAccount = &CashAccount{Identification: AccountIdentification{Other: &GenericIdentification{Identification: acd.DrawdownCreditAccountNumber}}}
+	}
+	if add := fwm.AccountDebitedDrawdown; add != nil {
+		pty, acct := party(wire.Personal{
+			IdentificationCode: add.IdentificationCode,
+			Identifier:         add.Identifier,
+			Name:               add.Name,
+			Address:            add.Address,
+		})
+		ref.Debtor, ref.DebtorAccount = &Party40Choice{Party: &pty}, acct
+	}
+	return ref
+}
+
+// camt029Unmapped are the tags of drawdown refusals which camt.029 has no element for
+var camt029Unmapped = []string{
+	wire.TagLocalInstrument,
+	wire.TagPaymentNotification,
+	wire.TagCharges,
+	wire.TagInstructedAmount,
+	wire.TagExchangeRate,
+	wire.TagBeneficiaryIntermediaryFI,
+	wire.TagOriginatorOptionF,
+	wire.TagOriginatorFI,
+	wire.TagInstructingFI,
+	wire.TagFIReceiverFI,
+	wire.TagFIDrawdownDebitAccountAdvice,
+	wire.TagFIIntermediaryFI,
+	wire.TagFIIntermediaryFIAdvice,
+	wire.TagFIBeneficiaryFI,
+	wire.TagFIBeneficiaryFIAdvice,
+	wire.TagFIBeneficiary,
+	wire.TagFIBeneficiaryAdvice,
+	wire.TagFIPaymentMethodToBeneficiary,
+	wire.TagFIAdditionalFIToFI,
+	wire.TagCurrencyInstructedAmount,
+	wire.TagOrderingCustomer,
+	wire.TagOrderingInstitution,
+	wire.TagIntermediaryInstitution,
+	wire.TagInstitutionAccount,
+	wire.TagBeneficiaryCustomer,
+	wire.TagRemittance,
+	wire.TagSenderToReceiver,
+	wire.TagUnstructuredAddenda,
+	wire.TagRelatedRemittance,
+	wire.TagRemittanceOriginator,
+	wire.TagRemittanceBeneficiary,
+	wire.TagPrimaryRemittanceDocument,
+	wire.TagActualAmountPaid,
+	wire.TagGrossAmountRemittanceDocument,
+	wire.TagAmountNegotiatedDiscount,
+	wire.TagAdjustment,
+	wire.TagDateRemittanceDocument,
+	wire.TagSecondaryRemittanceDocument,
+	wire.TagRemittanceFreeText,
+}
+
+// FEDWireMessage converts the Camt029 message to a refusal of the drawdown request its OrgnlGrpInf refers to.
+//
+// The BusinessFunctionCode is given by the LocalInstrument of the OrgnlTxRef, a refusal without a known
+// LocalInstrument being an SVC service message. Only messages rejecting the request are converted. ISO 20022
+// elements the FEDWireMessage has no tag for, and values truncated to fit their tag, are listed in the returned
+// Report. Only the first TxInfAndSts is converted.
+func (m *Camt029) FEDWireMessage() (*wire.FEDWireMessage, *Report, error) {
+	const path = "Document/RsltnOfInvstgtn"
+	rsltn := &m.Document.ResolutionOfInvestigation
+	if conf := rsltn.Status.Confirmation; conf != InvestigationStatusRejected {
+		return nil, nil, &ElementError{Element: path + "/Sts/Conf", Value: conf, Err: ErrElementCode}
+	}
+	if len(rsltn.CancellationDetails) == 0 || len(rsltn.CancellationDetails[0].TransactionInformationAndStatus) == 0 {
+		return nil, nil, &ElementError{Element: path + "/CxlDtls/TxInfAndSts", Err: ErrElementRequired}
+	}
+	tx := &rsltn.CancellationDetails[0].TransactionInformationAndStatus[0]
+	if tx.OriginalInterbankSettlementAmount == nil {
+		return nil, nil, &ElementError{Element: path + "/CxlDtls/TxInfAndSts/OrgnlIntrBkSttlmAmt", Err: ErrElementRequired}
+	}
+
+	report := &Report{}
+	if len(rsltn.CancellationDetails) > 1 || len(rsltn.CancellationDetails[0].TransactionInformationAndStatus) > 1 {
+		report.NoTag(at("", "CxlDtls/TxInfAndSts"), "")
+	}
+	it := interbankTransfer{
+		PaymentIdentification: PaymentIdentification{
+			InstructionIdentification: tx.CancellationStatusIdentification,
+			EndToEndIdentification:    tx.OriginalEndToEndIdentification,
+		},
+		InterbankSettlementAmount: *tx.OriginalInterbankSettlementAmount,
+		InstructingAgent:          assignmentAgent("Assgnmt/Assgnr", rsltn.Assignment.Assigner, report),
+		InstructedAgent:           assignmentAgent("Assgnmt/Assgne", rsltn.Assignment.Assignee, report),
+	}
+	fwm, err := it.fedWireMessage(&m.AppHdr, rsltn.Assignment.Identification, transferElements{
+		MessageIdentification:     path + "/Assgnmt/Id",
+		Amount:                    path + "/CxlDtls/TxInfAndSts/OrgnlIntrBkSttlmAmt",
+		InstructingAgent:          "Assgnmt/Assgnr/Agt",
+		InstructedAgent:           "Assgnmt/Assgne/Agt",
+		InstructionIdentification: "CxlDtls/TxInfAndSts/CxlStsId",
+		EndToEndIdentification:    "CxlDtls/TxInfAndSts/OrgnlEndToEndId",
+	}, report)
+	if err != nil {
+		return nil, nil, err
+	}
+	const element = "CxlDtls/TxInfAndSts"
+	var pti *PaymentTypeInformation
+	if tx.OriginalTransactionReference != nil {
+		pti = tx.OriginalTransactionReference.PaymentTypeInformation
+	}
+	businessFunctionFromPaymentType(element+"/OrgnlTxRef/PmtTpInf", pti, fwm, report,
+		wire.BFCServiceMessage, wire.BankDrawDownRequest, wire.CustomerCorporateDrawdownRequest)
+	fwm.TypeSubType.TypeCode = typeCode(fwm.BusinessFunctionCode.BusinessFunctionCode)
+	fwm.TypeSubType.SubTypeCode = wire.RefusalRequestCredit
+	previousMessageFromOriginal(element, tx.OriginalGroupInformation, tx.OriginalUETR, fwm, report)
+	if tx.OriginalInstructionIdentification != "" {
+		report.NoTag(at("", element+"/OrgnlInstrId"), "")
+	}
+	if sts := tx.TransactionCancellationStatus; sts != "" && sts != InvestigationStatusRejected {
+		report.NoTag(at("", element+"/TxCxlSts"), "")
+	}
+	if tx.OriginalInterbankSettlementDate != "" {
+		report.NoTag(at("", element+"/OrgnlIntrBkSttlmDt"), "")
+	}
+
+	if ref := tx.OriginalTransactionReference; ref != nil {
+		partiesFromDrawdownReference(element+"/OrgnlTxRef", ref, fwm, report)
+	}
+	reasons := tx.CancellationStatusReason
+	if len(reasons) > 0 && reasons[0].Originator != nil {
+		fwm.Originator = wire.NewOriginator()
+		fwm.Originator.Personal = personal(wire.TagOriginator, element+"/CxlStsRsnInf/Orgtr", *reasons[0].Originator, nil, report)
+		reasons = append([]ReasonInformation{{Reason: reasons[0].Reason, AdditionalInformation: reasons[0].AdditionalInformation}}, reasons[1:]...)
+	}
+	narrativeFromLines(element+"/CxlStsRsnInf/AddtlInf", reasonLines(element+"/CxlStsRsnInf", reasons, report), fwm, report)
+	for _, el := range tx.UnknownElements {
+		report.NoTag(at("", element+"/"+el.XMLName.Local), "")
+	}
+	return fwm, report, nil
+}
+
+// partiesFromDrawdownReference converts the parties and agents of the OrgnlTxRef of a drawdown refusal to the
+// {4100} BeneficiaryFI, {4200} Beneficiary, {4400} AccountDebitedDrawdown and {5400} AccountCreditedDrawdown
+func partiesFromDrawdownReference(element string, ref *OriginalTransactionReference, fwm *wire.FEDWireMessage, report *Report) {
+	if agt := ref.CreditorAgent; agt != nil {
+		fwm.BeneficiaryFI = wire.NewBeneficiaryFI()
+		fwm.BeneficiaryFI.FinancialInstitution = financialInstitution(wire.TagBeneficiaryFI, element+"/CdtrAgt", *agt, report)
+	}
+	if ref.Creditor != nil {
+		fwm.Beneficiary = wire.NewBeneficiary()
+		fwm.Beneficiary.Personal = transactionPersonal(wire.TagBeneficiary, element+"/Cdtr", *ref.Creditor, nil, report)
+	}
+	if acct := ref.CreditorAccount; acct != nil {
+		id := acct.Identification.IBAN
+		if acct.Identification.Other != nil {
+			id = acct.Identification.Other.Identification
+		}
+		fwm.AccountCreditedDrawdown = wire.NewAccountCreditedDrawdown()
+		fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber = report.Fit(at(wire.TagAccountCreditedDrawdown, element+"/CdtrAcct/Id"), id, 9)
+	}
+	switch {
+	case ref.Debtor != nil:
+		p := transactionPersonal(wire.TagAccountDebitedDrawdown, element+"/Dbtr", *ref.Debtor, ref.DebtorAccount, report)
+		fwm.AccountDebitedDrawdown = wire.NewAccountDebitedDrawdown()
+		add := fwm.AccountDebitedDrawdown
+		add.IdentificationCode, add.Identifier, add.Name, add.Address = p.IdentificationCode, p.Identifier, p.Name, p.Address
+	case ref.DebtorAccount != nil:
+		report.NoTag(at("", element+"/DbtrAcct"), "")
+	}
+	if ref.DebtorAgent != nil {
+		report.NoTag(at("", element+"/DbtrAgt"), "")
+	}
+	if ref.InterbankSettlementAmount != nil {
+		report.NoTag(at("", element+"/IntrBkSttlmAmt"), "")
+	}
+	if ref.RemittanceInformation != nil {
+		report.NoTag(at("", element+"/RmtInf"), "")
+	}
+}
+
+// Validate checks the Camt029 message against the head.001 and camt.029 schemas, returning the first error found
+func (m *Camt029) Validate() error {
+	return m.ValidateAll().Err()
+}
+
+// ValidateAll checks the Camt029 message against the head.001 and camt.029 schemas, returning every error found
+func (m *Camt029) ValidateAll() base.ErrorList {
+	v := &validator{}
+	m.AppHdr.validate(v, "AppHdr")
+	v.code("AppHdr/MsgDefIdr", m.AppHdr.MessageDefinitionIdentifier, true, MessageDefinitionCamt029)
+	m.Document.ResolutionOfInvestigation.validate(v, "Document/RsltnOfInvstgtn")
+	return v.errs
+}
+
+func (rsltn *ResolutionOfInvestigation) validate(v *validator, path string) {
+	rsltn.Assignment.validate(v, path+"/Assgnmt")
+	v.text(path+"/Sts/Conf", rsltn.Status.Confirmation, 4, true)
+	for _, d := range rsltn.CancellationDetails {
+		for i := range d.TransactionInformationAndStatus {
+			d.TransactionInformationAndStatus[i].validate(v, path+"/CxlDtls/TxInfAndSts")
+		}
+	}
+}
+
+func (tx *PaymentTransactionStatus) validate(v *validator, path string) {
+	v.text(path+"/CxlStsId", tx.CancellationStatusIdentification, 35, false)
+	tx.OriginalGroupInformation.validate(v, path+"/OrgnlGrpInf")
+	v.text(path+"/OrgnlInstrId", tx.OriginalInstructionIdentification, 35, false)
+	v.text(path+"/OrgnlEndToEndId", tx.OriginalEndToEndIdentification, 35, false)
+	v.pattern(path+"/OrgnlUETR", tx.OriginalUETR, uuidv4Regex, false)
+	v.text(path+"/TxCxlSts", tx.TransactionCancellationStatus, 4, false)
+	for i := range tx.CancellationStatusReason {
+		tx.CancellationStatusReason[i].validate(v, path+"/CxlStsRsnInf")
+	}
+	if tx.OriginalInterbankSettlementAmount != nil {
+		tx.OriginalInterbankSettlementAmount.validate(v, path+"/OrgnlIntrBkSttlmAmt")
+	}
+	v.date(path+"/OrgnlIntrBkSttlmDt", tx.OriginalInterbankSettlementDate, false)
+	if tx.OriginalTransactionReference != nil {
+		tx.OriginalTransactionReference.validate(v, path+"/OrgnlTxRef")
+	}
+}
+
+// ReadCamt029 reads a Camt029 message from r
+func ReadCamt029(r io.Reader) (*Camt029, error) {
+	msg := &Camt029{}
+	if err := readXML(r, msg); err != nil {
+		return nil, err
+	}
+	if msg.Document.ResolutionOfInvestigation.Assignment.Identification == "" {
+		return nil, fmt.Errorf("%w: no RsltnOfInvstgtn", ErrMessageDefinition)
+	}
+	return msg, nil
+}
+
+// Write writes the Camt029 message to w as XML
+func (m *Camt029) Write(w io.Writer) error {
+	return writeXML(w, m)
+}
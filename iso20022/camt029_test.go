@@ -0,0 +1,163 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCamt029FromFEDWireMessage_BankDrawdownRefusal(t *testing.T) {
+	fixedNow(t)
+
+	fwm := referTo(readMessage(t, "fedWireMessage-BankDrawDownRequest.json"), wire.RefusalRequestCredit, sameDayIMAD)
+	msg, report, err := Camt029FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+	require.NoError(t, msg.Validate())
+
+	require.Equal(t, MessageDefinitionCamt029, msg.AppHdr.MessageDefinitionIdentifier)
+	rsltn := msg.Document.ResolutionOfInvestigation
+	require.Equal(t, InvestigationStatusRejected, rsltn.Status.Confirmation)
+	require.Len(t, rsltn.CancellationDetails, 1)
+
+	tx := rsltn.CancellationDetails[0].TransactionInformationAndStatus[0]
+	require.Equal(t, OriginalGroupInformation{
+		OriginalMessageIdentification:     sameDayIMAD,
+		OriginalMessageNameIdentification: MessageDefinitionPain013,
+	}, tx.OriginalGroupInformation)
+	require.Equal(t, InvestigationStatusRejected, tx.TransactionCancellationStatus)
+	require.Equal(t, uetr(sameDayIMAD), tx.OriginalUETR)
+	require.Equal(t, []string{"LineOne", "LineTwo", "LineThree", "LineFour"}, tx.CancellationStatusReason[0].AdditionalInformation)
+	require.Equal(t, "Name", tx.CancellationStatusReason[0].Originator.Name)
+
+	ref := tx.OriginalTransactionReference
+	require.Equal(t, LocalInstrumentBankDrawdownRequest, ref.PaymentTypeInformation.LocalInstrument.Proprietary)
+	require.Equal(t, "debitDD Name", ref.Debtor.Party.Name)
+	require.Equal(t, "123456789", ref.DebtorAccount.Identification.Other.Identification)
+	require.Equal(t, "231380104", ref.CreditorAccount.Identification.Other.Identification)
+	require.Equal(t, "FI Name", ref.CreditorAgent.FinancialInstitutionIdentification.Name)
+
+	tags := report.Tags()
+	for _, tag := range []string{wire.TagOriginatorFI, wire.TagInstructingFI, wire.TagBeneficiaryIntermediaryFI} {
+		require.Contains(t, tags, tag)
+	}
+	require.NotContains(t, tags, wire.TagTypeSubType)
+	require.NotContains(t, tags, wire.TagAccountDebitedDrawdown)
+	require.NotContains(t, tags, wire.TagOriginator)
+}
+
+func TestCamt029FromFEDWireMessage_Errors(t *testing.T) {
+	_, _, err := Camt029FromFEDWireMessage(readMessage(t, "fedWireMessage-BankDrawDownRequest.json"))
+	require.ErrorIs(t, err, ErrSubTypeCode)
+
+	_, _, err = Camt029FromFEDWireMessage(referTo(readMessage(t, "fedWireMessage-BankTransfer.json"), wire.RefusalRequestCredit, sameDayIMAD))
+	require.ErrorIs(t, err, ErrBusinessFunctionCode)
+
+	_, _, err = Camt029FromFEDWireMessage(nil)
+	require.ErrorIs(t, err, ErrNoMessage)
+}
+
+func TestCamt029_Validate(t *testing.T) {
+	fixedNow(t)
+
+	fwm := referTo(readMessage(t, "fedWireMessage-CustomerCorporateDrawDownRequest.json"), wire.RefusalRequestCredit, sameDayIMAD)
+	msg, _, err := Camt029FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+
+	rsltn := &msg.Document.ResolutionOfInvestigation
+	rsltn.Status.Confirmation = ""
+	tx := &rsltn.CancellationDetails[0].TransactionInformationAndStatus[0]
+	tx.OriginalInterbankSettlementDate = "20190410"
+	tx.OriginalTransactionReference.Debtor.Agent = tx.OriginalTransactionReference.CreditorAgent
+
+	errs := msg.ValidateAll()
+	require.Len(t, errs, 3)
+	require.Equal(t, errs[0], msg.Validate())
+
+	var elementErr *ElementError
+	require.True(t, errors.As(errs[0], &elementErr))
+	require.Equal(t, "Document/RsltnOfInvstgtn/Sts/Conf", elementErr.Element)
+	require.ErrorIs(t, errs[1], ErrElementFormat)
+	require.Contains(t, errs[2].Error(), "OrgnlTxRef/Dbtr")
+}
+
+func TestCamt029_WriteRead(t *testing.T) {
+	fixedNow(t)
+
+	fwm := referTo(readMessage(t, "fedWireMessage-BankDrawDownRequest.json"), wire.RefusalRequestCredit, sameDayIMAD)
+	msg, _, err := Camt029FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, msg.Write(&buf))
+	require.Contains(t, buf.String(), `<Document xmlns="`+NamespaceCamt029+`">`)
+	require.Contains(t, buf.String(), `<Conf>RJCR</Conf>`)
+
+	read, err := ReadCamt029(&buf)
+	require.NoError(t, err)
+	require.NoError(t, read.Validate())
+	require.Equal(t, msg.AppHdr, read.AppHdr)
+	require.Equal(t, msg.Document, read.Document)
+
+	_, err = ReadCamt029(bytes.NewReader([]byte("<Envelope></Envelope>")))
+	require.ErrorIs(t, err, ErrMessageDefinition)
+}
+
+func TestCamt029_FEDWireMessage(t *testing.T) {
+	fixedNow(t)
+
+	for _, name := range []string{
+		"fedWireMessage-BankDrawDownRequest.json",
+		"fedWireMessage-CustomerCorporateDrawDownRequest.json",
+		"fedWireMessage-ServiceMessage.json",
+	} {
+		t.Run(name, func(t *testing.T) {
+			fwm := referTo(readMessage(t, name), wire.RefusalRequestCredit, sameDayIMAD)
+			// Blank values are not carried by camt.029
+			fwm.BusinessFunctionCode.TransactionTypeCode = strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode)
+			msg, exported, err := Camt029FromFEDWireMessage(fwm)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, msg.Write(&buf))
+			read, err := ReadCamt029(&buf)
+			require.NoError(t, err)
+
+			got, imported, err := read.FEDWireMessage()
+			require.NoError(t, err)
+			require.NoError(t, got.ValidateAll().Err())
+			require.Equal(t, fwm.BusinessFunctionCode.BusinessFunctionCode, got.BusinessFunctionCode.BusinessFunctionCode)
+			require.Equal(t, wire.RefusalRequestCredit, got.TypeSubType.SubTypeCode)
+			requireSameTags(t, fwm, got, exported, imported)
+		})
+	}
+}
+
+func TestCamt029_FEDWireMessageErrors(t *testing.T) {
+	fixedNow(t)
+
+	fwm := referTo(readMessage(t, "fedWireMessage-BankDrawDownRequest.json"), wire.RefusalRequestCredit, sameDayIMAD)
+	msg, _, err := Camt029FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+
+	rsltn := &msg.Document.ResolutionOfInvestigation
+	rsltn.CancellationDetails[0].TransactionInformationAndStatus[0].OriginalInterbankSettlementAmount = nil
+	_, _, err = msg.FEDWireMessage()
+	require.ErrorIs(t, err, ErrElementRequired)
+
+	rsltn.CancellationDetails = nil
+	_, _, err = msg.FEDWireMessage()
+	require.ErrorIs(t, err, ErrElementRequired)
+
+	// Only refusals are converted
+	rsltn.Status.Confirmation = "CNCL"
+	_, _, err = msg.FEDWireMessage()
+	require.ErrorIs(t, err, ErrElementCode)
+}
@@ -0,0 +1,272 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/wire"
+)
+
+// Camt056 is a FIToFIPaymentCancellationRequest message and its business application header
+type Camt056 struct {
+	XMLName  xml.Name                  `xml:"Envelope"`
+	AppHdr   BusinessApplicationHeader `xml:"urn:iso:std:iso:20022:tech:xsd:head.001.001.03 AppHdr"`
+	Document Camt056Document           `xml:"urn:iso:std:iso:20022:tech:xsd:camt.056.001.08 Document"`
+}
+
+// Camt056Document is the Document of a Camt056 message
+type Camt056Document struct {
+	FIToFIPaymentCancellationRequest FIToFIPaymentCancellationRequest `xml:"FIToFIPmtCxlReq"`
+}
+
+// FIToFIPaymentCancellationRequest is the FIToFIPmtCxlReq of a Camt056Document
+type FIToFIPaymentCancellationRequest struct {
+	Assignment CaseAssignment          `xml:"Assgnmt"`
+	Underlying []UnderlyingTransaction `xml:"Undrlyg"`
+}
+
+// UnderlyingTransaction is an Undrlyg of a FIToFIPaymentCancellationRequest, the transactions to cancel
+type UnderlyingTransaction struct {
+	TransactionInformation []PaymentCancellationTransaction `xml:"TxInf"`
+}
+
+// PaymentCancellationTransaction is a TxInf of an UnderlyingTransaction, requesting the reversal of the
+// transaction its OrgnlGrpInf refers to
+type PaymentCancellationTransaction struct {
+	CancellationIdentification        string                        `xml:"CxlId,omitempty"`
+	OriginalGroupInformation          OriginalGroupInformation      `xml:"OrgnlGrpInf"`
+	OriginalInstructionIdentification string                        `xml:"OrgnlInstrId,omitempty"`
+	OriginalEndToEndIdentification    string                        `xml:"OrgnlEndToEndId,omitempty"`
+	OriginalUETR                      string                        `xml:"OrgnlUETR,omitempty"`
+	OriginalInterbankSettlementAmount *ActiveCurrencyAndAmount      `xml:"OrgnlIntrBkSttlmAmt,omitempty"`
+	OriginalInterbankSettlementDate   string                        `xml:"OrgnlIntrBkSttlmDt,omitempty"`
+	CancellationReasonInformation     []ReasonInformation           `xml:"CxlRsnInf,omitempty"`
+	OriginalTransactionReference      *OriginalTransactionReference `xml:"OrgnlTxRef,omitempty"`
+	UnknownElements                   []UnknownElement              `xml:",any"`
+}
+
+// Camt056FromFEDWireMessage converts a request for reversal, a CTP customer transfer or SVC service message with the
+// SubTypeCode RequestReversal or RequestReversalPriorDayTransfer, to a Camt056 message. The transfer to reverse is
+// given by the {3500} PreviousMessageIdentifier, as a pacs.008 customer transfer or, for service messages, a pacs.009
+// transfer. The reason for the request is the {6000} OriginatorToBeneficiary of customer transfers and the {9000}
+// ServiceMessage of service messages.
+//
+// Data of the FEDWireMessage which camt.056 has no element for is listed in the returned Report.
+func Camt056FromFEDWireMessage(fwm *wire.FEDWireMessage) (*Camt056, *Report, error) {
+	if err := requireBusinessFunctionCode(fwm, wire.CustomerTransferPlus, wire.BFCServiceMessage); err != nil {
+		return nil, nil, err
+	}
+	if err := requireSubTypeCode(fwm, wire.RequestReversal, wire.RequestReversalPriorDayTransfer); err != nil {
+		return nil, nil, err
+	}
+	report := &Report{}
+	id := messageIdentification(fwm.InputMessageAccountabilityData)
+	createdAt := created()
+
+	definition := MessageDefinitionPacs009
+	if fwm.BusinessFunctionCode.BusinessFunctionCode == wire.CustomerTransferPlus {
+		definition = MessageDefinitionPacs008
+	}
+	it := newInterbankTransfer(fwm, id)
+	tx := PaymentCancellationTransaction{
+		CancellationIdentification:        it.PaymentIdentification.InstructionIdentification,
+		OriginalGroupInformation:          originalGroupInformation(fwm, definition),
+		OriginalEndToEndIdentification:    it.PaymentIdentification.EndToEndIdentification,
+		OriginalInterbankSettlementAmount: &it.InterbankSettlementAmount,
+		OriginalInterbankSettlementDate:   originalSettlementDate(fwm),
+		OriginalTransactionReference:      originalTransactionReference(fwm, report),
+	}
+	tx.OriginalUETR = originalUETR(tx.OriginalGroupInformation)
+	subType := subTypeCode(fwm, tx.OriginalInterbankSettlementDate, wire.RequestReversal, wire.RequestReversalPriorDayTransfer)
+	reportTypeSubType(fwm, wire.FundsTransfer+subType, report)
+
+	lines, unmapped := narrative(fwm)
+	tx.CancellationReasonInformation = reasonInformation(lines)
+	reportUnmappedTags(fwm, report, append(camt056Unmapped, unmapped))
+
+	msg := &Camt056{
+		AppHdr: newBusinessApplicationHeader(fwm, MessageDefinitionCamt056, id, createdAt, report),
+		Document: Camt056Document{
+			FIToFIPaymentCancellationRequest: FIToFIPaymentCancellationRequest{
+				Assignment: newCaseAssignment(it, id, createdAt),
+				Underlying: []UnderlyingTransaction{{TransactionInformation: []PaymentCancellationTransaction{tx}}},
+			},
+		},
+	}
+	return msg, report, nil
+}
+
+// camt056Unmapped are the tags of requests for reversal which camt.056 has no element for
+var camt056Unmapped = []string{
+	wire.TagPaymentNotification,
+	wire.TagCharges,
+	wire.TagInstructedAmount,
+	wire.TagExchangeRate,
+	wire.TagBeneficiaryIntermediaryFI,
+	wire.TagAccountDebitedDrawdown,
+	wire.TagInstructingFI,
+	wire.TagAccountCreditedDrawdown,
+	wire.TagFIReceiverFI,
+	wire.TagFIDrawdownDebitAccountAdvice,
+	wire.TagFIIntermediaryFI,
+	wire.TagFIIntermediaryFIAdvice,
+	wire.TagFIBeneficiaryFI,
+	wire.TagFIBeneficiaryFIAdvice,
+	wire.TagFIBeneficiary,
+	wire.TagFIBeneficiaryAdvice,
+	wire.TagFIPaymentMethodToBeneficiary,
+	wire.TagFIAdditionalFIToFI,
+	wire.TagCurrencyInstructedAmount,
+	wire.TagOrderingCustomer,
+	wire.TagOrderingInstitution,
+	wire.TagIntermediaryInstitution,
+	wire.TagInstitutionAccount,
+	wire.TagBeneficiaryCustomer,
+	wire.TagRemittance,
+	wire.TagSenderToReceiver,
+	wire.TagUnstructuredAddenda,
+	wire.TagRelatedRemittance,
+	wire.TagRemittanceOriginator,
+	wire.TagRemittanceBeneficiary,
+	wire.TagPrimaryRemittanceDocument,
+	wire.TagActualAmountPaid,
+	wire.TagGrossAmountRemittanceDocument,
+	wire.TagAmountNegotiatedDiscount,
+	wire.TagAdjustment,
+	wire.TagDateRemittanceDocument,
+	wire.TagSecondaryRemittanceDocument,
+	wire.TagRemittanceFreeText,
+}
+
+// FEDWireMessage converts the Camt056 message to a request for reversal of the transfer its OrgnlGrpInf refers to.
+//
+// The request is a CTP customer transfer when the original message is a pacs.008, and an SVC service message
+// otherwise, and is for a prior day transfer when the original settlement date is before the message's. The
+// Assgnmt identifies the message, its sender and its receiver. ISO 20022 elements the FEDWireMessage has no tag for,
+// and values truncated to fit their tag, are listed in the returned Report. Only the first TxInf is converted.
+func (m *Camt056) FEDWireMessage() (*wire.FEDWireMessage, *Report, error) {
+	const path = "Document/FIToFIPmtCxlReq"
+	req := &m.Document.FIToFIPaymentCancellationRequest
+	if len(req.Underlying) == 0 || len(req.Underlying[0].TransactionInformation) == 0 {
+		return nil, nil, &ElementError{Element: path + "/Undrlyg/TxInf", Err: ErrElementRequired}
+	}
+	tx := &req.Underlying[0].TransactionInformation[0]
+	if tx.OriginalInterbankSettlementAmount == nil {
+		return nil, nil, &ElementError{Element: path + "/Undrlyg/TxInf/OrgnlIntrBkSttlmAmt", Err: ErrElementRequired}
+	}
+
+	report := &Report{}
+	if len(req.Underlying) > 1 || len(req.Underlying[0].TransactionInformation) > 1 {
+		report.NoTag(at("", "Undrlyg/TxInf"), "")
+	}
+	it := interbankTransfer{
+		PaymentIdentification: PaymentIdentification{
+			InstructionIdentification: tx.CancellationIdentification,
+			EndToEndIdentification:    tx.OriginalEndToEndIdentification,
+		},
+		InterbankSettlementAmount: *tx.OriginalInterbankSettlementAmount,
+		InstructingAgent:          assignmentAgent("Assgnmt/Assgnr", req.Assignment.Assigner, report),
+		InstructedAgent:           assignmentAgent("Assgnmt/Assgne", req.Assignment.Assignee, report),
+	}
+	fwm, err := it.fedWireMessage(&m.AppHdr, req.Assignment.Identification, transferElements{
+		MessageIdentification:     path + "/Assgnmt/Id",
+		Amount:                    path + "/Undrlyg/TxInf/OrgnlIntrBkSttlmAmt",
+		InstructingAgent:          "Assgnmt/Assgnr/Agt",
+		InstructedAgent:           "Assgnmt/Assgne/Agt",
+		InstructionIdentification: "Undrlyg/TxInf/CxlId",
+		EndToEndIdentification:    "Undrlyg/TxInf/OrgnlEndToEndId",
+	}, report)
+	if err != nil {
+		return nil, nil, err
+	}
+	bfc := fwm.BusinessFunctionCode
+	bfc.BusinessFunctionCode = wire.BFCServiceMessage
+	if tx.OriginalGroupInformation.OriginalMessageNameIdentification == MessageDefinitionPacs008 {
+		bfc.BusinessFunctionCode = wire.CustomerTransferPlus
+	}
+	fwm.TypeSubType.SubTypeCode = subTypeCode(fwm, tx.OriginalInterbankSettlementDate, wire.RequestReversal, wire.RequestReversalPriorDayTransfer)
+	previousMessageFromOriginal("Undrlyg/TxInf", tx.OriginalGroupInformation, tx.OriginalUETR, fwm, report)
+	if tx.OriginalInstructionIdentification != "" {
+		report.NoTag(at("", "Undrlyg/TxInf/OrgnlInstrId"), "")
+	}
+
+	if ref := tx.OriginalTransactionReference; ref != nil {
+		const element = "Undrlyg/TxInf/OrgnlTxRef"
+		if bfc.BusinessFunctionCode == wire.CustomerTransferPlus {
+			businessFunctionFromPaymentType(element+"/PmtTpInf", ref.PaymentTypeInformation, fwm, report, wire.CustomerTransferPlus)
+		} else if ref.PaymentTypeInformation != nil {
+			report.NoTag(at("", element+"/PmtTpInf"), "")
+		}
+		partiesFromReference(element, ref, fwm, report)
+	}
+	narrativeFromLines("Undrlyg/TxInf/CxlRsnInf/AddtlInf", reasonLines("Undrlyg/TxInf/CxlRsnInf", tx.CancellationReasonInformation, report), fwm, report)
+	for _, el := range tx.UnknownElements {
+		report.NoTag(at("", "Undrlyg/TxInf/"+el.XMLName.Local), "")
+	}
+	return fwm, report, nil
+}
+
+// Validate checks the Camt056 message against the head.001 and camt.056 schemas, returning the first error found
+func (m *Camt056) Validate() error {
+	return m.ValidateAll().Err()
+}
+
+// ValidateAll checks the Camt056 message against the head.001 and camt.056 schemas, returning every error found
+func (m *Camt056) ValidateAll() base.ErrorList {
+	v := &validator{}
+	m.AppHdr.validate(v, "AppHdr")
+	v.code("AppHdr/MsgDefIdr", m.AppHdr.MessageDefinitionIdentifier, true, MessageDefinitionCamt056)
+	m.Document.FIToFIPaymentCancellationRequest.validate(v, "Document/FIToFIPmtCxlReq")
+	return v.errs
+}
+
+func (req *FIToFIPaymentCancellationRequest) validate(v *validator, path string) {
+	req.Assignment.validate(v, path+"/Assgnmt")
+	v.required(path+"/Undrlyg", len(req.Underlying) > 0)
+	for _, u := range req.Underlying {
+		for i := range u.TransactionInformation {
+			u.TransactionInformation[i].validate(v, path+"/Undrlyg/TxInf")
+		}
+	}
+}
+
+func (tx *PaymentCancellationTransaction) validate(v *validator, path string) {
+	v.text(path+"/CxlId", tx.CancellationIdentification, 35, false)
+	tx.OriginalGroupInformation.validate(v, path+"/OrgnlGrpInf")
+	v.text(path+"/OrgnlInstrId", tx.OriginalInstructionIdentification, 35, false)
+	v.text(path+"/OrgnlEndToEndId", tx.OriginalEndToEndIdentification, 35, false)
+	v.pattern(path+"/OrgnlUETR", tx.OriginalUETR, uuidv4Regex, false)
+	if tx.OriginalInterbankSettlementAmount != nil {
+		tx.OriginalInterbankSettlementAmount.validate(v, path+"/OrgnlIntrBkSttlmAmt")
+	}
+	v.date(path+"/OrgnlIntrBkSttlmDt", tx.OriginalInterbankSettlementDate, false)
+	for i := range tx.CancellationReasonInformation {
+		tx.CancellationReasonInformation[i].validate(v, path+"/CxlRsnInf")
+	}
+	if tx.OriginalTransactionReference != nil {
+		tx.OriginalTransactionReference.validate(v, path+"/OrgnlTxRef")
+	}
+}
+
+// ReadCamt056 reads a Camt056 message from r
+func ReadCamt056(r io.Reader) (*Camt056, error) {
+	msg := &Camt056{}
+	if err := readXML(r, msg); err != nil {
+		return nil, err
+	}
+	if msg.Document.FIToFIPaymentCancellationRequest.Assignment.Identification == "" {
+		return nil, fmt.Errorf("%w: no FIToFIPmtCxlReq", ErrMessageDefinition)
+	}
+	return msg, nil
+}
+
+// Write writes the Camt056 message to w as XML
+func (m *Camt056) Write(w io.Writer) error {
+	return writeXML(w, m)
+}
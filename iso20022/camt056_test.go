@@ -0,0 +1,179 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCamt056FromFEDWireMessage_ServiceMessage(t *testing.T) {
+	fixedNow(t)
+
+	fwm := referTo(readMessage(t, "fedWireMessage-ServiceMessage.json"), wire.RequestReversal, sameDayIMAD)
+	msg, report, err := Camt056FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+	require.NoError(t, msg.Validate())
+
+	require.Equal(t, MessageDefinitionCamt056, msg.AppHdr.MessageDefinitionIdentifier)
+	req := msg.Document.FIToFIPaymentCancellationRequest
+	require.Equal(t, msg.AppHdr.BusinessMessageIdentifier, req.Assignment.Identification)
+	require.Equal(t, "121042882", req.Assignment.Assigner.Agent.FinancialInstitutionIdentification.ClearingSystemMemberIdentification.MemberIdentification)
+	require.Equal(t, "231380104", req.Assignment.Assignee.Agent.FinancialInstitutionIdentification.ClearingSystemMemberIdentification.MemberIdentification)
+	require.Len(t, req.Underlying, 1)
+
+	tx := req.Underlying[0].TransactionInformation[0]
+	require.Equal(t, OriginalGroupInformation{
+		OriginalMessageIdentification:     sameDayIMAD,
+		OriginalMessageNameIdentification: MessageDefinitionPacs009,
+	}, tx.OriginalGroupInformation)
+	require.Equal(t, uetr(sameDayIMAD), tx.OriginalUETR)
+	require.Equal(t, "2019-04-10", tx.OriginalInterbankSettlementDate)
+	require.Equal(t, "USD", tx.OriginalInterbankSettlementAmount.Currency)
+	require.Len(t, tx.CancellationReasonInformation[0].AdditionalInformation, 12)
+	require.NotNil(t, tx.OriginalTransactionReference.Debtor.Agent)
+	require.NotNil(t, tx.OriginalTransactionReference.CreditorAgent)
+
+	tags := report.Tags()
+	require.Contains(t, tags, wire.TagOriginatorToBeneficiary)
+	require.Contains(t, tags, wire.TagFIReceiverFI)
+	require.NotContains(t, tags, wire.TagServiceMessage)
+	require.NotContains(t, tags, wire.TagTypeSubType)
+}
+
+func TestCamt056FromFEDWireMessage_CustomerTransferPlus(t *testing.T) {
+	fixedNow(t)
+
+	fwm := referTo(readMessage(t, "fedWireMessage-CustomerTransferPlus.json"), wire.RequestReversalPriorDayTransfer, priorDayIMAD)
+	msg, report, err := Camt056FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+	require.NoError(t, msg.Validate())
+
+	tx := msg.Document.FIToFIPaymentCancellationRequest.Underlying[0].TransactionInformation[0]
+	require.Equal(t, MessageDefinitionPacs008, tx.OriginalGroupInformation.OriginalMessageNameIdentification)
+	require.Equal(t, "2019-04-09", tx.OriginalInterbankSettlementDate)
+	require.Equal(t, []string{"LineOne", "LineTwo", "LineThree", "LineFour"}, tx.CancellationReasonInformation[0].AdditionalInformation)
+	require.NotNil(t, tx.OriginalTransactionReference.Debtor.Party)
+	require.NotNil(t, tx.OriginalTransactionReference.Creditor.Party)
+	require.NotContains(t, report.Tags(), wire.TagTypeSubType)
+}
+
+func TestCamt056FromFEDWireMessage_Errors(t *testing.T) {
+	_, _, err := Camt056FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerTransferPlus.json"))
+	require.ErrorIs(t, err, ErrSubTypeCode)
+
+	_, _, err = Camt056FromFEDWireMessage(referTo(readMessage(t, "fedWireMessage-BankTransfer.json"), wire.RequestReversal, sameDayIMAD))
+	require.ErrorIs(t, err, ErrBusinessFunctionCode)
+
+	_, _, err = Camt056FromFEDWireMessage(nil)
+	require.ErrorIs(t, err, ErrNoMessage)
+}
+
+func TestCamt056_Validate(t *testing.T) {
+	fixedNow(t)
+
+	fwm := referTo(readMessage(t, "fedWireMessage-CustomerTransferPlus.json"), wire.RequestReversal, sameDayIMAD)
+	msg, _, err := Camt056FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+
+	req := &msg.Document.FIToFIPaymentCancellationRequest
+	req.Assignment.Assigner.Party = &PartyIdentification{Name: "Assigner"}
+	tx := &req.Underlying[0].TransactionInformation[0]
+	tx.OriginalUETR = "UETR"
+	tx.OriginalTransactionReference.DebtorAgent.FinancialInstitutionIdentification.BICFI = "BANK"
+
+	errs := msg.ValidateAll()
+	require.Len(t, errs, 3)
+	require.Equal(t, errs[0], msg.Validate())
+
+	var elementErr *ElementError
+	require.True(t, errors.As(errs[0], &elementErr))
+	require.Equal(t, "Document/FIToFIPmtCxlReq/Assgnmt/Assgnr", elementErr.Element)
+	require.ErrorIs(t, errs[1], ErrElementFormat)
+	require.Contains(t, errs[2].Error(), "OrgnlTxRef/DbtrAgt/FinInstnId/BICFI")
+}
+
+func TestCamt056_WriteRead(t *testing.T) {
+	fixedNow(t)
+
+	fwm := referTo(readMessage(t, "fedWireMessage-ServiceMessage.json"), wire.RequestReversal, sameDayIMAD)
+	msg, _, err := Camt056FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, msg.Write(&buf))
+	require.Contains(t, buf.String(), `<Document xmlns="`+NamespaceCamt056+`">`)
+	require.Contains(t, buf.String(), `<CxlRsnInf>`)
+
+	read, err := ReadCamt056(&buf)
+	require.NoError(t, err)
+	require.NoError(t, read.Validate())
+	require.Equal(t, msg.AppHdr, read.AppHdr)
+	require.Equal(t, msg.Document, read.Document)
+
+	_, err = ReadCamt056(bytes.NewReader([]byte("<Envelope></Envelope>")))
+	require.ErrorIs(t, err, ErrMessageDefinition)
+}
+
+func TestCamt056_FEDWireMessage(t *testing.T) {
+	fixedNow(t)
+
+	for _, name := range []string{
+		"fedWireMessage-ServiceMessage.json",
+		"fedWireMessage-CustomerTransferPlus.json",
+	} {
+		for subType, imad := range map[string]string{
+			wire.RequestReversal:                 sameDayIMAD,
+			wire.RequestReversalPriorDayTransfer: priorDayIMAD,
+		} {
+			t.Run(name+"/"+subType, func(t *testing.T) {
+				fwm := referTo(readMessage(t, name), subType, imad)
+				// Blank values are not carried by camt.056
+				fwm.BusinessFunctionCode.TransactionTypeCode = strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode)
+				msg, exported, err := Camt056FromFEDWireMessage(fwm)
+				require.NoError(t, err)
+
+				var buf bytes.Buffer
+				require.NoError(t, msg.Write(&buf))
+				read, err := ReadCamt056(&buf)
+				require.NoError(t, err)
+
+				got, imported, err := read.FEDWireMessage()
+				require.NoError(t, err)
+				require.NoError(t, got.ValidateAll().Err())
+				require.Equal(t, fwm.BusinessFunctionCode.BusinessFunctionCode, got.BusinessFunctionCode.BusinessFunctionCode)
+				require.Equal(t, subType, got.TypeSubType.SubTypeCode)
+				requireSameTags(t, fwm, got, exported, imported)
+			})
+		}
+	}
+}
+
+func TestCamt056_FEDWireMessageErrors(t *testing.T) {
+	fixedNow(t)
+
+	fwm := referTo(readMessage(t, "fedWireMessage-ServiceMessage.json"), wire.RequestReversal, sameDayIMAD)
+	msg, _, err := Camt056FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+
+	req := &msg.Document.FIToFIPaymentCancellationRequest
+	req.Assignment.Identification = "Case"
+	msg.AppHdr.BusinessMessageIdentifier = "Case"
+	_, _, err = msg.FEDWireMessage()
+	require.ErrorIs(t, err, ErrElementFormat)
+
+	req.Underlying[0].TransactionInformation[0].OriginalInterbankSettlementAmount = nil
+	_, _, err = msg.FEDWireMessage()
+	require.ErrorIs(t, err, ErrElementRequired)
+
+	req.Underlying = nil
+	_, _, err = msg.FEDWireMessage()
+	require.ErrorIs(t, err, ErrElementRequired)
+}
@@ -0,0 +1,912 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/moov-io/wire"
+)
+
+const (
+	// isoDate is the layout of an ISODate
+	isoDate = "2006-01-02"
+	// faimDate is the layout of a FEDWireMessage date
+	faimDate = "20060102"
+	// isoDateTime is the layout of an ISODateTime
+	isoDateTime = "2006-01-02T15:04:05Z07:00"
+
+	// currencyUSD is the currency of Fedwire Funds Service settlement
+	currencyUSD = "USD"
+
+	// channelPaymentNotification is the ChannelType of the {3620} PaymentNotificationIndicator
+	channelPaymentNotification = "FWPN"
+	// channelOther is the ChannelType of a ContactOther
+	channelOther = "OTHR"
+)
+
+// now returns the time messages are created, and is replaced in tests
+var now = time.Now
+
+// created returns the ISODateTime messages are created at
+func created() string {
+	return now().Truncate(time.Second).Format(isoDateTime)
+}
+
+// parseDateTime parses an ISODateTime with or without a time zone
+func parseDateTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		t, err = time.Parse("2006-01-02T15:04:05.999999999", value)
+	}
+	return t, err
+}
+
+// settlementDate returns the ISODate of an InputCycleDate
+func settlementDate(cycleDate string) string {
+	t, err := time.Parse(faimDate, cycleDate)
+	if err != nil {
+		return cycleDate
+	}
+	return t.Format(isoDate)
+}
+
+// messageIdentification returns the IMAD of imad as a MessageIdentification
+func messageIdentification(imad *wire.InputMessageAccountabilityData) string {
+	if imad == nil {
+		return ""
+	}
+	return imad.InputCycleDate + imad.InputSource + imad.InputSequenceNumber
+}
+
+// uetr returns the unique end-to-end transaction reference of the message with MessageIdentification id.
+//
+// The UETR is a version 4 UUID derived from id, so converting a message again gives the same UETR.
+func uetr(id string) string {
+	sum := sha1.Sum([]byte(id)) //nolint:gosec
+	sum[6] = (sum[6] & 0x0f) | 0x40
+	sum[8] = (sum[8] & 0x3f) | 0x80
+	buf := hex.EncodeToString(sum[:16])
+	return buf[0:8] + "-" + buf[8:12] + "-" + buf[12:16] + "-" + buf[16:20] + "-" + buf[20:32]
+}
+
+// decimal returns a FEDWireMessage amount or rate, which may use a decimal comma, as a decimal
+func decimal(value string) string {
+	value = strings.Replace(strings.TrimSpace(value), ",", ".", 1)
+	for len(value) > 1 && value[0] == '0' && value[1] != '.' {
+		value = value[1:]
+	}
+	return value
+}
+
+// currencyAmount returns the amount of a FEDWireMessage value holding a currency code followed by an amount
+func currencyAmount(value string) *ActiveCurrencyAndAmount {
+	value = strings.TrimSpace(value)
+	if len(value) < 3 {
+		return nil
+	}
+	return &ActiveCurrencyAndAmount{Currency: value[:3], Value: decimal(value[3:])}
+}
+
+// remittanceAmount returns the amount of a {8450} to {8600} RemittanceAmount
+func remittanceAmount(amt wire.RemittanceAmount) ActiveCurrencyAndAmount {
+	return ActiveCurrencyAndAmount{Currency: amt.CurrencyCode, Value: decimal(amt.Amount)}
+}
+
+// textLines returns lines up to the last which is not empty, with empty lines
+// before it replaced by a space so each line keeps its position
+func textLines(lines ...string) []string {
+	last := -1
+	for i := range lines {
+		if strings.TrimSpace(lines[i]) != "" {
+			last = i
+		}
+	}
+	var out []string
+	for _, line := range lines[:last+1] {
+		if strings.TrimSpace(line) == "" {
+			line = " "
+		}
+		out = append(out, line)
+	}
+	return out
+}
+
+// postalAddress returns the PostalAddress of a FEDWireMessage Address
+func postalAddress(addr wire.Address) *PostalAddress {
+	lines := textLines(addr.AddressLineOne, addr.AddressLineTwo, addr.AddressLineThree)
+	if len(lines) == 0 {
+		return nil
+	}
+	return &PostalAddress{AddressLine: lines}
+}
+
+// coverAddress returns the lines of a {7xxx} CoverPayment as a PostalAddress
+func coverAddress(cp wire.CoverPayment) *PostalAddress {
+	lines := textLines(cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree, cp.SwiftLineFour, cp.SwiftLineFive, cp.SwiftLineSix)
+	if len(lines) == 0 {
+		return nil
+	}
+	return &PostalAddress{AddressLine: lines}
+}
+
+// remittanceAddress returns the structured PostalAddress of {8250} to {8350} RemittanceData
+func remittanceAddress(rd wire.RemittanceData) *PostalAddress {
+	if rd.AddressType+rd.Department+rd.SubDepartment+rd.StreetName+rd.BuildingNumber+rd.PostCode+rd.TownName+
+		rd.CountrySubDivisionState+rd.Country+rd.AddressLineOne+rd.AddressLineTwo+rd.AddressLineThree+
+		rd.AddressLineFour+rd.AddressLineFive+rd.AddressLineSix+rd.AddressLineSeven == "" {
+		return nil
+	}
+	adr := &PostalAddress{
+		Department:         rd.Department,
+		SubDepartment:      rd.SubDepartment,
+		StreetName:         rd.StreetName,
+		BuildingNumber:     rd.BuildingNumber,
+		PostCode:           rd.PostCode,
+		TownName:           rd.TownName,
+		CountrySubDivision: rd.CountrySubDivisionState,
+		Country:            rd.Country,
+		AddressLine: textLines(rd.AddressLineOne, rd.AddressLineTwo, rd.AddressLineThree, rd.AddressLineFour,
+			rd.AddressLineFive, rd.AddressLineSix, rd.AddressLineSeven),
+	}
+	if rd.AddressType != "" {
+		adr.AddressType = &CodeOrProprietary{Code: rd.AddressType}
+	}
+	return adr
+}
+
+// routingNumberAgent returns the agent with the ABA routing number aba
+func routingNumberAgent(aba string) BranchAndFinancialInstitutionIdentification {
+	return BranchAndFinancialInstitutionIdentification{
+		FinancialInstitutionIdentification: FinancialInstitutionIdentification{
+			ClearingSystemMemberIdentification: &ClearingSystemMemberIdentification{
+				ClearingSystemIdentification: &ClearingSystemIdentification{Code: ClearingSystemUSABA},
+				MemberIdentification:         aba,
+			},
+		},
+	}
+}
+
+// agent returns the agent identified by a FEDWireMessage FinancialInstitution.
+//
+// BICs, ABA routing numbers and CHIPS participant identifiers are identified as such, and any
+// other identifier as Other identification in a scheme named by its IdentificationCode.
+func agent(fi wire.FinancialInstitution) BranchAndFinancialInstitutionIdentification {
+	var agt BranchAndFinancialInstitutionIdentification
+	id := &agt.FinancialInstitutionIdentification
+	switch {
+	case fi.Identifier == "" && fi.IdentificationCode == "":
+	case fi.IdentificationCode == wire.SWIFTBankIdentifierCode:
+		id.BICFI = fi.Identifier
+	case fi.IdentificationCode == wire.FEDRoutingNumber:
+		agt = routingNumberAgent(fi.Identifier)
+	case fi.IdentificationCode == wire.CHIPSParticipant:
+		agt = routingNumberAgent(fi.Identifier)
+		id.ClearingSystemMemberIdentification.ClearingSystemIdentification.Code = ClearingSystemCHIPSParticipant
+	default:
+		id.Other = &GenericIdentification{
+			Identification: fi.Identifier,
+			SchemeName:     &CodeOrProprietary{Proprietary: fi.IdentificationCode},
+		}
+	}
+	id.Name = fi.Name
+	id.PostalAddress = postalAddress(fi.Address)
+	return agt
+}
+
+// personCodes are the ISO 20022 codes of FEDWireMessage IdentificationCodes of people
+var personCodes = map[string]string{
+	wire.PassportNumber:          "CCPT",
+	wire.TaxIdentificationNumber: "TXID",
+	wire.DriversLicenseNumber:    "DRLC",
+	wire.AlienRegistrationNumber: "ARNU",
+}
+
+// party returns the party identified by a FEDWireMessage Personal, and its account.
+//
+// Demand deposit account numbers identify the party's account, BICs the organisation, and passport, tax,
+// driver's license and alien registration numbers the person. Any other identifier is Other identification
+// of the organisation in a scheme named by its IdentificationCode.
+func party(p wire.Personal) (PartyIdentification, *CashAccount) {
+	pty := PartyIdentification{
+		Name:          p.Name,
+		PostalAddress: postalAddress(p.Address),
+	}
+	var acct *CashAccount
+	switch code := p.IdentificationCode; {
+	case p.Identifier == "" && code == "":
+	case code == wire.DemandDepositAccountNumber:
+		acct = &CashAccount{Identification: AccountIdentification{Other: &GenericIdentification{Identification: p.Identifier}}}
+	case code == wire.SWIFTBankIdentifierCode:
+		pty.Identification = &Party{OrganisationIdentification: &OrganisationIdentification{AnyBIC: p.Identifier}}
+	case personCodes[code] != "":
+		pty.Identification = &Party{PrivateIdentification: &PersonIdentification{Other: []GenericIdentification{{
+			Identification: p.Identifier,
+			SchemeName:     &CodeOrProprietary{Code: personCodes[code]},
+		}}}}
+	default:
+		pty.Identification = &Party{OrganisationIdentification: &OrganisationIdentification{Other: []GenericIdentification{{
+			Identification: p.Identifier,
+			SchemeName:     &CodeOrProprietary{Proprietary: code},
+		}}}}
+	}
+	return pty, acct
+}
+
+// optionFScheme names the scheme of the PartyIdentifier of a {5010} OriginatorOptionF
+const optionFScheme = "F"
+
+// optionFParty returns the party of a {5010} OriginatorOptionF
+func optionFParty(of *wire.OriginatorOptionF) PartyIdentification {
+	return PartyIdentification{
+		Name:          of.Name,
+		PostalAddress: postalAddress(wire.Address{AddressLineOne: of.LineOne, AddressLineTwo: of.LineTwo, AddressLineThree: of.LineThree}),
+		Identification: &Party{PrivateIdentification: &PersonIdentification{Other: []GenericIdentification{{
+			Identification: of.PartyIdentifier,
+			SchemeName:     &CodeOrProprietary{Proprietary: optionFScheme},
+		}}}},
+	}
+}
+
+// remittanceParty returns the party of a {8300} RemittanceOriginator or {8350} RemittanceBeneficiary
+func remittanceParty(tag, idType, idCode, idNumber, issuer string, rd wire.RemittanceData, report *Report) *PartyIdentification {
+	pty := &PartyIdentification{
+		Name:               rd.Name,
+		PostalAddress:      remittanceAddress(rd),
+		CountryOfResidence: rd.CountryOfResidence,
+	}
+	id := GenericIdentification{
+		Identification: idNumber,
+		SchemeName:     &CodeOrProprietary{Code: idCode},
+		Issuer:         issuer,
+	}
+	switch {
+	case idNumber == "":
+		if idType != "" || idCode != "" {
+			report.Unmapped(tag, "IdentificationType without an IdentificationNumber", nowhere)
+		}
+	case idType == wire.OrganizationID:
+		pty.Identification = &Party{OrganisationIdentification: &OrganisationIdentification{Other: []GenericIdentification{id}}}
+	default:
+		pty.Identification = &Party{PrivateIdentification: &PersonIdentification{Other: []GenericIdentification{id}}}
+	}
+	if rd.DateBirthPlace != "" {
+		report.Unmapped(tag, "DateBirthPlace", nowhere)
+	}
+	return pty
+}
+
+// referredDocument returns the ReferredDocumentInformation of a {8400} PrimaryRemittanceDocument or {8700} SecondaryRemittanceDocument
+func referredDocument(code, proprietary, number, issuer string) ReferredDocumentInformation {
+	tp := &ReferredDocumentType{CodeOrProprietary: CodeOrProprietary{Code: code}, Issuer: issuer}
+	if code == wire.ProprietaryDocumentType {
+		tp.CodeOrProprietary = CodeOrProprietary{Proprietary: proprietary}
+	}
+	return ReferredDocumentInformation{Type: tp, Number: number}
+}
+
+// businessFunctionFromPaymentType sets the {3600} BusinessFunctionCode of fwm to the one of codes whose LocalInstrument
+// is the LclInstrm of pti, or else to the first of codes. The CtgyPurp of a CTR customer transfer is its
+// TransactionTypeCode, and any other LclInstrm of a CTP customer transfer its {3610} LocalInstrument.
+func businessFunctionFromPaymentType(element string, pti *PaymentTypeInformation, fwm *wire.FEDWireMessage, report *Report, codes ...string) {
+	var instrument, purpose string
+	if pti != nil {
+		instrument = codeOf(pti.LocalInstrument)
+		purpose = codeOf(pti.CategoryPurpose)
+	}
+	bfc := fwm.BusinessFunctionCode
+	bfc.BusinessFunctionCode = codes[0]
+	for _, code := range codes {
+		if li := businessFunctionInstruments[code]; li != "" && li == instrument {
+			bfc.BusinessFunctionCode = code
+			instrument = ""
+		}
+	}
+	switch bfc.BusinessFunctionCode {
+	case wire.CustomerTransfer:
+		bfc.TransactionTypeCode = report.Fit(at(wire.TagBusinessFunctionCode, element+"/CtgyPurp"), purpose, 3)
+		purpose = ""
+	case wire.CustomerTransferPlus:
+		if instrument != "" {
+			fwm.LocalInstrument = localInstrument(element+"/LclInstrm", instrument, report)
+			instrument = ""
+		}
+	}
+	if instrument != "" {
+		report.NoTag(at("", element+"/LclInstrm"), "")
+	}
+	if purpose != "" {
+		report.NoTag(at("", element+"/CtgyPurp"), "")
+	}
+}
+
+// localInstrument returns the {3610} LocalInstrument of a CTP customer transfer with the LclInstrm instrument
+func localInstrument(element, instrument string, report *Report) *wire.LocalInstrument {
+	li := wire.NewLocalInstrument()
+	for _, code := range localInstrumentCodes {
+		if instrument == code {
+			li.LocalInstrumentCode = code
+			return li
+		}
+	}
+	li.LocalInstrumentCode = wire.ProprietaryLocalInstrumentCode
+	li.ProprietaryCode = report.Fit(at(wire.TagLocalInstrument, element), instrument, 35)
+	return li
+}
+
+// inputMessageAccountabilityData returns the {1520} IMAD of a MessageIdentification, or nil when id is not an IMAD
+func inputMessageAccountabilityData(id string) *wire.InputMessageAccountabilityData {
+	if len(id) != 22 || !numericRegex.MatchString(id[16:]) {
+		return nil
+	}
+	if _, err := time.Parse(faimDate, id[:8]); err != nil {
+		return nil
+	}
+	imad := wire.NewInputMessageAccountabilityData()
+	imad.InputCycleDate = id[:8]
+	imad.InputSource = id[8:16]
+	imad.InputSequenceNumber = id[16:]
+	return imad
+}
+
+// faimDecimal returns a decimal as a FEDWireMessage amount or rate, which uses a decimal comma
+func faimDecimal(value string) string {
+	if !strings.Contains(value, ".") {
+		return value + ","
+	}
+	return strings.Replace(value, ".", ",", 1)
+}
+
+// faimDateOf returns an ISODate as a FEDWireMessage date, or value when it is not an ISODate
+func faimDateOf(value string) string {
+	t, err := time.Parse(isoDate, value)
+	if err != nil {
+		return value
+	}
+	return t.Format(faimDate)
+}
+
+// codeOf returns the Code of c, or its Proprietary value
+func codeOf(c *CodeOrProprietary) string {
+	if c == nil {
+		return ""
+	}
+	if c.Code != "" {
+		return c.Code
+	}
+	return c.Proprietary
+}
+
+// joinText returns the values which are not empty separated by a space
+func joinText(values ...string) string {
+	var out []string
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return strings.Join(out, " ")
+}
+
+// addressLines returns the lines of adr, composing them from its structured elements when it has no AddressLine
+func addressLines(adr *PostalAddress) []string {
+	if adr == nil {
+		return nil
+	}
+	if len(adr.AddressLine) > 0 {
+		return adr.AddressLine
+	}
+	var lines []string
+	for _, line := range []string{
+		joinText(adr.Department, adr.SubDepartment),
+		joinText(adr.BuildingNumber, adr.StreetName),
+		joinText(adr.TownName, adr.CountrySubDivision, adr.PostCode),
+		adr.Country,
+	} {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// address returns the FEDWireMessage Address of a PostalAddress
+func address(tag, element string, adr *PostalAddress, report *Report) wire.Address {
+	if adr != nil && len(adr.AddressLine) > 0 && joinText(adr.Department, adr.SubDepartment, adr.StreetName, adr.BuildingNumber,
+		adr.PostCode, adr.TownName, adr.CountrySubDivision, adr.Country) != "" {
+		report.Truncated(at(tag, element))
+	}
+	lines := report.FitLineSlots(at(tag, element), addressLines(adr), 3, 35)
+	return wire.Address{AddressLineOne: lines[0], AddressLineTwo: lines[1], AddressLineThree: lines[2]}
+}
+
+// remittanceData returns the {8250} to {8350} RemittanceData of a structured PostalAddress
+func remittanceData(tag, element string, adr *PostalAddress, report *Report) wire.RemittanceData {
+	if adr == nil {
+		return wire.RemittanceData{}
+	}
+	lines := report.FitLineSlots(at(tag, element+"/AdrLine"), adr.AddressLine, 7, 70)
+	return wire.RemittanceData{
+		AddressType:             report.Fit(at(tag, element+"/AdrTp"), codeOf(adr.AddressType), 4),
+		Department:              report.Fit(at(tag, element+"/Dept"), adr.Department, 70),
+		SubDepartment:           report.Fit(at(tag, element+"/SubDept"), adr.SubDepartment, 70),
+		StreetName:              report.Fit(at(tag, element+"/StrtNm"), adr.StreetName, 70),
+		BuildingNumber:          report.Fit(at(tag, element+"/BldgNb"), adr.BuildingNumber, 16),
+		PostCode:                report.Fit(at(tag, element+"/PstCd"), adr.PostCode, 16),
+		TownName:                report.Fit(at(tag, element+"/TwnNm"), adr.TownName, 35),
+		CountrySubDivisionState: report.Fit(at(tag, element+"/CtrySubDvsn"), adr.CountrySubDivision, 35),
+		Country:                 report.Fit(at(tag, element+"/Ctry"), adr.Country, 2),
+		AddressLineOne:          lines[0],
+		AddressLineTwo:          lines[1],
+		AddressLineThree:        lines[2],
+		AddressLineFour:         lines[3],
+		AddressLineFive:         lines[4],
+		AddressLineSix:          lines[5],
+		AddressLineSeven:        lines[6],
+	}
+}
+
+// routingNumber returns the ABA routing number of the first of agents identified by one
+func routingNumber(agents ...BranchAndFinancialInstitutionIdentification) string {
+	for _, agt := range agents {
+		mmb := agt.FinancialInstitutionIdentification.ClearingSystemMemberIdentification
+		if mmb != nil && (mmb.ClearingSystemIdentification == nil || mmb.ClearingSystemIdentification.Code == ClearingSystemUSABA) {
+			return mmb.MemberIdentification
+		}
+	}
+	return ""
+}
+
+// financialInstitution returns the FEDWireMessage FinancialInstitution of an agent.
+//
+// BICs, ABA routing numbers and CHIPS participant identifiers are identified as such, and Other
+// identification by the IdentificationCode naming its scheme.
+func financialInstitution(tag, element string, agt BranchAndFinancialInstitutionIdentification, report *Report) wire.FinancialInstitution {
+	id := agt.FinancialInstitutionIdentification
+	element += "/FinInstnId"
+	fi := wire.FinancialInstitution{
+		Name:    report.Fit(at(tag, element+"/Nm"), id.Name, 35),
+		Address: address(tag, element+"/PstlAdr", id.PostalAddress, report),
+	}
+	mmb := id.ClearingSystemMemberIdentification
+	switch {
+	case id.BICFI != "":
+		fi.IdentificationCode = wire.SWIFTBankIdentifierCode
+		fi.Identifier = id.BICFI
+		if mmb != nil {
+			report.NoTag(at("", element+"/ClrSysMmbId"), "")
+		}
+	case mmb != nil:
+		var clearingSystem string
+		if mmb.ClearingSystemIdentification != nil {
+			clearingSystem = mmb.ClearingSystemIdentification.Code
+		}
+		switch clearingSystem {
+		case "", ClearingSystemUSABA:
+			fi.IdentificationCode = wire.FEDRoutingNumber
+			fi.Identifier = mmb.MemberIdentification
+		case ClearingSystemCHIPSParticipant:
+			fi.IdentificationCode = wire.CHIPSParticipant
+			fi.Identifier = mmb.MemberIdentification
+		default:
+			report.NoTag(at("", element+"/ClrSysMmbId"), "")
+		}
+	case id.Other != nil:
+		fi.IdentificationCode = codeOf(id.Other.SchemeName)
+		if len(fi.IdentificationCode) != 1 {
+			report.NoTag(at("", element+"/Othr/SchmeNm"), "")
+			fi.IdentificationCode = wire.DemandDepositAccountNumber
+		}
+		fi.Identifier = id.Other.Identification
+	}
+	if id.Other != nil && (id.BICFI != "" || mmb != nil) {
+		report.NoTag(at("", element+"/Othr"), "")
+	}
+	fi.Identifier = report.Fit(at(tag, element), fi.Identifier, 34)
+	return fi
+}
+
+// otherIdentification returns the first Other identification of a party, and whether it has more
+func otherIdentification(id *Party) (*GenericIdentification, bool) {
+	var others []GenericIdentification
+	if id.OrganisationIdentification != nil {
+		others = append(others, id.OrganisationIdentification.Other...)
+	}
+	if id.PrivateIdentification != nil {
+		others = append(others, id.PrivateIdentification.Other...)
+	}
+	if len(others) == 0 {
+		return nil, false
+	}
+	return &others[0], len(others) > 1
+}
+
+// identificationCode returns the FEDWireMessage IdentificationCode of a party identification scheme
+func identificationCode(scheme *CodeOrProprietary) string {
+	code := codeOf(scheme)
+	for faim, iso := range personCodes {
+		if code == iso {
+			return faim
+		}
+	}
+	if len(code) == 1 {
+		return code
+	}
+	return wire.OtherIdentification
+}
+
+// personal returns the FEDWireMessage Personal of a party and its account.
+//
+// An account identifies the party by its demand deposit account number, otherwise the party's BIC or
+// its first Other identification is used.
+func personal(tag, element string, pty PartyIdentification, acct *CashAccount, report *Report) wire.Personal {
+	p := wire.Personal{
+		Name:    report.Fit(at(tag, element+"/Nm"), pty.Name, 35),
+		Address: address(tag, element+"/PstlAdr", pty.PostalAddress, report),
+	}
+	switch id := pty.Identification; {
+	case acct != nil:
+		p.IdentificationCode = wire.DemandDepositAccountNumber
+		p.Identifier = acct.Identification.IBAN
+		if acct.Identification.Other != nil {
+			p.Identifier = acct.Identification.Other.Identification
+		}
+		if id != nil {
+			report.NoTag(at("", element+"/Id"), "")
+		}
+	case id == nil:
+	case id.OrganisationIdentification != nil && id.OrganisationIdentification.AnyBIC != "":
+		p.IdentificationCode = wire.SWIFTBankIdentifierCode
+		p.Identifier = id.OrganisationIdentification.AnyBIC
+		if other, _ := otherIdentification(id); other != nil {
+			report.NoTag(at("", element+"/Id"), "")
+		}
+	default:
+		other, more := otherIdentification(id)
+		if other == nil || more {
+			report.NoTag(at("", element+"/Id"), "")
+		}
+		if other != nil {
+			p.IdentificationCode = identificationCode(other.SchemeName)
+			p.Identifier = other.Identification
+		}
+	}
+	p.Identifier = report.Fit(at(tag, element+"/Id"), p.Identifier, 34)
+	if pty.CountryOfResidence != "" {
+		report.NoTag(at("", element+"/CtryOfRes"), "")
+	}
+	return p
+}
+
+// isOptionFParty returns true when pty is identified in the scheme of {5010} OriginatorOptionF PartyIdentifiers
+func isOptionFParty(pty PartyIdentification) bool {
+	if pty.Identification == nil {
+		return false
+	}
+	other, _ := otherIdentification(pty.Identification)
+	return other != nil && other.SchemeName != nil && other.SchemeName.Proprietary == optionFScheme
+}
+
+// originatorOptionF returns the {5010} OriginatorOptionF of a party identified by isOptionFParty
+func originatorOptionF(element string, pty PartyIdentification, report *Report) *wire.OriginatorOptionF {
+	tag := wire.TagOriginatorOptionF
+	of := wire.NewOriginatorOptionF()
+	other, more := otherIdentification(pty.Identification)
+	if more {
+		report.NoTag(at("", element+"/Id"), "")
+	}
+	of.PartyIdentifier = report.Fit(at(tag, element+"/Id"), other.Identification, 35)
+	of.Name = report.Fit(at(tag, element+"/Nm"), pty.Name, 35)
+	lines := report.FitLineSlots(at(tag, element+"/PstlAdr"), addressLines(pty.PostalAddress), 3, 35)
+	of.LineOne, of.LineTwo, of.LineThree = lines[0], lines[1], lines[2]
+	if pty.CountryOfResidence != "" {
+		report.NoTag(at("", element+"/CtryOfRes"), "")
+	}
+	return of
+}
+
+// remittanceIdentification returns the IdentificationType, IdentificationCode, IdentificationNumber and
+// IdentificationNumberIssuer of a {8300} RemittanceOriginator or {8350} RemittanceBeneficiary party
+func remittanceIdentification(tag, element string, pty *PartyIdentification, report *Report) (string, string, string, string) {
+	id := pty.Identification
+	if id == nil {
+		return "", "", "", ""
+	}
+	if id.OrganisationIdentification != nil && id.OrganisationIdentification.AnyBIC != "" {
+		if other, _ := otherIdentification(id); other != nil {
+			report.NoTag(at("", element+"/Id"), "")
+		}
+		return wire.OrganizationID, wire.OICSWIFTBICORBEI, id.OrganisationIdentification.AnyBIC, ""
+	}
+	other, more := otherIdentification(id)
+	if other == nil {
+		return "", "", "", ""
+	}
+	if more {
+		report.NoTag(at("", element+"/Id"), "")
+	}
+	idType := wire.PrivateID
+	if id.OrganisationIdentification != nil && len(id.OrganisationIdentification.Other) > 0 {
+		idType = wire.OrganizationID
+	}
+	return idType,
+		report.Fit(at(tag, element+"/Id/Othr/SchmeNm"), codeOf(other.SchemeName), 4),
+		report.Fit(at(tag, element+"/Id/Othr/Id"), other.Identification, 35),
+		report.Fit(at(tag, element+"/Id/Othr/Issr"), other.Issuer, 35)
+}
+
+// documentIdentification returns the DocumentTypeCode, ProprietaryDocumentTypeCode, DocumentIdentificationNumber
+// and Issuer of a {8400} PrimaryRemittanceDocument or {8700} SecondaryRemittanceDocument
+func documentIdentification(tag, element string, doc ReferredDocumentInformation, report *Report) (string, string, string, string) {
+	var code, proprietary, issuer string
+	if tp := doc.Type; tp != nil {
+		code = report.Fit(at(tag, element+"/Tp/CdOrPrtry/Cd"), tp.CodeOrProprietary.Code, 4)
+		if code == "" && tp.CodeOrProprietary.Proprietary != "" {
+			code = wire.ProprietaryDocumentType
+			proprietary = report.Fit(at(tag, element+"/Tp/CdOrPrtry/Prtry"), tp.CodeOrProprietary.Proprietary, 35)
+		}
+		issuer = report.Fit(at(tag, element+"/Tp/Issr"), tp.Issuer, 35)
+	}
+	return code, proprietary, report.Fit(at(tag, element+"/Nb"), doc.Number, 35), issuer
+}
+
+// faimRemittanceAmount returns the {8450} to {8600} RemittanceAmount of an amount
+func faimRemittanceAmount(tag, element string, amt ActiveCurrencyAndAmount, report *Report) wire.RemittanceAmount {
+	return wire.RemittanceAmount{CurrencyCode: amt.Currency, Amount: report.Fit(at(tag, element), amt.Value, 19)}
+}
+
+// originalGroupInformation returns the OrgnlGrpInf of the message fwm refers to by its {3500}
+// PreviousMessageIdentifier, which was sent as definition
+func originalGroupInformation(fwm *wire.FEDWireMessage, definition string) OriginalGroupInformation {
+	ogi := OriginalGroupInformation{
+		OriginalMessageIdentification:     NotProvided,
+		OriginalMessageNameIdentification: definition,
+	}
+	if pmi := fwm.PreviousMessageIdentifier; pmi != nil && strings.TrimSpace(pmi.PreviousMessageIdentifier) != "" {
+		ogi.OriginalMessageIdentification = strings.TrimSpace(pmi.PreviousMessageIdentifier)
+	}
+	return ogi
+}
+
+// originalSettlementDate returns the ISODate the message fwm refers to settled on, when its {3500}
+// PreviousMessageIdentifier is an IMAD
+func originalSettlementDate(fwm *wire.FEDWireMessage) string {
+	if pmi := fwm.PreviousMessageIdentifier; pmi != nil {
+		if imad := inputMessageAccountabilityData(strings.TrimSpace(pmi.PreviousMessageIdentifier)); imad != nil {
+			return settlementDate(imad.InputCycleDate)
+		}
+	}
+	return ""
+}
+
+// originalUETR returns the UETR of the message an OrgnlGrpInf refers to
+func originalUETR(ogi OriginalGroupInformation) string {
+	if ogi.OriginalMessageIdentification == NotProvided {
+		return ""
+	}
+	return uetr(ogi.OriginalMessageIdentification)
+}
+
+// subTypeCode returns the {1510} SubTypeCode priorDay when the message fwm refers to settled on originalDate, a day
+// before fwm, and sameDay otherwise
+func subTypeCode(fwm *wire.FEDWireMessage, originalDate, sameDay, priorDay string) string {
+	imad := fwm.InputMessageAccountabilityData
+	if originalDate != "" && imad != nil && originalDate < settlementDate(imad.InputCycleDate) {
+		return priorDay
+	}
+	return sameDay
+}
+
+// previousMessageFromOriginal sets the {3500} PreviousMessageIdentifier of fwm to the message an OrgnlGrpInf refers
+// to, reporting an OrgnlUETR which is not that message's
+func previousMessageFromOriginal(element string, ogi OriginalGroupInformation, orgnlUETR string, fwm *wire.FEDWireMessage, report *Report) {
+	if id := ogi.OriginalMessageIdentification; id != "" && id != NotProvided {
+		fwm.PreviousMessageIdentifier = wire.NewPreviousMessageIdentifier()
+		fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = report.Fit(at(wire.TagPreviousMessageIdentifier, element+"/OrgnlGrpInf/OrgnlMsgId"), id, 22)
+	}
+	if orgnlUETR != "" && orgnlUETR != originalUETR(ogi) {
+		report.NoTag(at("", element+"/OrgnlUETR"), "")
+	}
+}
+
+// customerParties returns true when the parties of messages with the BusinessFunctionCode bfc are customers rather
+// than agents
+func customerParties(bfc string) bool {
+	switch bfc {
+	case wire.CustomerTransfer, wire.CustomerTransferPlus, wire.CustomerCorporateDrawdownRequest:
+		return true
+	}
+	return false
+}
+
+// transactionParty returns the party or agent identified by a FEDWireMessage Personal, and its account
+func transactionParty(p wire.Personal, customer bool) (*Party40Choice, *CashAccount) {
+	if customer {
+		pty, acct := party(p)
+		return &Party40Choice{Party: &pty}, acct
+	}
+	agt, acct := institution(p)
+	return &Party40Choice{Agent: &agt}, acct
+}
+
+// debtorParty returns the party or agent of the {5000} Originator or {5010} OriginatorOptionF of fwm, and its account
+func debtorParty(fwm *wire.FEDWireMessage, report *Report) (*Party40Choice, *CashAccount) {
+	switch {
+	case fwm.Originator != nil:
+		if fwm.OriginatorOptionF != nil {
+			report.Unmapped(wire.TagOriginatorOptionF, "OriginatorOptionF with an Originator", nowhere)
+		}
+		return transactionParty(fwm.Originator.Personal, customerParties(fwm.BusinessFunctionCode.BusinessFunctionCode))
+	case fwm.OriginatorOptionF != nil:
+		pty := optionFParty(fwm.OriginatorOptionF)
+		return &Party40Choice{Party: &pty}, nil
+	}
+	return nil, nil
+}
+
+// transactionPersonal returns the FEDWireMessage Personal of a party or agent and its account
+func transactionPersonal(tag, element string, pty Party40Choice, acct *CashAccount, report *Report) wire.Personal {
+	if pty.Agent != nil {
+		if pty.Party != nil {
+			report.NoTag(at("", element+"/Pty"), "")
+		}
+		return institutionPersonal(tag, element+"/Agt", *pty.Agent, acct, report)
+	}
+	if pty.Party == nil {
+		return personal(tag, element, PartyIdentification{}, acct, report)
+	}
+	if pty.Party.ContactDetails != nil {
+		report.NoTag(at("", element+"/Pty/CtctDtls"), "")
+	}
+	return personal(tag, element+"/Pty", *pty.Party, acct, report)
+}
+
+// originatorFromParty sets the {5000} Originator of fwm to a Dbtr and its account, or its {5010} OriginatorOptionF
+// for a CTP customer transfer's Dbtr identified by isOptionFParty
+func originatorFromParty(element string, pty Party40Choice, acct *CashAccount, fwm *wire.FEDWireMessage, report *Report) {
+	ctp := fwm.BusinessFunctionCode.BusinessFunctionCode == wire.CustomerTransferPlus
+	if ctp && pty.Agent == nil && pty.Party != nil && acct == nil && isOptionFParty(*pty.Party) {
+		fwm.OriginatorOptionF = originatorOptionF(element+"/Pty", *pty.Party, report)
+		return
+	}
+	fwm.Originator = wire.NewOriginator()
+	fwm.Originator.Personal = transactionPersonal(wire.TagOriginator, element, pty, acct, report)
+}
+
+// originalTransactionReference returns the OrgnlTxRef holding the PaymentTypeInformation and the {4100} to {5100}
+// parties and agents of fwm
+func originalTransactionReference(fwm *wire.FEDWireMessage, report *Report) *OriginalTransactionReference {
+	ref := &OriginalTransactionReference{PaymentTypeInformation: paymentTypeInformation(fwm)}
+	if fwm.BeneficiaryFI != nil {
+		agt := agent(fwm.BeneficiaryFI.FinancialInstitution)
+		ref.CreditorAgent = &agt
+	}
+	if fwm.Beneficiary != nil {
+		ref.Creditor, ref.CreditorAccount = transactionParty(fwm.Beneficiary.Personal, customerParties(fwm.BusinessFunctionCode.BusinessFunctionCode))
+	}
+	ref.Debtor, ref.DebtorAccount = debtorParty(fwm, report)
+	if fwm.OriginatorFI != nil {
+		agt := agent(fwm.OriginatorFI.FinancialInstitution)
+		ref.DebtorAgent = &agt
+	}
+	return ref
+}
+
+// partiesFromReference converts the parties and agents of an OrgnlTxRef to the {4100} to {5100} tags
+func partiesFromReference(element string, ref *OriginalTransactionReference, fwm *wire.FEDWireMessage, report *Report) {
+	if agt := ref.CreditorAgent; agt != nil {
+		fwm.BeneficiaryFI = wire.NewBeneficiaryFI()
+		fwm.BeneficiaryFI.FinancialInstitution = financialInstitution(wire.TagBeneficiaryFI, element+"/CdtrAgt", *agt, report)
+	}
+	switch {
+	case ref.Creditor != nil:
+		fwm.Beneficiary = wire.NewBeneficiary()
+		fwm.Beneficiary.Personal = transactionPersonal(wire.TagBeneficiary, element+"/Cdtr", *ref.Creditor, ref.CreditorAccount, report)
+	case ref.CreditorAccount != nil:
+		report.NoTag(at("", element+"/CdtrAcct"), "")
+	}
+	switch {
+	case ref.Debtor != nil:
+		originatorFromParty(element+"/Dbtr", *ref.Debtor, ref.DebtorAccount, fwm, report)
+	case ref.DebtorAccount != nil:
+		report.NoTag(at("", element+"/DbtrAcct"), "")
+	}
+	if agt := ref.DebtorAgent; agt != nil {
+		fwm.OriginatorFI = wire.NewOriginatorFI()
+		fwm.OriginatorFI.FinancialInstitution = financialInstitution(wire.TagOriginatorFI, element+"/DbtrAgt", *agt, report)
+	}
+	if ref.InterbankSettlementAmount != nil {
+		report.NoTag(at("", element+"/IntrBkSttlmAmt"), "")
+	}
+	if ref.RemittanceInformation != nil {
+		report.NoTag(at("", element+"/RmtInf"), "")
+	}
+}
+
+// newCaseAssignment returns the Assgnmt of an investigation message identified by messageID, assigned by the
+// InstgAgt to the InstdAgt of it
+func newCaseAssignment(it interbankTransfer, messageID, createdAt string) CaseAssignment {
+	return CaseAssignment{
+		Identification:   messageID,
+		Assigner:         Party40Choice{Agent: &it.InstructingAgent},
+		Assignee:         Party40Choice{Agent: &it.InstructedAgent},
+		CreationDateTime: createdAt,
+	}
+}
+
+// assignmentAgent returns the agent of an Assgnr or Assgne, reporting a party
+func assignmentAgent(element string, pty Party40Choice, report *Report) BranchAndFinancialInstitutionIdentification {
+	if pty.Party != nil {
+		report.NoTag(at("", element+"/Pty"), "")
+	}
+	if pty.Agent == nil {
+		return BranchAndFinancialInstitutionIdentification{}
+	}
+	return *pty.Agent
+}
+
+// narrative returns the lines of the {9000} ServiceMessage of an SVC service message, or else of the {6000}
+// OriginatorToBeneficiary of fwm, and the tag of the other, which is not converted
+func narrative(fwm *wire.FEDWireMessage) ([]string, string) {
+	if fwm.BusinessFunctionCode.BusinessFunctionCode == wire.BFCServiceMessage {
+		if sm := fwm.ServiceMessage; sm != nil {
+			return textLines(sm.LineOne, sm.LineTwo, sm.LineThree, sm.LineFour, sm.LineFive, sm.LineSix, sm.LineSeven,
+				sm.LineEight, sm.LineNine, sm.LineTen, sm.LineEleven, sm.LineTwelve), wire.TagOriginatorToBeneficiary
+		}
+		return nil, wire.TagOriginatorToBeneficiary
+	}
+	if ob := fwm.OriginatorToBeneficiary; ob != nil {
+		return textLines(ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour), wire.TagServiceMessage
+	}
+	return nil, wire.TagServiceMessage
+}
+
+// narrativeFromLines sets the {9000} ServiceMessage of an SVC service message, or else the {6000}
+// OriginatorToBeneficiary of fwm, to lines
+func narrativeFromLines(element string, lines []string, fwm *wire.FEDWireMessage, report *Report) {
+	if len(lines) == 0 {
+		return
+	}
+	if fwm.BusinessFunctionCode.BusinessFunctionCode == wire.BFCServiceMessage {
+		l := report.FitLineSlots(at(wire.TagServiceMessage, element), lines, 12, 35)
+		fwm.ServiceMessage = wire.NewServiceMessage()
+		sm := fwm.ServiceMessage
+		sm.LineOne, sm.LineTwo, sm.LineThree, sm.LineFour, sm.LineFive, sm.LineSix = l[0], l[1], l[2], l[3], l[4], l[5]
+		sm.LineSeven, sm.LineEight, sm.LineNine, sm.LineTen, sm.LineEleven, sm.LineTwelve = l[6], l[7], l[8], l[9], l[10], l[11]
+		return
+	}
+	l := report.FitLineSlots(at(wire.TagOriginatorToBeneficiary, element), lines, 4, 35)
+	fwm.OriginatorToBeneficiary = wire.NewOriginatorToBeneficiary()
+	ob := fwm.OriginatorToBeneficiary
+	ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour = l[0], l[1], l[2], l[3]
+}
+
+// reasonInformation returns the reason information holding lines as its AdditionalInformation
+func reasonInformation(lines []string) []ReasonInformation {
+	if len(lines) == 0 {
+		return nil
+	}
+	return []ReasonInformation{{AdditionalInformation: lines}}
+}
+
+// reasonLines returns the AdditionalInformation of the first of reasons, reporting any other reason information
+func reasonLines(element string, reasons []ReasonInformation, report *Report) []string {
+	if len(reasons) == 0 {
+		return nil
+	}
+	if len(reasons) > 1 {
+		report.NoTag(at("", element), "")
+	}
+	if reasons[0].Originator != nil {
+		report.NoTag(at("", element+"/Orgtr"), "")
+	}
+	if reasons[0].Reason != nil {
+		report.NoTag(at("", element+"/Rsn"), "")
+	}
+	return reasons[0].AdditionalInformation
+}
@@ -0,0 +1,184 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUETR(t *testing.T) {
+	id := uetr("20190410Source08000001")
+	require.Regexp(t, uuidv4Regex, id)
+	require.Equal(t, id, uetr("20190410Source08000001"))
+	require.NotEqual(t, id, uetr("20190410Source08000002"))
+}
+
+func TestAmounts(t *testing.T) {
+	require.Equal(t, "1500.49", decimal("000000000001500,49"))
+	require.Equal(t, "0.99", decimal("0,99"))
+	require.Equal(t, "1.2345", decimal("1,2345"))
+	require.Equal(t, "1234.56", decimal("1234.56"))
+
+	require.Equal(t, &ActiveCurrencyAndAmount{Currency: "USD", Value: "2.99"}, currencyAmount("USD2,99"))
+	require.Nil(t, currencyAmount(""))
+}
+
+func TestFEDWireMessageAmounts(t *testing.T) {
+	require.Equal(t, "4567,89", faimDecimal("4567.89"))
+	require.Equal(t, "1500,", faimDecimal("1500"))
+}
+
+func TestInputMessageAccountabilityData(t *testing.T) {
+	imad := inputMessageAccountabilityData("20190410Source08000001")
+	require.NotNil(t, imad)
+	require.Equal(t, "20190410", imad.InputCycleDate)
+	require.Equal(t, "Source08", imad.InputSource)
+	require.Equal(t, "000001", imad.InputSequenceNumber)
+	require.Equal(t, "20190410Source08000001", messageIdentification(imad))
+
+	require.Nil(t, inputMessageAccountabilityData("20191310Source08000001"))
+	require.Nil(t, inputMessageAccountabilityData("20190410Source0800000A"))
+	require.Nil(t, inputMessageAccountabilityData("MSG1"))
+}
+
+func TestDates(t *testing.T) {
+	require.Equal(t, "2019-04-10", settlementDate("20190410"))
+	require.Equal(t, "2019041", settlementDate("2019041"))
+	require.Equal(t, "20190509", faimDateOf("2019-05-09"))
+	require.Equal(t, "2019-5-9", faimDateOf("2019-5-9"))
+
+	_, err := parseDateTime("2019-05-08T10:30:00-04:00")
+	require.NoError(t, err)
+	_, err = parseDateTime("2019-05-08T10:30:00.123")
+	require.NoError(t, err)
+	_, err = parseDateTime("2019-05-08")
+	require.Error(t, err)
+}
+
+func TestTextLines(t *testing.T) {
+	require.Equal(t, []string{"One", " ", "Three"}, textLines("One", "", "Three", ""))
+	require.Nil(t, textLines("", ""))
+}
+
+func TestAgent(t *testing.T) {
+	bic := agent(wire.FinancialInstitution{IdentificationCode: wire.SWIFTBankIdentifierCode, Identifier: "CITIUS33XXX", Name: "Citibank"})
+	require.Equal(t, "CITIUS33XXX", bic.FinancialInstitutionIdentification.BICFI)
+	require.Equal(t, "Citibank", bic.FinancialInstitutionIdentification.Name)
+	require.Nil(t, bic.FinancialInstitutionIdentification.PostalAddress)
+
+	aba := agent(wire.FinancialInstitution{IdentificationCode: wire.FEDRoutingNumber, Identifier: "121042882"})
+	require.Equal(t, routingNumberAgent("121042882"), aba)
+
+	chips := agent(wire.FinancialInstitution{IdentificationCode: wire.CHIPSParticipant, Identifier: "0123"})
+	mmb := chips.FinancialInstitutionIdentification.ClearingSystemMemberIdentification
+	require.Equal(t, ClearingSystemCHIPSParticipant, mmb.ClearingSystemIdentification.Code)
+	require.Equal(t, "0123", mmb.MemberIdentification)
+
+	other := agent(wire.FinancialInstitution{IdentificationCode: wire.CHIPSIdentifier, Identifier: "123456"})
+	require.Equal(t, "123456", other.FinancialInstitutionIdentification.Other.Identification)
+	require.Equal(t, wire.CHIPSIdentifier, other.FinancialInstitutionIdentification.Other.SchemeName.Proprietary)
+
+	require.Equal(t, BranchAndFinancialInstitutionIdentification{}, agent(wire.FinancialInstitution{}))
+}
+
+func TestParty(t *testing.T) {
+	pty, acct := party(wire.Personal{IdentificationCode: wire.DemandDepositAccountNumber, Identifier: "123456789", Name: "Name"})
+	require.Equal(t, "Name", pty.Name)
+	require.Nil(t, pty.Identification)
+	require.Equal(t, "123456789", acct.Identification.Other.Identification)
+
+	pty, acct = party(wire.Personal{IdentificationCode: wire.SWIFTBankIdentifierCode, Identifier: "CITIUS33XXX"})
+	require.Nil(t, acct)
+	require.Equal(t, "CITIUS33XXX", pty.Identification.OrganisationIdentification.AnyBIC)
+
+	pty, _ = party(wire.Personal{IdentificationCode: wire.TaxIdentificationNumber, Identifier: "123-45-6789"})
+	require.Equal(t, "TXID", pty.Identification.PrivateIdentification.Other[0].SchemeName.Code)
+
+	pty, _ = party(wire.Personal{IdentificationCode: wire.CorporateIdentification, Identifier: "1234"})
+	require.Equal(t, wire.CorporateIdentification, pty.Identification.OrganisationIdentification.Other[0].SchemeName.Proprietary)
+}
+
+func TestRemittanceParty(t *testing.T) {
+	report := &Report{}
+	pty := remittanceParty(wire.TagRemittanceBeneficiary, wire.PrivateID, wire.PICPassportNumber, "1234", "", wire.RemittanceData{
+		Name:           "Name",
+		DateBirthPlace: "1990-01-01 Boston",
+	}, report)
+	require.Equal(t, wire.PICPassportNumber, pty.Identification.PrivateIdentification.Other[0].SchemeName.Code)
+	require.Nil(t, pty.PostalAddress)
+	require.Equal(t, []string{wire.TagRemittanceBeneficiary}, report.Tags())
+
+	report = &Report{}
+	pty = remittanceParty(wire.TagRemittanceOriginator, wire.OrganizationID, wire.OICCustomerNumber, "", "", wire.RemittanceData{}, report)
+	require.Nil(t, pty.Identification)
+	require.Equal(t, []string{wire.TagRemittanceOriginator}, report.Tags())
+}
+
+func TestFinancialInstitution(t *testing.T) {
+	for _, fi := range []wire.FinancialInstitution{
+		{IdentificationCode: wire.SWIFTBankIdentifierCode, Identifier: "CITIUS33XXX", Name: "Citibank"},
+		{IdentificationCode: wire.FEDRoutingNumber, Identifier: "121042882", Address: wire.Address{AddressLineOne: "One", AddressLineThree: "Three"}},
+		{IdentificationCode: wire.CHIPSParticipant, Identifier: "0123"},
+		{IdentificationCode: wire.CHIPSIdentifier, Identifier: "123456"},
+	} {
+		report := &Report{}
+		require.Equal(t, fi, financialInstitution(wire.TagBeneficiaryFI, "CdtrAgt", agent(fi), report))
+		require.True(t, report.Empty())
+	}
+
+	report := &Report{}
+	agt := routingNumberAgent("121042882")
+	agt.FinancialInstitutionIdentification.ClearingSystemMemberIdentification.ClearingSystemIdentification.Code = "GBDSC"
+	agt.FinancialInstitutionIdentification.PostalAddress = &PostalAddress{StreetName: "Main Street", BuildingNumber: "1", TownName: "Boston", Country: "US"}
+	fi := financialInstitution(wire.TagBeneficiaryFI, "CdtrAgt", agt, report)
+	require.Empty(t, fi.Identifier)
+	require.Equal(t, wire.Address{AddressLineOne: "1 Main Street", AddressLineTwo: "Boston", AddressLineThree: "US"}, fi.Address)
+	require.Equal(t, "CdtrAgt/FinInstnId/ClrSysMmbId", report.Warnings[0].Element)
+}
+
+func TestPersonal(t *testing.T) {
+	for _, p := range []wire.Personal{
+		{IdentificationCode: wire.DemandDepositAccountNumber, Identifier: "123456789", Name: "Name"},
+		{IdentificationCode: wire.SWIFTBankIdentifierCode, Identifier: "CITIUS33XXX"},
+		{IdentificationCode: wire.TaxIdentificationNumber, Identifier: "123-45-6789"},
+		{IdentificationCode: wire.CorporateIdentification, Identifier: "1234"},
+	} {
+		report := &Report{}
+		pty, acct := party(p)
+		require.Equal(t, p, personal(wire.TagOriginator, "Dbtr", pty, acct, report))
+		require.True(t, report.Empty())
+	}
+
+	report := &Report{}
+	p := personal(wire.TagBeneficiary, "Cdtr", PartyIdentification{
+		Name: "A name which is longer than thirty five characters",
+		Identification: &Party{OrganisationIdentification: &OrganisationIdentification{Other: []GenericIdentification{
+			{Identification: "1234", SchemeName: &CodeOrProprietary{Code: "DUNS"}},
+		}}},
+		CountryOfResidence: "US",
+	}, nil, report)
+	require.Equal(t, "A name which is longer than thirty ", p.Name)
+	require.Equal(t, wire.OtherIdentification, p.IdentificationCode)
+	require.Equal(t, "1234", p.Identifier)
+	require.Equal(t, []Warning{
+		{Tag: wire.TagBeneficiary, Element: "Cdtr/Nm", Reason: "was truncated"},
+		{Element: "Cdtr/CtryOfRes", Reason: "has no FEDWireMessage tag"},
+	}, report.Warnings)
+}
+
+func TestRemittanceIdentification(t *testing.T) {
+	report := &Report{}
+	pty := remittanceParty(wire.TagRemittanceOriginator, wire.OrganizationID, wire.OICCustomerNumber, "1234", "Issuer", wire.RemittanceData{}, report)
+	idType, code, number, issuer := remittanceIdentification(wire.TagRemittanceOriginator, "Strd/Invcr", pty, report)
+	require.Equal(t, []string{wire.OrganizationID, wire.OICCustomerNumber, "1234", "Issuer"}, []string{idType, code, number, issuer})
+
+	pty = &PartyIdentification{Identification: &Party{OrganisationIdentification: &OrganisationIdentification{AnyBIC: "CITIUS33XXX"}}}
+	idType, code, number, _ = remittanceIdentification(wire.TagRemittanceOriginator, "Strd/Invcr", pty, report)
+	require.Equal(t, []string{wire.OrganizationID, wire.OICSWIFTBICORBEI, "CITIUS33XXX"}, []string{idType, code, number})
+	require.True(t, report.Empty())
+}
@@ -0,0 +1,122 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"github.com/moov-io/base"
+	"github.com/moov-io/wire"
+)
+
+const (
+	// BusinessServiceTest is the BusinessService of test messages
+	BusinessServiceTest = "TEST"
+	// BusinessServiceProduction is the BusinessService of production messages
+	BusinessServiceProduction = "PROD"
+
+	// MarketPracticeRegistry is the registry of the Fedwire Funds Service market practice
+	MarketPracticeRegistry = "www2.swift.com/mystandards/#/group/Federal_Reserve_Financial_Services/Fedwire_Funds_Service"
+	// MarketPracticeIdentification identifies the Fedwire Funds Service market practice
+	MarketPracticeIdentification = "frb.fedwire.01"
+)
+
+// BusinessApplicationHeader is the head.001 AppHdr of a message
+type BusinessApplicationHeader struct {
+	// From is the agent sending the message
+	From Party44Choice `xml:"Fr"`
+	// To is the agent receiving the message
+	To Party44Choice `xml:"To"`
+	// BusinessMessageIdentifier is the MessageIdentification of the message
+	BusinessMessageIdentifier string `xml:"BizMsgIdr"`
+	// MessageDefinitionIdentifier of the message, such as pacs.008.001.08
+	MessageDefinitionIdentifier string `xml:"MsgDefIdr"`
+	// BusinessService is BusinessServiceTest or BusinessServiceProduction
+	BusinessService string `xml:"BizSvc,omitempty"`
+	// MarketPractice is the Fedwire Funds Service market practice
+	MarketPractice *ImplementationSpecification `xml:"MktPrctc,omitempty"`
+	// CreationDate of the message
+	CreationDate string `xml:"CreDt"`
+	// PossibleDuplicate is true when the message may have been sent before
+	PossibleDuplicate bool `xml:"PssblDplct,omitempty"`
+}
+
+// Party44Choice is the Fr or To agent of a BusinessApplicationHeader
+type Party44Choice struct {
+	FinancialInstitutionIdentification BranchAndFinancialInstitutionIdentification `xml:"FIId"`
+}
+
+// ImplementationSpecification identifies a market practice
+type ImplementationSpecification struct {
+	Registry       string `xml:"Regy"`
+	Identification string `xml:"Id"`
+}
+
+// newBusinessApplicationHeader returns the BusinessApplicationHeader of fwm sent as definition
+func newBusinessApplicationHeader(fwm *wire.FEDWireMessage, definition, messageID, created string, report *Report) BusinessApplicationHeader {
+	hdr := BusinessApplicationHeader{
+		BusinessMessageIdentifier:   messageID,
+		MessageDefinitionIdentifier: definition,
+		MarketPractice: &ImplementationSpecification{
+			Registry:       MarketPracticeRegistry,
+			Identification: MarketPracticeIdentification,
+		},
+		CreationDate: created,
+	}
+	if fwm.SenderDepositoryInstitution != nil {
+		hdr.From.FinancialInstitutionIdentification = routingNumberAgent(fwm.SenderDepositoryInstitution.SenderABANumber)
+	}
+	if fwm.ReceiverDepositoryInstitution != nil {
+		hdr.To.FinancialInstitutionIdentification = routingNumberAgent(fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+	}
+	if ss := fwm.SenderSupplied; ss != nil {
+		hdr.BusinessService = BusinessServiceTest
+		if ss.TestProductionCode == wire.EnvironmentProduction {
+			hdr.BusinessService = BusinessServiceProduction
+		}
+		hdr.PossibleDuplicate = ss.MessageDuplicationCode == wire.MessageDuplicationResend
+		if ss.UserRequestCorrelation != "" {
+			report.Unmapped(wire.TagSenderSupplied, "UserRequestCorrelation", nowhere)
+		}
+	}
+	return hdr
+}
+
+// senderSupplied returns the {1500} SenderSupplied of the message with hdr
+func (hdr *BusinessApplicationHeader) senderSupplied() *wire.SenderSupplied {
+	ss := wire.NewSenderSupplied()
+	ss.TestProductionCode = wire.EnvironmentTest
+	if hdr.BusinessService == BusinessServiceProduction {
+		ss.TestProductionCode = wire.EnvironmentProduction
+	}
+	ss.MessageDuplicationCode = wire.MessageDuplicationOriginal
+	if hdr.PossibleDuplicate {
+		ss.MessageDuplicationCode = wire.MessageDuplicationResend
+	}
+	return ss
+}
+
+// Validate checks the BusinessApplicationHeader against the head.001 schema, returning the first error found
+func (hdr *BusinessApplicationHeader) Validate() error {
+	return hdr.ValidateAll().Err()
+}
+
+// ValidateAll checks the BusinessApplicationHeader against the head.001 schema, returning every error found
+func (hdr *BusinessApplicationHeader) ValidateAll() base.ErrorList {
+	v := &validator{}
+	hdr.validate(v, "AppHdr")
+	return v.errs
+}
+
+func (hdr *BusinessApplicationHeader) validate(v *validator, path string) {
+	hdr.From.FinancialInstitutionIdentification.validate(v, path+"/Fr/FIId")
+	hdr.To.FinancialInstitutionIdentification.validate(v, path+"/To/FIId")
+	v.text(path+"/BizMsgIdr", hdr.BusinessMessageIdentifier, 35, true)
+	v.text(path+"/MsgDefIdr", hdr.MessageDefinitionIdentifier, 35, true)
+	v.text(path+"/BizSvc", hdr.BusinessService, 35, false)
+	if hdr.MarketPractice != nil {
+		v.text(path+"/MktPrctc/Regy", hdr.MarketPractice.Registry, 350, true)
+		v.text(path+"/MktPrctc/Id", hdr.MarketPractice.Identification, 2048, true)
+	}
+	v.dateTime(path+"/CreDt", hdr.CreationDate, true)
+}
@@ -0,0 +1,41 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBusinessApplicationHeader(t *testing.T) {
+	fixedNow(t)
+
+	fwm := readMessage(t, "fedWireMessage-CustomerTransfer.json")
+	fwm.SenderSupplied.TestProductionCode = wire.EnvironmentProduction
+	fwm.SenderSupplied.MessageDuplicationCode = wire.MessageDuplicationResend
+	fwm.SenderSupplied.UserRequestCorrelation = ""
+
+	report := &Report{}
+	hdr := newBusinessApplicationHeader(fwm, MessageDefinitionPacs008, "20190410Source08000001", created(), report)
+	require.True(t, report.Empty())
+	require.NoError(t, hdr.Validate())
+	require.Equal(t, BusinessServiceProduction, hdr.BusinessService)
+	require.True(t, hdr.PossibleDuplicate)
+	require.Equal(t, MarketPracticeIdentification, hdr.MarketPractice.Identification)
+
+	ss := hdr.senderSupplied()
+	require.Equal(t, wire.EnvironmentProduction, ss.TestProductionCode)
+	require.Equal(t, wire.MessageDuplicationResend, ss.MessageDuplicationCode)
+	require.NoError(t, ss.Validate())
+
+	hdr.BusinessMessageIdentifier = ""
+	hdr.CreationDate = "2019-04-10"
+	errs := hdr.ValidateAll()
+	require.Len(t, errs, 2)
+	require.Equal(t, "AppHdr/BizMsgIdr is required", errs[0].Error())
+	require.Equal(t, errs[0], hdr.Validate())
+}
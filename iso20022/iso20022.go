@@ -0,0 +1,601 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package iso20022 converts FEDWireMessages to and from the ISO 20022 messages of the Fedwire Funds Service.
+//
+// Each message is read and written as an Envelope element holding the business application header
+// (head.001) and the message's Document. Conversions return a Report of the data which could not be converted.
+package iso20022
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/moov-io/wire"
+	"github.com/moov-io/wire/internal/convert"
+)
+
+const (
+	// NamespaceHead001 is the XML namespace of the business application header
+	NamespaceHead001 = "urn:iso:std:iso:20022:tech:xsd:head.001.001.03"
+	// NamespacePacs008 is the XML namespace of FIToFICustomerCreditTransfer messages
+	NamespacePacs008 = "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"
+	// NamespacePacs009 is the XML namespace of FinancialInstitutionCreditTransfer messages
+	NamespacePacs009 = "urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08"
+	// NamespacePacs004 is the XML namespace of PaymentReturn messages
+	NamespacePacs004 = "urn:iso:std:iso:20022:tech:xsd:pacs.004.001.09"
+	// NamespaceCamt056 is the XML namespace of FIToFIPaymentCancellationRequest messages
+	NamespaceCamt056 = "urn:iso:std:iso:20022:tech:xsd:camt.056.001.08"
+	// NamespacePain013 is the XML namespace of CreditorPaymentActivationRequest messages
+	NamespacePain013 = "urn:iso:std:iso:20022:tech:xsd:pain.013.001.07"
+	// NamespaceCamt029 is the XML namespace of ResolutionOfInvestigation messages
+	NamespaceCamt029 = "urn:iso:std:iso:20022:tech:xsd:camt.029.001.09"
+
+	// MessageDefinitionPacs008 identifies FIToFICustomerCreditTransfer messages in the business application header
+	MessageDefinitionPacs008 = "pacs.008.001.08"
+	// MessageDefinitionPacs009 identifies FinancialInstitutionCreditTransfer messages in the business application header
+	MessageDefinitionPacs009 = "pacs.009.001.08"
+	// MessageDefinitionPacs004 identifies PaymentReturn messages in the business application header
+	MessageDefinitionPacs004 = "pacs.004.001.09"
+	// MessageDefinitionCamt056 identifies FIToFIPaymentCancellationRequest messages in the business application header
+	MessageDefinitionCamt056 = "camt.056.001.08"
+	// MessageDefinitionPain013 identifies CreditorPaymentActivationRequest messages in the business application header
+	MessageDefinitionPain013 = "pain.013.001.07"
+	// MessageDefinitionCamt029 identifies ResolutionOfInvestigation messages in the business application header
+	MessageDefinitionCamt029 = "camt.029.001.09"
+
+	// ClearingSystemFedwire is the clearing system code of the Fedwire Funds Service
+	ClearingSystemFedwire = "FDW"
+	// ClearingSystemUSABA is the clearing system code of ABA routing numbers
+	ClearingSystemUSABA = "USABA"
+	// ClearingSystemCHIPSParticipant is the clearing system code of CHIPS participant identifiers
+	ClearingSystemCHIPSParticipant = "USPID"
+
+	// SettlementMethodClearing settles a message through a clearing system
+	SettlementMethodClearing = "CLRG"
+
+	// ChargeBearerCreditor is the ChargeBearer when charges are borne by the creditor
+	ChargeBearerCreditor = "CRED"
+	// ChargeBearerShared is the ChargeBearer when charges are shared
+	ChargeBearerShared = "SHAR"
+	// ChargeBearerServiceLevel is the ChargeBearer when charges follow the service level
+	ChargeBearerServiceLevel = "SLEV"
+
+	// NotProvided is the EndToEndIdentification of a payment without one
+	NotProvided = "NOTPROVIDED"
+)
+
+var (
+	// ErrNoMessage is given when there is no FEDWireMessage to convert
+	ErrNoMessage = errors.New("no FEDWireMessage")
+	// ErrBusinessFunctionCode is given when a FEDWireMessage's BusinessFunctionCode cannot be converted to a message
+	ErrBusinessFunctionCode = errors.New("business function code cannot be converted to this message")
+	// ErrSubTypeCode is given when a FEDWireMessage's SubTypeCode cannot be converted to a message
+	ErrSubTypeCode = errors.New("subtype code cannot be converted to this message")
+	// ErrMessageDefinition is given when XML read does not hold the expected message
+	ErrMessageDefinition = errors.New("not the expected message definition")
+)
+
+// Warning describes data which could not be converted, or was changed to fit its new format
+type Warning = convert.Warning
+
+// Report lists the Warnings of a conversion
+type Report = convert.Report
+
+// nowhere completes the "has no ..." reason of the Warnings for unmapped data
+const nowhere = "ISO 20022 element"
+
+// at returns a Warning about the data of tag and the ISO 20022 element
+func at(tag, element string) Warning {
+	return Warning{Tag: tag, Element: element}
+}
+
+// requireBusinessFunctionCode returns an error unless fwm has one of the BusinessFunctionCodes codes
+func requireBusinessFunctionCode(fwm *wire.FEDWireMessage, codes ...string) error {
+	if fwm == nil {
+		return ErrNoMessage
+	}
+	if fwm.BusinessFunctionCode == nil {
+		return fmt.Errorf("%w: missing %s", ErrBusinessFunctionCode, wire.TagBusinessFunctionCode)
+	}
+	for _, code := range codes {
+		if fwm.BusinessFunctionCode.BusinessFunctionCode == code {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrBusinessFunctionCode, fwm.BusinessFunctionCode.BusinessFunctionCode)
+}
+
+// requireSubTypeCode returns an error unless fwm has one of the SubTypeCodes codes
+func requireSubTypeCode(fwm *wire.FEDWireMessage, codes ...string) error {
+	if fwm.TypeSubType == nil {
+		return fmt.Errorf("%w: missing %s", ErrSubTypeCode, wire.TagTypeSubType)
+	}
+	for _, code := range codes {
+		if fwm.TypeSubType.SubTypeCode == code {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrSubTypeCode, fwm.TypeSubType.SubTypeCode)
+}
+
+// appendedTags are the tags the Fedwire Funds Service appends to messages it sends, which are not part of any
+// ISO 20022 message
+var appendedTags = []string{
+	wire.TagMessageDisposition,
+	wire.TagReceiptTimeStamp,
+	wire.TagOutputMessageAccountabilityData,
+	wire.TagErrorWire,
+}
+
+// businessFunctionInstruments are the LocalInstruments identifying each BusinessFunctionCode other than CTP, whose
+// LocalInstrument is the {3610} LocalInstrument of the customer transfer
+var businessFunctionInstruments = map[string]string{
+	wire.CustomerTransfer:                 LocalInstrumentCustomerTransfer,
+	wire.BankTransfer:                     LocalInstrumentBankTransfer,
+	wire.CheckSameDaySettlement:           LocalInstrumentCheckSameDaySettlement,
+	wire.DepositSendersAccount:            LocalInstrumentDepositSendersAccount,
+	wire.FEDFundsReturned:                 LocalInstrumentFEDFundsReturned,
+	wire.FEDFundsSold:                     LocalInstrumentFEDFundsSold,
+	wire.BankDrawDownRequest:              LocalInstrumentBankDrawdownRequest,
+	wire.CustomerCorporateDrawdownRequest: LocalInstrumentCustomerDrawdownRequest,
+}
+
+// typeCode returns the {1510} TypeCode of messages with the BusinessFunctionCode bfc
+func typeCode(bfc string) string {
+	switch bfc {
+	case wire.CheckSameDaySettlement, wire.DepositSendersAccount, wire.FEDFundsReturned, wire.FEDFundsSold,
+		wire.BankDrawDownRequest:
+		return wire.SettlementTransfer
+	}
+	return wire.FundsTransfer
+}
+
+// reportUnmappedTags reports the tags of fwm which a message has no element for: the Fedwire appended tags,
+// the message's unmapped tags and any unknown tags
+func reportUnmappedTags(fwm *wire.FEDWireMessage, report *Report, unmapped []string) {
+	report.UnmappedTags(fwm, append(append([]string{}, appendedTags...), unmapped...), nowhere)
+}
+
+// newGroupHeader returns the GroupHeader of a message identified by messageID
+func newGroupHeader(messageID, createdAt string) GroupHeader {
+	return GroupHeader{
+		MessageIdentification: messageID,
+		CreationDateTime:      createdAt,
+		NumberOfTransactions:  "1",
+		SettlementInformation: SettlementInstruction{
+			SettlementMethod: SettlementMethodClearing,
+			ClearingSystem:   &ClearingSystemIdentification{Code: ClearingSystemFedwire},
+		},
+	}
+}
+
+// interbankTransfer holds the elements every CdtTrfTxInf of a credit transfer message has
+type interbankTransfer struct {
+	PaymentIdentification     PaymentIdentification
+	InterbankSettlementAmount ActiveCurrencyAndAmount
+	InterbankSettlementDate   string
+	InstructingAgent          BranchAndFinancialInstitutionIdentification
+	InstructedAgent           BranchAndFinancialInstitutionIdentification
+}
+
+// newInterbankTransfer returns the interbankTransfer of fwm sent as the message identified by messageID
+func newInterbankTransfer(fwm *wire.FEDWireMessage, messageID string) interbankTransfer {
+	it := interbankTransfer{
+		PaymentIdentification: PaymentIdentification{
+			EndToEndIdentification: NotProvided,
+			UETR:                   uetr(messageID),
+		},
+		InterbankSettlementAmount: ActiveCurrencyAndAmount{Currency: currencyUSD},
+	}
+	if fwm.InputMessageAccountabilityData != nil {
+		it.InterbankSettlementDate = settlementDate(fwm.InputMessageAccountabilityData.InputCycleDate)
+	}
+	if fwm.Amount != nil {
+		it.InterbankSettlementAmount.Value = convert.AmountFromCents(fwm.Amount.Amount, ".")
+	}
+	if sdi := fwm.SenderDepositoryInstitution; sdi != nil {
+		it.InstructingAgent = routingNumberAgent(sdi.SenderABANumber)
+		it.InstructingAgent.FinancialInstitutionIdentification.Name = sdi.SenderShortName
+	}
+	if rdi := fwm.ReceiverDepositoryInstitution; rdi != nil {
+		it.InstructedAgent = routingNumberAgent(rdi.ReceiverABANumber)
+		it.InstructedAgent.FinancialInstitutionIdentification.Name = rdi.ReceiverShortName
+	}
+	if fwm.SenderReference != nil {
+		it.PaymentIdentification.InstructionIdentification = fwm.SenderReference.SenderReference
+	}
+	if fwm.BeneficiaryReference != nil && fwm.BeneficiaryReference.BeneficiaryReference != "" {
+		it.PaymentIdentification.EndToEndIdentification = fwm.BeneficiaryReference.BeneficiaryReference
+	}
+	return it
+}
+
+// reportTypeSubType reports a {1510} TypeSubType of fwm other than typeSubType, which a message has no element for
+func reportTypeSubType(fwm *wire.FEDWireMessage, typeSubType string, report *Report) {
+	if fwm.TypeSubType != nil && fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode != typeSubType {
+		report.Unmapped(wire.TagTypeSubType, "TypeSubType other than "+typeSubType, nowhere)
+	}
+}
+
+// transferElements are the paths of the elements of an interbankTransfer in a message, for errors and warnings.
+// The MessageIdentification and amount paths start at the Document, the others at the transaction.
+type transferElements struct {
+	MessageIdentification     string
+	Amount                    string
+	InstructingAgent          string
+	InstructedAgent           string
+	InstructionIdentification string
+	EndToEndIdentification    string
+	UETR                      string
+}
+
+// creditTransferElements returns the transferElements of the CdtTrfTxInf of the credit transfer Document at path
+func creditTransferElements(path string) transferElements {
+	return transferElements{
+		MessageIdentification:     path + "/GrpHdr/MsgId",
+		Amount:                    path + "/CdtTrfTxInf/IntrBkSttlmAmt",
+		InstructingAgent:          "CdtTrfTxInf/InstgAgt",
+		InstructedAgent:           "CdtTrfTxInf/InstdAgt",
+		InstructionIdentification: "CdtTrfTxInf/PmtId/InstrId",
+		EndToEndIdentification:    "CdtTrfTxInf/PmtId/EndToEndId",
+		UETR:                      "CdtTrfTxInf/PmtId/UETR",
+	}
+}
+
+// fedWireMessage returns the FEDWireMessage of the interbankTransfer, holding the {1500} to {3600} tags and the
+// {3320} and {4320} references. The message is identified by messageID, or else by the BusinessApplicationHeader's
+// BusinessMessageIdentifier, each of which must be an IMAD.
+func (it *interbankTransfer) fedWireMessage(hdr *BusinessApplicationHeader, messageID string, el transferElements, report *Report) (*wire.FEDWireMessage, error) {
+	imad := inputMessageAccountabilityData(messageID)
+	if imad == nil {
+		imad = inputMessageAccountabilityData(hdr.BusinessMessageIdentifier)
+	}
+	if imad == nil {
+		return nil, &ElementError{Element: el.MessageIdentification, Value: messageID, Err: ErrElementFormat}
+	}
+	if it.InterbankSettlementAmount.Currency != currencyUSD {
+		return nil, &ElementError{Element: el.Amount + "/@Ccy", Value: it.InterbankSettlementAmount.Currency, Err: ErrElementCode}
+	}
+	cents, ok := convert.AmountInCents(it.InterbankSettlementAmount.Value, ".")
+	if !ok {
+		return nil, &ElementError{Element: el.Amount, Value: it.InterbankSettlementAmount.Value, Err: ErrElementFormat}
+	}
+
+	fwm := &wire.FEDWireMessage{
+		SenderSupplied:                 hdr.senderSupplied(),
+		TypeSubType:                    wire.NewTypeSubType(),
+		InputMessageAccountabilityData: imad,
+		Amount:                         wire.NewAmount(),
+		SenderDepositoryInstitution:    wire.NewSenderDepositoryInstitution(),
+		ReceiverDepositoryInstitution:  wire.NewReceiverDepositoryInstitution(),
+		BusinessFunctionCode:           wire.NewBusinessFunctionCode(),
+	}
+	fwm.TypeSubType.TypeCode = wire.FundsTransfer
+	fwm.TypeSubType.SubTypeCode = wire.BasicFundsTransfer
+	fwm.Amount.Amount = cents
+
+	sdi := fwm.SenderDepositoryInstitution
+	sdi.SenderABANumber = routingNumber(it.InstructingAgent, hdr.From.FinancialInstitutionIdentification)
+	sdi.SenderShortName = report.Fit(at(wire.TagSenderDepositoryInstitution, el.InstructingAgent+"/FinInstnId/Nm"), it.InstructingAgent.FinancialInstitutionIdentification.Name, 18)
+	rdi := fwm.ReceiverDepositoryInstitution
+	rdi.ReceiverABANumber = routingNumber(it.InstructedAgent, hdr.To.FinancialInstitutionIdentification)
+	rdi.ReceiverShortName = report.Fit(at(wire.TagReceiverDepositoryInstitution, el.InstructedAgent+"/FinInstnId/Nm"), it.InstructedAgent.FinancialInstitutionIdentification.Name, 18)
+
+	if it.PaymentIdentification.UETR != "" && it.PaymentIdentification.UETR != uetr(messageIdentification(imad)) {
+		report.NoTag(at("", el.UETR), "")
+	}
+	if id := it.PaymentIdentification.InstructionIdentification; id != "" {
+		fwm.SenderReference = wire.NewSenderReference()
+		fwm.SenderReference.SenderReference = report.Fit(at(wire.TagSenderReference, el.InstructionIdentification), id, 16)
+	}
+	if id := it.PaymentIdentification.EndToEndIdentification; id != "" && id != NotProvided {
+		fwm.BeneficiaryReference = wire.NewBeneficiaryReference()
+		fwm.BeneficiaryReference.BeneficiaryReference = report.Fit(at(wire.TagBeneficiaryReference, el.EndToEndIdentification), id, 16)
+	}
+	return fwm, nil
+}
+
+// readXML reads the message v from r
+func readXML(r io.Reader, v interface{}) error {
+	if err := xml.NewDecoder(r).Decode(v); err != nil {
+		return fmt.Errorf("reading XML: %w", err)
+	}
+	return nil
+}
+
+// writeXML writes the message v to w as an indented XML document
+func writeXML(w io.Writer, v interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// UnknownElement is an element read which this package does not model. Each is listed as data without a
+// FEDWireMessage tag when a message is converted.
+type UnknownElement struct {
+	XMLName  xml.Name
+	InnerXML string `xml:",innerxml"`
+}
+
+// GroupHeader is the GrpHdr of a message
+type GroupHeader struct {
+	// MessageIdentification is the Fedwire IMAD of the message
+	MessageIdentification string `xml:"MsgId"`
+	// CreationDateTime of the message
+	CreationDateTime string `xml:"CreDtTm"`
+	// NumberOfTransactions in the message, which is always 1
+	NumberOfTransactions string `xml:"NbOfTxs"`
+	// SettlementInformation of the message
+	SettlementInformation SettlementInstruction `xml:"SttlmInf"`
+}
+
+// SettlementInstruction is the SttlmInf of a GroupHeader
+type SettlementInstruction struct {
+	SettlementMethod string                        `xml:"SttlmMtd"`
+	ClearingSystem   *ClearingSystemIdentification `xml:"ClrSys,omitempty"`
+}
+
+// ClearingSystemIdentification identifies a clearing system by Code or Proprietary name
+type ClearingSystemIdentification struct {
+	Code        string `xml:"Cd,omitempty"`
+	Proprietary string `xml:"Prtry,omitempty"`
+}
+
+// CodeOrProprietary is an element holding an external Code or a Proprietary value
+type CodeOrProprietary struct {
+	Code        string `xml:"Cd,omitempty"`
+	Proprietary string `xml:"Prtry,omitempty"`
+}
+
+// PaymentIdentification is the PmtId of a transaction
+type PaymentIdentification struct {
+	InstructionIdentification string `xml:"InstrId,omitempty"`
+	EndToEndIdentification    string `xml:"EndToEndId"`
+	TransactionIdentification string `xml:"TxId,omitempty"`
+	UETR                      string `xml:"UETR,omitempty"`
+}
+
+// OriginalGroupInformation is the OrgnlGrpInf of a transaction, identifying the message it refers to
+type OriginalGroupInformation struct {
+	// OriginalMessageIdentification is the {3500} PreviousMessageIdentifier of the message referred to
+	OriginalMessageIdentification string `xml:"OrgnlMsgId"`
+	// OriginalMessageNameIdentification is the message definition of the message referred to, such as pacs.008.001.08
+	OriginalMessageNameIdentification string `xml:"OrgnlMsgNmId"`
+}
+
+// OriginalTransactionReference is the OrgnlTxRef of a transaction, holding elements of the transaction it refers to
+type OriginalTransactionReference struct {
+	InterbankSettlementAmount *ActiveCurrencyAndAmount                     `xml:"IntrBkSttlmAmt,omitempty"`
+	PaymentTypeInformation    *PaymentTypeInformation                      `xml:"PmtTpInf,omitempty"`
+	RemittanceInformation     *RemittanceInformation                       `xml:"RmtInf,omitempty"`
+	Debtor                    *Party40Choice                               `xml:"Dbtr,omitempty"`
+	DebtorAccount             *CashAccount                                 `xml:"DbtrAcct,omitempty"`
+	DebtorAgent               *BranchAndFinancialInstitutionIdentification `xml:"DbtrAgt,omitempty"`
+	CreditorAgent             *BranchAndFinancialInstitutionIdentification `xml:"CdtrAgt,omitempty"`
+	Creditor                  *Party40Choice                               `xml:"Cdtr,omitempty"`
+	CreditorAccount           *CashAccount                                 `xml:"CdtrAcct,omitempty"`
+}
+
+// CaseAssignment is the Assgnmt of an investigation message, identifying the message and its sender and receiver
+type CaseAssignment struct {
+	// Identification is the Fedwire IMAD of the message
+	Identification   string        `xml:"Id"`
+	Assigner         Party40Choice `xml:"Assgnr"`
+	Assignee         Party40Choice `xml:"Assgne"`
+	CreationDateTime string        `xml:"CreDtTm"`
+}
+
+// ReasonInformation is the reason given for a cancellation request, a return or a refusal
+type ReasonInformation struct {
+	Originator            *PartyIdentification `xml:"Orgtr,omitempty"`
+	Reason                *CodeOrProprietary   `xml:"Rsn,omitempty"`
+	AdditionalInformation []string             `xml:"AddtlInf,omitempty"`
+}
+
+// PaymentTypeInformation is the PmtTpInf of a transaction
+type PaymentTypeInformation struct {
+	LocalInstrument *CodeOrProprietary `xml:"LclInstrm,omitempty"`
+	CategoryPurpose *CodeOrProprietary `xml:"CtgyPurp,omitempty"`
+}
+
+// ActiveCurrencyAndAmount is an amount in Currency
+type ActiveCurrencyAndAmount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+// ChargesInformation is a ChrgsInf of a transaction
+type ChargesInformation struct {
+	Amount ActiveCurrencyAndAmount                     `xml:"Amt"`
+	Agent  BranchAndFinancialInstitutionIdentification `xml:"Agt"`
+}
+
+// BranchAndFinancialInstitutionIdentification identifies an agent
+type BranchAndFinancialInstitutionIdentification struct {
+	FinancialInstitutionIdentification FinancialInstitutionIdentification `xml:"FinInstnId"`
+}
+
+// FinancialInstitutionIdentification is the FinInstnId of an agent
+type FinancialInstitutionIdentification struct {
+	BICFI                              string                              `xml:"BICFI,omitempty"`
+	ClearingSystemMemberIdentification *ClearingSystemMemberIdentification `xml:"ClrSysMmbId,omitempty"`
+	Name                               string                              `xml:"Nm,omitempty"`
+	PostalAddress                      *PostalAddress                      `xml:"PstlAdr,omitempty"`
+	Other                              *GenericIdentification              `xml:"Othr,omitempty"`
+}
+
+// ClearingSystemMemberIdentification identifies an agent by its member identification in a clearing system
+type ClearingSystemMemberIdentification struct {
+	ClearingSystemIdentification *ClearingSystemIdentification `xml:"ClrSysId,omitempty"`
+	MemberIdentification         string                        `xml:"MmbId"`
+}
+
+// GenericIdentification is an Identification in a scheme
+type GenericIdentification struct {
+	Identification string             `xml:"Id"`
+	SchemeName     *CodeOrProprietary `xml:"SchmeNm,omitempty"`
+	Issuer         string             `xml:"Issr,omitempty"`
+}
+
+// Party40Choice identifies a party or an agent
+type Party40Choice struct {
+	Party *PartyIdentification                         `xml:"Pty,omitempty"`
+	Agent *BranchAndFinancialInstitutionIdentification `xml:"Agt,omitempty"`
+}
+
+// PostalAddress is the PstlAdr of a party or agent
+type PostalAddress struct {
+	AddressType        *CodeOrProprietary `xml:"AdrTp,omitempty"`
+	Department         string             `xml:"Dept,omitempty"`
+	SubDepartment      string             `xml:"SubDept,omitempty"`
+	StreetName         string             `xml:"StrtNm,omitempty"`
+	BuildingNumber     string             `xml:"BldgNb,omitempty"`
+	PostCode           string             `xml:"PstCd,omitempty"`
+	TownName           string             `xml:"TwnNm,omitempty"`
+	CountrySubDivision string             `xml:"CtrySubDvsn,omitempty"`
+	Country            string             `xml:"Ctry,omitempty"`
+	AddressLine        []string           `xml:"AdrLine,omitempty"`
+}
+
+// PartyIdentification identifies a party such as the Dbtr or Cdtr of a transaction
+type PartyIdentification struct {
+	Name               string         `xml:"Nm,omitempty"`
+	PostalAddress      *PostalAddress `xml:"PstlAdr,omitempty"`
+	Identification     *Party         `xml:"Id,omitempty"`
+	CountryOfResidence string         `xml:"CtryOfRes,omitempty"`
+	ContactDetails     *Contact       `xml:"CtctDtls,omitempty"`
+}
+
+// Party is the Id of a PartyIdentification, as an organisation or a private person
+type Party struct {
+	OrganisationIdentification *OrganisationIdentification `xml:"OrgId,omitempty"`
+	PrivateIdentification      *PersonIdentification       `xml:"PrvtId,omitempty"`
+}
+
+// OrganisationIdentification is the OrgId of a Party
+type OrganisationIdentification struct {
+	AnyBIC string                  `xml:"AnyBIC,omitempty"`
+	Other  []GenericIdentification `xml:"Othr,omitempty"`
+}
+
+// PersonIdentification is the PrvtId of a Party
+type PersonIdentification struct {
+	Other []GenericIdentification `xml:"Othr,omitempty"`
+}
+
+// Contact is the CtctDtls of a party
+type Contact struct {
+	Name         string         `xml:"Nm,omitempty"`
+	PhoneNumber  string         `xml:"PhneNb,omitempty"`
+	MobileNumber string         `xml:"MobNb,omitempty"`
+	FaxNumber    string         `xml:"FaxNb,omitempty"`
+	EmailAddress string         `xml:"EmailAdr,omitempty"`
+	Other        []OtherContact `xml:"Othr,omitempty"`
+}
+
+// OtherContact is a contact of a ChannelType
+type OtherContact struct {
+	ChannelType    string `xml:"ChanlTp"`
+	Identification string `xml:"Id,omitempty"`
+}
+
+// CashAccount identifies an account
+type CashAccount struct {
+	Identification AccountIdentification `xml:"Id"`
+}
+
+// AccountIdentification is the Id of a CashAccount, as an IBAN or Other identification
+type AccountIdentification struct {
+	IBAN  string                 `xml:"IBAN,omitempty"`
+	Other *GenericIdentification `xml:"Othr,omitempty"`
+}
+
+// InstructionForCreditorAgent is an InstrForCdtrAgt of a transaction
+type InstructionForCreditorAgent struct {
+	Code                   string `xml:"Cd,omitempty"`
+	InstructionInformation string `xml:"InstrInf,omitempty"`
+}
+
+// InstructionForNextAgent is an InstrForNxtAgt of a transaction
+type InstructionForNextAgent struct {
+	InstructionInformation string `xml:"InstrInf,omitempty"`
+}
+
+// RemittanceInformation is the RmtInf of a transaction
+type RemittanceInformation struct {
+	Unstructured []string                          `xml:"Ustrd,omitempty"`
+	Structured   []StructuredRemittanceInformation `xml:"Strd,omitempty"`
+}
+
+// StructuredRemittanceInformation is a Strd of RemittanceInformation
+type StructuredRemittanceInformation struct {
+	ReferredDocumentInformation     []ReferredDocumentInformation `xml:"RfrdDocInf,omitempty"`
+	ReferredDocumentAmount          *RemittanceAmount             `xml:"RfrdDocAmt,omitempty"`
+	Invoicer                        *PartyIdentification          `xml:"Invcr,omitempty"`
+	Invoicee                        *PartyIdentification          `xml:"Invcee,omitempty"`
+	AdditionalRemittanceInformation []string                      `xml:"AddtlRmtInf,omitempty"`
+}
+
+// ReferredDocumentInformation identifies a document remittance is for
+type ReferredDocumentInformation struct {
+	Type        *ReferredDocumentType `xml:"Tp,omitempty"`
+	Number      string                `xml:"Nb,omitempty"`
+	RelatedDate string                `xml:"RltdDt,omitempty"`
+}
+
+// ReferredDocumentType is the Tp of ReferredDocumentInformation
+type ReferredDocumentType struct {
+	CodeOrProprietary CodeOrProprietary `xml:"CdOrPrtry"`
+	Issuer            string            `xml:"Issr,omitempty"`
+}
+
+// RemittanceAmount holds the amounts of a referred document
+type RemittanceAmount struct {
+	DuePayableAmount          *ActiveCurrencyAndAmount `xml:"DuePyblAmt,omitempty"`
+	DiscountAppliedAmount     []DiscountAmountAndType  `xml:"DscntApldAmt,omitempty"`
+	AdjustmentAmountAndReason []DocumentAdjustment     `xml:"AdjstmntAmtAndRsn,omitempty"`
+	RemittedAmount            *ActiveCurrencyAndAmount `xml:"RmtdAmt,omitempty"`
+}
+
+// DiscountAmountAndType is a discount applied to a referred document
+type DiscountAmountAndType struct {
+	Amount ActiveCurrencyAndAmount `xml:"Amt"`
+}
+
+// DocumentAdjustment is an adjustment of a referred document
+type DocumentAdjustment struct {
+	Amount                ActiveCurrencyAndAmount `xml:"Amt"`
+	CreditDebitIndicator  string                  `xml:"CdtDbtInd,omitempty"`
+	Reason                string                  `xml:"Rsn,omitempty"`
+	AdditionalInformation string                  `xml:"AddtlInf,omitempty"`
+}
+
+// RemittanceLocation is a RltdRmtInf of a transaction
+type RemittanceLocation struct {
+	RemittanceIdentification  string                   `xml:"RmtId,omitempty"`
+	RemittanceLocationDetails []RemittanceLocationData `xml:"RmtLctnDtls,omitempty"`
+}
+
+// RemittanceLocationData is where remittance information was sent
+type RemittanceLocationData struct {
+	Method            string          `xml:"Mtd"`
+	ElectronicAddress string          `xml:"ElctrncAdr,omitempty"`
+	PostalAddress     *NameAndAddress `xml:"PstlAdr,omitempty"`
+}
+
+// NameAndAddress is a Name with its Address
+type NameAndAddress struct {
+	Name    string        `xml:"Nm"`
+	Address PostalAddress `xml:"Adr"`
+}
@@ -0,0 +1,118 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// readMessage returns the FEDWireMessage of the JSON test file name
+func readMessage(t *testing.T, name string) *wire.FEDWireMessage {
+	t.Helper()
+
+	bs, err := os.ReadFile(filepath.Join("..", "test", "testdata", name))
+	require.NoError(t, err)
+
+	file, err := wire.FileFromJSON(bs)
+	require.NoError(t, err)
+	require.Len(t, file.FEDWireMessages, 1)
+	return &file.FEDWireMessages[0]
+}
+
+// fixedNow sets the time messages are created for the duration of the test
+func fixedNow(t *testing.T) time.Time {
+	t.Helper()
+
+	ts := time.Date(2019, time.May, 8, 10, 30, 0, 0, time.UTC)
+	now = func() time.Time { return ts }
+	t.Cleanup(func() { now = time.Now })
+	return ts
+}
+
+// messageTags returns each tag of fwm as written with variable length fields, keyed by tag
+func messageTags(t *testing.T, fwm *wire.FEDWireMessage) map[string]string {
+	t.Helper()
+
+	file := wire.NewFile()
+	file.AddFEDWireMessage(*fwm)
+	var buf bytes.Buffer
+	require.NoError(t, wire.NewWriter(&buf, wire.VariableLengthFields(true)).Write(file))
+
+	tags := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		tags[line[:6]] = line
+	}
+	return tags
+}
+
+// requireSameTags checks the tags of want and got match, other than those listed in the reports
+func requireSameTags(t *testing.T, want, got *wire.FEDWireMessage, reports ...*Report) {
+	t.Helper()
+
+	skip := make(map[string]bool)
+	for _, r := range reports {
+		for _, tag := range r.Tags() {
+			skip[tag] = true
+		}
+	}
+	wantTags, gotTags := messageTags(t, want), messageTags(t, got)
+	for tag := range skip {
+		delete(wantTags, tag)
+		delete(gotTags, tag)
+	}
+	require.Equal(t, wantTags, gotTags)
+}
+
+// IMADs of original messages sent on the cycle date of the test files and the day before
+const (
+	sameDayIMAD  = "20190410MMQFMP9Z000100"
+	priorDayIMAD = "20190409MMQFMP9Z000100"
+)
+
+// referTo sets the SubTypeCode of fwm and its {3500} PreviousMessageIdentifier, the IMAD of the message it refers to
+func referTo(fwm *wire.FEDWireMessage, subType, imad string) *wire.FEDWireMessage {
+	fwm.TypeSubType.SubTypeCode = subType
+	fwm.PreviousMessageIdentifier = wire.NewPreviousMessageIdentifier()
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = imad
+	return fwm
+}
+
+func TestRequireBusinessFunctionCode(t *testing.T) {
+	require.ErrorIs(t, requireBusinessFunctionCode(nil, wire.CustomerTransfer), ErrNoMessage)
+
+	fwm := &wire.FEDWireMessage{}
+	require.ErrorIs(t, requireBusinessFunctionCode(fwm, wire.CustomerTransfer), ErrBusinessFunctionCode)
+
+	fwm.BusinessFunctionCode = wire.NewBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = wire.BankTransfer
+	err := requireBusinessFunctionCode(fwm, wire.CustomerTransfer, wire.CustomerTransferPlus)
+	require.ErrorIs(t, err, ErrBusinessFunctionCode)
+	require.Contains(t, err.Error(), wire.BankTransfer)
+
+	fwm.BusinessFunctionCode.BusinessFunctionCode = wire.CustomerTransferPlus
+	require.NoError(t, requireBusinessFunctionCode(fwm, wire.CustomerTransfer, wire.CustomerTransferPlus))
+}
+
+func TestRequireSubTypeCode(t *testing.T) {
+	fwm := &wire.FEDWireMessage{}
+	require.ErrorIs(t, requireSubTypeCode(fwm, wire.ReversalTransfer), ErrSubTypeCode)
+
+	fwm.TypeSubType = wire.NewTypeSubType()
+	fwm.TypeSubType.SubTypeCode = wire.BasicFundsTransfer
+	err := requireSubTypeCode(fwm, wire.ReversalTransfer, wire.ReversalPriorDayTransfer)
+	require.ErrorIs(t, err, ErrSubTypeCode)
+	require.Contains(t, err.Error(), wire.BasicFundsTransfer)
+
+	fwm.TypeSubType.SubTypeCode = wire.ReversalPriorDayTransfer
+	require.NoError(t, requireSubTypeCode(fwm, wire.ReversalTransfer, wire.ReversalPriorDayTransfer))
+}
@@ -0,0 +1,388 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/wire"
+)
+
+// LocalInstrumentDepositSendersAccount is the LocalInstrument of a DEP deposit to sender's account
+const LocalInstrumentDepositSendersAccount = "DEPC"
+
+// pacs004BusinessFunctions are the BusinessFunctionCodes of reversals of customer transfers, given as pacs.008, and
+// of other transfers, given as pacs.009. The first of each is that of reversals without a known LocalInstrument.
+var pacs004BusinessFunctions = map[string][]string{
+	MessageDefinitionPacs008: {wire.CustomerTransferPlus, wire.CustomerTransfer},
+	MessageDefinitionPacs009: {wire.BankTransfer, wire.CheckSameDaySettlement, wire.DepositSendersAccount, wire.FEDFundsReturned, wire.FEDFundsSold},
+}
+
+// Pacs004 is a PaymentReturn message and its business application header
+type Pacs004 struct {
+	XMLName  xml.Name                  `xml:"Envelope"`
+	AppHdr   BusinessApplicationHeader `xml:"urn:iso:std:iso:20022:tech:xsd:head.001.001.03 AppHdr"`
+	Document Pacs004Document           `xml:"urn:iso:std:iso:20022:tech:xsd:pacs.004.001.09 Document"`
+}
+
+// Pacs004Document is the Document of a Pacs004 message
+type Pacs004Document struct {
+	PaymentReturn PaymentReturn `xml:"PmtRtr"`
+}
+
+// PaymentReturn is the PmtRtr of a Pacs004Document
+type PaymentReturn struct {
+	GroupHeader            GroupHeader                `xml:"GrpHdr"`
+	TransactionInformation []PaymentReturnTransaction `xml:"TxInf"`
+}
+
+// PaymentReturnTransaction is a TxInf of a PaymentReturn, returning the transaction its OrgnlGrpInf refers to
+type PaymentReturnTransaction struct {
+	ReturnIdentification              string                                      `xml:"RtrId,omitempty"`
+	OriginalGroupInformation          OriginalGroupInformation                    `xml:"OrgnlGrpInf"`
+	OriginalInstructionIdentification string                                      `xml:"OrgnlInstrId,omitempty"`
+	OriginalEndToEndIdentification    string                                      `xml:"OrgnlEndToEndId,omitempty"`
+	OriginalUETR                      string                                      `xml:"OrgnlUETR,omitempty"`
+	OriginalInterbankSettlementAmount *ActiveCurrencyAndAmount                    `xml:"OrgnlIntrBkSttlmAmt,omitempty"`
+	OriginalInterbankSettlementDate   string                                      `xml:"OrgnlIntrBkSttlmDt,omitempty"`
+	PaymentTypeInformation            *PaymentTypeInformation                     `xml:"PmtTpInf,omitempty"`
+	ReturnedInterbankSettlementAmount ActiveCurrencyAndAmount                     `xml:"RtrdIntrBkSttlmAmt"`
+	InterbankSettlementDate           string                                      `xml:"IntrBkSttlmDt,omitempty"`
+	InstructingAgent                  BranchAndFinancialInstitutionIdentification `xml:"InstgAgt"`
+	InstructedAgent                   BranchAndFinancialInstitutionIdentification `xml:"InstdAgt"`
+	ReturnChain                       TransactionParties                          `xml:"RtrChain"`
+	ReturnReasonInformation           []ReasonInformation                         `xml:"RtrRsnInf,omitempty"`
+	OriginalTransactionReference      *OriginalTransactionReference               `xml:"OrgnlTxRef,omitempty"`
+	UnknownElements                   []UnknownElement                            `xml:",any"`
+}
+
+// TransactionParties is the RtrChain of a PaymentReturnTransaction, the parties and agents of the return
+type TransactionParties struct {
+	Debtor                    Party40Choice                                `xml:"Dbtr"`
+	DebtorAccount             *CashAccount                                 `xml:"DbtrAcct,omitempty"`
+	DebtorAgent               *BranchAndFinancialInstitutionIdentification `xml:"DbtrAgt,omitempty"`
+	PreviousInstructingAgent1 *BranchAndFinancialInstitutionIdentification `xml:"PrvsInstgAgt1,omitempty"`
+	IntermediaryAgent1        *BranchAndFinancialInstitutionIdentification `xml:"IntrmyAgt1,omitempty"`
+	CreditorAgent             *BranchAndFinancialInstitutionIdentification `xml:"CdtrAgt,omitempty"`
+	Creditor                  Party40Choice                                `xml:"Cdtr"`
+	CreditorAccount           *CashAccount                                 `xml:"CdtrAcct,omitempty"`
+}
+
+// Pacs004FromFEDWireMessage converts a reversal of a BTR, CTR, CTP, CKS, DEP, FFR or FFS transfer to a Pacs004
+// message. The transfer reversed is given by the {3500} PreviousMessageIdentifier, as a pacs.008 customer transfer
+// or a pacs.009 transfer of another BusinessFunctionCode.
+//
+// Data of the FEDWireMessage which pacs.004 has no element for is listed in the returned Report.
+func Pacs004FromFEDWireMessage(fwm *wire.FEDWireMessage) (*Pacs004, *Report, error) {
+	err := requireBusinessFunctionCode(fwm, wire.BankTransfer, wire.CustomerTransfer, wire.CustomerTransferPlus,
+		wire.CheckSameDaySettlement, wire.DepositSendersAccount, wire.FEDFundsReturned, wire.FEDFundsSold)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := requireSubTypeCode(fwm, wire.ReversalTransfer, wire.ReversalPriorDayTransfer); err != nil {
+		return nil, nil, err
+	}
+	report := &Report{}
+	id := messageIdentification(fwm.InputMessageAccountabilityData)
+	createdAt := created()
+	bfc := fwm.BusinessFunctionCode.BusinessFunctionCode
+
+	definition := MessageDefinitionPacs009
+	if customerParties(bfc) {
+		definition = MessageDefinitionPacs008
+	}
+	it := newInterbankTransfer(fwm, id)
+	tx := PaymentReturnTransaction{
+		ReturnIdentification:              it.PaymentIdentification.InstructionIdentification,
+		OriginalGroupInformation:          originalGroupInformation(fwm, definition),
+		OriginalEndToEndIdentification:    it.PaymentIdentification.EndToEndIdentification,
+		OriginalInterbankSettlementDate:   originalSettlementDate(fwm),
+		PaymentTypeInformation:            paymentTypeInformation(fwm),
+		ReturnedInterbankSettlementAmount: it.InterbankSettlementAmount,
+		InterbankSettlementDate:           it.InterbankSettlementDate,
+		InstructingAgent:                  it.InstructingAgent,
+		InstructedAgent:                   it.InstructedAgent,
+	}
+	tx.OriginalUETR = originalUETR(tx.OriginalGroupInformation)
+	subType := subTypeCode(fwm, tx.OriginalInterbankSettlementDate, wire.ReversalTransfer, wire.ReversalPriorDayTransfer)
+	reportTypeSubType(fwm, typeCode(bfc)+subType, report)
+
+	tx.ReturnChain = pacs004Parties(fwm, &tx, report)
+	lines, unmapped := narrative(fwm)
+	tx.ReturnReasonInformation = reasonInformation(lines)
+	reportUnmappedTags(fwm, report, append(pacs004Unmapped, unmapped))
+
+	msg := &Pacs004{
+		AppHdr: newBusinessApplicationHeader(fwm, MessageDefinitionPacs004, id, createdAt, report),
+		Document: Pacs004Document{
+			PaymentReturn: PaymentReturn{
+				GroupHeader:            newGroupHeader(id, createdAt),
+				TransactionInformation: []PaymentReturnTransaction{tx},
+			},
+		},
+	}
+	return msg, report, nil
+}
+
+// pacs004Parties returns the RtrChain of the {4000} to {5200} agents and parties of fwm. The Dbtr and Cdtr are the
+// InstgAgt and InstdAgt unless the message has an Originator or Beneficiary.
+func pacs004Parties(fwm *wire.FEDWireMessage, tx *PaymentReturnTransaction, report *Report) TransactionParties {
+	chain := TransactionParties{
+		Debtor:   Party40Choice{Agent: &tx.InstructingAgent},
+		Creditor: Party40Choice{Agent: &tx.InstructedAgent},
+	}
+	if fwm.BeneficiaryIntermediaryFI != nil {
+		agt := agent(fwm.BeneficiaryIntermediaryFI.FinancialInstitution)
+		chain.IntermediaryAgent1 = &agt
+	}
+	if fwm.BeneficiaryFI != nil {
+		agt := agent(fwm.BeneficiaryFI.FinancialInstitution)
+		chain.CreditorAgent = &agt
+	}
+	if fwm.Beneficiary != nil {
+		cdtr, acct := transactionParty(fwm.Beneficiary.Personal, customerParties(fwm.BusinessFunctionCode.BusinessFunctionCode))
+		chain.Creditor, chain.CreditorAccount = *cdtr, acct
+	}
+	if dbtr, acct := debtorParty(fwm, report); dbtr != nil {
+		chain.Debtor, chain.DebtorAccount = *dbtr, acct
+	}
+	if fwm.OriginatorFI != nil {
+		agt := agent(fwm.OriginatorFI.FinancialInstitution)
+		chain.DebtorAgent = &agt
+	}
+	if fwm.InstructingFI != nil {
+		agt := agent(fwm.InstructingFI.FinancialInstitution)
+		chain.PreviousInstructingAgent1 = &agt
+	}
+	return chain
+}
+
+// pacs004Unmapped are the tags of reversals which pacs.004 has no element for
+var pacs004Unmapped = []string{
+	wire.TagPaymentNotification,
+	wire.TagCharges,
+	wire.TagInstructedAmount,
+	wire.TagExchangeRate,
+	wire.TagAccountDebitedDrawdown,
+	wire.TagAccountCreditedDrawdown,
+	wire.TagFIReceiverFI,
+	wire.TagFIDrawdownDebitAccountAdvice,
+	wire.TagFIIntermediaryFI,
+	wire.TagFIIntermediaryFIAdvice,
+	wire.TagFIBeneficiaryFI,
+	wire.TagFIBeneficiaryFIAdvice,
+	wire.TagFIBeneficiary,
+	wire.TagFIBeneficiaryAdvice,
+	wire.TagFIPaymentMethodToBeneficiary,
+	wire.TagFIAdditionalFIToFI,
+	wire.TagCurrencyInstructedAmount,
+	wire.TagOrderingCustomer,
+	wire.TagOrderingInstitution,
+	wire.TagIntermediaryInstitution,
+	wire.TagInstitutionAccount,
+	wire.TagBeneficiaryCustomer,
+	wire.TagRemittance,
+	wire.TagSenderToReceiver,
+	wire.TagUnstructuredAddenda,
+	wire.TagRelatedRemittance,
+	wire.TagRemittanceOriginator,
+	wire.TagRemittanceBeneficiary,
+	wire.TagPrimaryRemittanceDocument,
+	wire.TagActualAmountPaid,
+	wire.TagGrossAmountRemittanceDocument,
+	wire.TagAmountNegotiatedDiscount,
+	wire.TagAdjustment,
+	wire.TagDateRemittanceDocument,
+	wire.TagSecondaryRemittanceDocument,
+	wire.TagRemittanceFreeText,
+}
+
+// FEDWireMessage converts the Pacs004 message to a reversal of the transfer its OrgnlGrpInf refers to.
+//
+// The BusinessFunctionCode is given by the LocalInstrument, a return of an unknown LocalInstrument being a CTP
+// customer transfer when the original message is a pacs.008 and a BTR bank transfer otherwise. The reversal is of
+// a prior day transfer when the original settlement date is before the message's. ISO 20022 elements the
+// FEDWireMessage has no tag for, and values truncated to fit their tag, are listed in the returned Report. Only the
+// first TxInf is converted.
+func (m *Pacs004) FEDWireMessage() (*wire.FEDWireMessage, *Report, error) {
+	const path = "Document/PmtRtr"
+	rtr := &m.Document.PaymentReturn
+	if len(rtr.TransactionInformation) == 0 {
+		return nil, nil, &ElementError{Element: path + "/TxInf", Err: ErrElementRequired}
+	}
+	tx := &rtr.TransactionInformation[0]
+
+	report := &Report{}
+	if len(rtr.TransactionInformation) > 1 {
+		report.NoTag(at("", "TxInf"), "")
+	}
+	it := interbankTransfer{
+		PaymentIdentification: PaymentIdentification{
+			InstructionIdentification: tx.ReturnIdentification,
+			EndToEndIdentification:    tx.OriginalEndToEndIdentification,
+		},
+		InterbankSettlementAmount: tx.ReturnedInterbankSettlementAmount,
+		InstructingAgent:          tx.InstructingAgent,
+		InstructedAgent:           tx.InstructedAgent,
+	}
+	fwm, err := it.fedWireMessage(&m.AppHdr, rtr.GroupHeader.MessageIdentification, transferElements{
+		MessageIdentification:     path + "/GrpHdr/MsgId",
+		Amount:                    path + "/TxInf/RtrdIntrBkSttlmAmt",
+		InstructingAgent:          "TxInf/InstgAgt",
+		InstructedAgent:           "TxInf/InstdAgt",
+		InstructionIdentification: "TxInf/RtrId",
+		EndToEndIdentification:    "TxInf/OrgnlEndToEndId",
+	}, report)
+	if err != nil {
+		return nil, nil, err
+	}
+	codes, ok := pacs004BusinessFunctions[tx.OriginalGroupInformation.OriginalMessageNameIdentification]
+	if !ok {
+		codes = pacs004BusinessFunctions[MessageDefinitionPacs009]
+	}
+	businessFunctionFromPaymentType("TxInf/PmtTpInf", tx.PaymentTypeInformation, fwm, report, codes...)
+	fwm.TypeSubType.TypeCode = typeCode(fwm.BusinessFunctionCode.BusinessFunctionCode)
+	fwm.TypeSubType.SubTypeCode = subTypeCode(fwm, tx.OriginalInterbankSettlementDate, wire.ReversalTransfer, wire.ReversalPriorDayTransfer)
+	previousMessageFromOriginal("TxInf", tx.OriginalGroupInformation, tx.OriginalUETR, fwm, report)
+	if tx.OriginalInstructionIdentification != "" {
+		report.NoTag(at("", "TxInf/OrgnlInstrId"), "")
+	}
+	if tx.OriginalInterbankSettlementAmount != nil {
+		report.NoTag(at("", "TxInf/OrgnlIntrBkSttlmAmt"), "")
+	}
+
+	partiesFromPacs004(&tx.ReturnChain, tx, fwm, report)
+	narrativeFromLines("TxInf/RtrRsnInf/AddtlInf", reasonLines("TxInf/RtrRsnInf", tx.ReturnReasonInformation, report), fwm, report)
+	if tx.OriginalTransactionReference != nil {
+		report.NoTag(at("", "TxInf/OrgnlTxRef"), "")
+	}
+	for _, el := range tx.UnknownElements {
+		report.NoTag(at("", "TxInf/"+el.XMLName.Local), "")
+	}
+	return fwm, report, nil
+}
+
+// partiesFromPacs004 converts the RtrChain of tx to the {4000} to {5200} tags. A Dbtr or Cdtr which is the InstgAgt
+// or InstdAgt is not converted to an Originator or Beneficiary.
+func partiesFromPacs004(chain *TransactionParties, tx *PaymentReturnTransaction, fwm *wire.FEDWireMessage, report *Report) {
+	const path = "TxInf/RtrChain"
+	if agt := chain.IntermediaryAgent1; agt != nil {
+		fwm.BeneficiaryIntermediaryFI = wire.NewBeneficiaryIntermediaryFI()
+		fwm.BeneficiaryIntermediaryFI.FinancialInstitution = financialInstitution(wire.TagBeneficiaryIntermediaryFI, path+"/IntrmyAgt1", *agt, report)
+	}
+	if agt := chain.CreditorAgent; agt != nil {
+		fwm.BeneficiaryFI = wire.NewBeneficiaryFI()
+		fwm.BeneficiaryFI.FinancialInstitution = financialInstitution(wire.TagBeneficiaryFI, path+"/CdtrAgt", *agt, report)
+	}
+	if chain.CreditorAccount != nil || !reflect.DeepEqual(chain.Creditor, Party40Choice{Agent: &tx.InstructedAgent}) {
+		fwm.Beneficiary = wire.NewBeneficiary()
+		fwm.Beneficiary.Personal = transactionPersonal(wire.TagBeneficiary, path+"/Cdtr", chain.Creditor, chain.CreditorAccount, report)
+	}
+	if chain.DebtorAccount != nil || !reflect.DeepEqual(chain.Debtor, Party40Choice{Agent: &tx.InstructingAgent}) {
+		originatorFromParty(path+"/Dbtr", chain.Debtor, chain.DebtorAccount, fwm, report)
+	}
+	if agt := chain.DebtorAgent; agt != nil {
+		fwm.OriginatorFI = wire.NewOriginatorFI()
+		fwm.OriginatorFI.FinancialInstitution = financialInstitution(wire.TagOriginatorFI, path+"/DbtrAgt", *agt, report)
+	}
+	if agt := chain.PreviousInstructingAgent1; agt != nil {
+		fwm.InstructingFI = wire.NewInstructingFI()
+		fwm.InstructingFI.FinancialInstitution = financialInstitution(wire.TagInstructingFI, path+"/PrvsInstgAgt1", *agt, report)
+	}
+}
+
+// Validate checks the Pacs004 message against the head.001 and pacs.004 schemas, returning the first error found
+func (m *Pacs004) Validate() error {
+	return m.ValidateAll().Err()
+}
+
+// ValidateAll checks the Pacs004 message against the head.001 and pacs.004 schemas, returning every error found
+func (m *Pacs004) ValidateAll() base.ErrorList {
+	v := &validator{}
+	m.AppHdr.validate(v, "AppHdr")
+	v.code("AppHdr/MsgDefIdr", m.AppHdr.MessageDefinitionIdentifier, true, MessageDefinitionPacs004)
+	m.Document.PaymentReturn.validate(v, "Document/PmtRtr")
+	return v.errs
+}
+
+func (rtr *PaymentReturn) validate(v *validator, path string) {
+	rtr.GroupHeader.validate(v, path+"/GrpHdr")
+	v.required(path+"/TxInf", len(rtr.TransactionInformation) > 0)
+	for i := range rtr.TransactionInformation {
+		rtr.TransactionInformation[i].validate(v, path+"/TxInf")
+	}
+}
+
+func (tx *PaymentReturnTransaction) validate(v *validator, path string) {
+	v.text(path+"/RtrId", tx.ReturnIdentification, 35, false)
+	tx.OriginalGroupInformation.validate(v, path+"/OrgnlGrpInf")
+	v.text(path+"/OrgnlInstrId", tx.OriginalInstructionIdentification, 35, false)
+	v.text(path+"/OrgnlEndToEndId", tx.OriginalEndToEndIdentification, 35, false)
+	v.pattern(path+"/OrgnlUETR", tx.OriginalUETR, uuidv4Regex, false)
+	if tx.OriginalInterbankSettlementAmount != nil {
+		tx.OriginalInterbankSettlementAmount.validate(v, path+"/OrgnlIntrBkSttlmAmt")
+	}
+	v.date(path+"/OrgnlIntrBkSttlmDt", tx.OriginalInterbankSettlementDate, false)
+	if tx.PaymentTypeInformation != nil {
+		tx.PaymentTypeInformation.validate(v, path+"/PmtTpInf")
+	}
+	tx.ReturnedInterbankSettlementAmount.validate(v, path+"/RtrdIntrBkSttlmAmt")
+	v.date(path+"/IntrBkSttlmDt", tx.InterbankSettlementDate, false)
+	tx.InstructingAgent.validate(v, path+"/InstgAgt")
+	tx.InstructedAgent.validate(v, path+"/InstdAgt")
+	tx.ReturnChain.validate(v, path+"/RtrChain")
+	for i := range tx.ReturnReasonInformation {
+		tx.ReturnReasonInformation[i].validate(v, path+"/RtrRsnInf")
+	}
+	if tx.OriginalTransactionReference != nil {
+		tx.OriginalTransactionReference.validate(v, path+"/OrgnlTxRef")
+	}
+}
+
+func (chain *TransactionParties) validate(v *validator, path string) {
+	chain.Debtor.validate(v, path+"/Dbtr")
+	if chain.DebtorAccount != nil {
+		chain.DebtorAccount.validate(v, path+"/DbtrAcct")
+	}
+	agents := []struct {
+		element string
+		agent   *BranchAndFinancialInstitutionIdentification
+	}{
+		{"DbtrAgt", chain.DebtorAgent},
+		{"PrvsInstgAgt1", chain.PreviousInstructingAgent1},
+		{"IntrmyAgt1", chain.IntermediaryAgent1},
+		{"CdtrAgt", chain.CreditorAgent},
+	}
+	for _, a := range agents {
+		if a.agent != nil {
+			a.agent.validate(v, path+"/"+a.element)
+		}
+	}
+	chain.Creditor.validate(v, path+"/Cdtr")
+	if chain.CreditorAccount != nil {
+		chain.CreditorAccount.validate(v, path+"/CdtrAcct")
+	}
+}
+
+// ReadPacs004 reads a Pacs004 message from r
+func ReadPacs004(r io.Reader) (*Pacs004, error) {
+	msg := &Pacs004{}
+	if err := readXML(r, msg); err != nil {
+		return nil, err
+	}
+	if msg.Document.PaymentReturn.GroupHeader.MessageIdentification == "" {
+		return nil, fmt.Errorf("%w: no PmtRtr", ErrMessageDefinition)
+	}
+	return msg, nil
+}
+
+// Write writes the Pacs004 message to w as XML
+func (m *Pacs004) Write(w io.Writer) error {
+	return writeXML(w, m)
+}
@@ -0,0 +1,223 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPacs004FromFEDWireMessage_BankTransfer(t *testing.T) {
+	fixedNow(t)
+
+	fwm := referTo(readMessage(t, "fedWireMessage-BankTransfer.json"), wire.ReversalTransfer, sameDayIMAD)
+	msg, report, err := Pacs004FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+	require.NoError(t, msg.Validate())
+
+	require.Equal(t, MessageDefinitionPacs004, msg.AppHdr.MessageDefinitionIdentifier)
+	rtr := msg.Document.PaymentReturn
+	require.Len(t, rtr.TransactionInformation, 1)
+
+	tx := rtr.TransactionInformation[0]
+	require.Equal(t, OriginalGroupInformation{
+		OriginalMessageIdentification:     sameDayIMAD,
+		OriginalMessageNameIdentification: MessageDefinitionPacs009,
+	}, tx.OriginalGroupInformation)
+	require.Equal(t, uetr(sameDayIMAD), tx.OriginalUETR)
+	require.Equal(t, "2019-04-10", tx.OriginalInterbankSettlementDate)
+	require.Equal(t, LocalInstrumentBankTransfer, tx.PaymentTypeInformation.LocalInstrument.Proprietary)
+	require.Equal(t, ActiveCurrencyAndAmount{Currency: "USD", Value: "12345.67"}, tx.ReturnedInterbankSettlementAmount)
+	require.Equal(t, "Sender Reference", tx.ReturnIdentification)
+	require.Equal(t, "Name", tx.ReturnChain.Debtor.Agent.FinancialInstitutionIdentification.Name)
+	require.Nil(t, tx.ReturnChain.Debtor.Party)
+	require.Equal(t, "FI Name", tx.ReturnChain.CreditorAgent.FinancialInstitutionIdentification.Name)
+	require.NotNil(t, tx.ReturnChain.IntermediaryAgent1)
+	require.NotNil(t, tx.ReturnChain.PreviousInstructingAgent1)
+	require.Equal(t, []ReasonInformation{{AdditionalInformation: []string{"LineOne", "LineTwo", "LineThree", "LineFour"}}}, tx.ReturnReasonInformation)
+
+	tags := report.Tags()
+	require.Contains(t, tags, wire.TagFIReceiverFI)
+	require.NotContains(t, tags, wire.TagTypeSubType)
+	require.NotContains(t, tags, wire.TagPreviousMessageIdentifier)
+}
+
+func TestPacs004FromFEDWireMessage_CustomerTransfer(t *testing.T) {
+	fixedNow(t)
+
+	fwm := referTo(readMessage(t, "fedWireMessage-CustomerTransfer.json"), wire.ReversalPriorDayTransfer, priorDayIMAD)
+	msg, report, err := Pacs004FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+	require.NoError(t, msg.Validate())
+
+	tx := msg.Document.PaymentReturn.TransactionInformation[0]
+	require.Equal(t, MessageDefinitionPacs008, tx.OriginalGroupInformation.OriginalMessageNameIdentification)
+	require.Equal(t, "2019-04-09", tx.OriginalInterbankSettlementDate)
+	require.Equal(t, LocalInstrumentCustomerTransfer, tx.PaymentTypeInformation.LocalInstrument.Proprietary)
+	require.NotNil(t, tx.ReturnChain.Debtor.Party)
+	require.NotNil(t, tx.ReturnChain.Creditor.Party)
+	require.NotContains(t, report.Tags(), wire.TagTypeSubType)
+
+	// A prior day reversal of a transfer sent the same day is reported
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = sameDayIMAD
+	_, report, err = Pacs004FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+	require.Contains(t, report.Tags(), wire.TagTypeSubType)
+
+	// Without an IMAD the original message is not known
+	fwm.PreviousMessageIdentifier = nil
+	msg, _, err = Pacs004FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+	tx = msg.Document.PaymentReturn.TransactionInformation[0]
+	require.Equal(t, NotProvided, tx.OriginalGroupInformation.OriginalMessageIdentification)
+	require.Empty(t, tx.OriginalUETR)
+	require.Empty(t, tx.OriginalInterbankSettlementDate)
+}
+
+func TestPacs004FromFEDWireMessage_Errors(t *testing.T) {
+	_, _, err := Pacs004FromFEDWireMessage(readMessage(t, "fedWireMessage-BankTransfer.json"))
+	require.ErrorIs(t, err, ErrSubTypeCode)
+
+	_, _, err = Pacs004FromFEDWireMessage(referTo(readMessage(t, "fedWireMessage-ServiceMessage.json"), wire.ReversalTransfer, sameDayIMAD))
+	require.ErrorIs(t, err, ErrBusinessFunctionCode)
+
+	_, _, err = Pacs004FromFEDWireMessage(nil)
+	require.ErrorIs(t, err, ErrNoMessage)
+}
+
+func TestPacs004_Validate(t *testing.T) {
+	fixedNow(t)
+
+	fwm := referTo(readMessage(t, "fedWireMessage-CustomerTransfer.json"), wire.ReversalTransfer, sameDayIMAD)
+	msg, _, err := Pacs004FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+
+	tx := &msg.Document.PaymentReturn.TransactionInformation[0]
+	tx.OriginalGroupInformation.OriginalMessageNameIdentification = ""
+	tx.ReturnChain.Creditor.Agent = &tx.InstructedAgent
+	tx.ReturnReasonInformation[0].AdditionalInformation[0] = strings.Repeat("A", 106)
+
+	errs := msg.ValidateAll()
+	require.Len(t, errs, 3)
+	require.Equal(t, errs[0], msg.Validate())
+
+	var elementErr *ElementError
+	require.True(t, errors.As(errs[0], &elementErr))
+	require.Equal(t, "Document/PmtRtr/TxInf/OrgnlGrpInf/OrgnlMsgNmId", elementErr.Element)
+	require.True(t, errors.As(errs[1], &elementErr))
+	require.Equal(t, "Document/PmtRtr/TxInf/RtrChain/Cdtr", elementErr.Element)
+	require.ErrorIs(t, errs[2], ErrElementLength)
+}
+
+func TestPacs004_WriteRead(t *testing.T) {
+	fixedNow(t)
+
+	fwm := referTo(readMessage(t, "fedWireMessage-CustomerTransferPlus.json"), wire.ReversalTransfer, sameDayIMAD)
+	msg, _, err := Pacs004FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, msg.Write(&buf))
+	require.Contains(t, buf.String(), `<Document xmlns="`+NamespacePacs004+`">`)
+	require.Contains(t, buf.String(), `<RtrChain>`)
+
+	read, err := ReadPacs004(&buf)
+	require.NoError(t, err)
+	require.NoError(t, read.Validate())
+	require.Equal(t, msg.AppHdr, read.AppHdr)
+	require.Equal(t, msg.Document, read.Document)
+
+	_, err = ReadPacs004(bytes.NewReader([]byte("<Envelope></Envelope>")))
+	require.ErrorIs(t, err, ErrMessageDefinition)
+}
+
+func TestPacs004_FEDWireMessage(t *testing.T) {
+	fixedNow(t)
+
+	for _, name := range []string{
+		"fedWireMessage-BankTransfer.json",
+		"fedWireMessage-CustomerTransfer.json",
+		"fedWireMessage-CustomerTransferPlus.json",
+		"fedWireMessage-CheckSameDaySettlement.json",
+		"fedWireMessage-DepositSendersAccount.json",
+		"fedWireMessage-FEDFundsReturned.json",
+		"fedWireMessage-FEDFundsSold.json",
+	} {
+		for subType, imad := range map[string]string{
+			wire.ReversalTransfer:         sameDayIMAD,
+			wire.ReversalPriorDayTransfer: priorDayIMAD,
+		} {
+			t.Run(name+"/"+subType, func(t *testing.T) {
+				fwm := referTo(readMessage(t, name), subType, imad)
+				// Blank values are not carried by pacs.004
+				fwm.BusinessFunctionCode.TransactionTypeCode = strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode)
+				msg, exported, err := Pacs004FromFEDWireMessage(fwm)
+				require.NoError(t, err)
+
+				var buf bytes.Buffer
+				require.NoError(t, msg.Write(&buf))
+				read, err := ReadPacs004(&buf)
+				require.NoError(t, err)
+
+				got, imported, err := read.FEDWireMessage()
+				require.NoError(t, err)
+				require.NoError(t, got.ValidateAll().Err())
+				require.Equal(t, fwm.BusinessFunctionCode.BusinessFunctionCode, got.BusinessFunctionCode.BusinessFunctionCode)
+				require.Equal(t, subType, got.TypeSubType.SubTypeCode)
+				requireSameTags(t, fwm, got, exported, imported)
+			})
+		}
+	}
+}
+
+func TestPacs004_FEDWireMessageDefaults(t *testing.T) {
+	fixedNow(t)
+
+	fwm := referTo(readMessage(t, "fedWireMessage-FEDFundsSold.json"), wire.ReversalTransfer, sameDayIMAD)
+	fwm.Originator, fwm.Beneficiary = nil, nil
+	msg, _, err := Pacs004FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+
+	got, report, err := msg.FEDWireMessage()
+	require.NoError(t, err)
+	require.True(t, report.Empty())
+	require.Equal(t, wire.FEDFundsSold, got.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, wire.SettlementTransfer, got.TypeSubType.TypeCode)
+	require.Nil(t, got.Originator)
+	require.Nil(t, got.Beneficiary)
+
+	// An unknown LocalInstrument of a customer transfer return is a CTP customer transfer
+	tx := &msg.Document.PaymentReturn.TransactionInformation[0]
+	tx.OriginalGroupInformation.OriginalMessageNameIdentification = MessageDefinitionPacs008
+	tx.PaymentTypeInformation = nil
+	tx.OriginalUETR = uetr(priorDayIMAD)
+	got, report, err = msg.FEDWireMessage()
+	require.NoError(t, err)
+	require.Equal(t, wire.CustomerTransferPlus, got.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, wire.FundsTransfer, got.TypeSubType.TypeCode)
+	require.Equal(t, sameDayIMAD, got.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, []Warning{{Element: "TxInf/OrgnlUETR", Reason: "has no FEDWireMessage tag"}}, report.Warnings)
+}
+
+func TestPacs004_FEDWireMessageErrors(t *testing.T) {
+	fixedNow(t)
+
+	fwm := referTo(readMessage(t, "fedWireMessage-BankTransfer.json"), wire.ReversalTransfer, sameDayIMAD)
+	msg, _, err := Pacs004FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+
+	msg.Document.PaymentReturn.TransactionInformation[0].ReturnedInterbankSettlementAmount.Currency = "EUR"
+	_, _, err = msg.FEDWireMessage()
+	require.ErrorIs(t, err, ErrElementCode)
+
+	msg.Document.PaymentReturn.TransactionInformation = nil
+	_, _, err = msg.FEDWireMessage()
+	require.ErrorIs(t, err, ErrElementRequired)
+}
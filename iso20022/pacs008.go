@@ -0,0 +1,986 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/wire"
+)
+
+// LocalInstrumentCustomerTransfer is the LocalInstrument of a CTR customer transfer
+const LocalInstrumentCustomerTransfer = "CTRC"
+
+// swiftFieldTags are the SwiftFieldTags of the {7xxx} cover payment tags which are converted
+var swiftFieldTags = map[string]string{
+	wire.TagCurrencyInstructedAmount: "33B",
+	wire.TagOrderingCustomer:         "50K",
+	wire.TagOrderingInstitution:      "52A",
+	wire.TagIntermediaryInstitution:  "56A",
+	wire.TagInstitutionAccount:       "57A",
+	wire.TagBeneficiaryCustomer:      "59",
+	wire.TagRemittance:               "70",
+	wire.TagSenderToReceiver:         "72",
+}
+
+// Pacs008 is a FIToFICustomerCreditTransfer message and its business application header
+type Pacs008 struct {
+	XMLName  xml.Name                  `xml:"Envelope"`
+	AppHdr   BusinessApplicationHeader `xml:"urn:iso:std:iso:20022:tech:xsd:head.001.001.03 AppHdr"`
+	Document Pacs008Document           `xml:"urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08 Document"`
+}
+
+// Pacs008Document is the Document of a Pacs008 message
+type Pacs008Document struct {
+	FIToFICustomerCreditTransfer FIToFICustomerCreditTransfer `xml:"FIToFICstmrCdtTrf"`
+}
+
+// FIToFICustomerCreditTransfer is the FIToFICstmrCdtTrf of a Pacs008Document
+type FIToFICustomerCreditTransfer struct {
+	GroupHeader                          GroupHeader                 `xml:"GrpHdr"`
+	CreditTransferTransactionInformation []CreditTransferTransaction `xml:"CdtTrfTxInf"`
+}
+
+// CreditTransferTransaction is a CdtTrfTxInf of a FIToFICustomerCreditTransfer
+type CreditTransferTransaction struct {
+	PaymentIdentification        PaymentIdentification                        `xml:"PmtId"`
+	PaymentTypeInformation       *PaymentTypeInformation                      `xml:"PmtTpInf,omitempty"`
+	InterbankSettlementAmount    ActiveCurrencyAndAmount                      `xml:"IntrBkSttlmAmt"`
+	InterbankSettlementDate      string                                       `xml:"IntrBkSttlmDt,omitempty"`
+	InstructedAmount             *ActiveCurrencyAndAmount                     `xml:"InstdAmt,omitempty"`
+	ExchangeRate                 string                                       `xml:"XchgRate,omitempty"`
+	ChargeBearer                 string                                       `xml:"ChrgBr"`
+	ChargesInformation           []ChargesInformation                         `xml:"ChrgsInf,omitempty"`
+	PreviousInstructingAgent1    *BranchAndFinancialInstitutionIdentification `xml:"PrvsInstgAgt1,omitempty"`
+	PreviousInstructingAgent2    *BranchAndFinancialInstitutionIdentification `xml:"PrvsInstgAgt2,omitempty"`
+	InstructingAgent             BranchAndFinancialInstitutionIdentification  `xml:"InstgAgt"`
+	InstructedAgent              BranchAndFinancialInstitutionIdentification  `xml:"InstdAgt"`
+	IntermediaryAgent1           *BranchAndFinancialInstitutionIdentification `xml:"IntrmyAgt1,omitempty"`
+	IntermediaryAgent2           *BranchAndFinancialInstitutionIdentification `xml:"IntrmyAgt2,omitempty"`
+	IntermediaryAgent3           *BranchAndFinancialInstitutionIdentification `xml:"IntrmyAgt3,omitempty"`
+	UltimateDebtor               *PartyIdentification                         `xml:"UltmtDbtr,omitempty"`
+	Debtor                       PartyIdentification                          `xml:"Dbtr"`
+	DebtorAccount                *CashAccount                                 `xml:"DbtrAcct,omitempty"`
+	DebtorAgent                  BranchAndFinancialInstitutionIdentification  `xml:"DbtrAgt"`
+	CreditorAgent                BranchAndFinancialInstitutionIdentification  `xml:"CdtrAgt"`
+	Creditor                     PartyIdentification                          `xml:"Cdtr"`
+	CreditorAccount              *CashAccount                                 `xml:"CdtrAcct,omitempty"`
+	UltimateCreditor             *PartyIdentification                         `xml:"UltmtCdtr,omitempty"`
+	InstructionForCreditorAgent  []InstructionForCreditorAgent                `xml:"InstrForCdtrAgt,omitempty"`
+	InstructionForNextAgent      []InstructionForNextAgent                    `xml:"InstrForNxtAgt,omitempty"`
+	RelatedRemittanceInformation *RemittanceLocation                          `xml:"RltdRmtInf,omitempty"`
+	RemittanceInformation        *RemittanceInformation                       `xml:"RmtInf,omitempty"`
+	UnknownElements              []UnknownElement                             `xml:",any"`
+}
+
+// Pacs008FromFEDWireMessage converts a CTR or CTP customer transfer to a Pacs008 message.
+//
+// Data of the FEDWireMessage which pacs.008 has no element for is listed in the returned Report.
+func Pacs008FromFEDWireMessage(fwm *wire.FEDWireMessage) (*Pacs008, *Report, error) {
+	if err := requireBusinessFunctionCode(fwm, wire.CustomerTransfer, wire.CustomerTransferPlus); err != nil {
+		return nil, nil, err
+	}
+	report := &Report{}
+	id := messageIdentification(fwm.InputMessageAccountabilityData)
+	createdAt := created()
+
+	it := newInterbankTransfer(fwm, id)
+	tx := CreditTransferTransaction{
+		PaymentIdentification:     it.PaymentIdentification,
+		InterbankSettlementAmount: it.InterbankSettlementAmount,
+		InterbankSettlementDate:   it.InterbankSettlementDate,
+		ChargeBearer:              ChargeBearerServiceLevel,
+		InstructingAgent:          it.InstructingAgent,
+		InstructedAgent:           it.InstructedAgent,
+	}
+	reportTypeSubType(fwm, wire.FundsTransfer+wire.BasicFundsTransfer, report)
+	tx.PaymentTypeInformation = paymentTypeInformation(fwm)
+
+	pacs008Charges(fwm, &tx)
+	pacs008Parties(fwm, &tx, report)
+	pacs008Remittance(fwm, &tx, report)
+	pacs008Cover(fwm, &tx, report)
+	reportUnmappedTags(fwm, report, pacs008Unmapped)
+
+	msg := &Pacs008{
+		AppHdr: newBusinessApplicationHeader(fwm, MessageDefinitionPacs008, id, createdAt, report),
+		Document: Pacs008Document{
+			FIToFICustomerCreditTransfer: FIToFICustomerCreditTransfer{
+				GroupHeader:                          newGroupHeader(id, createdAt),
+				CreditTransferTransactionInformation: []CreditTransferTransaction{tx},
+			},
+		},
+	}
+	return msg, report, nil
+}
+
+// paymentTypeInformation returns the PaymentTypeInformation of a transfer: the LocalInstrument identifying its
+// BusinessFunctionCode, or the {3610} LocalInstrument of a CTP customer transfer, and its TransactionTypeCode
+func paymentTypeInformation(fwm *wire.FEDWireMessage) *PaymentTypeInformation {
+	pti := &PaymentTypeInformation{}
+	bfc := fwm.BusinessFunctionCode
+	switch instrument := businessFunctionInstruments[bfc.BusinessFunctionCode]; {
+	case instrument != "":
+		pti.LocalInstrument = &CodeOrProprietary{Proprietary: instrument}
+	case bfc.BusinessFunctionCode != wire.CustomerTransferPlus || fwm.LocalInstrument == nil:
+	case fwm.LocalInstrument.LocalInstrumentCode == wire.ProprietaryLocalInstrumentCode:
+		pti.LocalInstrument = &CodeOrProprietary{Proprietary: fwm.LocalInstrument.ProprietaryCode}
+	default:
+		pti.LocalInstrument = &CodeOrProprietary{Proprietary: fwm.LocalInstrument.LocalInstrumentCode}
+	}
+	if code := strings.TrimSpace(bfc.TransactionTypeCode); code != "" {
+		pti.CategoryPurpose = &CodeOrProprietary{Proprietary: code}
+	}
+	if pti.LocalInstrument == nil && pti.CategoryPurpose == nil {
+		return nil
+	}
+	return pti
+}
+
+// pacs008Charges converts the {3700} to {3720} charges and amounts of fwm
+func pacs008Charges(fwm *wire.FEDWireMessage, tx *CreditTransferTransaction) {
+	if c := fwm.Charges; c != nil {
+		switch c.ChargeDetails {
+		case wire.CDBeneficiary:
+			tx.ChargeBearer = ChargeBearerCreditor
+		case wire.CDShared:
+			tx.ChargeBearer = ChargeBearerShared
+		}
+		for _, charge := range []string{c.SendersChargesOne, c.SendersChargesTwo, c.SendersChargesThree, c.SendersChargesFour} {
+			if amt := currencyAmount(charge); amt != nil {
+				tx.ChargesInformation = append(tx.ChargesInformation, ChargesInformation{Amount: *amt, Agent: tx.InstructingAgent})
+			}
+		}
+	}
+	if ia := fwm.InstructedAmount; ia != nil {
+		tx.InstructedAmount = &ActiveCurrencyAndAmount{Currency: ia.CurrencyCode, Value: decimal(ia.Amount)}
+	}
+	if fwm.ExchangeRate != nil {
+		tx.ExchangeRate = decimal(fwm.ExchangeRate.ExchangeRate)
+	}
+}
+
+// pacs008Parties converts the {4000} to {5200} agents and parties of fwm
+func pacs008Parties(fwm *wire.FEDWireMessage, tx *CreditTransferTransaction, report *Report) {
+	if fwm.BeneficiaryIntermediaryFI != nil {
+		agt := agent(fwm.BeneficiaryIntermediaryFI.FinancialInstitution)
+		tx.IntermediaryAgent1 = &agt
+	}
+	tx.CreditorAgent = tx.InstructedAgent
+	if fwm.BeneficiaryFI != nil {
+		tx.CreditorAgent = agent(fwm.BeneficiaryFI.FinancialInstitution)
+	}
+	if fwm.Beneficiary != nil {
+		tx.Creditor, tx.CreditorAccount = party(fwm.Beneficiary.Personal)
+	}
+	if pn := fwm.PaymentNotification; pn != nil {
+		tx.PaymentIdentification.TransactionIdentification = pn.EndToEndIdentification
+		ctct := &Contact{
+			Name:         pn.ContactName,
+			PhoneNumber:  pn.ContactPhoneNumber,
+			MobileNumber: pn.ContactMobileNumber,
+			FaxNumber:    pn.ContactFaxNumber,
+			EmailAddress: pn.ContactNotificationElectronicAddress,
+		}
+		if pn.PaymentNotificationIndicator != "" {
+			ctct.Other = []OtherContact{{ChannelType: channelPaymentNotification, Identification: pn.PaymentNotificationIndicator}}
+		}
+		if ctct.Name+ctct.PhoneNumber+ctct.MobileNumber+ctct.FaxNumber+ctct.EmailAddress != "" || len(ctct.Other) > 0 {
+			tx.Creditor.ContactDetails = ctct
+		}
+	}
+	switch {
+	case fwm.Originator != nil:
+		tx.Debtor, tx.DebtorAccount = party(fwm.Originator.Personal)
+		if fwm.OriginatorOptionF != nil {
+			report.Unmapped(wire.TagOriginatorOptionF, "OriginatorOptionF with an Originator", nowhere)
+		}
+	case fwm.OriginatorOptionF != nil:
+		tx.Debtor = optionFParty(fwm.OriginatorOptionF)
+	}
+	tx.DebtorAgent = tx.InstructingAgent
+	if fwm.OriginatorFI != nil {
+		tx.DebtorAgent = agent(fwm.OriginatorFI.FinancialInstitution)
+	}
+	if fwm.InstructingFI != nil {
+		agt := agent(fwm.InstructingFI.FinancialInstitution)
+		tx.PreviousInstructingAgent1 = &agt
+	}
+}
+
+// pacs008Remittance converts the {6000}, {6100} and {8250} to {8750} remittance information of fwm
+func pacs008Remittance(fwm *wire.FEDWireMessage, tx *CreditTransferTransaction, report *Report) {
+	rmt := &RemittanceInformation{}
+	if ob := fwm.OriginatorToBeneficiary; ob != nil {
+		rmt.Unstructured = textLines(ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour)
+	}
+	if fwm.FIReceiverFI != nil {
+		fi := fwm.FIReceiverFI.FIToFI
+		tx.InstructionForNextAgent = nextAgentInstructions(fi.LineOne, fi.LineTwo, fi.LineThree, fi.LineFour, fi.LineFive, fi.LineSix)
+	}
+	if rr := fwm.RelatedRemittance; rr != nil {
+		loc := &RemittanceLocation{RemittanceIdentification: rr.RemittanceIdentification}
+		data := RemittanceLocationData{Method: rr.RemittanceLocationMethod, ElectronicAddress: rr.RemittanceLocationElectronicAddress}
+		if adr := remittanceAddress(rr.RemittanceData); adr != nil || rr.RemittanceData.Name != "" {
+			data.PostalAddress = &NameAndAddress{Name: rr.RemittanceData.Name}
+			if adr != nil {
+				data.PostalAddress.Address = *adr
+			}
+		}
+		if data != (RemittanceLocationData{}) {
+			loc.RemittanceLocationDetails = []RemittanceLocationData{data}
+		}
+		if rr.RemittanceData.DateBirthPlace != "" {
+			report.Unmapped(wire.TagRelatedRemittance, "DateBirthPlace", nowhere)
+		}
+		if rr.RemittanceData.CountryOfResidence != "" {
+			report.Unmapped(wire.TagRelatedRemittance, "CountryOfResidence", nowhere)
+		}
+		tx.RelatedRemittanceInformation = loc
+	}
+	if strd := structuredRemittance(fwm, report); strd != nil {
+		rmt.Structured = []StructuredRemittanceInformation{*strd}
+	}
+	if len(rmt.Unstructured) > 0 || len(rmt.Structured) > 0 {
+		tx.RemittanceInformation = rmt
+	}
+}
+
+// nextAgentInstructions returns each line as an InstructionForNextAgent
+func nextAgentInstructions(lines ...string) []InstructionForNextAgent {
+	var out []InstructionForNextAgent
+	for _, line := range textLines(lines...) {
+		out = append(out, InstructionForNextAgent{InstructionInformation: line})
+	}
+	return out
+}
+
+// structuredRemittance returns the {8300} to {8750} structured remittance information of fwm
+func structuredRemittance(fwm *wire.FEDWireMessage, report *Report) *StructuredRemittanceInformation {
+	strd := &StructuredRemittanceInformation{}
+	if ro := fwm.RemittanceOriginator; ro != nil {
+		strd.Invoicer = remittanceParty(wire.TagRemittanceOriginator, ro.IdentificationType, ro.IdentificationCode,
+			ro.IdentificationNumber, ro.IdentificationNumberIssuer, ro.RemittanceData, report)
+		ctct := &Contact{
+			Name:         ro.ContactName,
+			PhoneNumber:  ro.ContactPhoneNumber,
+			MobileNumber: ro.ContactMobileNumber,
+			FaxNumber:    ro.ContactFaxNumber,
+			EmailAddress: ro.ContactElectronicAddress,
+		}
+		if ro.ContactOther != "" {
+			ctct.Other = []OtherContact{{ChannelType: channelOther, Identification: ro.ContactOther}}
+		}
+		if ctct.Name+ctct.PhoneNumber+ctct.MobileNumber+ctct.FaxNumber+ctct.EmailAddress != "" || len(ctct.Other) > 0 {
+			strd.Invoicer.ContactDetails = ctct
+		}
+	}
+	if rb := fwm.RemittanceBeneficiary; rb != nil {
+		strd.Invoicee = remittanceParty(wire.TagRemittanceBeneficiary, rb.IdentificationType, rb.IdentificationCode,
+			rb.IdentificationNumber, rb.IdentificationNumberIssuer, rb.RemittanceData, report)
+	}
+	if prd := fwm.PrimaryRemittanceDocument; prd != nil {
+		doc := referredDocument(prd.DocumentTypeCode, prd.ProprietaryDocumentTypeCode, prd.DocumentIdentificationNumber, prd.Issuer)
+		if fwm.DateRemittanceDocument != nil {
+			doc.RelatedDate = settlementDate(fwm.DateRemittanceDocument.DateRemittanceDocument)
+		}
+		strd.ReferredDocumentInformation = append(strd.ReferredDocumentInformation, doc)
+	} else if fwm.DateRemittanceDocument != nil {
+		report.Unmapped(wire.TagDateRemittanceDocument, "DateRemittanceDocument without a PrimaryRemittanceDocument", nowhere)
+	}
+	if srd := fwm.SecondaryRemittanceDocument; srd != nil {
+		if len(strd.ReferredDocumentInformation) > 0 {
+			doc := referredDocument(srd.DocumentTypeCode, srd.ProprietaryDocumentTypeCode, srd.DocumentIdentificationNumber, srd.Issuer)
+			strd.ReferredDocumentInformation = append(strd.ReferredDocumentInformation, doc)
+		} else {
+			report.Unmapped(wire.TagSecondaryRemittanceDocument, "SecondaryRemittanceDocument without a PrimaryRemittanceDocument", nowhere)
+		}
+	}
+	amt := &RemittanceAmount{}
+	if fwm.GrossAmountRemittanceDocument != nil {
+		due := remittanceAmount(fwm.GrossAmountRemittanceDocument.RemittanceAmount)
+		amt.DuePayableAmount = &due
+	}
+	if fwm.AmountNegotiatedDiscount != nil {
+		amt.DiscountAppliedAmount = []DiscountAmountAndType{{Amount: remittanceAmount(fwm.AmountNegotiatedDiscount.RemittanceAmount)}}
+	}
+	if adj := fwm.Adjustment; adj != nil {
+		amt.AdjustmentAmountAndReason = []DocumentAdjustment{{
+			Amount:                remittanceAmount(adj.RemittanceAmount),
+			CreditDebitIndicator:  adj.CreditDebitIndicator,
+			Reason:                adj.AdjustmentReasonCode,
+			AdditionalInformation: adj.AdditionalInfo,
+		}}
+	}
+	if fwm.ActualAmountPaid != nil {
+		paid := remittanceAmount(fwm.ActualAmountPaid.RemittanceAmount)
+		amt.RemittedAmount = &paid
+	}
+	if amt.DuePayableAmount != nil || amt.DiscountAppliedAmount != nil || amt.AdjustmentAmountAndReason != nil || amt.RemittedAmount != nil {
+		strd.ReferredDocumentAmount = amt
+	}
+	if ft := fwm.RemittanceFreeText; ft != nil {
+		strd.AdditionalRemittanceInformation = textLines(ft.LineOne, ft.LineTwo, ft.LineThree)
+	}
+	if strd.Invoicer == nil && strd.Invoicee == nil && strd.ReferredDocumentInformation == nil &&
+		strd.ReferredDocumentAmount == nil && strd.AdditionalRemittanceInformation == nil {
+		return nil
+	}
+	return strd
+}
+
+// pacs008Cover converts the {7033} to {7072} cover payment tags of fwm
+func pacs008Cover(fwm *wire.FEDWireMessage, tx *CreditTransferTransaction, report *Report) {
+	if cia := fwm.CurrencyInstructedAmount; cia != nil {
+		checkSwiftFieldTag(wire.TagCurrencyInstructedAmount, cia.SwiftFieldTag, report)
+		if tx.InstructedAmount == nil {
+			tx.InstructedAmount = &ActiveCurrencyAndAmount{Currency: currencyUSD, Value: decimal(cia.Amount)}
+		} else {
+			report.Unmapped(wire.TagCurrencyInstructedAmount, "CurrencyInstructedAmount with an InstructedAmount", nowhere)
+		}
+	}
+	if fwm.OrderingCustomer != nil {
+		cp := fwm.OrderingCustomer.CoverPayment
+		checkSwiftFieldTag(wire.TagOrderingCustomer, cp.SwiftFieldTag, report)
+		tx.UltimateDebtor = &PartyIdentification{PostalAddress: coverAddress(cp)}
+	}
+	if fwm.OrderingInstitution != nil {
+		tx.PreviousInstructingAgent2 = coverAgent(wire.TagOrderingInstitution, fwm.OrderingInstitution.CoverPayment, report)
+	}
+	if fwm.IntermediaryInstitution != nil {
+		tx.IntermediaryAgent2 = coverAgent(wire.TagIntermediaryInstitution, fwm.IntermediaryInstitution.CoverPayment, report)
+	}
+	if fwm.InstitutionAccount != nil {
+		tx.IntermediaryAgent3 = coverAgent(wire.TagInstitutionAccount, fwm.InstitutionAccount.CoverPayment, report)
+	}
+	if fwm.BeneficiaryCustomer != nil {
+		cp := fwm.BeneficiaryCustomer.CoverPayment
+		checkSwiftFieldTag(wire.TagBeneficiaryCustomer, cp.SwiftFieldTag, report)
+		tx.UltimateCreditor = &PartyIdentification{PostalAddress: coverAddress(cp)}
+	}
+	if fwm.Remittance != nil {
+		cp := fwm.Remittance.CoverPayment
+		checkSwiftFieldTag(wire.TagRemittance, cp.SwiftFieldTag, report)
+		switch {
+		case fwm.OriginatorToBeneficiary != nil:
+			report.Unmapped(wire.TagRemittance, "Remittance with an OriginatorToBeneficiary", nowhere)
+		default:
+			if tx.RemittanceInformation == nil {
+				tx.RemittanceInformation = &RemittanceInformation{}
+			}
+			tx.RemittanceInformation.Unstructured = textLines(cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree, cp.SwiftLineFour)
+		}
+	}
+	if fwm.SenderToReceiver != nil {
+		cp := fwm.SenderToReceiver.CoverPayment
+		checkSwiftFieldTag(wire.TagSenderToReceiver, cp.SwiftFieldTag, report)
+		switch {
+		case fwm.FIReceiverFI != nil:
+			report.Unmapped(wire.TagSenderToReceiver, "SenderToReceiver with a FIReceiverFI", nowhere)
+		default:
+			tx.InstructionForNextAgent = nextAgentInstructions(cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree,
+				cp.SwiftLineFour, cp.SwiftLineFive, cp.SwiftLineSix)
+		}
+	}
+}
+
+// coverAgent returns the agent of a {7052} to {7057} cover payment tag
+func coverAgent(tag string, cp wire.CoverPayment, report *Report) *BranchAndFinancialInstitutionIdentification {
+	checkSwiftFieldTag(tag, cp.SwiftFieldTag, report)
+	return &BranchAndFinancialInstitutionIdentification{
+		FinancialInstitutionIdentification: FinancialInstitutionIdentification{PostalAddress: coverAddress(cp)},
+	}
+}
+
+// checkSwiftFieldTag reports a SwiftFieldTag of tag other than the one its ISO 20022 element implies
+func checkSwiftFieldTag(tag, swiftFieldTag string, report *Report) {
+	if swiftFieldTag != "" && swiftFieldTag != swiftFieldTags[tag] {
+		report.Unmapped(tag, "SwiftFieldTag "+swiftFieldTag, nowhere)
+	}
+}
+
+// pacs008Unmapped are the tags of customer transfers which pacs.008 has no element for
+var pacs008Unmapped = []string{
+	wire.TagPreviousMessageIdentifier,
+	wire.TagAccountDebitedDrawdown,
+	wire.TagAccountCreditedDrawdown,
+	wire.TagFIDrawdownDebitAccountAdvice,
+	wire.TagFIIntermediaryFI,
+	wire.TagFIIntermediaryFIAdvice,
+	wire.TagFIBeneficiaryFI,
+	wire.TagFIBeneficiaryFIAdvice,
+	wire.TagFIBeneficiary,
+	wire.TagFIBeneficiaryAdvice,
+	wire.TagFIPaymentMethodToBeneficiary,
+	wire.TagFIAdditionalFIToFI,
+	wire.TagUnstructuredAddenda,
+	wire.TagServiceMessage,
+}
+
+// localInstrumentCodes are the {3610} LocalInstrument codes other than ProprietaryLocalInstrumentCode
+var localInstrumentCodes = []string{
+	wire.ANSIX12format,
+	wire.SequenceBCoverPaymentStructured,
+	wire.GeneralXMLformat,
+	wire.ISO20022XMLformat,
+	wire.NarrativeText,
+	wire.RemittanceInformationStructured,
+	wire.RelatedRemittanceInformation,
+	wire.STP820format,
+	wire.SWIFTfield70,
+	wire.UNEDIFACTformat,
+}
+
+// FEDWireMessage converts the Pacs008 message to a CTR or CTP customer transfer.
+//
+// A message with the LocalInstrument LocalInstrumentCustomerTransfer is converted to a CTR customer transfer, and
+// any other to a CTP customer transfer. ISO 20022 elements the FEDWireMessage has no tag for, and values truncated
+// to fit their tag, are listed in the returned Report. Only the first CdtTrfTxInf is converted.
+func (m *Pacs008) FEDWireMessage() (*wire.FEDWireMessage, *Report, error) {
+	const path = "Document/FIToFICstmrCdtTrf"
+	ct := &m.Document.FIToFICustomerCreditTransfer
+	if len(ct.CreditTransferTransactionInformation) == 0 {
+		return nil, nil, &ElementError{Element: path + "/CdtTrfTxInf", Err: ErrElementRequired}
+	}
+	tx := &ct.CreditTransferTransactionInformation[0]
+
+	report := &Report{}
+	if len(ct.CreditTransferTransactionInformation) > 1 {
+		report.NoTag(at("", "CdtTrfTxInf"), "")
+	}
+	it := interbankTransfer{
+		PaymentIdentification:     tx.PaymentIdentification,
+		InterbankSettlementAmount: tx.InterbankSettlementAmount,
+		InstructingAgent:          tx.InstructingAgent,
+		InstructedAgent:           tx.InstructedAgent,
+	}
+	fwm, err := it.fedWireMessage(&m.AppHdr, ct.GroupHeader.MessageIdentification, creditTransferElements(path), report)
+	if err != nil {
+		return nil, nil, err
+	}
+	businessFunctionFromPacs008(tx, fwm, report)
+	cover := fwm.LocalInstrument != nil && fwm.LocalInstrument.LocalInstrumentCode == wire.SequenceBCoverPaymentStructured
+
+	chargesFromPacs008(tx, fwm, cover, report)
+	partiesFromPacs008(tx, fwm, report)
+	remittanceFromPacs008(tx, fwm, cover, report)
+	coverFromPacs008(tx, fwm, cover, report)
+	for _, el := range tx.UnknownElements {
+		report.NoTag(at("", "CdtTrfTxInf/"+el.XMLName.Local), "")
+	}
+	return fwm, report, nil
+}
+
+// businessFunctionFromPacs008 converts the PmtTpInf of tx to the {3600} BusinessFunctionCode and {3610} LocalInstrument
+func businessFunctionFromPacs008(tx *CreditTransferTransaction, fwm *wire.FEDWireMessage, report *Report) {
+	businessFunctionFromPaymentType("CdtTrfTxInf/PmtTpInf", tx.PaymentTypeInformation, fwm, report,
+		wire.CustomerTransferPlus, wire.CustomerTransfer)
+}
+
+// chargesFromPacs008 converts the charges and amounts of tx to the {3700} to {3720} and {7033} tags
+func chargesFromPacs008(tx *CreditTransferTransaction, fwm *wire.FEDWireMessage, cover bool, report *Report) {
+	c := wire.NewCharges()
+	switch tx.ChargeBearer {
+	case ChargeBearerCreditor:
+		c.ChargeDetails = wire.CDBeneficiary
+	case ChargeBearerShared:
+		c.ChargeDetails = wire.CDShared
+	case ChargeBearerServiceLevel:
+	default:
+		report.NoTag(at("", "CdtTrfTxInf/ChrgBr"), "")
+	}
+	var charges []string
+	for _, ci := range tx.ChargesInformation {
+		charges = append(charges, ci.Amount.Currency+faimDecimal(ci.Amount.Value))
+	}
+	lines := report.FitLineSlots(at(wire.TagCharges, "CdtTrfTxInf/ChrgsInf"), charges, 4, 15)
+	c.SendersChargesOne, c.SendersChargesTwo, c.SendersChargesThree, c.SendersChargesFour = lines[0], lines[1], lines[2], lines[3]
+	if c.ChargeDetails != "" || len(charges) > 0 {
+		if cover {
+			report.NoTag(at("", "CdtTrfTxInf/ChrgsInf"), "")
+		} else {
+			fwm.Charges = c
+		}
+	}
+
+	if amt := tx.InstructedAmount; amt != nil {
+		switch {
+		case cover:
+			if amt.Currency != currencyUSD {
+				report.NoTag(at("", "CdtTrfTxInf/InstdAmt/@Ccy"), "")
+			}
+			value := report.Fit(at(wire.TagCurrencyInstructedAmount, "CdtTrfTxInf/InstdAmt"), faimDecimal(amt.Value), 18)
+			fwm.CurrencyInstructedAmount = wire.NewCurrencyInstructedAmount()
+			fwm.CurrencyInstructedAmount.SwiftFieldTag = swiftFieldTags[wire.TagCurrencyInstructedAmount]
+			fwm.CurrencyInstructedAmount.Amount = strings.Repeat("0", 18-len(value)) + value
+		default:
+			fwm.InstructedAmount = wire.NewInstructedAmount()
+			fwm.InstructedAmount.CurrencyCode = amt.Currency
+			fwm.InstructedAmount.Amount = report.Fit(at(wire.TagInstructedAmount, "CdtTrfTxInf/InstdAmt"), faimDecimal(amt.Value), 15)
+		}
+	}
+	if tx.ExchangeRate != "" {
+		if cover {
+			report.NoTag(at("", "CdtTrfTxInf/XchgRate"), "")
+		} else {
+			fwm.ExchangeRate = wire.NewExchangeRate()
+			fwm.ExchangeRate.ExchangeRate = report.Fit(at(wire.TagExchangeRate, "CdtTrfTxInf/XchgRate"), faimDecimal(tx.ExchangeRate), 12)
+		}
+	}
+}
+
+// partiesFromPacs008 converts the agents and parties of tx to the {3620} and {4000} to {5200} tags
+func partiesFromPacs008(tx *CreditTransferTransaction, fwm *wire.FEDWireMessage, report *Report) {
+	ctp := fwm.BusinessFunctionCode.BusinessFunctionCode == wire.CustomerTransferPlus
+	if agt := tx.IntermediaryAgent1; agt != nil {
+		fwm.BeneficiaryIntermediaryFI = wire.NewBeneficiaryIntermediaryFI()
+		fwm.BeneficiaryIntermediaryFI.FinancialInstitution = financialInstitution(wire.TagBeneficiaryIntermediaryFI, "CdtTrfTxInf/IntrmyAgt1", *agt, report)
+	}
+	if !reflect.DeepEqual(tx.CreditorAgent, tx.InstructedAgent) {
+		fwm.BeneficiaryFI = wire.NewBeneficiaryFI()
+		fwm.BeneficiaryFI.FinancialInstitution = financialInstitution(wire.TagBeneficiaryFI, "CdtTrfTxInf/CdtrAgt", tx.CreditorAgent, report)
+	}
+	fwm.Beneficiary = wire.NewBeneficiary()
+	fwm.Beneficiary.Personal = personal(wire.TagBeneficiary, "CdtTrfTxInf/Cdtr", tx.Creditor, tx.CreditorAccount, report)
+
+	switch ctct := tx.Creditor.ContactDetails; {
+	case ctp && (tx.PaymentIdentification.TransactionIdentification != "" || ctct != nil):
+		tag := wire.TagPaymentNotification
+		pn := wire.NewPaymentNotification()
+		pn.EndToEndIdentification = report.Fit(at(tag, "CdtTrfTxInf/PmtId/TxId"), tx.PaymentIdentification.TransactionIdentification, 35)
+		if ctct != nil {
+			pn.ContactName = report.Fit(at(tag, "CdtTrfTxInf/Cdtr/CtctDtls/Nm"), ctct.Name, 140)
+			pn.ContactPhoneNumber = report.Fit(at(tag, "CdtTrfTxInf/Cdtr/CtctDtls/PhneNb"), ctct.PhoneNumber, 35)
+			pn.ContactMobileNumber = report.Fit(at(tag, "CdtTrfTxInf/Cdtr/CtctDtls/MobNb"), ctct.MobileNumber, 35)
+			pn.ContactFaxNumber = report.Fit(at(tag, "CdtTrfTxInf/Cdtr/CtctDtls/FaxNb"), ctct.FaxNumber, 35)
+			pn.ContactNotificationElectronicAddress = report.Fit(at(tag, "CdtTrfTxInf/Cdtr/CtctDtls/EmailAdr"), ctct.EmailAddress, 2048)
+			for _, o := range ctct.Other {
+				if o.ChannelType == channelPaymentNotification && pn.PaymentNotificationIndicator == "" {
+					pn.PaymentNotificationIndicator = report.Fit(at(tag, "CdtTrfTxInf/Cdtr/CtctDtls/Othr/Id"), o.Identification, 1)
+				} else {
+					report.NoTag(at("", "CdtTrfTxInf/Cdtr/CtctDtls/Othr"), "")
+				}
+			}
+		}
+		fwm.PaymentNotification = pn
+	default:
+		if tx.PaymentIdentification.TransactionIdentification != "" {
+			report.NoTag(at("", "CdtTrfTxInf/PmtId/TxId"), "")
+		}
+		if ctct != nil {
+			report.NoTag(at("", "CdtTrfTxInf/Cdtr/CtctDtls"), "")
+		}
+	}
+
+	if ctp && isOptionFParty(tx.Debtor) && tx.DebtorAccount == nil {
+		fwm.OriginatorOptionF = originatorOptionF("CdtTrfTxInf/Dbtr", tx.Debtor, report)
+	} else {
+		fwm.Originator = wire.NewOriginator()
+		fwm.Originator.Personal = personal(wire.TagOriginator, "CdtTrfTxInf/Dbtr", tx.Debtor, tx.DebtorAccount, report)
+	}
+	if tx.Debtor.ContactDetails != nil {
+		report.NoTag(at("", "CdtTrfTxInf/Dbtr/CtctDtls"), "")
+	}
+	if !reflect.DeepEqual(tx.DebtorAgent, tx.InstructingAgent) {
+		fwm.OriginatorFI = wire.NewOriginatorFI()
+		fwm.OriginatorFI.FinancialInstitution = financialInstitution(wire.TagOriginatorFI, "CdtTrfTxInf/DbtrAgt", tx.DebtorAgent, report)
+	}
+	if agt := tx.PreviousInstructingAgent1; agt != nil {
+		fwm.InstructingFI = wire.NewInstructingFI()
+		fwm.InstructingFI.FinancialInstitution = financialInstitution(wire.TagInstructingFI, "CdtTrfTxInf/PrvsInstgAgt1", *agt, report)
+	}
+}
+
+// remittanceFromPacs008 converts the remittance information and instructions of tx to the {6000}, {6100}, {7072}
+// and {8250} to {8750} tags. Unstructured remittance information of cover payments is kept in {6000} rather than {7070}.
+func remittanceFromPacs008(tx *CreditTransferTransaction, fwm *wire.FEDWireMessage, cover bool, report *Report) {
+	ctp := fwm.BusinessFunctionCode.BusinessFunctionCode == wire.CustomerTransferPlus
+	if rmt := tx.RemittanceInformation; rmt != nil && len(rmt.Unstructured) > 0 {
+		tag := wire.TagOriginatorToBeneficiary
+		lines := report.FitLineSlots(at(tag, "CdtTrfTxInf/RmtInf/Ustrd"), rmt.Unstructured, 4, 35)
+		fwm.OriginatorToBeneficiary = wire.NewOriginatorToBeneficiary()
+		ob := fwm.OriginatorToBeneficiary
+		ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour = lines[0], lines[1], lines[2], lines[3]
+	}
+	switch {
+	case len(tx.InstructionForNextAgent) == 0:
+	case !ctp:
+		tag := wire.TagFIReceiverFI
+		lines := report.FitLineSlots(at(tag, "CdtTrfTxInf/InstrForNxtAgt"), nextAgentLines(tx.InstructionForNextAgent), 6, 33)
+		lines[0] = report.Fit(at(tag, "CdtTrfTxInf/InstrForNxtAgt"), lines[0], 30)
+		fwm.FIReceiverFI = wire.NewFIReceiverFI()
+		fi := &fwm.FIReceiverFI.FIToFI
+		fi.LineOne, fi.LineTwo, fi.LineThree, fi.LineFour, fi.LineFive, fi.LineSix = lines[0], lines[1], lines[2], lines[3], lines[4], lines[5]
+	case cover:
+		fwm.SenderToReceiver = wire.NewSenderToReceiver()
+		fwm.SenderToReceiver.CoverPayment = coverPayment(wire.TagSenderToReceiver, "CdtTrfTxInf/InstrForNxtAgt",
+			nextAgentLines(tx.InstructionForNextAgent), 6, report)
+	default:
+		report.NoTag(at("", "CdtTrfTxInf/InstrForNxtAgt"), "")
+	}
+	if len(tx.InstructionForCreditorAgent) > 0 {
+		report.NoTag(at("", "CdtTrfTxInf/InstrForCdtrAgt"), "")
+	}
+
+	if loc := tx.RelatedRemittanceInformation; loc != nil {
+		if ctp {
+			fwm.RelatedRemittance = relatedRemittance(loc, report)
+		} else {
+			report.NoTag(at("", "CdtTrfTxInf/RltdRmtInf"), "")
+		}
+	}
+	if rmt := tx.RemittanceInformation; rmt != nil && len(rmt.Structured) > 0 {
+		if ctp {
+			structuredRemittanceFromPacs008(&rmt.Structured[0], fwm, report)
+		}
+		if !ctp || len(rmt.Structured) > 1 {
+			report.NoTag(at("", "CdtTrfTxInf/RmtInf/Strd"), "")
+		}
+	}
+}
+
+// nextAgentLines returns the InstructionInformation of each InstructionForNextAgent
+func nextAgentLines(instructions []InstructionForNextAgent) []string {
+	var lines []string
+	for _, instr := range instructions {
+		lines = append(lines, instr.InstructionInformation)
+	}
+	return lines
+}
+
+// relatedRemittance returns the {8250} RelatedRemittance of a RemittanceLocation
+func relatedRemittance(loc *RemittanceLocation, report *Report) *wire.RelatedRemittance {
+	const path = "CdtTrfTxInf/RltdRmtInf"
+	tag := wire.TagRelatedRemittance
+	rr := wire.NewRelatedRemittance()
+	rr.RemittanceIdentification = report.Fit(at(tag, path+"/RmtId"), loc.RemittanceIdentification, 35)
+	if len(loc.RemittanceLocationDetails) > 0 {
+		d := loc.RemittanceLocationDetails[0]
+		rr.RemittanceLocationMethod = report.Fit(at(tag, path+"/RmtLctnDtls/Mtd"), d.Method, 4)
+		rr.RemittanceLocationElectronicAddress = report.Fit(at(tag, path+"/RmtLctnDtls/ElctrncAdr"), d.ElectronicAddress, 2048)
+		if d.PostalAddress != nil {
+			rr.RemittanceData = remittanceData(tag, path+"/RmtLctnDtls/PstlAdr/Adr", &d.PostalAddress.Address, report)
+			rr.RemittanceData.Name = report.Fit(at(tag, path+"/RmtLctnDtls/PstlAdr/Nm"), d.PostalAddress.Name, 140)
+		}
+	}
+	if len(loc.RemittanceLocationDetails) > 1 {
+		report.NoTag(at("", path+"/RmtLctnDtls"), "")
+	}
+	return rr
+}
+
+// structuredRemittanceFromPacs008 converts structured remittance information to the {8300} to {8750} tags
+func structuredRemittanceFromPacs008(strd *StructuredRemittanceInformation, fwm *wire.FEDWireMessage, report *Report) {
+	const path = "CdtTrfTxInf/RmtInf/Strd"
+	if pty := strd.Invoicer; pty != nil {
+		tag := wire.TagRemittanceOriginator
+		ro := wire.NewRemittanceOriginator()
+		ro.IdentificationType, ro.IdentificationCode, ro.IdentificationNumber, ro.IdentificationNumberIssuer =
+			remittanceIdentification(tag, path+"/Invcr", pty, report)
+		ro.RemittanceData = remittanceData(tag, path+"/Invcr/PstlAdr", pty.PostalAddress, report)
+		ro.RemittanceData.Name = report.Fit(at(tag, path+"/Invcr/Nm"), pty.Name, 140)
+		ro.RemittanceData.CountryOfResidence = report.Fit(at(tag, path+"/Invcr/CtryOfRes"), pty.CountryOfResidence, 2)
+		if ctct := pty.ContactDetails; ctct != nil {
+			ro.ContactName = report.Fit(at(tag, path+"/Invcr/CtctDtls/Nm"), ctct.Name, 140)
+			ro.ContactPhoneNumber = report.Fit(at(tag, path+"/Invcr/CtctDtls/PhneNb"), ctct.PhoneNumber, 35)
+			ro.ContactMobileNumber = report.Fit(at(tag, path+"/Invcr/CtctDtls/MobNb"), ctct.MobileNumber, 35)
+			ro.ContactFaxNumber = report.Fit(at(tag, path+"/Invcr/CtctDtls/FaxNb"), ctct.FaxNumber, 35)
+			ro.ContactElectronicAddress = report.Fit(at(tag, path+"/Invcr/CtctDtls/EmailAdr"), ctct.EmailAddress, 2048)
+			for i, o := range ctct.Other {
+				if i == 0 {
+					ro.ContactOther = report.Fit(at(tag, path+"/Invcr/CtctDtls/Othr/Id"), o.Identification, 35)
+				} else {
+					report.NoTag(at("", path+"/Invcr/CtctDtls/Othr"), "")
+				}
+			}
+		}
+		fwm.RemittanceOriginator = ro
+	}
+	if pty := strd.Invoicee; pty != nil {
+		tag := wire.TagRemittanceBeneficiary
+		rb := wire.NewRemittanceBeneficiary()
+		rb.IdentificationType, rb.IdentificationCode, rb.IdentificationNumber, rb.IdentificationNumberIssuer =
+			remittanceIdentification(tag, path+"/Invcee", pty, report)
+		rb.RemittanceData = remittanceData(tag, path+"/Invcee/PstlAdr", pty.PostalAddress, report)
+		rb.RemittanceData.Name = report.Fit(at(tag, path+"/Invcee/Nm"), pty.Name, 140)
+		rb.RemittanceData.CountryOfResidence = report.Fit(at(tag, path+"/Invcee/CtryOfRes"), pty.CountryOfResidence, 2)
+		if pty.ContactDetails != nil {
+			report.NoTag(at("", path+"/Invcee/CtctDtls"), "")
+		}
+		fwm.RemittanceBeneficiary = rb
+	}
+
+	docs := strd.ReferredDocumentInformation
+	if len(docs) > 0 {
+		prd := wire.NewPrimaryRemittanceDocument()
+		prd.DocumentTypeCode, prd.ProprietaryDocumentTypeCode, prd.DocumentIdentificationNumber, prd.Issuer =
+			documentIdentification(wire.TagPrimaryRemittanceDocument, path+"/RfrdDocInf", docs[0], report)
+		fwm.PrimaryRemittanceDocument = prd
+		if docs[0].RelatedDate != "" {
+			fwm.DateRemittanceDocument = wire.NewDateRemittanceDocument()
+			fwm.DateRemittanceDocument.DateRemittanceDocument = faimDateOf(docs[0].RelatedDate)
+		}
+	}
+	if len(docs) > 1 {
+		srd := wire.NewSecondaryRemittanceDocument()
+		srd.DocumentTypeCode, srd.ProprietaryDocumentTypeCode, srd.DocumentIdentificationNumber, srd.Issuer =
+			documentIdentification(wire.TagSecondaryRemittanceDocument, path+"/RfrdDocInf", docs[1], report)
+		fwm.SecondaryRemittanceDocument = srd
+		if docs[1].RelatedDate != "" {
+			report.NoTag(at("", path+"/RfrdDocInf/RltdDt"), "")
+		}
+	}
+	if len(docs) > 2 {
+		report.NoTag(at("", path+"/RfrdDocInf"), "")
+	}
+
+	if amt := strd.ReferredDocumentAmount; amt != nil {
+		if amt.DuePayableAmount != nil {
+			fwm.GrossAmountRemittanceDocument = wire.NewGrossAmountRemittanceDocument()
+			fwm.GrossAmountRemittanceDocument.RemittanceAmount = faimRemittanceAmount(wire.TagGrossAmountRemittanceDocument,
+				path+"/RfrdDocAmt/DuePyblAmt", *amt.DuePayableAmount, report)
+		}
+		if len(amt.DiscountAppliedAmount) > 0 {
+			fwm.AmountNegotiatedDiscount = wire.NewAmountNegotiatedDiscount()
+			fwm.AmountNegotiatedDiscount.RemittanceAmount = faimRemittanceAmount(wire.TagAmountNegotiatedDiscount,
+				path+"/RfrdDocAmt/DscntApldAmt/Amt", amt.DiscountAppliedAmount[0].Amount, report)
+		}
+		if len(amt.DiscountAppliedAmount) > 1 {
+			report.NoTag(at("", path+"/RfrdDocAmt/DscntApldAmt"), "")
+		}
+		if len(amt.AdjustmentAmountAndReason) > 0 {
+			tag := wire.TagAdjustment
+			adj := amt.AdjustmentAmountAndReason[0]
+			fwm.Adjustment = wire.NewAdjustment()
+			fwm.Adjustment.RemittanceAmount = faimRemittanceAmount(tag, path+"/RfrdDocAmt/AdjstmntAmtAndRsn/Amt", adj.Amount, report)
+			fwm.Adjustment.CreditDebitIndicator = adj.CreditDebitIndicator
+			fwm.Adjustment.AdjustmentReasonCode = report.Fit(at(tag, path+"/RfrdDocAmt/AdjstmntAmtAndRsn/Rsn"), adj.Reason, 2)
+			fwm.Adjustment.AdditionalInfo = report.Fit(at(tag, path+"/RfrdDocAmt/AdjstmntAmtAndRsn/AddtlInf"), adj.AdditionalInformation, 140)
+		}
+		if len(amt.AdjustmentAmountAndReason) > 1 {
+			report.NoTag(at("", path+"/RfrdDocAmt/AdjstmntAmtAndRsn"), "")
+		}
+		if amt.RemittedAmount != nil {
+			fwm.ActualAmountPaid = wire.NewActualAmountPaid()
+			fwm.ActualAmountPaid.RemittanceAmount = faimRemittanceAmount(wire.TagActualAmountPaid,
+				path+"/RfrdDocAmt/RmtdAmt", *amt.RemittedAmount, report)
+		}
+	}
+	if len(strd.AdditionalRemittanceInformation) > 0 {
+		lines := report.FitLineSlots(at(wire.TagRemittanceFreeText, path+"/AddtlRmtInf"), strd.AdditionalRemittanceInformation, 3, 140)
+		fwm.RemittanceFreeText = wire.NewRemittanceFreeText()
+		fwm.RemittanceFreeText.LineOne, fwm.RemittanceFreeText.LineTwo, fwm.RemittanceFreeText.LineThree = lines[0], lines[1], lines[2]
+	}
+}
+
+// coverFromPacs008 converts the parties and agents of tx which a SequenceBCoverPaymentStructured transfer holds in
+// its {7050} to {7059} cover payment tags
+func coverFromPacs008(tx *CreditTransferTransaction, fwm *wire.FEDWireMessage, cover bool, report *Report) {
+	if pty := tx.UltimateDebtor; pty != nil {
+		const element = "CdtTrfTxInf/UltmtDbtr"
+		if cover {
+			fwm.OrderingCustomer = wire.NewOrderingCustomer()
+			fwm.OrderingCustomer.CoverPayment = coverPayment(wire.TagOrderingCustomer, element, partyLines(element, pty, report), 5, report)
+		} else {
+			report.NoTag(at("", element), "")
+		}
+	}
+	if agt := tx.PreviousInstructingAgent2; agt != nil {
+		const element = "CdtTrfTxInf/PrvsInstgAgt2"
+		if cover {
+			fwm.OrderingInstitution = wire.NewOrderingInstitution()
+			fwm.OrderingInstitution.CoverPayment = coverPayment(wire.TagOrderingInstitution, element, agentLines(element, agt, report), 5, report)
+		} else {
+			report.NoTag(at("", element), "")
+		}
+	}
+	if agt := tx.IntermediaryAgent2; agt != nil {
+		const element = "CdtTrfTxInf/IntrmyAgt2"
+		if cover {
+			fwm.IntermediaryInstitution = wire.NewIntermediaryInstitution()
+			fwm.IntermediaryInstitution.CoverPayment = coverPayment(wire.TagIntermediaryInstitution, element, agentLines(element, agt, report), 5, report)
+		} else {
+			report.NoTag(at("", element), "")
+		}
+	}
+	if agt := tx.IntermediaryAgent3; agt != nil {
+		const element = "CdtTrfTxInf/IntrmyAgt3"
+		if cover {
+			fwm.InstitutionAccount = wire.NewInstitutionAccount()
+			fwm.InstitutionAccount.CoverPayment = coverPayment(wire.TagInstitutionAccount, element, agentLines(element, agt, report), 5, report)
+		} else {
+			report.NoTag(at("", element), "")
+		}
+	}
+	if pty := tx.UltimateCreditor; pty != nil {
+		const element = "CdtTrfTxInf/UltmtCdtr"
+		if cover {
+			fwm.BeneficiaryCustomer = wire.NewBeneficiaryCustomer()
+			fwm.BeneficiaryCustomer.CoverPayment = coverPayment(wire.TagBeneficiaryCustomer, element, partyLines(element, pty, report), 5, report)
+		} else {
+			report.NoTag(at("", element), "")
+		}
+	}
+}
+
+// partyLines returns the name and address of a party as the lines of a cover payment tag, reporting its other elements
+func partyLines(element string, pty *PartyIdentification, report *Report) []string {
+	var lines []string
+	if pty.Name != "" {
+		lines = append(lines, pty.Name)
+	}
+	if pty.Identification != nil {
+		report.NoTag(at("", element+"/Id"), "")
+	}
+	if pty.CountryOfResidence != "" {
+		report.NoTag(at("", element+"/CtryOfRes"), "")
+	}
+	if pty.ContactDetails != nil {
+		report.NoTag(at("", element+"/CtctDtls"), "")
+	}
+	return append(lines, addressLines(pty.PostalAddress)...)
+}
+
+// agentLines returns the BIC, name and address of an agent as the lines of a cover payment tag, reporting its
+// other identifications
+func agentLines(element string, agt *BranchAndFinancialInstitutionIdentification, report *Report) []string {
+	id := agt.FinancialInstitutionIdentification
+	var lines []string
+	for _, line := range []string{id.BICFI, id.Name} {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if id.ClearingSystemMemberIdentification != nil {
+		report.NoTag(at("", element+"/FinInstnId/ClrSysMmbId"), "")
+	}
+	if id.Other != nil {
+		report.NoTag(at("", element+"/FinInstnId/Othr"), "")
+	}
+	return append(lines, addressLines(id.PostalAddress)...)
+}
+
+// coverPayment returns up to count lines as the CoverPayment of a {7xxx} tag
+func coverPayment(tag, element string, lines []string, count int, report *Report) wire.CoverPayment {
+	l := append(report.FitLineSlots(at(tag, element), lines, count, 35), make([]string, 6-count)...)
+	return wire.CoverPayment{
+		SwiftFieldTag:  swiftFieldTags[tag],
+		SwiftLineOne:   l[0],
+		SwiftLineTwo:   l[1],
+		SwiftLineThree: l[2],
+		SwiftLineFour:  l[3],
+		SwiftLineFive:  l[4],
+		SwiftLineSix:   l[5],
+	}
+}
+
+// Validate checks the Pacs008 message against the head.001 and pacs.008 schemas, returning the first error found
+func (m *Pacs008) Validate() error {
+	return m.ValidateAll().Err()
+}
+
+// ValidateAll checks the Pacs008 message against the head.001 and pacs.008 schemas, returning every error found
+func (m *Pacs008) ValidateAll() base.ErrorList {
+	v := &validator{}
+	m.AppHdr.validate(v, "AppHdr")
+	v.code("AppHdr/MsgDefIdr", m.AppHdr.MessageDefinitionIdentifier, true, MessageDefinitionPacs008)
+	m.Document.FIToFICustomerCreditTransfer.validate(v, "Document/FIToFICstmrCdtTrf")
+	return v.errs
+}
+
+func (ct *FIToFICustomerCreditTransfer) validate(v *validator, path string) {
+	ct.GroupHeader.validate(v, path+"/GrpHdr")
+	v.required(path+"/CdtTrfTxInf", len(ct.CreditTransferTransactionInformation) > 0)
+	for i := range ct.CreditTransferTransactionInformation {
+		ct.CreditTransferTransactionInformation[i].validate(v, path+"/CdtTrfTxInf")
+	}
+}
+
+func (tx *CreditTransferTransaction) validate(v *validator, path string) {
+	tx.PaymentIdentification.validate(v, path+"/PmtId")
+	if tx.PaymentTypeInformation != nil {
+		tx.PaymentTypeInformation.validate(v, path+"/PmtTpInf")
+	}
+	tx.InterbankSettlementAmount.validate(v, path+"/IntrBkSttlmAmt")
+	v.date(path+"/IntrBkSttlmDt", tx.InterbankSettlementDate, false)
+	if tx.InstructedAmount != nil {
+		tx.InstructedAmount.validate(v, path+"/InstdAmt")
+	}
+	v.pattern(path+"/XchgRate", tx.ExchangeRate, decimalRegex, false)
+	v.code(path+"/ChrgBr", tx.ChargeBearer, true, "DEBT", ChargeBearerCreditor, ChargeBearerShared, ChargeBearerServiceLevel)
+	for i := range tx.ChargesInformation {
+		tx.ChargesInformation[i].Amount.validate(v, path+"/ChrgsInf/Amt")
+		tx.ChargesInformation[i].Agent.validate(v, path+"/ChrgsInf/Agt")
+	}
+	agents := []struct {
+		element string
+		agent   *BranchAndFinancialInstitutionIdentification
+	}{
+		{"PrvsInstgAgt1", tx.PreviousInstructingAgent1},
+		{"PrvsInstgAgt2", tx.PreviousInstructingAgent2},
+		{"InstgAgt", &tx.InstructingAgent},
+		{"InstdAgt", &tx.InstructedAgent},
+		{"IntrmyAgt1", tx.IntermediaryAgent1},
+		{"IntrmyAgt2", tx.IntermediaryAgent2},
+		{"IntrmyAgt3", tx.IntermediaryAgent3},
+		{"DbtrAgt", &tx.DebtorAgent},
+		{"CdtrAgt", &tx.CreditorAgent},
+	}
+	for _, a := range agents {
+		if a.agent != nil {
+			a.agent.validate(v, path+"/"+a.element)
+		}
+	}
+	if tx.UltimateDebtor != nil {
+		tx.UltimateDebtor.validate(v, path+"/UltmtDbtr")
+	}
+	tx.Debtor.validate(v, path+"/Dbtr")
+	if tx.DebtorAccount != nil {
+		tx.DebtorAccount.validate(v, path+"/DbtrAcct")
+	}
+	tx.Creditor.validate(v, path+"/Cdtr")
+	if tx.CreditorAccount != nil {
+		tx.CreditorAccount.validate(v, path+"/CdtrAcct")
+	}
+	if tx.UltimateCreditor != nil {
+		tx.UltimateCreditor.validate(v, path+"/UltmtCdtr")
+	}
+	if len(tx.InstructionForCreditorAgent) > 2 {
+		v.add(path+"/InstrForCdtrAgt", "", ErrElementLength)
+	}
+	for _, instr := range tx.InstructionForCreditorAgent {
+		v.text(path+"/InstrForCdtrAgt/Cd", instr.Code, 4, false)
+		v.text(path+"/InstrForCdtrAgt/InstrInf", instr.InstructionInformation, 140, false)
+	}
+	validateNextAgentInstructions(v, path+"/InstrForNxtAgt", tx.InstructionForNextAgent)
+	if tx.RelatedRemittanceInformation != nil {
+		tx.RelatedRemittanceInformation.validate(v, path+"/RltdRmtInf")
+	}
+	if tx.RemittanceInformation != nil {
+		tx.RemittanceInformation.validate(v, path+"/RmtInf")
+	}
+}
+
+// ReadPacs008 reads a Pacs008 message from r
+func ReadPacs008(r io.Reader) (*Pacs008, error) {
+	msg := &Pacs008{}
+	if err := readXML(r, msg); err != nil {
+		return nil, err
+	}
+	if msg.Document.FIToFICustomerCreditTransfer.GroupHeader.MessageIdentification == "" {
+		return nil, fmt.Errorf("%w: no FIToFICstmrCdtTrf", ErrMessageDefinition)
+	}
+	return msg, nil
+}
+
+// Write writes the Pacs008 message to w as XML
+func (m *Pacs008) Write(w io.Writer) error {
+	return writeXML(w, m)
+}
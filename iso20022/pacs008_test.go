@@ -0,0 +1,388 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPacs008FromFEDWireMessage_CustomerTransfer(t *testing.T) {
+	fixedNow(t)
+
+	msg, report, err := Pacs008FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerTransfer.json"))
+	require.NoError(t, err)
+	require.NoError(t, msg.Validate())
+
+	require.Equal(t, "20190410Source08000001", msg.AppHdr.BusinessMessageIdentifier)
+	require.Equal(t, MessageDefinitionPacs008, msg.AppHdr.MessageDefinitionIdentifier)
+	require.Equal(t, BusinessServiceTest, msg.AppHdr.BusinessService)
+	require.Equal(t, "121042882", msg.AppHdr.From.FinancialInstitutionIdentification.FinancialInstitutionIdentification.ClearingSystemMemberIdentification.MemberIdentification)
+	require.Equal(t, "231380104", msg.AppHdr.To.FinancialInstitutionIdentification.FinancialInstitutionIdentification.ClearingSystemMemberIdentification.MemberIdentification)
+	require.Equal(t, "2019-05-08T10:30:00Z", msg.AppHdr.CreationDate)
+
+	ct := msg.Document.FIToFICustomerCreditTransfer
+	require.Equal(t, "20190410Source08000001", ct.GroupHeader.MessageIdentification)
+	require.Equal(t, "1", ct.GroupHeader.NumberOfTransactions)
+	require.Equal(t, ClearingSystemFedwire, ct.GroupHeader.SettlementInformation.ClearingSystem.Code)
+	require.Len(t, ct.CreditTransferTransactionInformation, 1)
+
+	tx := ct.CreditTransferTransactionInformation[0]
+	require.Equal(t, "Sender Reference", tx.PaymentIdentification.InstructionIdentification)
+	require.Equal(t, "Reference", tx.PaymentIdentification.EndToEndIdentification)
+	require.Equal(t, uetr("20190410Source08000001"), tx.PaymentIdentification.UETR)
+	require.Equal(t, LocalInstrumentCustomerTransfer, tx.PaymentTypeInformation.LocalInstrument.Proprietary)
+	require.Equal(t, ActiveCurrencyAndAmount{Currency: "USD", Value: "12345.67"}, tx.InterbankSettlementAmount)
+	require.Equal(t, "2019-04-10", tx.InterbankSettlementDate)
+	require.Equal(t, &ActiveCurrencyAndAmount{Currency: "USD", Value: "4567.89"}, tx.InstructedAmount)
+	require.Equal(t, "1.2345", tx.ExchangeRate)
+	require.Equal(t, ChargeBearerCreditor, tx.ChargeBearer)
+	require.Len(t, tx.ChargesInformation, 4)
+	require.Equal(t, ActiveCurrencyAndAmount{Currency: "USD", Value: "0.99"}, tx.ChargesInformation[0].Amount)
+	require.Equal(t, tx.InstructingAgent, tx.ChargesInformation[0].Agent)
+	require.Equal(t, "Wells Fargo NA", tx.InstructingAgent.FinancialInstitutionIdentification.Name)
+	require.Equal(t, "Citadel", tx.InstructedAgent.FinancialInstitutionIdentification.Name)
+
+	require.Equal(t, "Name", tx.Debtor.Name)
+	require.Equal(t, []string{"Address One", " ", "Address Three"}, tx.Debtor.PostalAddress.AddressLine)
+	require.Equal(t, "CCPT", tx.Debtor.Identification.PrivateIdentification.Other[0].SchemeName.Code)
+	require.Equal(t, "DRLC", tx.Creditor.Identification.PrivateIdentification.Other[0].SchemeName.Code)
+	require.Equal(t, "123456789", tx.DebtorAgent.FinancialInstitutionIdentification.Other.Identification)
+	require.Equal(t, wire.DemandDepositAccountNumber, tx.CreditorAgent.FinancialInstitutionIdentification.Other.SchemeName.Proprietary)
+	require.NotNil(t, tx.IntermediaryAgent1)
+	require.NotNil(t, tx.PreviousInstructingAgent1)
+
+	require.Equal(t, []string{"LineOne", "LineTwo", "LineThree", "LineFour"}, tx.RemittanceInformation.Unstructured)
+	require.Equal(t, []InstructionForNextAgent{{InstructionInformation: "Line Six"}}, tx.InstructionForNextAgent)
+
+	require.Equal(t, []string{
+		wire.TagPreviousMessageIdentifier,
+		wire.TagFIIntermediaryFI,
+		wire.TagFIIntermediaryFIAdvice,
+		wire.TagFIBeneficiaryFI,
+		wire.TagFIBeneficiaryFIAdvice,
+		wire.TagFIBeneficiary,
+		wire.TagFIBeneficiaryAdvice,
+		wire.TagFIPaymentMethodToBeneficiary,
+		wire.TagFIAdditionalFIToFI,
+		wire.TagSenderSupplied,
+	}, report.Tags())
+}
+
+func TestPacs008FromFEDWireMessage_CustomerTransferPlus(t *testing.T) {
+	fixedNow(t)
+
+	msg, report, err := Pacs008FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.json"))
+	require.NoError(t, err)
+	require.NoError(t, msg.Validate())
+
+	tx := msg.Document.FIToFICustomerCreditTransfer.CreditTransferTransactionInformation[0]
+	require.Equal(t, wire.SequenceBCoverPaymentStructured, tx.PaymentTypeInformation.LocalInstrument.Proprietary)
+	require.Equal(t, "End To End Identification", tx.PaymentIdentification.TransactionIdentification)
+	require.Equal(t, ChargeBearerServiceLevel, tx.ChargeBearer)
+
+	ctct := tx.Creditor.ContactDetails
+	require.Equal(t, "Contact Name", ctct.Name)
+	require.Equal(t, "http://moov.io", ctct.EmailAddress)
+	require.Equal(t, []OtherContact{{ChannelType: channelPaymentNotification, Identification: "1"}}, ctct.Other)
+
+	require.Equal(t, &ActiveCurrencyAndAmount{Currency: "USD", Value: "1500.49"}, tx.InstructedAmount)
+	require.Len(t, tx.UltimateDebtor.PostalAddress.AddressLine, 5)
+	require.Len(t, tx.UltimateCreditor.PostalAddress.AddressLine, 5)
+	require.NotNil(t, tx.PreviousInstructingAgent2)
+	require.NotNil(t, tx.IntermediaryAgent2)
+	require.NotNil(t, tx.IntermediaryAgent3)
+	require.Len(t, tx.InstructionForNextAgent, 6)
+	require.Equal(t, []string{"LineOne", "LineTwo", "LineThree", "LineFour"}, tx.RemittanceInformation.Unstructured)
+
+	tags := report.Tags()
+	for _, tag := range []string{wire.TagOriginatorOptionF, wire.TagCurrencyInstructedAmount, wire.TagOrderingCustomer, wire.TagRemittance, wire.TagSenderToReceiver} {
+		require.Contains(t, tags, tag)
+	}
+	require.NotContains(t, tags, wire.TagOriginator)
+	require.NotContains(t, tags, wire.TagPaymentNotification)
+}
+
+func TestPacs008FromFEDWireMessage_Cover(t *testing.T) {
+	fixedNow(t)
+
+	fwm := readMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.json")
+	fwm.OriginatorToBeneficiary = nil
+	fwm.FIReceiverFI = nil
+	fwm.Originator = nil
+	fwm.Remittance.CoverPayment.SwiftFieldTag = "70"
+	fwm.SenderToReceiver.CoverPayment.SwiftFieldTag = "72"
+
+	msg, report, err := Pacs008FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+	require.NoError(t, msg.Validate())
+
+	tx := msg.Document.FIToFICustomerCreditTransfer.CreditTransferTransactionInformation[0]
+	require.Equal(t, []string{"Swift Line One", "Swift Line Two", "Swift Line Three", "Swift Line Four"}, tx.RemittanceInformation.Unstructured)
+	require.Equal(t, "Swift Line Six", tx.InstructionForNextAgent[5].InstructionInformation)
+	require.Equal(t, "TXID/123-45-6789", tx.Debtor.Identification.PrivateIdentification.Other[0].Identification)
+	require.Equal(t, optionFScheme, tx.Debtor.Identification.PrivateIdentification.Other[0].SchemeName.Proprietary)
+	require.Equal(t, "1/Name", tx.Debtor.Name)
+
+	tags := report.Tags()
+	require.NotContains(t, tags, wire.TagRemittance)
+	require.NotContains(t, tags, wire.TagSenderToReceiver)
+	require.NotContains(t, tags, wire.TagOriginatorOptionF)
+}
+
+func TestPacs008FromFEDWireMessage_StructuredRemittance(t *testing.T) {
+	fixedNow(t)
+
+	msg, report, err := Pacs008FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerTransferPlusStructuredRemittance.json"))
+	require.NoError(t, err)
+	require.NoError(t, msg.Validate())
+	require.NotContains(t, report.Tags(), wire.TagRemittanceOriginator)
+
+	strd := msg.Document.FIToFICustomerCreditTransfer.CreditTransferTransactionInformation[0].RemittanceInformation.Structured
+	require.Len(t, strd, 1)
+
+	docs := strd[0].ReferredDocumentInformation
+	require.Len(t, docs, 2)
+	require.Equal(t, "AROI", docs[0].Type.CodeOrProprietary.Code)
+	require.Equal(t, "111111", docs[0].Number)
+	require.Equal(t, "2019-05-09", docs[0].RelatedDate)
+	require.Equal(t, "Issuer 2", docs[1].Type.Issuer)
+
+	amt := strd[0].ReferredDocumentAmount
+	require.Equal(t, "1234.56", amt.RemittedAmount.Value)
+	require.Equal(t, "1234.56", amt.DuePayableAmount.Value)
+	require.Equal(t, "1234.56", amt.DiscountAppliedAmount[0].Amount.Value)
+	require.Equal(t, wire.CreditIndicator, amt.AdjustmentAmountAndReason[0].CreditDebitIndicator)
+
+	invcr := strd[0].Invoicer
+	require.Equal(t, "CUST", invcr.Identification.OrganisationIdentification.Other[0].SchemeName.Code)
+	require.Equal(t, "Bank", invcr.Identification.OrganisationIdentification.Other[0].Issuer)
+	require.Equal(t, "ADDR", invcr.PostalAddress.AddressType.Code)
+	require.Len(t, invcr.PostalAddress.AddressLine, 7)
+	require.Equal(t, "Contact Other", invcr.ContactDetails.Other[0].Identification)
+	require.NotNil(t, strd[0].Invoicee)
+	require.Len(t, strd[0].AdditionalRemittanceInformation, 3)
+}
+
+func TestPacs008FromFEDWireMessage_RelatedRemittance(t *testing.T) {
+	fixedNow(t)
+
+	msg, _, err := Pacs008FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerTransferPlusRelatedRemittance.json"))
+	require.NoError(t, err)
+	require.NoError(t, msg.Validate())
+
+	loc := msg.Document.FIToFICustomerCreditTransfer.CreditTransferTransactionInformation[0].RelatedRemittanceInformation
+	require.Equal(t, "Remittance Identification", loc.RemittanceIdentification)
+	require.Len(t, loc.RemittanceLocationDetails, 1)
+	require.Equal(t, wire.RLMElectronicDataExchange, loc.RemittanceLocationDetails[0].Method)
+	require.Equal(t, "Name", loc.RemittanceLocationDetails[0].PostalAddress.Name)
+}
+
+func TestPacs008FromFEDWireMessage_UnstructuredAddenda(t *testing.T) {
+	fixedNow(t)
+
+	msg, report, err := Pacs008FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerTransferPlusUnstructuredAddenda.json"))
+	require.NoError(t, err)
+	require.NoError(t, msg.Validate())
+	require.Contains(t, report.Tags(), wire.TagUnstructuredAddenda)
+}
+
+func TestPacs008FromFEDWireMessage_AppendedTags(t *testing.T) {
+	fixedNow(t)
+
+	fwm := readMessage(t, "fedWireMessage-CustomerTransfer.json")
+	fwm.MessageDisposition = wire.NewMessageDisposition()
+	fwm.UnknownTags = []wire.UnknownTag{{Tag: "{9100}", Value: "Unknown"}}
+
+	_, report, err := Pacs008FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+	require.Contains(t, report.Tags(), wire.TagMessageDisposition)
+	require.Contains(t, report.Tags(), "{9100}")
+}
+
+func TestPacs008FromFEDWireMessage_BusinessFunctionCode(t *testing.T) {
+	_, _, err := Pacs008FromFEDWireMessage(readMessage(t, "fedWireMessage-BankTransfer.json"))
+	require.ErrorIs(t, err, ErrBusinessFunctionCode)
+
+	_, _, err = Pacs008FromFEDWireMessage(nil)
+	require.ErrorIs(t, err, ErrNoMessage)
+}
+
+func TestPacs008_Validate(t *testing.T) {
+	fixedNow(t)
+
+	msg, _, err := Pacs008FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerTransfer.json"))
+	require.NoError(t, err)
+
+	tx := &msg.Document.FIToFICustomerCreditTransfer.CreditTransferTransactionInformation[0]
+	tx.PaymentIdentification.EndToEndIdentification = ""
+	tx.ChargeBearer = "CHRG"
+	tx.InstructingAgent.FinancialInstitutionIdentification.BICFI = "BANK"
+	tx.Debtor.PostalAddress.Country = "usa"
+
+	errs := msg.ValidateAll()
+	require.Len(t, errs, 4)
+	require.Equal(t, errs[0], msg.Validate())
+
+	var elementErr *ElementError
+	require.True(t, errors.As(errs[0], &elementErr))
+	require.Equal(t, "Document/FIToFICstmrCdtTrf/CdtTrfTxInf/PmtId/EndToEndId", elementErr.Element)
+	require.ErrorIs(t, elementErr, ErrElementRequired)
+	require.ErrorIs(t, errs[1], ErrElementCode)
+	require.ErrorIs(t, errs[2], ErrElementFormat)
+	require.Contains(t, errs[3].Error(), "Dbtr/PstlAdr/Ctry")
+}
+
+func TestPacs008_WriteRead(t *testing.T) {
+	fixedNow(t)
+
+	msg, _, err := Pacs008FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerTransferPlusStructuredRemittance.json"))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, msg.Write(&buf))
+	require.Contains(t, buf.String(), `<AppHdr xmlns="`+NamespaceHead001+`">`)
+	require.Contains(t, buf.String(), `<Document xmlns="`+NamespacePacs008+`">`)
+
+	read, err := ReadPacs008(&buf)
+	require.NoError(t, err)
+	require.NoError(t, read.Validate())
+	require.Equal(t, msg.AppHdr, read.AppHdr)
+	require.Equal(t, msg.Document, read.Document)
+
+	_, err = ReadPacs008(bytes.NewReader([]byte("<Envelope></Envelope>")))
+	require.ErrorIs(t, err, ErrMessageDefinition)
+
+	_, err = ReadPacs008(bytes.NewReader([]byte("<Envelope>")))
+	require.Error(t, err)
+}
+
+func TestPacs008_FEDWireMessage(t *testing.T) {
+	fixedNow(t)
+
+	for _, name := range []string{
+		"fedWireMessage-CustomerTransfer.json",
+		"fedWireMessage-CustomerTransferPlus.json",
+		"fedWireMessage-CustomerTransferPlusCOVS.json",
+		"fedWireMessage-CustomerTransferPlusRelatedRemittance.json",
+		"fedWireMessage-CustomerTransferPlusStructuredRemittance.json",
+	} {
+		t.Run(name, func(t *testing.T) {
+			fwm := readMessage(t, name)
+			// Blank values are not carried by pacs.008, and the writer rejects a blank MessageDuplicationCode
+			fwm.BusinessFunctionCode.TransactionTypeCode = strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode)
+			if fwm.SenderSupplied.MessageDuplicationCode == "" {
+				fwm.SenderSupplied.MessageDuplicationCode = wire.MessageDuplicationOriginal
+			}
+			msg, exported, err := Pacs008FromFEDWireMessage(fwm)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, msg.Write(&buf))
+			read, err := ReadPacs008(&buf)
+			require.NoError(t, err)
+
+			got, imported, err := read.FEDWireMessage()
+			require.NoError(t, err)
+			require.NoError(t, got.ValidateAll().Err())
+			requireSameTags(t, fwm, got, exported, imported)
+		})
+	}
+}
+
+func TestPacs008_FEDWireMessageReport(t *testing.T) {
+	fixedNow(t)
+
+	msg, _, err := Pacs008FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerTransfer.json"))
+	require.NoError(t, err)
+
+	tx := &msg.Document.FIToFICustomerCreditTransfer.CreditTransferTransactionInformation[0]
+	tx.InstructingAgent.FinancialInstitutionIdentification.Name = "Wells Fargo Bank National Association"
+	tx.PaymentIdentification.InstructionIdentification = "A Sender Reference"
+	tx.PaymentIdentification.UETR = "eb6305c9-1f7f-49de-aed0-16487c27b42d"
+	tx.InstructionForCreditorAgent = []InstructionForCreditorAgent{{Code: "PHOA"}}
+	tx.UnknownElements = []UnknownElement{{XMLName: xml.Name{Local: "Purp"}, InnerXML: "<Cd>CASH</Cd>"}}
+
+	fwm, report, err := msg.FEDWireMessage()
+	require.NoError(t, err)
+	require.Equal(t, "Wells Fargo Bank N", fwm.SenderDepositoryInstitution.SenderShortName)
+	require.Equal(t, "A Sender Referen", fwm.SenderReference.SenderReference)
+	require.Equal(t, wire.CustomerTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, []Warning{
+		{Tag: wire.TagSenderDepositoryInstitution, Element: "CdtTrfTxInf/InstgAgt/FinInstnId/Nm", Reason: "was truncated"},
+		{Element: "CdtTrfTxInf/PmtId/UETR", Reason: "has no FEDWireMessage tag"},
+		{Tag: wire.TagSenderReference, Element: "CdtTrfTxInf/PmtId/InstrId", Reason: "was truncated"},
+		{Element: "CdtTrfTxInf/InstrForCdtrAgt", Reason: "has no FEDWireMessage tag"},
+		{Element: "CdtTrfTxInf/Purp", Reason: "has no FEDWireMessage tag"},
+	}, report.Warnings)
+}
+
+func TestPacs008_FEDWireMessageCover(t *testing.T) {
+	fixedNow(t)
+
+	msg, _, err := Pacs008FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.json"))
+	require.NoError(t, err)
+
+	fwm, _, err := msg.FEDWireMessage()
+	require.NoError(t, err)
+	require.Equal(t, wire.SequenceBCoverPaymentStructured, fwm.LocalInstrument.LocalInstrumentCode)
+	require.Equal(t, "000000000001500,49", fwm.CurrencyInstructedAmount.Amount)
+	require.Equal(t, "33B", fwm.CurrencyInstructedAmount.SwiftFieldTag)
+	require.NotNil(t, fwm.OrderingCustomer)
+	require.NotNil(t, fwm.BeneficiaryCustomer)
+	require.Equal(t, "LineOne", fwm.OriginatorToBeneficiary.LineOne)
+	require.Nil(t, fwm.InstructedAmount)
+
+	// The same elements of a transfer which is not a cover payment have no tag
+	msg.Document.FIToFICustomerCreditTransfer.CreditTransferTransactionInformation[0].PaymentTypeInformation.LocalInstrument.Proprietary = "CUST"
+	fwm, report, err := msg.FEDWireMessage()
+	require.NoError(t, err)
+	require.Equal(t, wire.ProprietaryLocalInstrumentCode, fwm.LocalInstrument.LocalInstrumentCode)
+	require.Equal(t, "CUST", fwm.LocalInstrument.ProprietaryCode)
+	require.Nil(t, fwm.OrderingCustomer)
+	require.NotNil(t, fwm.InstructedAmount)
+	require.Contains(t, report.Warnings, Warning{Element: "CdtTrfTxInf/UltmtDbtr", Reason: "has no FEDWireMessage tag"})
+}
+
+func TestPacs008_FEDWireMessageErrors(t *testing.T) {
+	fixedNow(t)
+
+	newMessage := func() *Pacs008 {
+		msg, _, err := Pacs008FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerTransfer.json"))
+		require.NoError(t, err)
+		return msg
+	}
+
+	msg := newMessage()
+	msg.Document.FIToFICustomerCreditTransfer.GroupHeader.MessageIdentification = "MSG1"
+	fwm, _, err := msg.FEDWireMessage()
+	require.NoError(t, err)
+	require.Equal(t, "20190410Source08000001", messageIdentification(fwm.InputMessageAccountabilityData))
+
+	msg.AppHdr.BusinessMessageIdentifier = "MSG1"
+	_, _, err = msg.FEDWireMessage()
+	require.ErrorIs(t, err, ErrElementFormat)
+
+	msg = newMessage()
+	msg.Document.FIToFICustomerCreditTransfer.CreditTransferTransactionInformation[0].InterbankSettlementAmount.Currency = "EUR"
+	_, _, err = msg.FEDWireMessage()
+	require.ErrorIs(t, err, ErrElementCode)
+
+	msg = newMessage()
+	msg.Document.FIToFICustomerCreditTransfer.CreditTransferTransactionInformation[0].InterbankSettlementAmount.Value = "1.234"
+	_, _, err = msg.FEDWireMessage()
+	require.ErrorIs(t, err, ErrElementFormat)
+
+	msg = newMessage()
+	msg.Document.FIToFICustomerCreditTransfer.CreditTransferTransactionInformation = nil
+	_, _, err = msg.FEDWireMessage()
+	require.ErrorIs(t, err, ErrElementRequired)
+}
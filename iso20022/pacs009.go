@@ -0,0 +1,589 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/wire"
+)
+
+const (
+	// LocalInstrumentBankTransfer is the LocalInstrument of a BTR bank transfer
+	LocalInstrumentBankTransfer = "BTRC"
+	// LocalInstrumentCheckSameDaySettlement is the LocalInstrument of a CKS check same-day settlement
+	LocalInstrumentCheckSameDaySettlement = "CKSC"
+	// LocalInstrumentFEDFundsReturned is the LocalInstrument of a FFR Fed funds returned
+	LocalInstrumentFEDFundsReturned = "FFRC"
+	// LocalInstrumentFEDFundsSold is the LocalInstrument of a FFS Fed funds sold
+	LocalInstrumentFEDFundsSold = "FFSC"
+)
+
+// pacs009BusinessFunctions are the BusinessFunctionCodes converted to pacs.009 other than cover payments, the first
+// being that of messages without a known LocalInstrument
+var pacs009BusinessFunctions = []string{
+	wire.BankTransfer,
+	wire.CheckSameDaySettlement,
+	wire.FEDFundsReturned,
+	wire.FEDFundsSold,
+}
+
+// Pacs009 is a FinancialInstitutionCreditTransfer message and its business application header
+type Pacs009 struct {
+	XMLName  xml.Name                  `xml:"Envelope"`
+	AppHdr   BusinessApplicationHeader `xml:"urn:iso:std:iso:20022:tech:xsd:head.001.001.03 AppHdr"`
+	Document Pacs009Document           `xml:"urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08 Document"`
+}
+
+// Pacs009Document is the Document of a Pacs009 message
+type Pacs009Document struct {
+	FinancialInstitutionCreditTransfer FinancialInstitutionCreditTransfer `xml:"FICdtTrf"`
+}
+
+// FinancialInstitutionCreditTransfer is the FICdtTrf of a Pacs009Document
+type FinancialInstitutionCreditTransfer struct {
+	GroupHeader                          GroupHeader                   `xml:"GrpHdr"`
+	CreditTransferTransactionInformation []FICreditTransferTransaction `xml:"CdtTrfTxInf"`
+}
+
+// FICreditTransferTransaction is a CdtTrfTxInf of a FinancialInstitutionCreditTransfer, whose debtor and
+// creditor are both agents
+type FICreditTransferTransaction struct {
+	PaymentIdentification            PaymentIdentification                        `xml:"PmtId"`
+	PaymentTypeInformation           *PaymentTypeInformation                      `xml:"PmtTpInf,omitempty"`
+	InterbankSettlementAmount        ActiveCurrencyAndAmount                      `xml:"IntrBkSttlmAmt"`
+	InterbankSettlementDate          string                                       `xml:"IntrBkSttlmDt,omitempty"`
+	PreviousInstructingAgent1        *BranchAndFinancialInstitutionIdentification `xml:"PrvsInstgAgt1,omitempty"`
+	InstructingAgent                 BranchAndFinancialInstitutionIdentification  `xml:"InstgAgt"`
+	InstructedAgent                  BranchAndFinancialInstitutionIdentification  `xml:"InstdAgt"`
+	IntermediaryAgent1               *BranchAndFinancialInstitutionIdentification `xml:"IntrmyAgt1,omitempty"`
+	Debtor                           BranchAndFinancialInstitutionIdentification  `xml:"Dbtr"`
+	DebtorAccount                    *CashAccount                                 `xml:"DbtrAcct,omitempty"`
+	DebtorAgent                      *BranchAndFinancialInstitutionIdentification `xml:"DbtrAgt,omitempty"`
+	CreditorAgent                    *BranchAndFinancialInstitutionIdentification `xml:"CdtrAgt,omitempty"`
+	Creditor                         BranchAndFinancialInstitutionIdentification  `xml:"Cdtr"`
+	CreditorAccount                  *CashAccount                                 `xml:"CdtrAcct,omitempty"`
+	InstructionForCreditorAgent      []InstructionForCreditorAgent                `xml:"InstrForCdtrAgt,omitempty"`
+	InstructionForNextAgent          []InstructionForNextAgent                    `xml:"InstrForNxtAgt,omitempty"`
+	RemittanceInformation            *RemittanceInformation                       `xml:"RmtInf,omitempty"`
+	UnderlyingCustomerCreditTransfer *UnderlyingCustomerCreditTransfer            `xml:"UndrlygCstmrCdtTrf,omitempty"`
+	UnknownElements                  []UnknownElement                             `xml:",any"`
+}
+
+// UnderlyingCustomerCreditTransfer is the UndrlygCstmrCdtTrf of a cover payment, the customer credit transfer
+// the FICreditTransferTransaction settles
+type UnderlyingCustomerCreditTransfer struct {
+	Debtor                  PartyIdentification                          `xml:"Dbtr"`
+	DebtorAgent             BranchAndFinancialInstitutionIdentification  `xml:"DbtrAgt"`
+	IntermediaryAgent1      *BranchAndFinancialInstitutionIdentification `xml:"IntrmyAgt1,omitempty"`
+	CreditorAgent           BranchAndFinancialInstitutionIdentification  `xml:"CdtrAgt"`
+	Creditor                PartyIdentification                          `xml:"Cdtr"`
+	InstructionForNextAgent []InstructionForNextAgent                    `xml:"InstrForNxtAgt,omitempty"`
+	RemittanceInformation   *RemittanceInformation                       `xml:"RmtInf,omitempty"`
+	InstructedAmount        *ActiveCurrencyAndAmount                     `xml:"InstdAmt,omitempty"`
+	UnknownElements         []UnknownElement                             `xml:",any"`
+}
+
+// Pacs009FromFEDWireMessage converts a BTR bank transfer, CKS check same-day settlement, FFR Fed funds returned or
+// FFS Fed funds sold to a Pacs009 message, as does it a CTP customer transfer with the LocalInstrument
+// SequenceBCoverPaymentStructured, whose {7xxx} cover payment tags become the UndrlygCstmrCdtTrf.
+//
+// Data of the FEDWireMessage which pacs.009 has no element for is listed in the returned Report.
+func Pacs009FromFEDWireMessage(fwm *wire.FEDWireMessage) (*Pacs009, *Report, error) {
+	err := requireBusinessFunctionCode(fwm, wire.BankTransfer, wire.CheckSameDaySettlement, wire.FEDFundsReturned,
+		wire.FEDFundsSold, wire.CustomerTransferPlus)
+	if err != nil {
+		return nil, nil, err
+	}
+	bfc := fwm.BusinessFunctionCode.BusinessFunctionCode
+	cover := bfc == wire.CustomerTransferPlus
+	if cover && (fwm.LocalInstrument == nil || fwm.LocalInstrument.LocalInstrumentCode != wire.SequenceBCoverPaymentStructured) {
+		return nil, nil, fmt.Errorf("%w: %s without the %s LocalInstrument", ErrBusinessFunctionCode, bfc, wire.SequenceBCoverPaymentStructured)
+	}
+	report := &Report{}
+	id := messageIdentification(fwm.InputMessageAccountabilityData)
+	createdAt := created()
+
+	it := newInterbankTransfer(fwm, id)
+	tx := FICreditTransferTransaction{
+		PaymentIdentification:     it.PaymentIdentification,
+		InterbankSettlementAmount: it.InterbankSettlementAmount,
+		InterbankSettlementDate:   it.InterbankSettlementDate,
+		InstructingAgent:          it.InstructingAgent,
+		InstructedAgent:           it.InstructedAgent,
+	}
+	instrument := businessFunctionInstruments[bfc]
+	if cover {
+		instrument = wire.SequenceBCoverPaymentStructured
+	}
+	tx.PaymentTypeInformation = &PaymentTypeInformation{LocalInstrument: &CodeOrProprietary{Proprietary: instrument}}
+	reportTypeSubType(fwm, typeCode(bfc)+wire.BasicFundsTransfer, report)
+	if code := strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode); code != "" {
+		report.Unmapped(wire.TagBusinessFunctionCode, "TransactionTypeCode", nowhere)
+	}
+
+	pacs009Parties(fwm, &tx)
+	if ob := fwm.OriginatorToBeneficiary; ob != nil {
+		if lines := textLines(ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour); len(lines) > 0 {
+			tx.RemittanceInformation = &RemittanceInformation{Unstructured: lines}
+		}
+	}
+	if fwm.FIReceiverFI != nil {
+		fi := fwm.FIReceiverFI.FIToFI
+		tx.InstructionForNextAgent = nextAgentInstructions(fi.LineOne, fi.LineTwo, fi.LineThree, fi.LineFour, fi.LineFive, fi.LineSix)
+	}
+	if cover {
+		tx.UnderlyingCustomerCreditTransfer = pacs009Cover(fwm, &tx, report)
+	}
+	reportUnmappedTags(fwm, report, pacs009Unmapped)
+
+	msg := &Pacs009{
+		AppHdr: newBusinessApplicationHeader(fwm, MessageDefinitionPacs009, id, createdAt, report),
+		Document: Pacs009Document{
+			FinancialInstitutionCreditTransfer: FinancialInstitutionCreditTransfer{
+				GroupHeader:                          newGroupHeader(id, createdAt),
+				CreditTransferTransactionInformation: []FICreditTransferTransaction{tx},
+			},
+		},
+	}
+	return msg, report, nil
+}
+
+// pacs009Parties converts the {4000} to {5200} agents and parties of fwm. The Dbtr and Cdtr are the InstgAgt and
+// InstdAgt unless the message has an Originator or Beneficiary.
+func pacs009Parties(fwm *wire.FEDWireMessage, tx *FICreditTransferTransaction) {
+	if fwm.BeneficiaryIntermediaryFI != nil {
+		agt := agent(fwm.BeneficiaryIntermediaryFI.FinancialInstitution)
+		tx.IntermediaryAgent1 = &agt
+	}
+	if fwm.BeneficiaryFI != nil {
+		agt := agent(fwm.BeneficiaryFI.FinancialInstitution)
+		tx.CreditorAgent = &agt
+	}
+	tx.Creditor = tx.InstructedAgent
+	if fwm.Beneficiary != nil {
+		tx.Creditor, tx.CreditorAccount = institution(fwm.Beneficiary.Personal)
+	}
+	tx.Debtor = tx.InstructingAgent
+	if fwm.Originator != nil {
+		tx.Debtor, tx.DebtorAccount = institution(fwm.Originator.Personal)
+	}
+	if fwm.OriginatorFI != nil {
+		agt := agent(fwm.OriginatorFI.FinancialInstitution)
+		tx.DebtorAgent = &agt
+	}
+	if fwm.InstructingFI != nil {
+		agt := agent(fwm.InstructingFI.FinancialInstitution)
+		tx.PreviousInstructingAgent1 = &agt
+	}
+}
+
+// institution returns the agent identified by a FEDWireMessage Personal, and its account.
+//
+// Demand deposit account numbers identify the account of an agent known by its name and address, and any other
+// identifier the agent as it does for a FinancialInstitution.
+func institution(p wire.Personal) (BranchAndFinancialInstitutionIdentification, *CashAccount) {
+	fi := wire.FinancialInstitution{
+		IdentificationCode: p.IdentificationCode,
+		Identifier:         p.Identifier,
+		Name:               p.Name,
+		Address:            p.Address,
+	}
+	if p.IdentificationCode != wire.DemandDepositAccountNumber {
+		return agent(fi), nil
+	}
+	fi.IdentificationCode, fi.Identifier = "", ""
+	return agent(fi), &CashAccount{Identification: AccountIdentification{Other: &GenericIdentification{Identification: p.Identifier}}}
+}
+
+// pacs009Cover returns the UnderlyingCustomerCreditTransfer of the {7033} to {7072} cover payment tags of fwm.
+// Without an {7052} OrderingInstitution or {7057} InstitutionAccount the underlying DbtrAgt and CdtrAgt are the
+// Dbtr and Cdtr of tx.
+func pacs009Cover(fwm *wire.FEDWireMessage, tx *FICreditTransferTransaction, report *Report) *UnderlyingCustomerCreditTransfer {
+	cov := &UnderlyingCustomerCreditTransfer{
+		DebtorAgent:   tx.Debtor,
+		CreditorAgent: tx.Creditor,
+	}
+	if cia := fwm.CurrencyInstructedAmount; cia != nil {
+		checkSwiftFieldTag(wire.TagCurrencyInstructedAmount, cia.SwiftFieldTag, report)
+		cov.InstructedAmount = &ActiveCurrencyAndAmount{Currency: currencyUSD, Value: decimal(cia.Amount)}
+	}
+	if fwm.OrderingCustomer != nil {
+		cp := fwm.OrderingCustomer.CoverPayment
+		checkSwiftFieldTag(wire.TagOrderingCustomer, cp.SwiftFieldTag, report)
+		cov.Debtor = PartyIdentification{PostalAddress: coverAddress(cp)}
+	}
+	if fwm.OrderingInstitution != nil {
+		cov.DebtorAgent = *coverAgent(wire.TagOrderingInstitution, fwm.OrderingInstitution.CoverPayment, report)
+	}
+	if fwm.IntermediaryInstitution != nil {
+		cov.IntermediaryAgent1 = coverAgent(wire.TagIntermediaryInstitution, fwm.IntermediaryInstitution.CoverPayment, report)
+	}
+	if fwm.InstitutionAccount != nil {
+		cov.CreditorAgent = *coverAgent(wire.TagInstitutionAccount, fwm.InstitutionAccount.CoverPayment, report)
+	}
+	if fwm.BeneficiaryCustomer != nil {
+		cp := fwm.BeneficiaryCustomer.CoverPayment
+		checkSwiftFieldTag(wire.TagBeneficiaryCustomer, cp.SwiftFieldTag, report)
+		cov.Creditor = PartyIdentification{PostalAddress: coverAddress(cp)}
+	}
+	if fwm.Remittance != nil {
+		cp := fwm.Remittance.CoverPayment
+		checkSwiftFieldTag(wire.TagRemittance, cp.SwiftFieldTag, report)
+		if lines := textLines(cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree, cp.SwiftLineFour); len(lines) > 0 {
+			cov.RemittanceInformation = &RemittanceInformation{Unstructured: lines}
+		}
+	}
+	if fwm.SenderToReceiver != nil {
+		cp := fwm.SenderToReceiver.CoverPayment
+		checkSwiftFieldTag(wire.TagSenderToReceiver, cp.SwiftFieldTag, report)
+		cov.InstructionForNextAgent = nextAgentInstructions(cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree,
+			cp.SwiftLineFour, cp.SwiftLineFive, cp.SwiftLineSix)
+	}
+	return cov
+}
+
+// pacs009Unmapped are the tags of the messages converted to pacs.009 which it has no element for
+var pacs009Unmapped = []string{
+	wire.TagPreviousMessageIdentifier,
+	wire.TagPaymentNotification,
+	wire.TagCharges,
+	wire.TagInstructedAmount,
+	wire.TagExchangeRate,
+	wire.TagAccountDebitedDrawdown,
+	wire.TagOriginatorOptionF,
+	wire.TagAccountCreditedDrawdown,
+	wire.TagFIDrawdownDebitAccountAdvice,
+	wire.TagFIIntermediaryFI,
+	wire.TagFIIntermediaryFIAdvice,
+	wire.TagFIBeneficiaryFI,
+	wire.TagFIBeneficiaryFIAdvice,
+	wire.TagFIBeneficiary,
+	wire.TagFIBeneficiaryAdvice,
+	wire.TagFIPaymentMethodToBeneficiary,
+	wire.TagFIAdditionalFIToFI,
+	wire.TagUnstructuredAddenda,
+	wire.TagRelatedRemittance,
+	wire.TagRemittanceOriginator,
+	wire.TagRemittanceBeneficiary,
+	wire.TagPrimaryRemittanceDocument,
+	wire.TagActualAmountPaid,
+	wire.TagGrossAmountRemittanceDocument,
+	wire.TagAmountNegotiatedDiscount,
+	wire.TagAdjustment,
+	wire.TagDateRemittanceDocument,
+	wire.TagSecondaryRemittanceDocument,
+	wire.TagRemittanceFreeText,
+	wire.TagServiceMessage,
+}
+
+// FEDWireMessage converts the Pacs009 message to a BTR, CKS, FFR or FFS transfer, or a CTP customer transfer with
+// the LocalInstrument SequenceBCoverPaymentStructured.
+//
+// The BusinessFunctionCode is given by the LocalInstrument, a message with an UndrlygCstmrCdtTrf being a cover
+// payment and one with an unknown LocalInstrument a BTR bank transfer. ISO 20022 elements the FEDWireMessage has
+// no tag for, and values truncated to fit their tag, are listed in the returned Report. Only the first CdtTrfTxInf
+// is converted.
+func (m *Pacs009) FEDWireMessage() (*wire.FEDWireMessage, *Report, error) {
+	const path = "Document/FICdtTrf"
+	ct := &m.Document.FinancialInstitutionCreditTransfer
+	if len(ct.CreditTransferTransactionInformation) == 0 {
+		return nil, nil, &ElementError{Element: path + "/CdtTrfTxInf", Err: ErrElementRequired}
+	}
+	tx := &ct.CreditTransferTransactionInformation[0]
+
+	report := &Report{}
+	if len(ct.CreditTransferTransactionInformation) > 1 {
+		report.NoTag(at("", "CdtTrfTxInf"), "")
+	}
+	it := interbankTransfer{
+		PaymentIdentification:     tx.PaymentIdentification,
+		InterbankSettlementAmount: tx.InterbankSettlementAmount,
+		InstructingAgent:          tx.InstructingAgent,
+		InstructedAgent:           tx.InstructedAgent,
+	}
+	fwm, err := it.fedWireMessage(&m.AppHdr, ct.GroupHeader.MessageIdentification, creditTransferElements(path), report)
+	if err != nil {
+		return nil, nil, err
+	}
+	if tx.PaymentIdentification.TransactionIdentification != "" {
+		report.NoTag(at("", "CdtTrfTxInf/PmtId/TxId"), "")
+	}
+	businessFunctionFromPacs009(tx, fwm, report)
+	cover := fwm.BusinessFunctionCode.BusinessFunctionCode == wire.CustomerTransferPlus
+	fwm.TypeSubType.TypeCode = typeCode(fwm.BusinessFunctionCode.BusinessFunctionCode)
+
+	partiesFromPacs009(tx, fwm, report)
+	if rmt := tx.RemittanceInformation; rmt != nil {
+		if len(rmt.Unstructured) > 0 {
+			lines := report.FitLineSlots(at(wire.TagOriginatorToBeneficiary, "CdtTrfTxInf/RmtInf/Ustrd"), rmt.Unstructured, 4, 35)
+			fwm.OriginatorToBeneficiary = wire.NewOriginatorToBeneficiary()
+			ob := fwm.OriginatorToBeneficiary
+			ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour = lines[0], lines[1], lines[2], lines[3]
+		}
+		if len(rmt.Structured) > 0 {
+			report.NoTag(at("", "CdtTrfTxInf/RmtInf/Strd"), "")
+		}
+	}
+	switch {
+	case len(tx.InstructionForNextAgent) == 0:
+	case cover:
+		report.NoTag(at("", "CdtTrfTxInf/InstrForNxtAgt"), "")
+	default:
+		tag := wire.TagFIReceiverFI
+		lines := report.FitLineSlots(at(tag, "CdtTrfTxInf/InstrForNxtAgt"), nextAgentLines(tx.InstructionForNextAgent), 6, 33)
+		lines[0] = report.Fit(at(tag, "CdtTrfTxInf/InstrForNxtAgt"), lines[0], 30)
+		fwm.FIReceiverFI = wire.NewFIReceiverFI()
+		fi := &fwm.FIReceiverFI.FIToFI
+		fi.LineOne, fi.LineTwo, fi.LineThree, fi.LineFour, fi.LineFive, fi.LineSix = lines[0], lines[1], lines[2], lines[3], lines[4], lines[5]
+	}
+	if len(tx.InstructionForCreditorAgent) > 0 {
+		report.NoTag(at("", "CdtTrfTxInf/InstrForCdtrAgt"), "")
+	}
+	if cov := tx.UnderlyingCustomerCreditTransfer; cov != nil {
+		coverFromPacs009(tx, cov, fwm, report)
+	}
+	for _, el := range tx.UnknownElements {
+		report.NoTag(at("", "CdtTrfTxInf/"+el.XMLName.Local), "")
+	}
+	return fwm, report, nil
+}
+
+// businessFunctionFromPacs009 converts the PmtTpInf and UndrlygCstmrCdtTrf of tx to the {3600} BusinessFunctionCode
+// and {3610} LocalInstrument
+func businessFunctionFromPacs009(tx *FICreditTransferTransaction, fwm *wire.FEDWireMessage, report *Report) {
+	pti := tx.PaymentTypeInformation
+	if tx.UnderlyingCustomerCreditTransfer == nil && (pti == nil || codeOf(pti.LocalInstrument) != wire.SequenceBCoverPaymentStructured) {
+		businessFunctionFromPaymentType("CdtTrfTxInf/PmtTpInf", pti, fwm, report, pacs009BusinessFunctions...)
+		return
+	}
+	fwm.BusinessFunctionCode.BusinessFunctionCode = wire.CustomerTransferPlus
+	fwm.LocalInstrument = wire.NewLocalInstrument()
+	fwm.LocalInstrument.LocalInstrumentCode = wire.SequenceBCoverPaymentStructured
+	if pti != nil && pti.CategoryPurpose != nil {
+		report.NoTag(at("", "CdtTrfTxInf/PmtTpInf/CtgyPurp"), "")
+	}
+}
+
+// partiesFromPacs009 converts the agents of tx to the {4000} to {5200} tags. A Dbtr or Cdtr which is the InstgAgt
+// or InstdAgt is not converted to an Originator or Beneficiary.
+func partiesFromPacs009(tx *FICreditTransferTransaction, fwm *wire.FEDWireMessage, report *Report) {
+	if agt := tx.IntermediaryAgent1; agt != nil {
+		fwm.BeneficiaryIntermediaryFI = wire.NewBeneficiaryIntermediaryFI()
+		fwm.BeneficiaryIntermediaryFI.FinancialInstitution = financialInstitution(wire.TagBeneficiaryIntermediaryFI, "CdtTrfTxInf/IntrmyAgt1", *agt, report)
+	}
+	if agt := tx.CreditorAgent; agt != nil {
+		fwm.BeneficiaryFI = wire.NewBeneficiaryFI()
+		fwm.BeneficiaryFI.FinancialInstitution = financialInstitution(wire.TagBeneficiaryFI, "CdtTrfTxInf/CdtrAgt", *agt, report)
+	}
+	if tx.CreditorAccount != nil || !reflect.DeepEqual(tx.Creditor, tx.InstructedAgent) {
+		fwm.Beneficiary = wire.NewBeneficiary()
+		fwm.Beneficiary.Personal = institutionPersonal(wire.TagBeneficiary, "CdtTrfTxInf/Cdtr", tx.Creditor, tx.CreditorAccount, report)
+	}
+	if tx.DebtorAccount != nil || !reflect.DeepEqual(tx.Debtor, tx.InstructingAgent) {
+		fwm.Originator = wire.NewOriginator()
+		fwm.Originator.Personal = institutionPersonal(wire.TagOriginator, "CdtTrfTxInf/Dbtr", tx.Debtor, tx.DebtorAccount, report)
+	}
+	if agt := tx.DebtorAgent; agt != nil {
+		fwm.OriginatorFI = wire.NewOriginatorFI()
+		fwm.OriginatorFI.FinancialInstitution = financialInstitution(wire.TagOriginatorFI, "CdtTrfTxInf/DbtrAgt", *agt, report)
+	}
+	if agt := tx.PreviousInstructingAgent1; agt != nil {
+		fwm.InstructingFI = wire.NewInstructingFI()
+		fwm.InstructingFI.FinancialInstitution = financialInstitution(wire.TagInstructingFI, "CdtTrfTxInf/PrvsInstgAgt1", *agt, report)
+	}
+}
+
+// institutionPersonal returns the FEDWireMessage Personal of an agent and its account. An account identifies the
+// agent by its demand deposit account number.
+func institutionPersonal(tag, element string, agt BranchAndFinancialInstitutionIdentification, acct *CashAccount, report *Report) wire.Personal {
+	fi := financialInstitution(tag, element, agt, report)
+	p := wire.Personal{
+		IdentificationCode: fi.IdentificationCode,
+		Identifier:         fi.Identifier,
+		Name:               fi.Name,
+		Address:            fi.Address,
+	}
+	if acct != nil {
+		if p.Identifier != "" {
+			report.NoTag(at("", element+"/FinInstnId"), "")
+		}
+		p.IdentificationCode = wire.DemandDepositAccountNumber
+		p.Identifier = acct.Identification.IBAN
+		if acct.Identification.Other != nil {
+			p.Identifier = acct.Identification.Other.Identification
+		}
+		p.Identifier = report.Fit(at(tag, element+"Acct/Id"), p.Identifier, 34)
+	}
+	return p
+}
+
+// coverFromPacs009 converts the UndrlygCstmrCdtTrf of tx to the {7033} to {7072} cover payment tags. An underlying
+// DbtrAgt or CdtrAgt which is the Dbtr or Cdtr of tx is not converted.
+func coverFromPacs009(tx *FICreditTransferTransaction, cov *UnderlyingCustomerCreditTransfer, fwm *wire.FEDWireMessage, report *Report) {
+	const path = "CdtTrfTxInf/UndrlygCstmrCdtTrf"
+	if amt := cov.InstructedAmount; amt != nil {
+		if amt.Currency != currencyUSD {
+			report.NoTag(at("", path+"/InstdAmt/@Ccy"), "")
+		}
+		value := report.Fit(at(wire.TagCurrencyInstructedAmount, path+"/InstdAmt"), faimDecimal(amt.Value), 18)
+		fwm.CurrencyInstructedAmount = wire.NewCurrencyInstructedAmount()
+		fwm.CurrencyInstructedAmount.SwiftFieldTag = swiftFieldTags[wire.TagCurrencyInstructedAmount]
+		fwm.CurrencyInstructedAmount.Amount = strings.Repeat("0", 18-len(value)) + value
+	}
+	{
+		const element = path + "/Dbtr"
+		fwm.OrderingCustomer = wire.NewOrderingCustomer()
+		fwm.OrderingCustomer.CoverPayment = coverPayment(wire.TagOrderingCustomer, element, partyLines(element, &cov.Debtor, report), 5, report)
+	}
+	if !reflect.DeepEqual(cov.DebtorAgent, tx.Debtor) {
+		const element = path + "/DbtrAgt"
+		fwm.OrderingInstitution = wire.NewOrderingInstitution()
+		fwm.OrderingInstitution.CoverPayment = coverPayment(wire.TagOrderingInstitution, element, agentLines(element, &cov.DebtorAgent, report), 5, report)
+	}
+	if agt := cov.IntermediaryAgent1; agt != nil {
+		const element = path + "/IntrmyAgt1"
+		fwm.IntermediaryInstitution = wire.NewIntermediaryInstitution()
+		fwm.IntermediaryInstitution.CoverPayment = coverPayment(wire.TagIntermediaryInstitution, element, agentLines(element, agt, report), 5, report)
+	}
+	if !reflect.DeepEqual(cov.CreditorAgent, tx.Creditor) {
+		const element = path + "/CdtrAgt"
+		fwm.InstitutionAccount = wire.NewInstitutionAccount()
+		fwm.InstitutionAccount.CoverPayment = coverPayment(wire.TagInstitutionAccount, element, agentLines(element, &cov.CreditorAgent, report), 5, report)
+	}
+	{
+		const element = path + "/Cdtr"
+		fwm.BeneficiaryCustomer = wire.NewBeneficiaryCustomer()
+		fwm.BeneficiaryCustomer.CoverPayment = coverPayment(wire.TagBeneficiaryCustomer, element, partyLines(element, &cov.Creditor, report), 5, report)
+	}
+	if rmt := cov.RemittanceInformation; rmt != nil {
+		if len(rmt.Unstructured) > 0 {
+			fwm.Remittance = wire.NewRemittance()
+			fwm.Remittance.CoverPayment = coverPayment(wire.TagRemittance, path+"/RmtInf/Ustrd", rmt.Unstructured, 4, report)
+		}
+		if len(rmt.Structured) > 0 {
+			report.NoTag(at("", path+"/RmtInf/Strd"), "")
+		}
+	}
+	if len(cov.InstructionForNextAgent) > 0 {
+		fwm.SenderToReceiver = wire.NewSenderToReceiver()
+		fwm.SenderToReceiver.CoverPayment = coverPayment(wire.TagSenderToReceiver, path+"/InstrForNxtAgt",
+			nextAgentLines(cov.InstructionForNextAgent), 6, report)
+	}
+	for _, el := range cov.UnknownElements {
+		report.NoTag(at("", path+"/"+el.XMLName.Local), "")
+	}
+}
+
+// Validate checks the Pacs009 message against the head.001 and pacs.009 schemas, returning the first error found
+func (m *Pacs009) Validate() error {
+	return m.ValidateAll().Err()
+}
+
+// ValidateAll checks the Pacs009 message against the head.001 and pacs.009 schemas, returning every error found
+func (m *Pacs009) ValidateAll() base.ErrorList {
+	v := &validator{}
+	m.AppHdr.validate(v, "AppHdr")
+	v.code("AppHdr/MsgDefIdr", m.AppHdr.MessageDefinitionIdentifier, true, MessageDefinitionPacs009)
+	m.Document.FinancialInstitutionCreditTransfer.validate(v, "Document/FICdtTrf")
+	return v.errs
+}
+
+func (ct *FinancialInstitutionCreditTransfer) validate(v *validator, path string) {
+	ct.GroupHeader.validate(v, path+"/GrpHdr")
+	v.required(path+"/CdtTrfTxInf", len(ct.CreditTransferTransactionInformation) > 0)
+	for i := range ct.CreditTransferTransactionInformation {
+		ct.CreditTransferTransactionInformation[i].validate(v, path+"/CdtTrfTxInf")
+	}
+}
+
+func (tx *FICreditTransferTransaction) validate(v *validator, path string) {
+	tx.PaymentIdentification.validate(v, path+"/PmtId")
+	if tx.PaymentTypeInformation != nil {
+		tx.PaymentTypeInformation.validate(v, path+"/PmtTpInf")
+	}
+	tx.InterbankSettlementAmount.validate(v, path+"/IntrBkSttlmAmt")
+	v.date(path+"/IntrBkSttlmDt", tx.InterbankSettlementDate, false)
+	agents := []struct {
+		element string
+		agent   *BranchAndFinancialInstitutionIdentification
+	}{
+		{"PrvsInstgAgt1", tx.PreviousInstructingAgent1},
+		{"InstgAgt", &tx.InstructingAgent},
+		{"InstdAgt", &tx.InstructedAgent},
+		{"IntrmyAgt1", tx.IntermediaryAgent1},
+		{"Dbtr", &tx.Debtor},
+		{"DbtrAgt", tx.DebtorAgent},
+		{"CdtrAgt", tx.CreditorAgent},
+		{"Cdtr", &tx.Creditor},
+	}
+	for _, a := range agents {
+		if a.agent != nil {
+			a.agent.validate(v, path+"/"+a.element)
+		}
+	}
+	if tx.DebtorAccount != nil {
+		tx.DebtorAccount.validate(v, path+"/DbtrAcct")
+	}
+	if tx.CreditorAccount != nil {
+		tx.CreditorAccount.validate(v, path+"/CdtrAcct")
+	}
+	if len(tx.InstructionForCreditorAgent) > 2 {
+		v.add(path+"/InstrForCdtrAgt", "", ErrElementLength)
+	}
+	for _, instr := range tx.InstructionForCreditorAgent {
+		v.text(path+"/InstrForCdtrAgt/Cd", instr.Code, 4, false)
+		v.text(path+"/InstrForCdtrAgt/InstrInf", instr.InstructionInformation, 140, false)
+	}
+	validateNextAgentInstructions(v, path+"/InstrForNxtAgt", tx.InstructionForNextAgent)
+	if rmt := tx.RemittanceInformation; rmt != nil {
+		if len(rmt.Structured) > 0 {
+			v.add(path+"/RmtInf/Strd", "", ErrElementLength)
+		}
+		rmt.validate(v, path+"/RmtInf")
+	}
+	if tx.UnderlyingCustomerCreditTransfer != nil {
+		tx.UnderlyingCustomerCreditTransfer.validate(v, path+"/UndrlygCstmrCdtTrf")
+	}
+}
+
+func (cov *UnderlyingCustomerCreditTransfer) validate(v *validator, path string) {
+	cov.Debtor.validate(v, path+"/Dbtr")
+	cov.DebtorAgent.validate(v, path+"/DbtrAgt")
+	if cov.IntermediaryAgent1 != nil {
+		cov.IntermediaryAgent1.validate(v, path+"/IntrmyAgt1")
+	}
+	cov.CreditorAgent.validate(v, path+"/CdtrAgt")
+	cov.Creditor.validate(v, path+"/Cdtr")
+	validateNextAgentInstructions(v, path+"/InstrForNxtAgt", cov.InstructionForNextAgent)
+	if cov.RemittanceInformation != nil {
+		cov.RemittanceInformation.validate(v, path+"/RmtInf")
+	}
+	if cov.InstructedAmount != nil {
+		cov.InstructedAmount.validate(v, path+"/InstdAmt")
+	}
+}
+
+// ReadPacs009 reads a Pacs009 message from r
+func ReadPacs009(r io.Reader) (*Pacs009, error) {
+	msg := &Pacs009{}
+	if err := readXML(r, msg); err != nil {
+		return nil, err
+	}
+	if msg.Document.FinancialInstitutionCreditTransfer.GroupHeader.MessageIdentification == "" {
+		return nil, fmt.Errorf("%w: no FICdtTrf", ErrMessageDefinition)
+	}
+	return msg, nil
+}
+
+// Write writes the Pacs009 message to w as XML
+func (m *Pacs009) Write(w io.Writer) error {
+	return writeXML(w, m)
+}
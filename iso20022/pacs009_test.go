@@ -0,0 +1,302 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPacs009FromFEDWireMessage_BankTransfer(t *testing.T) {
+	fixedNow(t)
+
+	msg, report, err := Pacs009FromFEDWireMessage(readMessage(t, "fedWireMessage-BankTransfer.json"))
+	require.NoError(t, err)
+	require.NoError(t, msg.Validate())
+
+	require.Equal(t, MessageDefinitionPacs009, msg.AppHdr.MessageDefinitionIdentifier)
+	ct := msg.Document.FinancialInstitutionCreditTransfer
+	require.Len(t, ct.CreditTransferTransactionInformation, 1)
+
+	tx := ct.CreditTransferTransactionInformation[0]
+	require.Equal(t, LocalInstrumentBankTransfer, tx.PaymentTypeInformation.LocalInstrument.Proprietary)
+	require.Equal(t, ActiveCurrencyAndAmount{Currency: "USD", Value: "12345.67"}, tx.InterbankSettlementAmount)
+	require.Equal(t, "Name", tx.Debtor.FinancialInstitutionIdentification.Name)
+	require.Equal(t, "1234", tx.Debtor.FinancialInstitutionIdentification.Other.Identification)
+	require.Equal(t, wire.PassportNumber, tx.Debtor.FinancialInstitutionIdentification.Other.SchemeName.Proprietary)
+	require.Nil(t, tx.DebtorAccount)
+	require.Equal(t, "123456789", tx.DebtorAgent.FinancialInstitutionIdentification.Other.Identification)
+	require.Equal(t, "FI Name", tx.CreditorAgent.FinancialInstitutionIdentification.Name)
+	require.NotNil(t, tx.IntermediaryAgent1)
+	require.NotNil(t, tx.PreviousInstructingAgent1)
+	require.Equal(t, []string{"LineOne", "LineTwo", "LineThree", "LineFour"}, tx.RemittanceInformation.Unstructured)
+	require.Equal(t, []InstructionForNextAgent{{InstructionInformation: "Line Six"}}, tx.InstructionForNextAgent)
+	require.Nil(t, tx.UnderlyingCustomerCreditTransfer)
+
+	tags := report.Tags()
+	require.Contains(t, tags, wire.TagFIBeneficiaryFI)
+	require.NotContains(t, tags, wire.TagTypeSubType)
+	require.NotContains(t, tags, wire.TagOriginator)
+}
+
+func TestPacs009FromFEDWireMessage_FEDFunds(t *testing.T) {
+	fixedNow(t)
+
+	for name, instrument := range map[string]string{
+		"fedWireMessage-CheckSameDaySettlement.json": LocalInstrumentCheckSameDaySettlement,
+		"fedWireMessage-FEDFundsReturned.json":       LocalInstrumentFEDFundsReturned,
+		"fedWireMessage-FEDFundsSold.json":           LocalInstrumentFEDFundsSold,
+	} {
+		msg, report, err := Pacs009FromFEDWireMessage(readMessage(t, name))
+		require.NoError(t, err, name)
+		require.NoError(t, msg.Validate(), name)
+		tx := msg.Document.FinancialInstitutionCreditTransfer.CreditTransferTransactionInformation[0]
+		require.Equal(t, instrument, tx.PaymentTypeInformation.LocalInstrument.Proprietary, name)
+		require.NotContains(t, report.Tags(), wire.TagTypeSubType, name)
+	}
+}
+
+func TestPacs009FromFEDWireMessage_Accounts(t *testing.T) {
+	fixedNow(t)
+
+	fwm := readMessage(t, "fedWireMessage-BankTransfer.json")
+	fwm.Originator.Personal.IdentificationCode = wire.DemandDepositAccountNumber
+	fwm.Beneficiary = nil
+	fwm.TypeSubType.TypeCode = wire.SettlementTransfer
+
+	msg, report, err := Pacs009FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+	require.NoError(t, msg.Validate())
+
+	tx := msg.Document.FinancialInstitutionCreditTransfer.CreditTransferTransactionInformation[0]
+	require.Equal(t, "1234", tx.DebtorAccount.Identification.Other.Identification)
+	require.Nil(t, tx.Debtor.FinancialInstitutionIdentification.Other)
+	require.Equal(t, "Name", tx.Debtor.FinancialInstitutionIdentification.Name)
+	require.Equal(t, tx.InstructedAgent, tx.Creditor)
+	require.Contains(t, report.Tags(), wire.TagTypeSubType)
+}
+
+func TestPacs009FromFEDWireMessage_Cover(t *testing.T) {
+	fixedNow(t)
+
+	msg, report, err := Pacs009FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.json"))
+	require.NoError(t, err)
+	require.NoError(t, msg.Validate())
+
+	tx := msg.Document.FinancialInstitutionCreditTransfer.CreditTransferTransactionInformation[0]
+	require.Equal(t, wire.SequenceBCoverPaymentStructured, tx.PaymentTypeInformation.LocalInstrument.Proprietary)
+	require.Equal(t, []string{"LineOne", "LineTwo", "LineThree", "LineFour"}, tx.RemittanceInformation.Unstructured)
+
+	cov := tx.UnderlyingCustomerCreditTransfer
+	require.NotNil(t, cov)
+	require.Len(t, cov.Debtor.PostalAddress.AddressLine, 5)
+	require.Len(t, cov.Creditor.PostalAddress.AddressLine, 5)
+	require.Equal(t, "Swift Line One", cov.DebtorAgent.FinancialInstitutionIdentification.PostalAddress.AddressLine[0])
+	require.NotNil(t, cov.IntermediaryAgent1)
+	require.Len(t, cov.CreditorAgent.FinancialInstitutionIdentification.PostalAddress.AddressLine, 5)
+	require.Equal(t, []string{"Swift Line One", "Swift Line Two", "Swift Line Three", "Swift Line Four"}, cov.RemittanceInformation.Unstructured)
+	require.Len(t, cov.InstructionForNextAgent, 6)
+	require.Equal(t, &ActiveCurrencyAndAmount{Currency: "USD", Value: "1500.49"}, cov.InstructedAmount)
+
+	tags := report.Tags()
+	for _, tag := range []string{wire.TagPaymentNotification, wire.TagOriginatorOptionF, wire.TagOrderingCustomer} {
+		require.Contains(t, tags, tag)
+	}
+	require.NotContains(t, tags, wire.TagLocalInstrument)
+}
+
+func TestPacs009FromFEDWireMessage_BusinessFunctionCode(t *testing.T) {
+	_, _, err := Pacs009FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerTransfer.json"))
+	require.ErrorIs(t, err, ErrBusinessFunctionCode)
+
+	_, _, err = Pacs009FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerTransferPlus.json"))
+	require.ErrorIs(t, err, ErrBusinessFunctionCode)
+	require.Contains(t, err.Error(), wire.SequenceBCoverPaymentStructured)
+
+	_, _, err = Pacs009FromFEDWireMessage(nil)
+	require.ErrorIs(t, err, ErrNoMessage)
+}
+
+func TestPacs009_Validate(t *testing.T) {
+	fixedNow(t)
+
+	msg, _, err := Pacs009FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.json"))
+	require.NoError(t, err)
+
+	tx := &msg.Document.FinancialInstitutionCreditTransfer.CreditTransferTransactionInformation[0]
+	tx.Debtor.FinancialInstitutionIdentification.BICFI = "BANK"
+	tx.RemittanceInformation.Structured = []StructuredRemittanceInformation{{}}
+	tx.UnderlyingCustomerCreditTransfer.Creditor.PostalAddress.Country = "usa"
+
+	errs := msg.ValidateAll()
+	require.Len(t, errs, 3)
+	require.Equal(t, errs[0], msg.Validate())
+
+	var elementErr *ElementError
+	require.True(t, errors.As(errs[0], &elementErr))
+	require.Equal(t, "Document/FICdtTrf/CdtTrfTxInf/Dbtr/FinInstnId/BICFI", elementErr.Element)
+	require.ErrorIs(t, errs[1], ErrElementLength)
+	require.Contains(t, errs[2].Error(), "UndrlygCstmrCdtTrf/Cdtr/PstlAdr/Ctry")
+}
+
+func TestPacs009_WriteRead(t *testing.T) {
+	fixedNow(t)
+
+	msg, _, err := Pacs009FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.json"))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, msg.Write(&buf))
+	require.Contains(t, buf.String(), `<Document xmlns="`+NamespacePacs009+`">`)
+	require.Contains(t, buf.String(), `<UndrlygCstmrCdtTrf>`)
+
+	read, err := ReadPacs009(&buf)
+	require.NoError(t, err)
+	require.NoError(t, read.Validate())
+	require.Equal(t, msg.AppHdr, read.AppHdr)
+	require.Equal(t, msg.Document, read.Document)
+
+	_, err = ReadPacs009(bytes.NewReader([]byte("<Envelope></Envelope>")))
+	require.ErrorIs(t, err, ErrMessageDefinition)
+}
+
+func TestPacs009_FEDWireMessage(t *testing.T) {
+	fixedNow(t)
+
+	for _, name := range []string{
+		"fedWireMessage-BankTransfer.json",
+		"fedWireMessage-CheckSameDaySettlement.json",
+		"fedWireMessage-FEDFundsReturned.json",
+		"fedWireMessage-FEDFundsSold.json",
+		"fedWireMessage-CustomerTransferPlusCOVS.json",
+	} {
+		t.Run(name, func(t *testing.T) {
+			fwm := readMessage(t, name)
+			// Blank values and the fixtures' placeholder SwiftFieldTags are not carried by pacs.009
+			fwm.BusinessFunctionCode.TransactionTypeCode = strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode)
+			setSwiftFieldTags(fwm)
+			msg, exported, err := Pacs009FromFEDWireMessage(fwm)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, msg.Write(&buf))
+			read, err := ReadPacs009(&buf)
+			require.NoError(t, err)
+
+			got, imported, err := read.FEDWireMessage()
+			require.NoError(t, err)
+			require.NoError(t, got.ValidateAll().Err())
+			require.Equal(t, fwm.BusinessFunctionCode.BusinessFunctionCode, got.BusinessFunctionCode.BusinessFunctionCode)
+			requireSameTags(t, fwm, got, exported, imported)
+		})
+	}
+}
+
+// setSwiftFieldTags sets the SwiftFieldTag of each cover payment tag of fwm to the one its ISO 20022 element implies
+func setSwiftFieldTags(fwm *wire.FEDWireMessage) {
+	if fwm.CurrencyInstructedAmount != nil {
+		fwm.CurrencyInstructedAmount.SwiftFieldTag = swiftFieldTags[wire.TagCurrencyInstructedAmount]
+	}
+	covers := map[string]*wire.CoverPayment{}
+	if fwm.OrderingCustomer != nil {
+		covers[wire.TagOrderingCustomer] = &fwm.OrderingCustomer.CoverPayment
+	}
+	if fwm.OrderingInstitution != nil {
+		covers[wire.TagOrderingInstitution] = &fwm.OrderingInstitution.CoverPayment
+	}
+	if fwm.IntermediaryInstitution != nil {
+		covers[wire.TagIntermediaryInstitution] = &fwm.IntermediaryInstitution.CoverPayment
+	}
+	if fwm.InstitutionAccount != nil {
+		covers[wire.TagInstitutionAccount] = &fwm.InstitutionAccount.CoverPayment
+	}
+	if fwm.BeneficiaryCustomer != nil {
+		covers[wire.TagBeneficiaryCustomer] = &fwm.BeneficiaryCustomer.CoverPayment
+	}
+	if fwm.Remittance != nil {
+		covers[wire.TagRemittance] = &fwm.Remittance.CoverPayment
+	}
+	if fwm.SenderToReceiver != nil {
+		covers[wire.TagSenderToReceiver] = &fwm.SenderToReceiver.CoverPayment
+	}
+	for tag, cp := range covers {
+		cp.SwiftFieldTag = swiftFieldTags[tag]
+	}
+}
+
+func TestPacs009_FEDWireMessageDefaults(t *testing.T) {
+	fixedNow(t)
+
+	fwm := readMessage(t, "fedWireMessage-FEDFundsSold.json")
+	fwm.Originator, fwm.Beneficiary = nil, nil
+	msg, _, err := Pacs009FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+
+	got, report, err := msg.FEDWireMessage()
+	require.NoError(t, err)
+	require.True(t, report.Empty())
+	require.Equal(t, wire.FEDFundsSold, got.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, wire.SettlementTransfer, got.TypeSubType.TypeCode)
+	require.Nil(t, got.Originator)
+	require.Nil(t, got.Beneficiary)
+
+	// An unknown LocalInstrument is a bank transfer
+	tx := &msg.Document.FinancialInstitutionCreditTransfer.CreditTransferTransactionInformation[0]
+	tx.PaymentTypeInformation.LocalInstrument.Proprietary = "CUST"
+	tx.PaymentIdentification.TransactionIdentification = "Transaction"
+	got, report, err = msg.FEDWireMessage()
+	require.NoError(t, err)
+	require.Equal(t, wire.BankTransfer, got.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, wire.FundsTransfer, got.TypeSubType.TypeCode)
+	require.Equal(t, []Warning{
+		{Element: "CdtTrfTxInf/PmtId/TxId", Reason: "has no FEDWireMessage tag"},
+		{Element: "CdtTrfTxInf/PmtTpInf/LclInstrm", Reason: "has no FEDWireMessage tag"},
+	}, report.Warnings)
+}
+
+func TestPacs009_FEDWireMessageCover(t *testing.T) {
+	fixedNow(t)
+
+	fwm := readMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.json")
+	fwm.OrderingInstitution, fwm.InstitutionAccount = nil, nil
+	msg, _, err := Pacs009FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+
+	tx := &msg.Document.FinancialInstitutionCreditTransfer.CreditTransferTransactionInformation[0]
+	require.Equal(t, tx.Debtor, tx.UnderlyingCustomerCreditTransfer.DebtorAgent)
+	require.Equal(t, tx.Creditor, tx.UnderlyingCustomerCreditTransfer.CreditorAgent)
+
+	tx.InstructionForNextAgent = []InstructionForNextAgent{{InstructionInformation: "Line One"}}
+	got, report, err := msg.FEDWireMessage()
+	require.NoError(t, err)
+	require.Equal(t, wire.CustomerTransferPlus, got.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, wire.SequenceBCoverPaymentStructured, got.LocalInstrument.LocalInstrumentCode)
+	require.Equal(t, "000000000001500,49", got.CurrencyInstructedAmount.Amount)
+	require.Nil(t, got.OrderingInstitution)
+	require.Nil(t, got.InstitutionAccount)
+	require.Nil(t, got.FIReceiverFI)
+	require.NotNil(t, got.SenderToReceiver)
+	require.Contains(t, report.Warnings, Warning{Element: "CdtTrfTxInf/InstrForNxtAgt", Reason: "has no FEDWireMessage tag"})
+}
+
+func TestPacs009_FEDWireMessageErrors(t *testing.T) {
+	fixedNow(t)
+
+	msg, _, err := Pacs009FromFEDWireMessage(readMessage(t, "fedWireMessage-BankTransfer.json"))
+	require.NoError(t, err)
+
+	msg.Document.FinancialInstitutionCreditTransfer.CreditTransferTransactionInformation[0].InterbankSettlementAmount.Currency = "EUR"
+	_, _, err = msg.FEDWireMessage()
+	require.ErrorIs(t, err, ErrElementCode)
+
+	msg.Document.FinancialInstitutionCreditTransfer.CreditTransferTransactionInformation = nil
+	_, _, err = msg.FEDWireMessage()
+	require.ErrorIs(t, err, ErrElementRequired)
+}
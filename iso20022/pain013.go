@@ -0,0 +1,440 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/wire"
+)
+
+const (
+	// LocalInstrumentBankDrawdownRequest is the LocalInstrument of a DRB bank-to-bank drawdown request
+	LocalInstrumentBankDrawdownRequest = "DRRB"
+	// LocalInstrumentCustomerDrawdownRequest is the LocalInstrument of a DRC customer or corporate drawdown request
+	LocalInstrumentCustomerDrawdownRequest = "DRRC"
+
+	// PaymentMethodTransfer is the PmtMtd of a credit transfer
+	PaymentMethodTransfer = "TRF"
+)
+
+// Pain013 is a CreditorPaymentActivationRequest message and its business application header
+type Pain013 struct {
+	XMLName  xml.Name                  `xml:"Envelope"`
+	AppHdr   BusinessApplicationHeader `xml:"urn:iso:std:iso:20022:tech:xsd:head.001.001.03 AppHdr"`
+	Document Pain013Document           `xml:"urn:iso:std:iso:20022:tech:xsd:pain.013.001.07 Document"`
+}
+
+// Pain013Document is the Document of a Pain013 message
+type Pain013Document struct {
+	CreditorPaymentActivationRequest CreditorPaymentActivationRequest `xml:"CdtrPmtActvtnReq"`
+}
+
+// CreditorPaymentActivationRequest is the CdtrPmtActvtnReq of a Pain013Document
+type CreditorPaymentActivationRequest struct {
+	GroupHeader        PaymentActivationGroupHeader `xml:"GrpHdr"`
+	PaymentInformation []PaymentInstruction         `xml:"PmtInf"`
+}
+
+// PaymentActivationGroupHeader is the GrpHdr of a CreditorPaymentActivationRequest
+type PaymentActivationGroupHeader struct {
+	// MessageIdentification is the Fedwire IMAD of the message
+	MessageIdentification string `xml:"MsgId"`
+	// CreationDateTime of the message
+	CreationDateTime string `xml:"CreDtTm"`
+	// NumberOfTransactions in the message, which is always 1
+	NumberOfTransactions string `xml:"NbOfTxs"`
+	// InitiatingParty is the party requesting the drawdown
+	InitiatingParty PartyIdentification `xml:"InitgPty"`
+	// ForwardingAgent is the agent sending the request
+	ForwardingAgent *BranchAndFinancialInstitutionIdentification `xml:"FwdgAgt,omitempty"`
+}
+
+// PaymentInstruction is a PmtInf of a CreditorPaymentActivationRequest, the debit requested of the Dbtr
+type PaymentInstruction struct {
+	PaymentInformationIdentification string                                      `xml:"PmtInfId"`
+	PaymentMethod                    string                                      `xml:"PmtMtd"`
+	PaymentTypeInformation           *PaymentTypeInformation                     `xml:"PmtTpInf,omitempty"`
+	RequestedExecutionDate           DateChoice                                  `xml:"ReqdExctnDt"`
+	Debtor                           PartyIdentification                         `xml:"Dbtr"`
+	DebtorAccount                    *CashAccount                                `xml:"DbtrAcct,omitempty"`
+	DebtorAgent                      BranchAndFinancialInstitutionIdentification `xml:"DbtrAgt"`
+	CreditTransferTransaction        []PaymentActivationTransaction              `xml:"CdtTrfTx"`
+}
+
+// DateChoice is a date given as an ISODate
+type DateChoice struct {
+	Date string `xml:"Dt"`
+}
+
+// PaymentAmount is the Amt of a PaymentActivationTransaction
+type PaymentAmount struct {
+	InstructedAmount ActiveCurrencyAndAmount `xml:"InstdAmt"`
+}
+
+// PaymentActivationTransaction is a CdtTrfTx of a PaymentInstruction, the credit transfer requested
+type PaymentActivationTransaction struct {
+	PaymentIdentification PaymentIdentification                        `xml:"PmtId"`
+	Amount                PaymentAmount                                `xml:"Amt"`
+	IntermediaryAgent1    *BranchAndFinancialInstitutionIdentification `xml:"IntrmyAgt1,omitempty"`
+	CreditorAgent         BranchAndFinancialInstitutionIdentification  `xml:"CdtrAgt"`
+	Creditor              PartyIdentification                          `xml:"Cdtr"`
+	CreditorAccount       *CashAccount                                 `xml:"CdtrAcct,omitempty"`
+	RemittanceInformation *RemittanceInformation                       `xml:"RmtInf,omitempty"`
+	UnknownElements       []UnknownElement                             `xml:",any"`
+}
+
+// Pain013FromFEDWireMessage converts a DRB bank-to-bank or DRC customer or corporate drawdown request to a Pain013
+// message. The {4400} AccountDebitedDrawdown is the Dbtr, the {5000} Originator requesting the drawdown the InitgPty
+// and the {4200} Beneficiary the Cdtr, whose {5400} AccountCreditedDrawdown is the CdtrAcct.
+//
+// Data of the FEDWireMessage which pain.013 has no element for is listed in the returned Report.
+func Pain013FromFEDWireMessage(fwm *wire.FEDWireMessage) (*Pain013, *Report, error) {
+	if err := requireBusinessFunctionCode(fwm, wire.BankDrawDownRequest, wire.CustomerCorporateDrawdownRequest); err != nil {
+		return nil, nil, err
+	}
+	if err := requireSubTypeCode(fwm, wire.RequestCredit); err != nil {
+		return nil, nil, err
+	}
+	report := &Report{}
+	id := messageIdentification(fwm.InputMessageAccountabilityData)
+	createdAt := created()
+	bfc := fwm.BusinessFunctionCode.BusinessFunctionCode
+	reportTypeSubType(fwm, typeCode(bfc)+wire.RequestCredit, report)
+	if code := strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode); code != "" {
+		report.Unmapped(wire.TagBusinessFunctionCode, "TransactionTypeCode", nowhere)
+	}
+
+	it := newInterbankTransfer(fwm, id)
+	hdr := PaymentActivationGroupHeader{
+		MessageIdentification: id,
+		CreationDateTime:      createdAt,
+		NumberOfTransactions:  "1",
+		InitiatingParty:       PartyIdentification{Name: it.InstructingAgent.FinancialInstitutionIdentification.Name},
+		ForwardingAgent:       &it.InstructingAgent,
+	}
+	if fwm.Originator != nil {
+		hdr.InitiatingParty = identifiedParty(fwm.Originator.Personal)
+	}
+	pmt := PaymentInstruction{
+		PaymentInformationIdentification: id,
+		PaymentMethod:                    PaymentMethodTransfer,
+		PaymentTypeInformation: &PaymentTypeInformation{
+			LocalInstrument: &CodeOrProprietary{Proprietary: businessFunctionInstruments[bfc]},
+		},
+		RequestedExecutionDate: DateChoice{Date: it.InterbankSettlementDate},
+		DebtorAgent:            it.InstructedAgent,
+	}
+	if add := fwm.AccountDebitedDrawdown; add != nil {
+		pmt.Debtor, pmt.DebtorAccount = party(wire.Personal{
+			IdentificationCode: add.IdentificationCode,
+			Identifier:         add.Identifier,
+			Name:               add.Name,
+			Address:            add.Address,
+		})
+	}
+	pmt.CreditTransferTransaction = []PaymentActivationTransaction{pain013Transaction(fwm, &hdr, it)}
+	reportUnmappedTags(fwm, report, pain013Unmapped)
+
+	msg := &Pain013{
+		AppHdr: newBusinessApplicationHeader(fwm, MessageDefinitionPain013, id, createdAt, report),
+		Document: Pain013Document{
+			CreditorPaymentActivationRequest: CreditorPaymentActivationRequest{
+				GroupHeader:        hdr,
+				PaymentInformation: []PaymentInstruction{pmt},
+			},
+		},
+	}
+	return msg, report, nil
+}
+
+// pain013Transaction returns the CdtTrfTx of the {4000} to {4200} agents and parties and the {5400}
+// AccountCreditedDrawdown of fwm. The CdtrAgt is the FwdgAgt and the Cdtr the InitgPty unless the message has a
+// BeneficiaryFI or Beneficiary.
+func pain013Transaction(fwm *wire.FEDWireMessage, hdr *PaymentActivationGroupHeader, it interbankTransfer) PaymentActivationTransaction {
+	tx := PaymentActivationTransaction{
+		PaymentIdentification: it.PaymentIdentification,
+		Amount:                PaymentAmount{InstructedAmount: it.InterbankSettlementAmount},
+		CreditorAgent:         *hdr.ForwardingAgent,
+		Creditor:              hdr.InitiatingParty,
+	}
+	if fwm.BeneficiaryIntermediaryFI != nil {
+		agt := agent(fwm.BeneficiaryIntermediaryFI.FinancialInstitution)
+		tx.IntermediaryAgent1 = &agt
+	}
+	if fwm.BeneficiaryFI != nil {
+		tx.CreditorAgent = agent(fwm.BeneficiaryFI.FinancialInstitution)
+	}
+	if fwm.Beneficiary != nil {
+		tx.Creditor = identifiedParty(fwm.Beneficiary.Personal)
+	}
+	if acd := fwm.AccountCreditedDrawdown; acd != nil {
+		tx.CreditorAccount = &CashAccount{Identification: AccountIdentification{Other: &GenericIdentification{Identification: acd.DrawdownCreditAccountNumber}}}
+	}
+	if ob := fwm.OriginatorToBeneficiary; ob != nil {
+		if lines := textLines(ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour); len(lines) > 0 {
+			tx.RemittanceInformation = &RemittanceInformation{Unstructured: lines}
+		}
+	}
+	return tx
+}
+
+// identifiedParty returns the party identified by a FEDWireMessage Personal as party does, keeping a demand deposit
+// account number as Other identification of the party, since the account of a drawdown's InitgPty and Cdtr is
+// not the account the request is for
+func identifiedParty(p wire.Personal) PartyIdentification {
+	pty, acct := party(p)
+	if acct != nil {
+		pty.Identification = &Party{OrganisationIdentification: &OrganisationIdentification{Other: []GenericIdentification{{
+			Identification: p.Identifier,
+			SchemeName:     &CodeOrProprietary{Proprietary: wire.DemandDepositAccountNumber},
+		}}}}
+	}
+	return pty
+}
+
+// pain013Unmapped are the tags of drawdown requests which pain.013 has no element for
+var pain013Unmapped = []string{
+	wire.TagPreviousMessageIdentifier,
+	wire.TagLocalInstrument,
+	wire.TagPaymentNotification,
+	wire.TagCharges,
+	wire.TagInstructedAmount,
+	wire.TagExchangeRate,
+	wire.TagOriginatorOptionF,
+	wire.TagOriginatorFI,
+	wire.TagInstructingFI,
+	wire.TagFIReceiverFI,
+	wire.TagFIDrawdownDebitAccountAdvice,
+	wire.TagFIIntermediaryFI,
+	wire.TagFIIntermediaryFIAdvice,
+	wire.TagFIBeneficiaryFI,
+	wire.TagFIBeneficiaryFIAdvice,
+	wire.TagFIBeneficiary,
+	wire.TagFIBeneficiaryAdvice,
+	wire.TagFIPaymentMethodToBeneficiary,
+	wire.TagFIAdditionalFIToFI,
+	wire.TagCurrencyInstructedAmount,
+	wire.TagOrderingCustomer,
+	wire.TagOrderingInstitution,
+	wire.TagIntermediaryInstitution,
+	wire.TagInstitutionAccount,
+	wire.TagBeneficiaryCustomer,
+	wire.TagRemittance,
+	wire.TagSenderToReceiver,
+	wire.TagUnstructuredAddenda,
+	wire.TagRelatedRemittance,
+	wire.TagRemittanceOriginator,
+	wire.TagRemittanceBeneficiary,
+	wire.TagPrimaryRemittanceDocument,
+	wire.TagActualAmountPaid,
+	wire.TagGrossAmountRemittanceDocument,
+	wire.TagAmountNegotiatedDiscount,
+	wire.TagAdjustment,
+	wire.TagDateRemittanceDocument,
+	wire.TagSecondaryRemittanceDocument,
+	wire.TagRemittanceFreeText,
+	wire.TagServiceMessage,
+}
+
+// FEDWireMessage converts the Pain013 message to a DRB bank-to-bank or DRC customer or corporate drawdown request.
+//
+// The BusinessFunctionCode is given by the LocalInstrument, a request with an unknown LocalInstrument being a DRC
+// drawdown request. ISO 20022 elements the FEDWireMessage has no tag for, and values truncated to fit their tag,
+// are listed in the returned Report. Only the first CdtTrfTx of the first PmtInf is converted.
+func (m *Pain013) FEDWireMessage() (*wire.FEDWireMessage, *Report, error) {
+	const path = "Document/CdtrPmtActvtnReq"
+	req := &m.Document.CreditorPaymentActivationRequest
+	if len(req.PaymentInformation) == 0 {
+		return nil, nil, &ElementError{Element: path + "/PmtInf", Err: ErrElementRequired}
+	}
+	pmt := &req.PaymentInformation[0]
+	if len(pmt.CreditTransferTransaction) == 0 {
+		return nil, nil, &ElementError{Element: path + "/PmtInf/CdtTrfTx", Err: ErrElementRequired}
+	}
+	tx := &pmt.CreditTransferTransaction[0]
+
+	report := &Report{}
+	if len(req.PaymentInformation) > 1 {
+		report.NoTag(at("", "PmtInf"), "")
+	}
+	if len(pmt.CreditTransferTransaction) > 1 {
+		report.NoTag(at("", "PmtInf/CdtTrfTx"), "")
+	}
+	it := interbankTransfer{
+		PaymentIdentification:     tx.PaymentIdentification,
+		InterbankSettlementAmount: tx.Amount.InstructedAmount,
+		InstructedAgent:           pmt.DebtorAgent,
+	}
+	if req.GroupHeader.ForwardingAgent != nil {
+		it.InstructingAgent = *req.GroupHeader.ForwardingAgent
+	}
+	fwm, err := it.fedWireMessage(&m.AppHdr, req.GroupHeader.MessageIdentification, transferElements{
+		MessageIdentification:     path + "/GrpHdr/MsgId",
+		Amount:                    path + "/PmtInf/CdtTrfTx/Amt/InstdAmt",
+		InstructingAgent:          "GrpHdr/FwdgAgt",
+		InstructedAgent:           "PmtInf/DbtrAgt",
+		InstructionIdentification: "PmtInf/CdtTrfTx/PmtId/InstrId",
+		EndToEndIdentification:    "PmtInf/CdtTrfTx/PmtId/EndToEndId",
+		UETR:                      "PmtInf/CdtTrfTx/PmtId/UETR",
+	}, report)
+	if err != nil {
+		return nil, nil, err
+	}
+	if tx.PaymentIdentification.TransactionIdentification != "" {
+		report.NoTag(at("", "PmtInf/CdtTrfTx/PmtId/TxId"), "")
+	}
+	if pmt.PaymentInformationIdentification != req.GroupHeader.MessageIdentification {
+		report.NoTag(at("", "PmtInf/PmtInfId"), "")
+	}
+	if pmt.RequestedExecutionDate.Date != settlementDate(fwm.InputMessageAccountabilityData.InputCycleDate) {
+		report.NoTag(at("", "PmtInf/ReqdExctnDt"), "")
+	}
+	businessFunctionFromPaymentType("PmtInf/PmtTpInf", pmt.PaymentTypeInformation, fwm, report,
+		wire.CustomerCorporateDrawdownRequest, wire.BankDrawDownRequest)
+	fwm.TypeSubType.TypeCode = typeCode(fwm.BusinessFunctionCode.BusinessFunctionCode)
+	fwm.TypeSubType.SubTypeCode = wire.RequestCredit
+
+	partiesFromPain013(&req.GroupHeader, pmt, tx, fwm, report)
+	if rmt := tx.RemittanceInformation; rmt != nil {
+		if len(rmt.Unstructured) > 0 {
+			lines := report.FitLineSlots(at(wire.TagOriginatorToBeneficiary, "PmtInf/CdtTrfTx/RmtInf/Ustrd"), rmt.Unstructured, 4, 35)
+			fwm.OriginatorToBeneficiary = wire.NewOriginatorToBeneficiary()
+			ob := fwm.OriginatorToBeneficiary
+			ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour = lines[0], lines[1], lines[2], lines[3]
+		}
+		if len(rmt.Structured) > 0 {
+			report.NoTag(at("", "PmtInf/CdtTrfTx/RmtInf/Strd"), "")
+		}
+	}
+	for _, el := range tx.UnknownElements {
+		report.NoTag(at("", "PmtInf/CdtTrfTx/"+el.XMLName.Local), "")
+	}
+	return fwm, report, nil
+}
+
+// partiesFromPain013 converts the parties and agents of a drawdown request to the {4000} to {5400} tags. A CdtrAgt
+// which is the FwdgAgt, a Cdtr which is the InitgPty and an InitgPty named as the FwdgAgt are not converted.
+func partiesFromPain013(hdr *PaymentActivationGroupHeader, pmt *PaymentInstruction, tx *PaymentActivationTransaction, fwm *wire.FEDWireMessage, report *Report) {
+	if agt := tx.IntermediaryAgent1; agt != nil {
+		fwm.BeneficiaryIntermediaryFI = wire.NewBeneficiaryIntermediaryFI()
+		fwm.BeneficiaryIntermediaryFI.FinancialInstitution = financialInstitution(wire.TagBeneficiaryIntermediaryFI, "PmtInf/CdtTrfTx/IntrmyAgt1", *agt, report)
+	}
+	if hdr.ForwardingAgent == nil || !reflect.DeepEqual(tx.CreditorAgent, *hdr.ForwardingAgent) {
+		fwm.BeneficiaryFI = wire.NewBeneficiaryFI()
+		fwm.BeneficiaryFI.FinancialInstitution = financialInstitution(wire.TagBeneficiaryFI, "PmtInf/CdtTrfTx/CdtrAgt", tx.CreditorAgent, report)
+	}
+	if !reflect.DeepEqual(tx.Creditor, hdr.InitiatingParty) {
+		fwm.Beneficiary = wire.NewBeneficiary()
+		fwm.Beneficiary.Personal = personal(wire.TagBeneficiary, "PmtInf/CdtTrfTx/Cdtr", tx.Creditor, nil, report)
+	}
+	{
+		const element = "PmtInf/Dbtr"
+		p := personal(wire.TagAccountDebitedDrawdown, element, pmt.Debtor, pmt.DebtorAccount, report)
+		fwm.AccountDebitedDrawdown = wire.NewAccountDebitedDrawdown()
+		add := fwm.AccountDebitedDrawdown
+		add.IdentificationCode, add.Identifier, add.Name, add.Address = p.IdentificationCode, p.Identifier, p.Name, p.Address
+	}
+	if named := (PartyIdentification{Name: fwm.SenderDepositoryInstitution.SenderShortName}); !reflect.DeepEqual(hdr.InitiatingParty, named) {
+		fwm.Originator = wire.NewOriginator()
+		fwm.Originator.Personal = personal(wire.TagOriginator, "GrpHdr/InitgPty", hdr.InitiatingParty, nil, report)
+	}
+	if acct := tx.CreditorAccount; acct != nil {
+		id := acct.Identification.IBAN
+		if acct.Identification.Other != nil {
+			id = acct.Identification.Other.Identification
+		}
+		fwm.AccountCreditedDrawdown = wire.NewAccountCreditedDrawdown()
+		fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber = report.Fit(at(wire.TagAccountCreditedDrawdown, "PmtInf/CdtTrfTx/CdtrAcct/Id"), id, 9)
+	}
+}
+
+// Validate checks the Pain013 message against the head.001 and pain.013 schemas, returning the first error found
+func (m *Pain013) Validate() error {
+	return m.ValidateAll().Err()
+}
+
+// ValidateAll checks the Pain013 message against the head.001 and pain.013 schemas, returning every error found
+func (m *Pain013) ValidateAll() base.ErrorList {
+	v := &validator{}
+	m.AppHdr.validate(v, "AppHdr")
+	v.code("AppHdr/MsgDefIdr", m.AppHdr.MessageDefinitionIdentifier, true, MessageDefinitionPain013)
+	m.Document.CreditorPaymentActivationRequest.validate(v, "Document/CdtrPmtActvtnReq")
+	return v.errs
+}
+
+func (req *CreditorPaymentActivationRequest) validate(v *validator, path string) {
+	req.GroupHeader.validate(v, path+"/GrpHdr")
+	v.required(path+"/PmtInf", len(req.PaymentInformation) > 0)
+	for i := range req.PaymentInformation {
+		req.PaymentInformation[i].validate(v, path+"/PmtInf")
+	}
+}
+
+func (hdr *PaymentActivationGroupHeader) validate(v *validator, path string) {
+	v.text(path+"/MsgId", hdr.MessageIdentification, 35, true)
+	v.dateTime(path+"/CreDtTm", hdr.CreationDateTime, true)
+	v.pattern(path+"/NbOfTxs", hdr.NumberOfTransactions, numericRegex, true)
+	hdr.InitiatingParty.validate(v, path+"/InitgPty")
+	if hdr.ForwardingAgent != nil {
+		hdr.ForwardingAgent.validate(v, path+"/FwdgAgt")
+	}
+}
+
+func (pmt *PaymentInstruction) validate(v *validator, path string) {
+	v.text(path+"/PmtInfId", pmt.PaymentInformationIdentification, 35, true)
+	v.code(path+"/PmtMtd", pmt.PaymentMethod, true, PaymentMethodTransfer)
+	if pmt.PaymentTypeInformation != nil {
+		pmt.PaymentTypeInformation.validate(v, path+"/PmtTpInf")
+	}
+	v.date(path+"/ReqdExctnDt/Dt", pmt.RequestedExecutionDate.Date, true)
+	pmt.Debtor.validate(v, path+"/Dbtr")
+	if pmt.DebtorAccount != nil {
+		pmt.DebtorAccount.validate(v, path+"/DbtrAcct")
+	}
+	pmt.DebtorAgent.validate(v, path+"/DbtrAgt")
+	v.required(path+"/CdtTrfTx", len(pmt.CreditTransferTransaction) > 0)
+	for i := range pmt.CreditTransferTransaction {
+		pmt.CreditTransferTransaction[i].validate(v, path+"/CdtTrfTx")
+	}
+}
+
+func (tx *PaymentActivationTransaction) validate(v *validator, path string) {
+	tx.PaymentIdentification.validate(v, path+"/PmtId")
+	tx.Amount.InstructedAmount.validate(v, path+"/Amt/InstdAmt")
+	if tx.IntermediaryAgent1 != nil {
+		tx.IntermediaryAgent1.validate(v, path+"/IntrmyAgt1")
+	}
+	tx.CreditorAgent.validate(v, path+"/CdtrAgt")
+	tx.Creditor.validate(v, path+"/Cdtr")
+	if tx.CreditorAccount != nil {
+		tx.CreditorAccount.validate(v, path+"/CdtrAcct")
+	}
+	if tx.RemittanceInformation != nil {
+		tx.RemittanceInformation.validate(v, path+"/RmtInf")
+	}
+}
+
+// ReadPain013 reads a Pain013 message from r
+func ReadPain013(r io.Reader) (*Pain013, error) {
+	msg := &Pain013{}
+	if err := readXML(r, msg); err != nil {
+		return nil, err
+	}
+	if msg.Document.CreditorPaymentActivationRequest.GroupHeader.MessageIdentification == "" {
+		return nil, fmt.Errorf("%w: no CdtrPmtActvtnReq", ErrMessageDefinition)
+	}
+	return msg, nil
+}
+
+// Write writes the Pain013 message to w as XML
+func (m *Pain013) Write(w io.Writer) error {
+	return writeXML(w, m)
+}
@@ -0,0 +1,203 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPain013FromFEDWireMessage_BankDrawdownRequest(t *testing.T) {
+	fixedNow(t)
+
+	msg, report, err := Pain013FromFEDWireMessage(readMessage(t, "fedWireMessage-BankDrawDownRequest.json"))
+	require.NoError(t, err)
+	require.NoError(t, msg.Validate())
+
+	require.Equal(t, MessageDefinitionPain013, msg.AppHdr.MessageDefinitionIdentifier)
+	req := msg.Document.CreditorPaymentActivationRequest
+	require.Equal(t, "Name", req.GroupHeader.InitiatingParty.Name)
+	require.Equal(t, "121042882", req.GroupHeader.ForwardingAgent.FinancialInstitutionIdentification.ClearingSystemMemberIdentification.MemberIdentification)
+	require.Len(t, req.PaymentInformation, 1)
+
+	pmt := req.PaymentInformation[0]
+	require.Equal(t, req.GroupHeader.MessageIdentification, pmt.PaymentInformationIdentification)
+	require.Equal(t, LocalInstrumentBankDrawdownRequest, pmt.PaymentTypeInformation.LocalInstrument.Proprietary)
+	require.Equal(t, "2019-04-10", pmt.RequestedExecutionDate.Date)
+	require.Equal(t, "debitDD Name", pmt.Debtor.Name)
+	require.Equal(t, "123456789", pmt.DebtorAccount.Identification.Other.Identification)
+	require.Equal(t, "231380104", pmt.DebtorAgent.FinancialInstitutionIdentification.ClearingSystemMemberIdentification.MemberIdentification)
+
+	tx := pmt.CreditTransferTransaction[0]
+	require.Equal(t, "USD", tx.Amount.InstructedAmount.Currency)
+	require.Equal(t, "FI Name", tx.CreditorAgent.FinancialInstitutionIdentification.Name)
+	require.NotNil(t, tx.IntermediaryAgent1)
+	require.Equal(t, "Name", tx.Creditor.Name)
+	require.Equal(t, "231380104", tx.CreditorAccount.Identification.Other.Identification)
+	require.Equal(t, []string{"LineOne", "LineTwo", "LineThree", "LineFour"}, tx.RemittanceInformation.Unstructured)
+
+	tags := report.Tags()
+	for _, tag := range []string{wire.TagPreviousMessageIdentifier, wire.TagOriginatorFI, wire.TagInstructingFI} {
+		require.Contains(t, tags, tag)
+	}
+	require.NotContains(t, tags, wire.TagTypeSubType)
+	require.NotContains(t, tags, wire.TagAccountDebitedDrawdown)
+}
+
+func TestPain013FromFEDWireMessage_Defaults(t *testing.T) {
+	fixedNow(t)
+
+	fwm := readMessage(t, "fedWireMessage-CustomerCorporateDrawDownRequest.json")
+	fwm.Originator, fwm.BeneficiaryFI = nil, nil
+	fwm.Beneficiary.Personal.IdentificationCode = wire.DemandDepositAccountNumber
+	msg, _, err := Pain013FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+	require.NoError(t, msg.Validate())
+
+	req := msg.Document.CreditorPaymentActivationRequest
+	require.Equal(t, PartyIdentification{Name: "Wells Fargo NA"}, req.GroupHeader.InitiatingParty)
+	pmt := req.PaymentInformation[0]
+	require.Equal(t, LocalInstrumentCustomerDrawdownRequest, pmt.PaymentTypeInformation.LocalInstrument.Proprietary)
+
+	tx := pmt.CreditTransferTransaction[0]
+	require.Equal(t, *req.GroupHeader.ForwardingAgent, tx.CreditorAgent)
+	other, _ := otherIdentification(tx.Creditor.Identification)
+	require.Equal(t, "1234", other.Identification)
+	require.Equal(t, wire.DemandDepositAccountNumber, other.SchemeName.Proprietary)
+
+	got, _, err := msg.FEDWireMessage()
+	require.NoError(t, err)
+	require.Nil(t, got.Originator)
+	require.Nil(t, got.BeneficiaryFI)
+	require.Equal(t, fwm.Beneficiary.Personal, got.Beneficiary.Personal)
+}
+
+func TestPain013FromFEDWireMessage_Errors(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-BankDrawDownRequest.json")
+	fwm.TypeSubType.SubTypeCode = wire.RefusalRequestCredit
+	_, _, err := Pain013FromFEDWireMessage(fwm)
+	require.ErrorIs(t, err, ErrSubTypeCode)
+
+	_, _, err = Pain013FromFEDWireMessage(readMessage(t, "fedWireMessage-DrawdownResponse.json"))
+	require.ErrorIs(t, err, ErrBusinessFunctionCode)
+
+	_, _, err = Pain013FromFEDWireMessage(nil)
+	require.ErrorIs(t, err, ErrNoMessage)
+}
+
+func TestPain013_Validate(t *testing.T) {
+	fixedNow(t)
+
+	msg, _, err := Pain013FromFEDWireMessage(readMessage(t, "fedWireMessage-BankDrawDownRequest.json"))
+	require.NoError(t, err)
+
+	req := &msg.Document.CreditorPaymentActivationRequest
+	req.GroupHeader.NumberOfTransactions = "one"
+	req.PaymentInformation[0].PaymentMethod = "CHK"
+	req.PaymentInformation[0].CreditTransferTransaction[0].Amount.InstructedAmount.Value = "1,00"
+
+	errs := msg.ValidateAll()
+	require.Len(t, errs, 3)
+	require.Equal(t, errs[0], msg.Validate())
+
+	var elementErr *ElementError
+	require.True(t, errors.As(errs[0], &elementErr))
+	require.Equal(t, "Document/CdtrPmtActvtnReq/GrpHdr/NbOfTxs", elementErr.Element)
+	require.ErrorIs(t, errs[1], ErrElementCode)
+	require.Contains(t, errs[2].Error(), "CdtTrfTx/Amt/InstdAmt")
+}
+
+func TestPain013_WriteRead(t *testing.T) {
+	fixedNow(t)
+
+	msg, _, err := Pain013FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerCorporateDrawDownRequest.json"))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, msg.Write(&buf))
+	require.Contains(t, buf.String(), `<Document xmlns="`+NamespacePain013+`">`)
+	require.Contains(t, buf.String(), `<ReqdExctnDt>`)
+
+	read, err := ReadPain013(&buf)
+	require.NoError(t, err)
+	require.NoError(t, read.Validate())
+	require.Equal(t, msg.AppHdr, read.AppHdr)
+	require.Equal(t, msg.Document, read.Document)
+
+	_, err = ReadPain013(bytes.NewReader([]byte("<Envelope></Envelope>")))
+	require.ErrorIs(t, err, ErrMessageDefinition)
+}
+
+func TestPain013_FEDWireMessage(t *testing.T) {
+	fixedNow(t)
+
+	for _, name := range []string{
+		"fedWireMessage-BankDrawDownRequest.json",
+		"fedWireMessage-CustomerCorporateDrawDownRequest.json",
+	} {
+		t.Run(name, func(t *testing.T) {
+			fwm := readMessage(t, name)
+			msg, exported, err := Pain013FromFEDWireMessage(fwm)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, msg.Write(&buf))
+			read, err := ReadPain013(&buf)
+			require.NoError(t, err)
+
+			got, imported, err := read.FEDWireMessage()
+			require.NoError(t, err)
+			require.True(t, imported.Empty())
+			require.NoError(t, got.ValidateAll().Err())
+			require.Equal(t, fwm.BusinessFunctionCode.BusinessFunctionCode, got.BusinessFunctionCode.BusinessFunctionCode)
+			requireSameTags(t, fwm, got, exported)
+		})
+	}
+}
+
+func TestPain013_FEDWireMessageReport(t *testing.T) {
+	fixedNow(t)
+
+	msg, _, err := Pain013FromFEDWireMessage(readMessage(t, "fedWireMessage-BankDrawDownRequest.json"))
+	require.NoError(t, err)
+
+	pmt := &msg.Document.CreditorPaymentActivationRequest.PaymentInformation[0]
+	pmt.PaymentTypeInformation = nil
+	pmt.RequestedExecutionDate.Date = "2019-04-11"
+	pmt.CreditTransferTransaction[0].CreditorAccount.Identification.Other.Identification = "1234567890"
+	got, report, err := msg.FEDWireMessage()
+	require.NoError(t, err)
+	require.Equal(t, wire.CustomerCorporateDrawdownRequest, got.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, wire.FundsTransfer+wire.RequestCredit, got.TypeSubType.TypeCode+got.TypeSubType.SubTypeCode)
+	require.Equal(t, "123456789", got.AccountCreditedDrawdown.DrawdownCreditAccountNumber)
+	require.Equal(t, []Warning{
+		{Element: "PmtInf/ReqdExctnDt", Reason: "has no FEDWireMessage tag"},
+		{Tag: wire.TagAccountCreditedDrawdown, Element: "PmtInf/CdtTrfTx/CdtrAcct/Id", Reason: "was truncated"},
+	}, report.Warnings)
+}
+
+func TestPain013_FEDWireMessageErrors(t *testing.T) {
+	fixedNow(t)
+
+	msg, _, err := Pain013FromFEDWireMessage(readMessage(t, "fedWireMessage-BankDrawDownRequest.json"))
+	require.NoError(t, err)
+
+	req := &msg.Document.CreditorPaymentActivationRequest
+	req.PaymentInformation[0].CreditTransferTransaction[0].Amount.InstructedAmount.Currency = "EUR"
+	_, _, err = msg.FEDWireMessage()
+	require.ErrorIs(t, err, ErrElementCode)
+
+	req.PaymentInformation[0].CreditTransferTransaction = nil
+	_, _, err = msg.FEDWireMessage()
+	require.ErrorIs(t, err, ErrElementRequired)
+
+	req.PaymentInformation = nil
+	_, _, err = msg.FEDWireMessage()
+	require.ErrorIs(t, err, ErrElementRequired)
+}
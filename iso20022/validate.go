@@ -0,0 +1,422 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
+)
+
+var (
+	// ErrElementRequired is given when a mandatory element is missing
+	ErrElementRequired = errors.New("is required")
+	// ErrElementLength is given when the length of an element is outside of its schema's limits
+	ErrElementLength = errors.New("has an invalid length")
+	// ErrElementFormat is given when an element does not match its schema's pattern
+	ErrElementFormat = errors.New("has an invalid format")
+	// ErrElementCode is given when an element is not one of its schema's codes
+	ErrElementCode = errors.New("is not a valid code")
+)
+
+var (
+	bicRegex      = regexp.MustCompile(`^[A-Z0-9]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+	currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+	countryRegex  = regexp.MustCompile(`^[A-Z]{2}$`)
+	uuidv4Regex   = regexp.MustCompile(`^[a-f0-9]{8}-[a-f0-9]{4}-4[a-f0-9]{3}-[89ab][a-f0-9]{3}-[a-f0-9]{12}$`)
+	numericRegex  = regexp.MustCompile(`^[0-9]{1,15}$`)
+	amountRegex   = regexp.MustCompile(`^[0-9]{1,18}(\.[0-9]{1,5})?$`)
+	decimalRegex  = regexp.MustCompile(`^[0-9]{1,11}(\.[0-9]{1,10})?$`)
+)
+
+// ElementError is the error given when an element does not match its schema
+type ElementError struct {
+	// Element is the path of the element, such as Document/FIToFICstmrCdtTrf/GrpHdr/MsgId
+	Element string
+	// Value of the element
+	Value string
+	// Err is ErrElementRequired, ErrElementLength, ErrElementFormat or ErrElementCode
+	Err error
+}
+
+func (e *ElementError) Error() string {
+	if e.Value == "" {
+		return fmt.Sprintf("%s %s", e.Element, e.Err)
+	}
+	return fmt.Sprintf("%s %q %s", e.Element, e.Value, e.Err)
+}
+
+// Unwrap returns the underlying error of the ElementError
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+// validator collects the ElementErrors of a message
+type validator struct {
+	errs base.ErrorList
+}
+
+func (v *validator) add(path, value string, err error) {
+	v.errs.Add(&ElementError{Element: path, Value: value, Err: err})
+}
+
+// required checks a mandatory element is present
+func (v *validator) required(path string, present bool) bool {
+	if !present {
+		v.add(path, "", ErrElementRequired)
+	}
+	return present
+}
+
+// text checks value is a MaxNText element of at most max characters
+func (v *validator) text(path, value string, max int, required bool) {
+	if value == "" {
+		if required {
+			v.add(path, "", ErrElementRequired)
+		}
+		return
+	}
+	if utf8.RuneCountInString(value) > max {
+		v.add(path, value, ErrElementLength)
+	}
+}
+
+// pattern checks value matches the schema's pattern
+func (v *validator) pattern(path, value string, re *regexp.Regexp, required bool) {
+	if value == "" {
+		if required {
+			v.add(path, "", ErrElementRequired)
+		}
+		return
+	}
+	if !re.MatchString(value) {
+		v.add(path, value, ErrElementFormat)
+	}
+}
+
+// code checks value is one of codes
+func (v *validator) code(path, value string, required bool, codes ...string) {
+	if value == "" {
+		if required {
+			v.add(path, "", ErrElementRequired)
+		}
+		return
+	}
+	for _, c := range codes {
+		if value == c {
+			return
+		}
+	}
+	v.add(path, value, ErrElementCode)
+}
+
+// date checks value is an ISODate
+func (v *validator) date(path, value string, required bool) {
+	if value == "" {
+		if required {
+			v.add(path, "", ErrElementRequired)
+		}
+		return
+	}
+	if _, err := time.Parse(isoDate, value); err != nil {
+		v.add(path, value, ErrElementFormat)
+	}
+}
+
+// dateTime checks value is an ISODateTime
+func (v *validator) dateTime(path, value string, required bool) {
+	if value == "" {
+		if required {
+			v.add(path, "", ErrElementRequired)
+		}
+		return
+	}
+	if _, err := parseDateTime(value); err != nil {
+		v.add(path, value, ErrElementFormat)
+	}
+}
+
+func (a *ActiveCurrencyAndAmount) validate(v *validator, path string) {
+	v.pattern(path+"/@Ccy", a.Currency, currencyRegex, true)
+	v.pattern(path, a.Value, amountRegex, true)
+}
+
+func (c *CodeOrProprietary) validate(v *validator, path string, max int) {
+	if c.Code == "" && c.Proprietary == "" {
+		v.add(path, "", ErrElementRequired)
+		return
+	}
+	v.text(path+"/Cd", c.Code, 4, false)
+	v.text(path+"/Prtry", c.Proprietary, max, false)
+}
+
+func (cs *ClearingSystemIdentification) validate(v *validator, path string) {
+	if cs.Code == "" && cs.Proprietary == "" {
+		v.add(path, "", ErrElementRequired)
+		return
+	}
+	v.text(path+"/Cd", cs.Code, 5, false)
+	v.text(path+"/Prtry", cs.Proprietary, 35, false)
+}
+
+func (agent *BranchAndFinancialInstitutionIdentification) validate(v *validator, path string) {
+	agent.FinancialInstitutionIdentification.validate(v, path+"/FinInstnId")
+}
+
+func (fi *FinancialInstitutionIdentification) validate(v *validator, path string) {
+	v.pattern(path+"/BICFI", fi.BICFI, bicRegex, false)
+	if mmb := fi.ClearingSystemMemberIdentification; mmb != nil {
+		if mmb.ClearingSystemIdentification != nil {
+			mmb.ClearingSystemIdentification.validate(v, path+"/ClrSysMmbId/ClrSysId")
+		}
+		v.text(path+"/ClrSysMmbId/MmbId", mmb.MemberIdentification, 35, true)
+	}
+	v.text(path+"/Nm", fi.Name, 140, false)
+	if fi.PostalAddress != nil {
+		fi.PostalAddress.validate(v, path+"/PstlAdr")
+	}
+	if fi.Other != nil {
+		fi.Other.validate(v, path+"/Othr")
+	}
+}
+
+func (id *GenericIdentification) validate(v *validator, path string) {
+	v.text(path+"/Id", id.Identification, 35, true)
+	if id.SchemeName != nil {
+		id.SchemeName.validate(v, path+"/SchmeNm", 35)
+	}
+	v.text(path+"/Issr", id.Issuer, 35, false)
+}
+
+func (adr *PostalAddress) validate(v *validator, path string) {
+	if adr.AddressType != nil {
+		adr.AddressType.validate(v, path+"/AdrTp", 35)
+	}
+	v.text(path+"/Dept", adr.Department, 70, false)
+	v.text(path+"/SubDept", adr.SubDepartment, 70, false)
+	v.text(path+"/StrtNm", adr.StreetName, 70, false)
+	v.text(path+"/BldgNb", adr.BuildingNumber, 16, false)
+	v.text(path+"/PstCd", adr.PostCode, 16, false)
+	v.text(path+"/TwnNm", adr.TownName, 35, false)
+	v.text(path+"/CtrySubDvsn", adr.CountrySubDivision, 35, false)
+	v.pattern(path+"/Ctry", adr.Country, countryRegex, false)
+	if len(adr.AddressLine) > 7 {
+		v.add(path+"/AdrLine", "", ErrElementLength)
+	}
+	for _, line := range adr.AddressLine {
+		v.text(path+"/AdrLine", line, 70, true)
+	}
+}
+
+func (p *PartyIdentification) validate(v *validator, path string) {
+	v.text(path+"/Nm", p.Name, 140, false)
+	if p.PostalAddress != nil {
+		p.PostalAddress.validate(v, path+"/PstlAdr")
+	}
+	if id := p.Identification; id != nil {
+		if id.OrganisationIdentification != nil {
+			v.pattern(path+"/Id/OrgId/AnyBIC", id.OrganisationIdentification.AnyBIC, bicRegex, false)
+			for i := range id.OrganisationIdentification.Other {
+				id.OrganisationIdentification.Other[i].validate(v, path+"/Id/OrgId/Othr")
+			}
+		}
+		if id.PrivateIdentification != nil {
+			for i := range id.PrivateIdentification.Other {
+				id.PrivateIdentification.Other[i].validate(v, path+"/Id/PrvtId/Othr")
+			}
+		}
+	}
+	v.pattern(path+"/CtryOfRes", p.CountryOfResidence, countryRegex, false)
+	if c := p.ContactDetails; c != nil {
+		v.text(path+"/CtctDtls/Nm", c.Name, 140, false)
+		v.text(path+"/CtctDtls/PhneNb", c.PhoneNumber, 30, false)
+		v.text(path+"/CtctDtls/MobNb", c.MobileNumber, 30, false)
+		v.text(path+"/CtctDtls/FaxNb", c.FaxNumber, 30, false)
+		v.text(path+"/CtctDtls/EmailAdr", c.EmailAddress, 2048, false)
+		for _, o := range c.Other {
+			v.text(path+"/CtctDtls/Othr/ChanlTp", o.ChannelType, 4, true)
+			v.text(path+"/CtctDtls/Othr/Id", o.Identification, 128, false)
+		}
+	}
+}
+
+func (acct *CashAccount) validate(v *validator, path string) {
+	id := acct.Identification
+	if id.IBAN == "" && id.Other == nil {
+		v.add(path+"/Id", "", ErrElementRequired)
+	}
+	v.text(path+"/Id/IBAN", id.IBAN, 34, false)
+	if id.Other != nil {
+		v.text(path+"/Id/Othr/Id", id.Other.Identification, 34, true)
+		if id.Other.SchemeName != nil {
+			id.Other.SchemeName.validate(v, path+"/Id/Othr/SchmeNm", 35)
+		}
+	}
+}
+
+func (rmt *RemittanceInformation) validate(v *validator, path string) {
+	for _, line := range rmt.Unstructured {
+		v.text(path+"/Ustrd", line, 140, true)
+	}
+	for i := range rmt.Structured {
+		strd := &rmt.Structured[i]
+		for _, doc := range strd.ReferredDocumentInformation {
+			if doc.Type != nil {
+				doc.Type.CodeOrProprietary.validate(v, path+"/Strd/RfrdDocInf/Tp/CdOrPrtry", 35)
+				v.text(path+"/Strd/RfrdDocInf/Tp/Issr", doc.Type.Issuer, 35, false)
+			}
+			v.text(path+"/Strd/RfrdDocInf/Nb", doc.Number, 35, false)
+			v.date(path+"/Strd/RfrdDocInf/RltdDt", doc.RelatedDate, false)
+		}
+		if amt := strd.ReferredDocumentAmount; amt != nil {
+			if amt.DuePayableAmount != nil {
+				amt.DuePayableAmount.validate(v, path+"/Strd/RfrdDocAmt/DuePyblAmt")
+			}
+			for j := range amt.DiscountAppliedAmount {
+				amt.DiscountAppliedAmount[j].Amount.validate(v, path+"/Strd/RfrdDocAmt/DscntApldAmt/Amt")
+			}
+			for j := range amt.AdjustmentAmountAndReason {
+				adj := &amt.AdjustmentAmountAndReason[j]
+				adj.Amount.validate(v, path+"/Strd/RfrdDocAmt/AdjstmntAmtAndRsn/Amt")
+				v.code(path+"/Strd/RfrdDocAmt/AdjstmntAmtAndRsn/CdtDbtInd", adj.CreditDebitIndicator, false, "CRDT", "DBIT")
+				v.text(path+"/Strd/RfrdDocAmt/AdjstmntAmtAndRsn/Rsn", adj.Reason, 4, false)
+				v.text(path+"/Strd/RfrdDocAmt/AdjstmntAmtAndRsn/AddtlInf", adj.AdditionalInformation, 140, false)
+			}
+			if amt.RemittedAmount != nil {
+				amt.RemittedAmount.validate(v, path+"/Strd/RfrdDocAmt/RmtdAmt")
+			}
+		}
+		if strd.Invoicer != nil {
+			strd.Invoicer.validate(v, path+"/Strd/Invcr")
+		}
+		if strd.Invoicee != nil {
+			strd.Invoicee.validate(v, path+"/Strd/Invcee")
+		}
+		if len(strd.AdditionalRemittanceInformation) > 3 {
+			v.add(path+"/Strd/AddtlRmtInf", "", ErrElementLength)
+		}
+		for _, line := range strd.AdditionalRemittanceInformation {
+			v.text(path+"/Strd/AddtlRmtInf", line, 140, true)
+		}
+	}
+}
+
+// validateNextAgentInstructions checks the at most six InstrForNxtAgt of a transaction
+func validateNextAgentInstructions(v *validator, path string, instructions []InstructionForNextAgent) {
+	if len(instructions) > 6 {
+		v.add(path, "", ErrElementLength)
+	}
+	for _, instr := range instructions {
+		v.text(path+"/InstrInf", instr.InstructionInformation, 140, false)
+	}
+}
+
+func (loc *RemittanceLocation) validate(v *validator, path string) {
+	v.text(path+"/RmtId", loc.RemittanceIdentification, 35, false)
+	for _, d := range loc.RemittanceLocationDetails {
+		v.code(path+"/RmtLctnDtls/Mtd", d.Method, true, "FAXI", "EDIC", "URID", "EMAL", "POST", "SMSM")
+		v.text(path+"/RmtLctnDtls/ElctrncAdr", d.ElectronicAddress, 2048, false)
+		if d.PostalAddress != nil {
+			v.text(path+"/RmtLctnDtls/PstlAdr/Nm", d.PostalAddress.Name, 140, true)
+			d.PostalAddress.Address.validate(v, path+"/RmtLctnDtls/PstlAdr/Adr")
+		}
+	}
+}
+
+func (hdr *GroupHeader) validate(v *validator, path string) {
+	v.text(path+"/MsgId", hdr.MessageIdentification, 35, true)
+	v.dateTime(path+"/CreDtTm", hdr.CreationDateTime, true)
+	v.pattern(path+"/NbOfTxs", hdr.NumberOfTransactions, numericRegex, true)
+	v.code(path+"/SttlmInf/SttlmMtd", hdr.SettlementInformation.SettlementMethod, true, "INDA", "INGA", "COVE", SettlementMethodClearing)
+	if cs := hdr.SettlementInformation.ClearingSystem; cs != nil {
+		cs.validate(v, path+"/SttlmInf/ClrSys")
+	}
+}
+
+func (id *PaymentIdentification) validate(v *validator, path string) {
+	v.text(path+"/InstrId", id.InstructionIdentification, 35, false)
+	v.text(path+"/EndToEndId", id.EndToEndIdentification, 35, true)
+	v.text(path+"/TxId", id.TransactionIdentification, 35, false)
+	v.pattern(path+"/UETR", id.UETR, uuidv4Regex, false)
+}
+
+func (pti *PaymentTypeInformation) validate(v *validator, path string) {
+	if pti.LocalInstrument != nil {
+		pti.LocalInstrument.validate(v, path+"/LclInstrm", 35)
+	}
+	if pti.CategoryPurpose != nil {
+		pti.CategoryPurpose.validate(v, path+"/CtgyPurp", 35)
+	}
+}
+
+func (ogi *OriginalGroupInformation) validate(v *validator, path string) {
+	v.text(path+"/OrgnlMsgId", ogi.OriginalMessageIdentification, 35, true)
+	v.text(path+"/OrgnlMsgNmId", ogi.OriginalMessageNameIdentification, 35, true)
+}
+
+func (p *Party40Choice) validate(v *validator, path string) {
+	if (p.Party == nil) == (p.Agent == nil) {
+		v.add(path, "", ErrElementRequired)
+	}
+	if p.Party != nil {
+		p.Party.validate(v, path+"/Pty")
+	}
+	if p.Agent != nil {
+		p.Agent.validate(v, path+"/Agt")
+	}
+}
+
+func (ref *OriginalTransactionReference) validate(v *validator, path string) {
+	if ref.InterbankSettlementAmount != nil {
+		ref.InterbankSettlementAmount.validate(v, path+"/IntrBkSttlmAmt")
+	}
+	if ref.PaymentTypeInformation != nil {
+		ref.PaymentTypeInformation.validate(v, path+"/PmtTpInf")
+	}
+	if ref.RemittanceInformation != nil {
+		ref.RemittanceInformation.validate(v, path+"/RmtInf")
+	}
+	if ref.Debtor != nil {
+		ref.Debtor.validate(v, path+"/Dbtr")
+	}
+	if ref.DebtorAccount != nil {
+		ref.DebtorAccount.validate(v, path+"/DbtrAcct")
+	}
+	if ref.DebtorAgent != nil {
+		ref.DebtorAgent.validate(v, path+"/DbtrAgt")
+	}
+	if ref.CreditorAgent != nil {
+		ref.CreditorAgent.validate(v, path+"/CdtrAgt")
+	}
+	if ref.Creditor != nil {
+		ref.Creditor.validate(v, path+"/Cdtr")
+	}
+	if ref.CreditorAccount != nil {
+		ref.CreditorAccount.validate(v, path+"/CdtrAcct")
+	}
+}
+
+func (a *CaseAssignment) validate(v *validator, path string) {
+	v.text(path+"/Id", a.Identification, 35, true)
+	a.Assigner.validate(v, path+"/Assgnr")
+	a.Assignee.validate(v, path+"/Assgne")
+	v.dateTime(path+"/CreDtTm", a.CreationDateTime, true)
+}
+
+func (r *ReasonInformation) validate(v *validator, path string) {
+	if r.Originator != nil {
+		r.Originator.validate(v, path+"/Orgtr")
+	}
+	if r.Reason != nil {
+		r.Reason.validate(v, path+"/Rsn", 35)
+	}
+	for _, line := range r.AdditionalInformation {
+		v.text(path+"/AddtlInf", line, 105, true)
+	}
+}
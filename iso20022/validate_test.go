@@ -0,0 +1,58 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidator(t *testing.T) {
+	v := &validator{}
+	v.text("Nm", "", 35, false)
+	v.text("MsgId", "", 35, true)
+	v.text("Nm", strings.Repeat("a", 36), 35, false)
+	v.pattern("BICFI", "CITIUS33", bicRegex, false)
+	v.pattern("BICFI", "CITI US33", bicRegex, false)
+	v.code("ChrgBr", "SLEV", true, ChargeBearerServiceLevel)
+	v.code("ChrgBr", "OTHR", true, ChargeBearerServiceLevel)
+	v.date("RltdDt", "2019-05-09", true)
+	v.date("RltdDt", "20190509", true)
+	v.dateTime("CreDtTm", "2019-05-09T10:00:00", true)
+	v.dateTime("CreDtTm", "2019-05-09", true)
+	require.Len(t, v.errs, 6)
+
+	require.Equal(t, "MsgId is required", v.errs[0].Error())
+	require.ErrorIs(t, v.errs[1], ErrElementLength)
+	require.Equal(t, `BICFI "CITI US33" has an invalid format`, v.errs[2].Error())
+	require.ErrorIs(t, v.errs[3], ErrElementCode)
+	require.ErrorIs(t, v.errs[4], ErrElementFormat)
+	require.ErrorIs(t, v.errs[5], ErrElementFormat)
+}
+
+func TestValidateAmounts(t *testing.T) {
+	v := &validator{}
+	(&ActiveCurrencyAndAmount{Currency: "USD", Value: "12345.67"}).validate(v, "Amt")
+	require.Empty(t, v.errs)
+
+	(&ActiveCurrencyAndAmount{Currency: "usd", Value: "-1.00"}).validate(v, "Amt")
+	(&ActiveCurrencyAndAmount{Currency: "USD", Value: "1.123456"}).validate(v, "Amt")
+	require.Len(t, v.errs, 3)
+	require.Contains(t, v.errs[0].Error(), "Amt/@Ccy")
+}
+
+func TestValidatePostalAddress(t *testing.T) {
+	v := &validator{}
+	adr := &PostalAddress{Country: "US", AddressLine: []string{"1", "2", "3", "4", "5", "6", "7"}}
+	adr.validate(v, "PstlAdr")
+	require.Empty(t, v.errs)
+
+	adr.AddressLine = append(adr.AddressLine, "8")
+	adr.Country = "United States"
+	adr.validate(v, "PstlAdr")
+	require.Len(t, v.errs, 2)
+}
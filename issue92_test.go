@@ -43,12 +43,12 @@ func issue92FedWireMessage() *FEDWireMessage {
 	}
 	fwm.SenderDepositoryInstitution = &SenderDepositoryInstitution{
 		tag:             TagSenderDepositoryInstitution,
-		SenderABANumber: "000714895",
+		SenderABANumber: "000714891",
 		SenderShortName: "Fake Institution",
 	}
 	fwm.ReceiverDepositoryInstitution = &ReceiverDepositoryInstitution{
 		tag:               TagReceiverDepositoryInstitution,
-		ReceiverABANumber: "000738119",
+		ReceiverABANumber: "000738110",
 		ReceiverShortName: "Fake Institution",
 	}
 	fwm.BusinessFunctionCode = &BusinessFunctionCode{
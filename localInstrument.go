@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // LocalInstrument is the LocalInstrument of the wire
@@ -47,14 +49,14 @@ func (li *LocalInstrument) Parse(record string) error {
 
 	value, read, err := li.parseFixedStringField(record[length:], 4)
 	if err != nil {
-		return fieldError("LocalInstrumentCode", err)
+		return fieldErrorAt(length, "LocalInstrumentCode", err)
 	}
 	li.LocalInstrumentCode = value
 	length += read
 
 	value, read, err = li.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("ProprietaryCode", err)
+		return fieldErrorAt(length, "ProprietaryCode", err)
 	}
 	li.ProprietaryCode = value
 	length += read
@@ -104,31 +106,35 @@ func (li *LocalInstrument) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on LocalInstrument and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (li *LocalInstrument) Validate() error {
-	if err := li.fieldInclusion(); err != nil {
-		return err
-	}
+	return li.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on LocalInstrument and returns every error found.
+// Only the first error for each field is returned.
+func (li *LocalInstrument) ValidateAll() base.ErrorList {
+	errs := li.fieldInclusion()
 	if li.tag != TagLocalInstrument {
-		return fieldError("tag", ErrValidTagForType, li.tag)
+		addFieldError(&errs, TagLocalInstrument, fieldError("tag", ErrValidTagForType, li.tag))
 	}
 	if err := li.isLocalInstrumentCode(li.LocalInstrumentCode); err != nil {
-		return fieldError("LocalInstrumentCode", err, li.LocalInstrumentCode)
+		addFieldError(&errs, TagLocalInstrument, fieldError("LocalInstrumentCode", err, li.LocalInstrumentCode))
 	}
 	if err := li.isAlphanumeric(li.ProprietaryCode); err != nil {
-		return fieldError("ProprietaryCode", err, li.ProprietaryCode)
+		addFieldError(&errs, TagLocalInstrument, fieldError("ProprietaryCode", err, li.ProprietaryCode))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
 // ProprietaryCode is only allowed if LocalInstrument Code is PROP
-func (li *LocalInstrument) fieldInclusion() error {
+func (li *LocalInstrument) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if li.LocalInstrumentCode != ProprietaryLocalInstrumentCode && li.ProprietaryCode != "" {
-		return fieldError("ProprietaryCode", ErrInvalidProperty, li.ProprietaryCode)
+		addFieldError(&errs, TagLocalInstrument, fieldError("ProprietaryCode", ErrInvalidProperty, li.ProprietaryCode))
 	}
-	return nil
+	return errs
 }
 
 // LocalInstrumentCodeField gets a string of LocalInstrumentCode field
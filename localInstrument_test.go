@@ -61,7 +61,7 @@ func TestParseLocalInstrumentWrongLength(t *testing.T) {
 
 	err := r.parseLocalInstrument()
 
-	require.EqualError(t, err, r.parseError(fieldError("ProprietaryCode", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(10, "ProprietaryCode", ErrRequireDelimiter)).Error())
 }
 
 // TestParseLocalInstrumentReaderParseError parses a wrong LocalInstrumente reader parse error
@@ -76,7 +76,7 @@ func TestParseLocalInstrumentReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("LocalInstrumentCode", ErrLocalInstrumentCode, "ABCD")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("LocalInstrumentCode", ErrLocalInstrumentCode, "ABCD"))).Error())
 }
 
 // TestLocalInstrumentTagError validates a LocalInstrument tag
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // MessageDisposition is the message disposition of the wire
@@ -54,28 +56,28 @@ func (md *MessageDisposition) Parse(record string) error {
 
 	value, read, err := md.parseFixedStringField(record[length:], 2)
 	if err != nil {
-		return fieldError("FormatVersion", err)
+		return fieldErrorAt(length, "FormatVersion", err)
 	}
 	md.FormatVersion = value
 	length += read
 
 	value, read, err = md.parseFixedStringField(record[length:], 1)
 	if err != nil {
-		return fieldError("TestProductionCode", err)
+		return fieldErrorAt(length, "TestProductionCode", err)
 	}
 	md.TestProductionCode = value
 	length += read
 
 	value, read, err = md.parseFixedStringField(record[length:], 1)
 	if err != nil {
-		return fieldError("MessageDuplicationCode", err)
+		return fieldErrorAt(length, "MessageDuplicationCode", err)
 	}
 	md.MessageDuplicationCode = value
 	length += read
 
 	value, read, err = md.parseFixedStringField(record[length:], 1)
 	if err != nil {
-		return fieldError("MessageStatusIndicator", err)
+		return fieldErrorAt(length, "MessageStatusIndicator", err)
 	}
 	md.MessageStatusIndicator = value
 	length += read
@@ -127,13 +129,19 @@ func (md *MessageDisposition) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on MessageDisposition and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (md *MessageDisposition) Validate() error {
+	return md.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on MessageDisposition and returns every error found.
+// Only the first error for each field is returned.
+func (md *MessageDisposition) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	// Currently no validation as the FED is responsible for the values
 	if md.tag != TagMessageDisposition {
-		return fieldError("tag", ErrValidTagForType, md.tag)
+		addFieldError(&errs, TagMessageDisposition, fieldError("tag", ErrValidTagForType, md.tag))
 	}
-	return nil
+	return errs
 }
 
 // MessageDispositionFormatVersionField gets a string of the FormatVersion field
@@ -0,0 +1,168 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/currency"
+)
+
+var (
+	// ErrAmountRange is returned for an amount which is negative, too large for its tag or overflows an int64
+	ErrAmountRange = errors.New("is out of range")
+	// ErrAmountPrecision is returned for an amount with more decimal places than the minor unit of its currency
+	ErrAmountPrecision = errors.New("has more decimal places than its currency")
+	// ErrCurrencyMismatch is returned when amounts of different currencies are combined, or an amount is not
+	// in the currency of its tag
+	ErrCurrencyMismatch = errors.New("is not the same currency")
+)
+
+// Money is an amount of a currency in the currency's ISO 4217 minor unit, such as cents of USD or yen of JPY.
+//
+// The amount tags of a FEDWireMessage each write amounts differently: {2000} Amount has an implied decimal point,
+// {3710} InstructedAmount and {3700} Charges a decimal comma and the remittance amounts of {8450} to {8600} a
+// decimal period. Their Money and SetMoney methods convert between those formats and Money.
+type Money struct {
+	// CurrencyCode is the ISO 4217 code of the currency, such as USD
+	CurrencyCode string
+	// MinorUnits is the amount in the minor unit of the currency, such as 123456 for USD 1,234.56
+	MinorUnits int64
+}
+
+// NewMoney returns the Money of minorUnits of the currency, such as NewMoney("USD", 123456) for USD 1,234.56
+func NewMoney(currencyCode string, minorUnits int64) (Money, error) {
+	if _, err := currency.ParseISO(currencyCode); err != nil || len(currencyCode) != 3 {
+		return Money{}, fieldError("CurrencyCode", ErrNonCurrencyCode, currencyCode)
+	}
+	return Money{CurrencyCode: strings.ToUpper(currencyCode), MinorUnits: minorUnits}, nil
+}
+
+// ParseMoney returns the Money of a decimal amount of the currency, such as ParseMoney("USD", "1234.56").
+// The decimal marker may be a period or a comma. Decimal places beyond the minor unit of the currency must be zero.
+func ParseMoney(currencyCode, amount string) (Money, error) {
+	m, err := NewMoney(currencyCode, 0)
+	if err != nil {
+		return m, err
+	}
+	value := strings.TrimSpace(amount)
+	whole, frac := value, ""
+	if i := strings.IndexAny(value, ".,"); i >= 0 {
+		whole, frac = value[:i], value[i+1:]
+	}
+	if whole+frac == "" || !isDigits(whole) || !isDigits(frac) {
+		return Money{}, fieldError("Amount", ErrNonAmount, amount)
+	}
+
+	scale := m.Scale()
+	if len(frac) > scale {
+		if strings.Trim(frac[scale:], "0") != "" {
+			return Money{}, fieldError("Amount", ErrAmountPrecision, amount)
+		}
+		frac = frac[:scale]
+	}
+	digits := strings.TrimLeft(whole+frac+strings.Repeat("0", scale-len(frac)), "0")
+	if digits == "" {
+		return m, nil
+	}
+	m.MinorUnits, err = strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return Money{}, fieldError("Amount", ErrAmountRange, amount)
+	}
+	return m, nil
+}
+
+// minorUnits are the ISO 4217 minor units of the currencies whose minor unit is not 2 decimal places. The CLDR
+// rounding of golang.org/x/text/currency is for display and cash, so it differs from ISO 4217 for currencies
+// such as IQD and RSD.
+var minorUnits = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0, "PYG": 0,
+	"RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+}
+
+// Scale returns the number of decimal places of the ISO 4217 minor unit of the currency of m, such as 2 for USD.
+// Currencies without a minor unit, such as XAU, have a scale of 2.
+func (m Money) Scale() int {
+	if scale, ok := minorUnits[strings.ToUpper(m.CurrencyCode)]; ok {
+		return scale
+	}
+	return 2
+}
+
+// Add returns the sum of m and o, which must be of the same currency
+func (m Money) Add(o Money) (Money, error) {
+	if !strings.EqualFold(m.CurrencyCode, o.CurrencyCode) {
+		return Money{}, fieldError("CurrencyCode", ErrCurrencyMismatch, o.CurrencyCode)
+	}
+	if (o.MinorUnits > 0 && m.MinorUnits > math.MaxInt64-o.MinorUnits) ||
+		(o.MinorUnits < 0 && m.MinorUnits < math.MinInt64-o.MinorUnits) {
+		return Money{}, fieldError("MinorUnits", ErrAmountRange, o.MinorUnits)
+	}
+	m.MinorUnits += o.MinorUnits
+	return m, nil
+}
+
+// Sub returns m less o, which must be of the same currency
+func (m Money) Sub(o Money) (Money, error) {
+	if o.MinorUnits == math.MinInt64 {
+		return Money{}, fieldError("MinorUnits", ErrAmountRange, o.MinorUnits)
+	}
+	o.MinorUnits = -o.MinorUnits
+	return m.Add(o)
+}
+
+// IsZero returns true when m has no minor units
+func (m Money) IsZero() bool {
+	return m.MinorUnits == 0
+}
+
+// String returns the currency code and decimal amount of m, such as "USD 1234.56"
+func (m Money) String() string {
+	return m.CurrencyCode + " " + strings.TrimSuffix(m.decimal("."), ".")
+}
+
+// decimal returns the amount of m with the decimal marker. Amounts of currencies without a minor unit end with
+// the marker, as the SWIFT derived tags require one.
+func (m Money) decimal(marker string) string {
+	units := uint64(m.MinorUnits)
+	sign := ""
+	if m.MinorUnits < 0 {
+		units = -units
+		sign = "-"
+	}
+	digits := strconv.FormatUint(units, 10)
+	scale := m.Scale()
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-scale] + marker + digits[len(digits)-scale:]
+}
+
+// formatMoney returns the amount of m with the decimal marker, or an error when it is negative or longer than max
+func formatMoney(m Money, marker string, max int) (string, error) {
+	if m.MinorUnits < 0 {
+		return "", fieldError("Amount", ErrAmountRange, m.MinorUnits)
+	}
+	amount := m.decimal(marker)
+	if len(amount) > max {
+		return "", fieldError("Amount", ErrAmountRange, amount)
+	}
+	return amount, nil
+}
+
+// isDigits returns true when s only contains ASCII numeric (0-9) characters
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,120 @@
+package wire
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMoney(t *testing.T) {
+	m, err := NewMoney("usd", 123456)
+	require.NoError(t, err)
+	require.Equal(t, Money{CurrencyCode: "USD", MinorUnits: 123456}, m)
+	require.Equal(t, "USD 1234.56", m.String())
+
+	_, err = NewMoney("ZZZ", 1)
+	require.ErrorIs(t, err, ErrNonCurrencyCode)
+	_, err = NewMoney("US", 1)
+	require.ErrorIs(t, err, ErrNonCurrencyCode)
+}
+
+func TestMoney_Scale(t *testing.T) {
+	tests := []struct {
+		currencyCode string
+		scale        int
+	}{
+		{"USD", 2},
+		{"EUR", 2},
+		{"JPY", 0},
+		{"KRW", 0},
+		{"BHD", 3},
+		{"KWD", 3},
+		// ISO 4217 minor units which differ from the CLDR rounding
+		{"IQD", 3},
+		{"RSD", 2},
+		{"IRR", 2},
+		{"LBP", 2},
+		{"CLF", 4},
+		{"jpy", 0},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.scale, Money{CurrencyCode: tt.currencyCode}.Scale(), tt.currencyCode)
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		currencyCode, amount string
+		want                 int64
+		wantErr              error
+	}{
+		{"USD", "1234.56", 123456, nil},
+		{"USD", "1234,56", 123456, nil},
+		{"USD", "0001234,5", 123450, nil},
+		{"USD", "1234", 123400, nil},
+		{"USD", "1234,", 123400, nil},
+		{"USD", ".01", 1, nil},
+		{"USD", "0,00", 0, nil},
+		{"USD", "1234.56000", 123456, nil},
+		{"JPY", "1234", 1234, nil},
+		{"BHD", "1,234", 1234, nil},
+		{"IQD", "1.500", 1500, nil},
+		{"RSD", "1.50", 150, nil},
+		{"CLF", "1,2345", 12345, nil},
+		{"JPY", "1500", 1500, nil},
+		{"BHD", "1.5", 1500, nil},
+		{"IQD", "1.5001", 0, ErrAmountPrecision},
+		{"USD", "1234.567", 0, ErrAmountPrecision},
+		{"JPY", "1234,5", 0, ErrAmountPrecision},
+		{"USD", "1,234.56", 0, ErrNonAmount},
+		{"USD", "-1.00", 0, ErrNonAmount},
+		{"USD", "", 0, ErrNonAmount},
+		{"USD", ",", 0, ErrNonAmount},
+		{"USD", "92233720368547758,08", 0, ErrAmountRange},
+		{"XYZ", "1,00", 0, ErrNonCurrencyCode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.currencyCode+" "+tt.amount, func(t *testing.T) {
+			m, err := ParseMoney(tt.currencyCode, tt.amount)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				require.Equal(t, tt.want, m.MinorUnits)
+			}
+		})
+	}
+}
+
+func TestMoney_Arithmetic(t *testing.T) {
+	a := Money{CurrencyCode: "USD", MinorUnits: 1099}
+	b := Money{CurrencyCode: "USD", MinorUnits: 1}
+
+	sum, err := a.Add(b)
+	require.NoError(t, err)
+	require.Equal(t, "USD 11.00", sum.String())
+
+	diff, err := b.Sub(a)
+	require.NoError(t, err)
+	require.Equal(t, "USD -10.98", diff.String())
+	require.False(t, diff.IsZero())
+
+	_, err = a.Add(Money{CurrencyCode: "EUR", MinorUnits: 1})
+	require.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	_, err = Money{CurrencyCode: "USD", MinorUnits: math.MaxInt64}.Add(b)
+	require.ErrorIs(t, err, ErrAmountRange)
+	_, err = Money{CurrencyCode: "USD", MinorUnits: math.MinInt64}.Sub(b)
+	require.ErrorIs(t, err, ErrAmountRange)
+	_, err = a.Sub(Money{CurrencyCode: "USD", MinorUnits: math.MinInt64})
+	require.ErrorIs(t, err, ErrAmountRange)
+}
+
+func TestMoney_Decimal(t *testing.T) {
+	require.Equal(t, "0,05", Money{CurrencyCode: "USD", MinorUnits: 5}.decimal(","))
+	require.Equal(t, "1234,", Money{CurrencyCode: "JPY", MinorUnits: 1234}.decimal(","))
+	require.Equal(t, "1.234", Money{CurrencyCode: "BHD", MinorUnits: 1234}.decimal("."))
+	require.Equal(t, "-92233720368547758.08", Money{CurrencyCode: "USD", MinorUnits: math.MinInt64}.decimal("."))
+	require.Equal(t, "JPY 1234", Money{CurrencyCode: "JPY", MinorUnits: 1234}.String())
+	require.Equal(t, "IQD 1.500", Money{CurrencyCode: "IQD", MinorUnits: 1500}.String())
+	require.Equal(t, "RSD 1.50", Money{CurrencyCode: "RSD", MinorUnits: 150}.String())
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // OrderingCustomer is the ordering customer
@@ -45,42 +47,42 @@ func (oc *OrderingCustomer) Parse(record string) error {
 
 	value, read, err := oc.parseVariableStringField(record[length:], 5)
 	if err != nil {
-		return fieldError("SwiftFieldTag", err)
+		return fieldErrorAt(length, "SwiftFieldTag", err)
 	}
 	oc.CoverPayment.SwiftFieldTag = value
 	length += read
 
 	value, read, err = oc.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineOne", err)
+		return fieldErrorAt(length, "SwiftLineOne", err)
 	}
 	oc.CoverPayment.SwiftLineOne = value
 	length += read
 
 	value, read, err = oc.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineTwo", err)
+		return fieldErrorAt(length, "SwiftLineTwo", err)
 	}
 	oc.CoverPayment.SwiftLineTwo = value
 	length += read
 
 	value, read, err = oc.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineThree", err)
+		return fieldErrorAt(length, "SwiftLineThree", err)
 	}
 	oc.CoverPayment.SwiftLineThree = value
 	length += read
 
 	value, read, err = oc.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineFour", err)
+		return fieldErrorAt(length, "SwiftLineFour", err)
 	}
 	oc.CoverPayment.SwiftLineFour = value
 	length += read
 
 	value, read, err = oc.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineFive", err)
+		return fieldErrorAt(length, "SwiftLineFive", err)
 	}
 	oc.CoverPayment.SwiftLineFive = value
 	length += read
@@ -134,42 +136,49 @@ func (oc *OrderingCustomer) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on OrderingCustomer and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (oc *OrderingCustomer) Validate() error {
-	if err := oc.fieldInclusion(); err != nil {
-		return err
-	}
+	return oc.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on OrderingCustomer and returns every error found.
+// Only the first error for each field is returned.
+func (oc *OrderingCustomer) ValidateAll() base.ErrorList {
+	errs := oc.fieldInclusion()
 	if oc.tag != TagOrderingCustomer {
-		return fieldError("tag", ErrValidTagForType, oc.tag)
+		addFieldError(&errs, TagOrderingCustomer, fieldError("tag", ErrValidTagForType, oc.tag))
 	}
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, oc.CoverPayment.SwiftFieldTag)
+		addFieldError(&errs, TagOrderingCustomer, fieldError("SwiftFieldTag", err, oc.CoverPayment.SwiftFieldTag))
 	}
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, oc.CoverPayment.SwiftLineOne)
+		addFieldError(&errs, TagOrderingCustomer, fieldError("SwiftLineOne", err, oc.CoverPayment.SwiftLineOne))
 	}
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, oc.CoverPayment.SwiftLineTwo)
+		addFieldError(&errs, TagOrderingCustomer, fieldError("SwiftLineTwo", err, oc.CoverPayment.SwiftLineTwo))
 	}
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, oc.CoverPayment.SwiftLineThree)
+		addFieldError(&errs, TagOrderingCustomer, fieldError("SwiftLineThree", err, oc.CoverPayment.SwiftLineThree))
 	}
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, oc.CoverPayment.SwiftLineFour)
+		addFieldError(&errs, TagOrderingCustomer, fieldError("SwiftLineFour", err, oc.CoverPayment.SwiftLineFour))
 	}
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineFive); err != nil {
-		return fieldError("SwiftLineFive", err, oc.CoverPayment.SwiftLineFive)
+		addFieldError(&errs, TagOrderingCustomer, fieldError("SwiftLineFive", err, oc.CoverPayment.SwiftLineFive))
 	}
-	return nil
+	if i, err := oc.validateCoverPaymentParty(oc.CoverPayment); err != nil {
+		addFieldError(&errs, TagOrderingCustomer, fieldError(swiftLineNames[i], err, oc.CoverPayment.lines()[i]))
+	}
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (oc *OrderingCustomer) fieldInclusion() error {
+func (oc *OrderingCustomer) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if oc.CoverPayment.SwiftLineSix != "" {
-		return fieldError("SwiftLineSix", ErrInvalidProperty, oc.CoverPayment.SwiftLineSix)
+		addFieldError(&errs, TagOrderingCustomer, fieldError("SwiftLineSix", ErrInvalidProperty, oc.CoverPayment.SwiftLineSix))
 	}
-	return nil
+	return errs
 }
 
 // SwiftFieldTagField gets a string of the SwiftFieldTag field
@@ -97,6 +97,28 @@ func TestOrderingCustomerSwiftLineSixAlphaNumeric(t *testing.T) {
 	require.EqualError(t, err, fieldError("SwiftLineSix", ErrInvalidProperty, oc.CoverPayment.SwiftLineSix).Error())
 }
 
+// TestOrderingCustomerParty validates the party identifier and BIC of an OrderingCustomer
+func TestOrderingCustomerParty(t *testing.T) {
+	oc := mockOrderingCustomer()
+	oc.CoverPayment.SwiftFieldTag = "50A"
+	oc.CoverPayment.SwiftLineOne = "/GB82WEST12345698765432"
+	oc.CoverPayment.SwiftLineTwo = "NWBKGB2L"
+
+	require.NoError(t, oc.Validate())
+
+	oc.CoverPayment.SwiftLineTwo = "Swift Line Two"
+
+	err := oc.Validate()
+
+	require.EqualError(t, err, fieldError("SwiftLineTwo", ErrNonBIC, oc.CoverPayment.SwiftLineTwo).Error())
+
+	oc.CoverPayment.SwiftLineOne = "/GB83WEST12345698765432"
+
+	err = oc.Validate()
+
+	require.EqualError(t, err, fieldError("SwiftLineOne", ErrIBANCheckDigit, oc.CoverPayment.SwiftLineOne).Error())
+}
+
 // TestParseOrderingCustomerWrongLength parses a wrong OrderingCustomer record length
 func TestParseOrderingCustomerWrongLength(t *testing.T) {
 	var line = "{7050}SwiftSwift Line One                     Swift Line Two                     Swift Line Three                   Swift Line Four                    Swift Line Five                  "
@@ -105,7 +127,7 @@ func TestParseOrderingCustomerWrongLength(t *testing.T) {
 
 	err := r.parseOrderingCustomer()
 
-	require.EqualError(t, err, r.parseError(fieldError("SwiftFieldTag", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "SwiftFieldTag", ErrRequireDelimiter)).Error())
 }
 
 // TestParseOrderingCustomerReaderParseError parses a wrong OrderingCustomer reader parse error
@@ -120,7 +142,7 @@ func TestParseOrderingCustomerReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("SwiftLineOne", ErrNonAlphanumeric, "Swift ®ine One")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("SwiftLineOne", ErrNonAlphanumeric, "Swift ®ine One"))).Error())
 }
 
 // TestOrderingCustomerTagError validates a OrderingCustomer tag
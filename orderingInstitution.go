@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // OrderingInstitution is the ordering institution
@@ -45,42 +47,42 @@ func (oi *OrderingInstitution) Parse(record string) error {
 
 	value, read, err := oi.parseVariableStringField(record[length:], 5)
 	if err != nil {
-		return fieldError("SwiftFieldTag", err)
+		return fieldErrorAt(length, "SwiftFieldTag", err)
 	}
 	oi.CoverPayment.SwiftFieldTag = value
 	length += read
 
 	value, read, err = oi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineOne", err)
+		return fieldErrorAt(length, "SwiftLineOne", err)
 	}
 	oi.CoverPayment.SwiftLineOne = value
 	length += read
 
 	value, read, err = oi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineTwo", err)
+		return fieldErrorAt(length, "SwiftLineTwo", err)
 	}
 	oi.CoverPayment.SwiftLineTwo = value
 	length += read
 
 	value, read, err = oi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineThree", err)
+		return fieldErrorAt(length, "SwiftLineThree", err)
 	}
 	oi.CoverPayment.SwiftLineThree = value
 	length += read
 
 	value, read, err = oi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineFour", err)
+		return fieldErrorAt(length, "SwiftLineFour", err)
 	}
 	oi.CoverPayment.SwiftLineFour = value
 	length += read
 
 	value, read, err = oi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineFive", err)
+		return fieldErrorAt(length, "SwiftLineFive", err)
 	}
 	oi.CoverPayment.SwiftLineFive = value
 	length += read
@@ -134,42 +136,49 @@ func (oi *OrderingInstitution) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on OrderingInstitution and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (oi *OrderingInstitution) Validate() error {
-	if err := oi.fieldInclusion(); err != nil {
-		return err
-	}
+	return oi.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on OrderingInstitution and returns every error found.
+// Only the first error for each field is returned.
+func (oi *OrderingInstitution) ValidateAll() base.ErrorList {
+	errs := oi.fieldInclusion()
 	if oi.tag != TagOrderingInstitution {
-		return fieldError("tag", ErrValidTagForType, oi.tag)
+		addFieldError(&errs, TagOrderingInstitution, fieldError("tag", ErrValidTagForType, oi.tag))
 	}
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, oi.CoverPayment.SwiftFieldTag)
+		addFieldError(&errs, TagOrderingInstitution, fieldError("SwiftFieldTag", err, oi.CoverPayment.SwiftFieldTag))
 	}
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, oi.CoverPayment.SwiftLineOne)
+		addFieldError(&errs, TagOrderingInstitution, fieldError("SwiftLineOne", err, oi.CoverPayment.SwiftLineOne))
 	}
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, oi.CoverPayment.SwiftLineTwo)
+		addFieldError(&errs, TagOrderingInstitution, fieldError("SwiftLineTwo", err, oi.CoverPayment.SwiftLineTwo))
 	}
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, oi.CoverPayment.SwiftLineThree)
+		addFieldError(&errs, TagOrderingInstitution, fieldError("SwiftLineThree", err, oi.CoverPayment.SwiftLineThree))
 	}
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, oi.CoverPayment.SwiftLineFour)
+		addFieldError(&errs, TagOrderingInstitution, fieldError("SwiftLineFour", err, oi.CoverPayment.SwiftLineFour))
 	}
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineFive); err != nil {
-		return fieldError("SwiftLineFive", err, oi.CoverPayment.SwiftLineFive)
+		addFieldError(&errs, TagOrderingInstitution, fieldError("SwiftLineFive", err, oi.CoverPayment.SwiftLineFive))
 	}
-	return nil
+	if i, err := oi.validateCoverPaymentParty(oi.CoverPayment); err != nil {
+		addFieldError(&errs, TagOrderingInstitution, fieldError(swiftLineNames[i], err, oi.CoverPayment.lines()[i]))
+	}
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (oi *OrderingInstitution) fieldInclusion() error {
+func (oi *OrderingInstitution) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if oi.CoverPayment.SwiftLineSix != "" {
-		return fieldError("SwiftLineSix", ErrInvalidProperty, oi.CoverPayment.SwiftLineSix)
+		addFieldError(&errs, TagOrderingInstitution, fieldError("SwiftLineSix", ErrInvalidProperty, oi.CoverPayment.SwiftLineSix))
 	}
-	return nil
+	return errs
 }
 
 // SwiftFieldTagField gets a string of the SwiftFieldTag field
@@ -104,7 +104,7 @@ func TestParseOrderingInstitutionWrongLength(t *testing.T) {
 	r.line = line
 
 	err := r.parseOrderingInstitution()
-	require.EqualError(t, err, r.parseError(fieldError("SwiftFieldTag", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "SwiftFieldTag", ErrRequireDelimiter)).Error())
 }
 
 // TestParseOrderingInstitutionReaderParseError parses a wrong OrderingInstitution reader parse error
@@ -119,7 +119,7 @@ func TestParseOrderingInstitutionReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("SwiftLineOne", ErrNonAlphanumeric, "Swift ®ine One")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("SwiftLineOne", ErrNonAlphanumeric, "Swift ®ine One"))).Error())
 }
 
 // TestOrderingInstitutionTagError validates a OrderingInstitution tag
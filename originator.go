@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // Originator is the originator of the wire
@@ -46,35 +48,35 @@ func (o *Originator) Parse(record string) error {
 
 	value, read, err := o.parseVariableStringField(record[length:], 34)
 	if err != nil {
-		return fieldError("Identifier", err)
+		return fieldErrorAt(length, "Identifier", err)
 	}
 	o.Personal.Identifier = value
 	length += read
 
 	value, read, err = o.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("Name", err)
+		return fieldErrorAt(length, "Name", err)
 	}
 	o.Personal.Name = value
 	length += read
 
 	value, read, err = o.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineOne", err)
+		return fieldErrorAt(length, "AddressLineOne", err)
 	}
 	o.Personal.Address.AddressLineOne = value
 	length += read
 
 	value, read, err = o.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineTwo", err)
+		return fieldErrorAt(length, "AddressLineTwo", err)
 	}
 	o.Personal.Address.AddressLineTwo = value
 	length += read
 
 	value, read, err = o.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineThree", err)
+		return fieldErrorAt(length, "AddressLineThree", err)
 	}
 	o.Personal.Address.AddressLineThree = value
 	length += read
@@ -128,15 +130,19 @@ func (o *Originator) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on Originator and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (o *Originator) Validate() error {
+	return o.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on Originator and returns every error found.
+// Only the first error for each field is returned.
+func (o *Originator) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if o.tag != TagOriginator {
-		return fieldError("tag", ErrValidTagForType, o.tag)
+		addFieldError(&errs, TagOriginator, fieldError("tag", ErrValidTagForType, o.tag))
 	}
 
-	if err := o.fieldInclusion(); err != nil {
-		return err
-	}
+	errs = append(errs, o.fieldInclusion()...)
 
 	// Per FAIM 3.0.6, Originator ID code is optional
 	// fieldInclusion() above already checks for the mandatory combination of IDCode & Identifier
@@ -144,39 +150,46 @@ func (o *Originator) Validate() error {
 	if o.Personal.IdentificationCode != "" {
 		// If it is present, confirm it is a valid code
 		if err := o.isIdentificationCode(o.Personal.IdentificationCode); err != nil {
-			return fieldError("IdentificationCode", err, o.Personal.IdentificationCode)
+			addFieldError(&errs, TagOriginator, fieldError("IdentificationCode", err, o.Personal.IdentificationCode))
 		}
 		// Identifier text must only contain allowed characters
 		if err := o.isAlphanumeric(o.Personal.Identifier); err != nil {
-			return fieldError("Identifier", err, o.Personal.Identifier)
+			addFieldError(&errs, TagOriginator, fieldError("Identifier", err, o.Personal.Identifier))
+		}
+		if err := o.validateIdentifier(o.Personal.IdentificationCode, o.Personal.Identifier); err != nil {
+			addFieldError(&errs, TagOriginator, fieldError("Identifier", err, o.Personal.Identifier))
 		}
 	}
 
 	if err := o.isAlphanumeric(o.Personal.Name); err != nil {
-		return fieldError("Name", err, o.Personal.Name)
+		addFieldError(&errs, TagOriginator, fieldError("Name", err, o.Personal.Name))
+	}
+	if err := o.validateIdentifierName(o.Personal.IdentificationCode, o.Personal.Name); err != nil {
+		addFieldError(&errs, TagOriginator, fieldError("Name", err, o.Personal.Name))
 	}
 	if err := o.isAlphanumeric(o.Personal.Address.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, o.Personal.Address.AddressLineOne)
+		addFieldError(&errs, TagOriginator, fieldError("AddressLineOne", err, o.Personal.Address.AddressLineOne))
 	}
 	if err := o.isAlphanumeric(o.Personal.Address.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, o.Personal.Address.AddressLineTwo)
+		addFieldError(&errs, TagOriginator, fieldError("AddressLineTwo", err, o.Personal.Address.AddressLineTwo))
 	}
 	if err := o.isAlphanumeric(o.Personal.Address.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, o.Personal.Address.AddressLineThree)
+		addFieldError(&errs, TagOriginator, fieldError("AddressLineThree", err, o.Personal.Address.AddressLineThree))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (o *Originator) fieldInclusion() error {
+func (o *Originator) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if o.Personal.IdentificationCode != "" && o.Personal.Identifier == "" {
-		return fieldError("Identifier", ErrFieldRequired)
+		addFieldError(&errs, TagOriginator, fieldError("Identifier", ErrFieldRequired))
 	}
 	if o.Personal.IdentificationCode == "" && o.Personal.Identifier != "" {
-		return fieldError("IdentificationCode", ErrFieldRequired)
+		addFieldError(&errs, TagOriginator, fieldError("IdentificationCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // IdentificationCodeField gets a string of the IdentificationCode field
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // OriginatorFI is the originator Financial Institution
@@ -45,42 +47,42 @@ func (ofi *OriginatorFI) Parse(record string) error {
 
 	value, read, err := ofi.parseFixedStringField(record[length:], 1)
 	if err != nil {
-		return fieldError("IdentificationCode", err)
+		return fieldErrorAt(length, "IdentificationCode", err)
 	}
 	ofi.FinancialInstitution.IdentificationCode = value
 	length += read
 
 	value, read, err = ofi.parseVariableStringField(record[length:], 34)
 	if err != nil {
-		return fieldError("Identifier", err)
+		return fieldErrorAt(length, "Identifier", err)
 	}
 	ofi.FinancialInstitution.Identifier = value
 	length += read
 
 	value, read, err = ofi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("Name", err)
+		return fieldErrorAt(length, "Name", err)
 	}
 	ofi.FinancialInstitution.Name = value
 	length += read
 
 	value, read, err = ofi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineOne", err)
+		return fieldErrorAt(length, "AddressLineOne", err)
 	}
 	ofi.FinancialInstitution.Address.AddressLineOne = value
 	length += read
 
 	value, read, err = ofi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineTwo", err)
+		return fieldErrorAt(length, "AddressLineTwo", err)
 	}
 	ofi.FinancialInstitution.Address.AddressLineTwo = value
 	length += read
 
 	value, read, err = ofi.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("AddressLineThree", err)
+		return fieldErrorAt(length, "AddressLineThree", err)
 	}
 	ofi.FinancialInstitution.Address.AddressLineThree = value
 	length += read
@@ -134,53 +136,63 @@ func (ofi *OriginatorFI) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on OriginatorFI and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 // If ID Code is present, Identifier is mandatory and vice versa.
 func (ofi *OriginatorFI) Validate() error {
-	if err := ofi.fieldInclusion(); err != nil {
-		return err
-	}
+	return ofi.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on OriginatorFI and returns every error found.
+// Only the first error for each field is returned.
+func (ofi *OriginatorFI) ValidateAll() base.ErrorList {
+	errs := ofi.fieldInclusion()
 	if ofi.tag != TagOriginatorFI {
-		return fieldError("tag", ErrValidTagForType, ofi.tag)
+		addFieldError(&errs, TagOriginatorFI, fieldError("tag", ErrValidTagForType, ofi.tag))
 	}
 	if err := ofi.isIdentificationCode(ofi.FinancialInstitution.IdentificationCode); err != nil {
-		return fieldError("IdentificationCode", err, ofi.FinancialInstitution.IdentificationCode)
+		addFieldError(&errs, TagOriginatorFI, fieldError("IdentificationCode", err, ofi.FinancialInstitution.IdentificationCode))
 	}
 	// Can only be these Identification Codes
 	switch ofi.FinancialInstitution.IdentificationCode {
 	case
 		"B", "C", "D", "F", "U":
 	default:
-		return fieldError("IdentificationCode", ErrIdentificationCode, ofi.FinancialInstitution.IdentificationCode)
+		addFieldError(&errs, TagOriginatorFI, fieldError("IdentificationCode", ErrIdentificationCode, ofi.FinancialInstitution.IdentificationCode))
 	}
 	if err := ofi.isAlphanumeric(ofi.FinancialInstitution.Identifier); err != nil {
-		return fieldError("Identifier", err, ofi.FinancialInstitution.Identifier)
+		addFieldError(&errs, TagOriginatorFI, fieldError("Identifier", err, ofi.FinancialInstitution.Identifier))
+	}
+	if err := ofi.validateIdentifier(ofi.FinancialInstitution.IdentificationCode, ofi.FinancialInstitution.Identifier); err != nil {
+		addFieldError(&errs, TagOriginatorFI, fieldError("Identifier", err, ofi.FinancialInstitution.Identifier))
 	}
 	if err := ofi.isAlphanumeric(ofi.FinancialInstitution.Name); err != nil {
-		return fieldError("Name", err, ofi.FinancialInstitution.Name)
+		addFieldError(&errs, TagOriginatorFI, fieldError("Name", err, ofi.FinancialInstitution.Name))
+	}
+	if err := ofi.validateIdentifierName(ofi.FinancialInstitution.IdentificationCode, ofi.FinancialInstitution.Name); err != nil {
+		addFieldError(&errs, TagOriginatorFI, fieldError("Name", err, ofi.FinancialInstitution.Name))
 	}
 	if err := ofi.isAlphanumeric(ofi.FinancialInstitution.Address.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, ofi.FinancialInstitution.Address.AddressLineOne)
+		addFieldError(&errs, TagOriginatorFI, fieldError("AddressLineOne", err, ofi.FinancialInstitution.Address.AddressLineOne))
 	}
 	if err := ofi.isAlphanumeric(ofi.FinancialInstitution.Address.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, ofi.FinancialInstitution.Address.AddressLineTwo)
+		addFieldError(&errs, TagOriginatorFI, fieldError("AddressLineTwo", err, ofi.FinancialInstitution.Address.AddressLineTwo))
 	}
 	if err := ofi.isAlphanumeric(ofi.FinancialInstitution.Address.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, ofi.FinancialInstitution.Address.AddressLineThree)
+		addFieldError(&errs, TagOriginatorFI, fieldError("AddressLineThree", err, ofi.FinancialInstitution.Address.AddressLineThree))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (ofi *OriginatorFI) fieldInclusion() error {
+func (ofi *OriginatorFI) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if ofi.FinancialInstitution.IdentificationCode != "" && ofi.FinancialInstitution.Identifier == "" {
-		return fieldError("Identifier", ErrFieldRequired)
+		addFieldError(&errs, TagOriginatorFI, fieldError("Identifier", ErrFieldRequired))
 	}
 	if ofi.FinancialInstitution.IdentificationCode == "" && ofi.FinancialInstitution.Identifier != "" {
-		return fieldError("IdentificationCode", ErrFieldRequired)
+		addFieldError(&errs, TagOriginatorFI, fieldError("IdentificationCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // IdentificationCodeField gets a string of the IdentificationCode field
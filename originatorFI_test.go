@@ -125,7 +125,7 @@ func TestParseOriginatorFIWrongLength(t *testing.T) {
 
 	err := r.parseOriginatorFI()
 
-	require.EqualError(t, err, r.parseError(fieldError("Identifier", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(7, "Identifier", ErrRequireDelimiter)).Error())
 }
 
 // TestParseOriginatorFIReaderParseError parses a wrong OriginatorFI reader parse error
@@ -140,7 +140,7 @@ func TestParseOriginatorFIReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("Name", ErrNonAlphanumeric, "®I Name")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("Name", ErrNonAlphanumeric, "®I Name"))).Error())
 }
 
 // TestOriginatorFITagError validates a OriginatorFI tag
@@ -153,28 +153,28 @@ func TestOriginatorFITagError(t *testing.T) {
 
 // TestStringOriginatorFIVariableLength parses using variable length
 func TestStringOriginatorFIVariableLength(t *testing.T) {
-	var line = "{5100}B1*"
+	var line = "{5100}D1*"
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 
 	err := r.parseOriginatorFI()
 	require.Nil(t, err)
 
-	line = "{5100}B1                                                                                                                                                                             NNN"
+	line = "{5100}D1                                                                                                                                                                             NNN"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
 	err = r.parseOriginatorFI()
 	require.ErrorContains(t, err, ErrRequireDelimiter.Error())
 
-	line = "{5100}B1*******"
+	line = "{5100}D1*******"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
 	err = r.parseOriginatorFI()
 	require.ErrorContains(t, err, r.parseError(NewTagMaxLengthErr(errors.New(""))).Error())
 
-	line = "{5100}B1*"
+	line = "{5100}D1*"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -184,7 +184,7 @@ func TestStringOriginatorFIVariableLength(t *testing.T) {
 
 // TestStringOriginatorFIOptions validates Format() formatted according to the FormatOptions
 func TestStringOriginatorFIOptions(t *testing.T) {
-	var line = "{5100}B1*"
+	var line = "{5100}D1*"
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -192,7 +192,7 @@ func TestStringOriginatorFIOptions(t *testing.T) {
 	require.Equal(t, err, nil)
 
 	record := r.currentFEDWireMessage.OriginatorFI
-	require.Equal(t, record.String(), "{5100}B1                                 *                                   *                                   *                                   *                                   *")
-	require.Equal(t, record.Format(FormatOptions{VariableLengthFields: true}), "{5100}B1*")
+	require.Equal(t, record.String(), "{5100}D1                                 *                                   *                                   *                                   *                                   *")
+	require.Equal(t, record.Format(FormatOptions{VariableLengthFields: true}), "{5100}D1*")
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // OriginatorOptionF is originator option F information
@@ -109,35 +111,35 @@ func (oof *OriginatorOptionF) Parse(record string) error {
 
 	value, read, err := oof.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("PartyIdentifier", err)
+		return fieldErrorAt(length, "PartyIdentifier", err)
 	}
 	oof.PartyIdentifier = value
 	length += read
 
 	value, read, err = oof.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("Name", err)
+		return fieldErrorAt(length, "Name", err)
 	}
 	oof.Name = value
 	length += read
 
 	value, read, err = oof.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineOne", err)
+		return fieldErrorAt(length, "LineOne", err)
 	}
 	oof.LineOne = value
 	length += read
 
 	value, read, err = oof.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineTwo", err)
+		return fieldErrorAt(length, "LineTwo", err)
 	}
 	oof.LineTwo = value
 	length += read
 
 	value, read, err = oof.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineThree", err)
+		return fieldErrorAt(length, "LineThree", err)
 	}
 	oof.LineThree = value
 	length += read
@@ -190,32 +192,40 @@ func (oof *OriginatorOptionF) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on OriginatorOptionF and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (oof *OriginatorOptionF) Validate() error {
-	if err := oof.fieldInclusion(); err != nil {
-		return err
-	}
+	return oof.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on OriginatorOptionF and returns every error found.
+// Only the first error for each field is returned.
+func (oof *OriginatorOptionF) ValidateAll() base.ErrorList {
+	errs := oof.fieldInclusion()
 	if err := oof.validatePartyIdentifier(oof.PartyIdentifier); err != nil {
-		return fieldError("PartyIdentifier", err, oof.PartyIdentifier)
+		addFieldError(&errs, TagOriginatorOptionF, fieldError("PartyIdentifier", err, oof.PartyIdentifier))
+	}
+	if strings.HasPrefix(oof.PartyIdentifier, "/") {
+		if err := oof.validateAccountIdentifier(oof.PartyIdentifier[1:]); err != nil {
+			addFieldError(&errs, TagOriginatorOptionF, fieldError("PartyIdentifier", err, oof.PartyIdentifier))
+		}
 	}
 	if err := oof.validateOptionFName(oof.Name); err != nil {
-		return fieldError("Name", err, oof.Name)
+		addFieldError(&errs, TagOriginatorOptionF, fieldError("Name", err, oof.Name))
 	}
 	if err := oof.validateOptionFLine(oof.LineOne); err != nil {
-		return fieldError("LineOne", err, oof.LineOne)
+		addFieldError(&errs, TagOriginatorOptionF, fieldError("LineOne", err, oof.LineOne))
 	}
 	if err := oof.validateOptionFLine(oof.LineTwo); err != nil {
-		return fieldError("LineTwo", err, oof.LineTwo)
+		addFieldError(&errs, TagOriginatorOptionF, fieldError("LineTwo", err, oof.LineTwo))
 	}
 	if err := oof.validateOptionFLine(oof.LineThree); err != nil {
-		return fieldError("LineThree", err, oof.LineThree)
+		addFieldError(&errs, TagOriginatorOptionF, fieldError("LineThree", err, oof.LineThree))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (oof *OriginatorOptionF) fieldInclusion() error {
+func (oof *OriginatorOptionF) fieldInclusion() base.ErrorList {
 	return nil
 }
 
@@ -38,6 +38,20 @@ func TestOriginatorOptionFPartyIdentifier(t *testing.T) {
 	require.EqualError(t, err, fieldError("PartyIdentifier", ErrPartyIdentifier, oof.PartyIdentifier).Error())
 }
 
+// TestOriginatorOptionFPartyIdentifierIBAN validates the check digits of an OriginatorOptionF account which is an IBAN
+func TestOriginatorOptionFPartyIdentifierIBAN(t *testing.T) {
+	oof := mockOriginatorOptionF()
+	oof.PartyIdentifier = "/GB82WEST12345698765432"
+
+	require.NoError(t, oof.Validate())
+
+	oof.PartyIdentifier = "/GB83WEST12345698765432"
+
+	err := oof.Validate()
+
+	require.EqualError(t, err, fieldError("PartyIdentifier", ErrIBANCheckDigit, oof.PartyIdentifier).Error())
+}
+
 // TestOriginatorOptionFPartyIdentifierNull validates OriginatorOptionF PartyIdentifier is not null
 func TestOriginatorOptionFPartyIdentifierNull(t *testing.T) {
 	oof := mockOriginatorOptionF()
@@ -157,7 +171,7 @@ func TestParseOriginatorOptionFWrongLength(t *testing.T) {
 
 	err := r.parseOriginatorOptionF()
 
-	require.EqualError(t, err, r.parseError(fieldError("PartyIdentifier", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "PartyIdentifier", ErrRequireDelimiter)).Error())
 }
 
 // TestParseOriginatorOptionFReaderParseError parses a wrong OriginatorOptionF reader parse error
@@ -172,7 +186,7 @@ func TestParseOriginatorOptionFReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("Name", ErrOptionFName, "®ame")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("Name", ErrOptionFName, "®ame"))).Error())
 }
 
 // TestStringOriginatorOptionFVariableLength parses using variable length
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // OriginatorToBeneficiary is the OriginatorToBeneficiary of the wire
@@ -51,28 +53,28 @@ func (ob *OriginatorToBeneficiary) Parse(record string) error {
 
 	value, read, err := ob.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineOne", err)
+		return fieldErrorAt(length, "LineOne", err)
 	}
 	ob.LineOne = value
 	length += read
 
 	value, read, err = ob.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineTwo", err)
+		return fieldErrorAt(length, "LineTwo", err)
 	}
 	ob.LineTwo = value
 	length += read
 
 	value, read, err = ob.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineThree", err)
+		return fieldErrorAt(length, "LineThree", err)
 	}
 	ob.LineThree = value
 	length += read
 
 	value, read, err = ob.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineFour", err)
+		return fieldErrorAt(length, "LineFour", err)
 	}
 	ob.LineFour = value
 	length += read
@@ -124,25 +126,31 @@ func (ob *OriginatorToBeneficiary) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on OriginatorToBeneficiary and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 // See latest version of the FAIM manual for Line Limits for Tags {6000} to {6500}.
 func (ob *OriginatorToBeneficiary) Validate() error {
+	return ob.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on OriginatorToBeneficiary and returns every error found.
+// Only the first error for each field is returned.
+func (ob *OriginatorToBeneficiary) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if ob.tag != TagOriginatorToBeneficiary {
-		return fieldError("tag", ErrValidTagForType, ob.tag)
+		addFieldError(&errs, TagOriginatorToBeneficiary, fieldError("tag", ErrValidTagForType, ob.tag))
 	}
 	if err := ob.isAlphanumeric(ob.LineOne); err != nil {
-		return fieldError("LineOne", err, ob.LineOne)
+		addFieldError(&errs, TagOriginatorToBeneficiary, fieldError("LineOne", err, ob.LineOne))
 	}
 	if err := ob.isAlphanumeric(ob.LineTwo); err != nil {
-		return fieldError("LineTwo", err, ob.LineTwo)
+		addFieldError(&errs, TagOriginatorToBeneficiary, fieldError("LineTwo", err, ob.LineTwo))
 	}
 	if err := ob.isAlphanumeric(ob.LineThree); err != nil {
-		return fieldError("LineThree", err, ob.LineThree)
+		addFieldError(&errs, TagOriginatorToBeneficiary, fieldError("LineThree", err, ob.LineThree))
 	}
 	if err := ob.isAlphanumeric(ob.LineFour); err != nil {
-		return fieldError("LineFour", err, ob.LineFour)
+		addFieldError(&errs, TagOriginatorToBeneficiary, fieldError("LineFour", err, ob.LineFour))
 	}
-	return nil
+	return errs
 }
 
 // FormatLineOne returns LineOne formatted according to the FormatOptions
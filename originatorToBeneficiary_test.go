@@ -73,7 +73,7 @@ func TestParseOriginatorToBeneficiaryWrongLength(t *testing.T) {
 
 	err := r.parseOriginatorToBeneficiary()
 
-	require.EqualError(t, err, r.parseError(fieldError("LineOne", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "LineOne", ErrRequireDelimiter)).Error())
 }
 
 // TestParseOriginatorToBeneficiaryReaderParseError parses a wrong OriginatorToBeneficiary reader parse error
@@ -88,7 +88,7 @@ func TestParseOriginatorToBeneficiaryReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("LineTwo", ErrNonAlphanumeric, "®ineTwo")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("LineTwo", ErrNonAlphanumeric, "®ineTwo"))).Error())
 }
 
 // TestOriginatorToBeneficiaryTagError validates a OriginatorToBeneficiary tag
@@ -122,7 +122,7 @@ func TestParseOriginatorWrongLength(t *testing.T) {
 
 	err := r.parseOriginator()
 
-	require.EqualError(t, err, r.parseError(fieldError("Identifier", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(7, "Identifier", ErrRequireDelimiter)).Error())
 }
 
 // TestParseOriginatorReaderParseError parses a wrong Originator reader parse error
@@ -137,7 +137,7 @@ func TestParseOriginatorReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("Name", ErrNonAlphanumeric, "®ame")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("Name", ErrNonAlphanumeric, "®ame"))).Error())
 }
 
 // TestOriginatorTagError validates a Originator tag
@@ -150,28 +150,28 @@ func TestOriginatorTagError(t *testing.T) {
 
 // TestStringOriginatorVariableLength parses using variable length
 func TestStringOriginatorVariableLength(t *testing.T) {
-	var line = "{5000}B1*"
+	var line = "{5000}D1*"
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 
 	err := r.parseOriginator()
 	require.Nil(t, err)
 
-	line = "{5000}B1                                                                                                                                                                             NNN"
+	line = "{5000}D1                                                                                                                                                                             NNN"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
 	err = r.parseOriginator()
 	require.ErrorContains(t, err, ErrRequireDelimiter.Error())
 
-	line = "{5000}B1*******"
+	line = "{5000}D1*******"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
 	err = r.parseOriginator()
 	require.ErrorContains(t, err, r.parseError(NewTagMaxLengthErr(errors.New(""))).Error())
 
-	line = "{5000}B1*"
+	line = "{5000}D1*"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -181,7 +181,7 @@ func TestStringOriginatorVariableLength(t *testing.T) {
 
 // TestStringOriginatorOptions validates Format() formatted according to the FormatOptions
 func TestStringOriginatorOptions(t *testing.T) {
-	var line = "{5000}B1*"
+	var line = "{5000}D1*"
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -189,7 +189,7 @@ func TestStringOriginatorOptions(t *testing.T) {
 	require.Equal(t, err, nil)
 
 	record := r.currentFEDWireMessage.Originator
-	require.Equal(t, record.String(), "{5000}B1                                 *                                   *                                   *                                   *                                   *")
-	require.Equal(t, record.Format(FormatOptions{VariableLengthFields: true}), "{5000}B1*")
+	require.Equal(t, record.String(), "{5000}D1                                 *                                   *                                   *                                   *                                   *")
+	require.Equal(t, record.Format(FormatOptions{VariableLengthFields: true}), "{5000}D1*")
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
@@ -7,7 +7,10 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"time"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // OutputMessageAccountabilityData is the Output Message Accountability Data (OMAD) of the wire
@@ -55,20 +58,20 @@ func (omad *OutputMessageAccountabilityData) Parse(record string) error {
 
 	value, read, err := omad.parseFixedStringField(record[length:], 8)
 	if err != nil {
-		return fieldError("OutputCycleDate", err)
+		return fieldErrorAt(length, "OutputCycleDate", err)
 	}
 	omad.OutputCycleDate = value
 	length += read
 
 	value, read, err = omad.parseFixedStringField(record[length:], 8)
 	if err != nil {
-		return fieldError("OutputDestinationID", err)
+		return fieldErrorAt(length, "OutputDestinationID", err)
 	}
 	omad.OutputDestinationID = value
 	length += read
 
 	if len(record) < length+6 {
-		return fieldError("OutputSequenceNumber", ErrValidLength)
+		return fieldErrorAt(length, "OutputSequenceNumber", ErrValidLength)
 	}
 
 	omad.OutputSequenceNumber = record[length : length+6]
@@ -76,21 +79,21 @@ func (omad *OutputMessageAccountabilityData) Parse(record string) error {
 
 	value, read, err = omad.parseFixedStringField(record[length:], 4)
 	if err != nil {
-		return fieldError("OutputDate", err)
+		return fieldErrorAt(length, "OutputDate", err)
 	}
 	omad.OutputDate = value
 	length += read
 
 	value, read, err = omad.parseFixedStringField(record[length:], 4)
 	if err != nil {
-		return fieldError("OutputTime", err)
+		return fieldErrorAt(length, "OutputTime", err)
 	}
 	omad.OutputTime = value
 	length += read
 
 	value, read, err = omad.parseFixedStringField(record[length:], 4)
 	if err != nil {
-		return fieldError("OutputFRBApplicationIdentification", err)
+		return fieldErrorAt(length, "OutputFRBApplicationIdentification", err)
 	}
 	omad.OutputFRBApplicationIdentification = value
 	length += read
@@ -147,13 +150,19 @@ func (omad *OutputMessageAccountabilityData) Format(options FormatOptions) strin
 }
 
 // Validate performs WIRE format rule checks on OutputMessageAccountabilityData and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (omad *OutputMessageAccountabilityData) Validate() error {
+	return omad.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on OutputMessageAccountabilityData and returns every error found.
+// Only the first error for each field is returned.
+func (omad *OutputMessageAccountabilityData) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	// Currently no validation as the FED is responsible for the values
 	if omad.tag != TagOutputMessageAccountabilityData {
-		return fieldError("tag", ErrValidTagForType, omad.tag)
+		addFieldError(&errs, TagOutputMessageAccountabilityData, fieldError("tag", ErrValidTagForType, omad.tag))
 	}
-	return nil
+	return errs
 }
 
 // OutputCycleDateField
@@ -210,3 +219,28 @@ func (omad *OutputMessageAccountabilityData) OutputFRBApplicationIdentificationF
 func (omad *OutputMessageAccountabilityData) FormatOutputFRBApplicationIdentification(options FormatOptions) string {
 	return omad.formatAlphaField(omad.OutputFRBApplicationIdentification, 4, options)
 }
+
+// CycleDate returns the OutputCycleDate at midnight Eastern time
+func (omad *OutputMessageAccountabilityData) CycleDate() (time.Time, error) {
+	return parseDate("OutputCycleDate", omad.OutputCycleDate)
+}
+
+// SetCycleDate sets the OutputCycleDate to the date of t in its location
+func (omad *OutputMessageAccountabilityData) SetCycleDate(t time.Time) {
+	omad.OutputCycleDate = formatDate(t)
+}
+
+// OutputDateTime returns the Eastern time of the OutputDate and OutputTime. The OutputDate has no year, so the
+// year is the one which puts it nearest to the OutputCycleDate.
+func (omad *OutputMessageAccountabilityData) OutputDateTime() (time.Time, error) {
+	cycleDate, err := omad.CycleDate()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return parseMonthDayTime("OutputDate", omad.OutputDate, omad.OutputTime, cycleDate)
+}
+
+// SetOutputDateTime sets the OutputDate and OutputTime to t in Eastern time
+func (omad *OutputMessageAccountabilityData) SetOutputDateTime(t time.Time) {
+	omad.OutputDate, omad.OutputTime = formatMonthDayTime(t)
+}
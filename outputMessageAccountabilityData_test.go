@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -109,3 +110,28 @@ func TestStringOutputMessageAccountabilityDataOptions(t *testing.T) {
 	require.Equal(t, record.Format(FormatOptions{VariableLengthFields: true}), "{1120}                000001            ")
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
+
+func TestOutputMessageAccountabilityData_DateTime(t *testing.T) {
+	omad := mockOutputMessageAccountabilityData()
+	date, err := omad.CycleDate()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.May, 2, 0, 0, 0, 0, EasternTime), date)
+
+	output, err := omad.OutputDateTime()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.May, 2, 12, 30, 0, 0, EasternTime), output)
+
+	omad.SetCycleDate(time.Date(2020, time.January, 2, 0, 0, 0, 0, EasternTime))
+	omad.SetOutputDateTime(time.Date(2020, time.January, 1, 2, 15, 0, 0, time.UTC))
+	require.Equal(t, "20200102", omad.OutputCycleDate)
+	require.Equal(t, "1231", omad.OutputDate)
+	require.Equal(t, "2115", omad.OutputTime)
+
+	output, err = omad.OutputDateTime()
+	require.NoError(t, err)
+	require.True(t, output.Equal(time.Date(2020, time.January, 1, 2, 15, 0, 0, time.UTC)))
+
+	omad.OutputCycleDate = ""
+	_, err = omad.OutputDateTime()
+	require.ErrorIs(t, err, ErrValidDate)
+}
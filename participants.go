@@ -0,0 +1,52 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+
+	"github.com/moov-io/wire/directory"
+)
+
+var (
+	// ErrNotParticipant is returned for a routing number which is not a participant of the fedwire directory
+	ErrNotParticipant = errors.New("is not a Fedwire Funds Service participant")
+	// ErrNotFundsTransferEligible is returned for a participant of the fedwire directory which cannot receive funds
+	// transfers
+	ErrNotFundsTransferEligible = errors.New("is not eligible to receive funds transfers")
+)
+
+// ValidateReceiver checks the ReceiverABANumber of the FEDWireMessage is a participant of the fedwire directory
+// which is eligible to receive funds transfers
+func (fwm *FEDWireMessage) ValidateReceiver(dir *directory.Directory) error {
+	if fwm.ReceiverDepositoryInstitution == nil {
+		return fieldError("ReceiverDepositoryInstitution", ErrFieldRequired)
+	}
+	aba := fwm.ReceiverDepositoryInstitution.ReceiverABANumber
+	p, ok := dir.Lookup(aba)
+	if !ok {
+		return fieldError("ReceiverABANumber", ErrNotParticipant, aba)
+	}
+	if !p.FundsTransferEligible {
+		return fieldError("ReceiverABANumber", ErrNotFundsTransferEligible, aba)
+	}
+	return nil
+}
+
+// FillShortNames sets a blank ReceiverShortName and SenderShortName to the telegraphic name of the participant of
+// the fedwire directory with their ABA number. Short names which are set, or whose ABA number is not in the
+// directory, are kept.
+func (fwm *FEDWireMessage) FillShortNames(dir *directory.Directory) {
+	if rdi := fwm.ReceiverDepositoryInstitution; rdi != nil && rdi.ReceiverShortName == "" {
+		if p, ok := dir.Lookup(rdi.ReceiverABANumber); ok {
+			rdi.ReceiverShortName = p.TelegraphicName
+		}
+	}
+	if sdi := fwm.SenderDepositoryInstitution; sdi != nil && sdi.SenderShortName == "" {
+		if p, ok := dir.Lookup(sdi.SenderABANumber); ok {
+			sdi.SenderShortName = p.TelegraphicName
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package wire
+
+import (
+	"testing"
+
+	"github.com/moov-io/wire/directory"
+	"github.com/stretchr/testify/require"
+)
+
+func mockDirectory() *directory.Directory {
+	return directory.NewDirectory([]*directory.Participant{
+		{RoutingNumber: "121042882", TelegraphicName: "WELLS SF", FundsTransferEligible: true},
+		{RoutingNumber: "231380104", TelegraphicName: "CITADEL FCU", FundsTransferEligible: true},
+		{RoutingNumber: "011000015", TelegraphicName: "FRB BOS"},
+	})
+}
+
+func TestFEDWireMessage_ValidateReceiver(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	require.NoError(t, fwm.ValidateReceiver(mockDirectory()))
+
+	fwm.ReceiverDepositoryInstitution.ReceiverABANumber = "011000015"
+	require.ErrorIs(t, fwm.ValidateReceiver(mockDirectory()), ErrNotFundsTransferEligible)
+
+	fwm.ReceiverDepositoryInstitution.ReceiverABANumber = "021000021"
+	require.ErrorIs(t, fwm.ValidateReceiver(mockDirectory()), ErrNotParticipant)
+
+	fwm.ReceiverDepositoryInstitution = nil
+	require.ErrorIs(t, fwm.ValidateReceiver(mockDirectory()), ErrFieldRequired)
+}
+
+func TestFEDWireMessage_ValidateAllDirectory(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	fwm.ReceiverDepositoryInstitution.ReceiverABANumber = "011000015"
+	require.Empty(t, fwm.ValidateAll())
+
+	fwm.ValidateOptions = &ValidateOpts{Directory: mockDirectory()}
+	errs := fwm.ValidateAll()
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs.Err(), ErrNotFundsTransferEligible)
+
+	var fe *FieldError
+	require.ErrorAs(t, errs.Err(), &fe)
+	require.Equal(t, TagReceiverDepositoryInstitution, fe.Tag)
+	require.Equal(t, "ReceiverABANumber", fe.FieldName)
+}
+
+func TestFEDWireMessage_FillShortNames(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.ReceiverDepositoryInstitution.ReceiverShortName = ""
+	fwm.SenderDepositoryInstitution.SenderShortName = ""
+	fwm.FillShortNames(mockDirectory())
+	require.Equal(t, "CITADEL FCU", fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+	require.Equal(t, "WELLS SF", fwm.SenderDepositoryInstitution.SenderShortName)
+
+	// short names which are set are kept
+	fwm = mockCustomerTransferData()
+	fwm.FillShortNames(mockDirectory())
+	require.Equal(t, "Citadel", fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+	require.Equal(t, "Wells Fargo NA", fwm.SenderDepositoryInstitution.SenderShortName)
+
+	// ABA numbers which are not in the directory are kept blank
+	fwm.ReceiverDepositoryInstitution.ReceiverABANumber = "021000021"
+	fwm.ReceiverDepositoryInstitution.ReceiverShortName = ""
+	fwm.FillShortNames(mockDirectory())
+	require.Empty(t, fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+
+	fwm = FEDWireMessage{}
+	fwm.FillShortNames(mockDirectory())
+}
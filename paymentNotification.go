@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // PaymentNotification is the PaymentNotification of the wire
@@ -59,49 +61,49 @@ func (pn *PaymentNotification) Parse(record string) error {
 
 	value, read, err := pn.parseFixedStringField(record[length:], 1)
 	if err != nil {
-		return fieldError("PaymentNotificationIndicator", err)
+		return fieldErrorAt(length, "PaymentNotificationIndicator", err)
 	}
 	pn.PaymentNotificationIndicator = value
 	length += read
 
 	value, read, err = pn.parseVariableStringField(record[length:], 2048)
 	if err != nil {
-		return fieldError("ContactNotificationElectronicAddress", err)
+		return fieldErrorAt(length, "ContactNotificationElectronicAddress", err)
 	}
 	pn.ContactNotificationElectronicAddress = value
 	length += read
 
 	value, read, err = pn.parseVariableStringField(record[length:], 140)
 	if err != nil {
-		return fieldError("ContactName", err)
+		return fieldErrorAt(length, "ContactName", err)
 	}
 	pn.ContactName = value
 	length += read
 
 	value, read, err = pn.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("ContactPhoneNumber", err)
+		return fieldErrorAt(length, "ContactPhoneNumber", err)
 	}
 	pn.ContactPhoneNumber = value
 	length += read
 
 	value, read, err = pn.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("ContactMobileNumber", err)
+		return fieldErrorAt(length, "ContactMobileNumber", err)
 	}
 	pn.ContactMobileNumber = value
 	length += read
 
 	value, read, err = pn.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("ContactFaxNumber", err)
+		return fieldErrorAt(length, "ContactFaxNumber", err)
 	}
 	pn.ContactFaxNumber = value
 	length += read
 
 	value, read, err = pn.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("EndToEndIdentification", err)
+		return fieldErrorAt(length, "EndToEndIdentification", err)
 	}
 	pn.EndToEndIdentification = value
 	length += read
@@ -156,33 +158,39 @@ func (pn *PaymentNotification) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on PaymentNotification and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (pn *PaymentNotification) Validate() error {
+	return pn.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on PaymentNotification and returns every error found.
+// Only the first error for each field is returned.
+func (pn *PaymentNotification) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if pn.tag != TagPaymentNotification {
-		return fieldError("tag", ErrValidTagForType, pn.tag)
+		addFieldError(&errs, TagPaymentNotification, fieldError("tag", ErrValidTagForType, pn.tag))
 	}
 	if err := pn.isNumeric(pn.PaymentNotificationIndicator); err != nil {
-		return fieldError("PaymentNotificationIndicator", err, pn.PaymentNotificationIndicator)
+		addFieldError(&errs, TagPaymentNotification, fieldError("PaymentNotificationIndicator", err, pn.PaymentNotificationIndicator))
 	}
 	if err := pn.isAlphanumeric(pn.ContactNotificationElectronicAddress); err != nil {
-		return fieldError("ContactNotificationElectronicAddress", err, pn.ContactNotificationElectronicAddress)
+		addFieldError(&errs, TagPaymentNotification, fieldError("ContactNotificationElectronicAddress", err, pn.ContactNotificationElectronicAddress))
 	}
 	if err := pn.isAlphanumeric(pn.ContactName); err != nil {
-		return fieldError("ContactName", err, pn.ContactName)
+		addFieldError(&errs, TagPaymentNotification, fieldError("ContactName", err, pn.ContactName))
 	}
 	if err := pn.isAlphanumeric(pn.ContactPhoneNumber); err != nil {
-		return fieldError("ContactPhoneNumber", err, pn.ContactPhoneNumber)
+		addFieldError(&errs, TagPaymentNotification, fieldError("ContactPhoneNumber", err, pn.ContactPhoneNumber))
 	}
 	if err := pn.isAlphanumeric(pn.ContactMobileNumber); err != nil {
-		return fieldError("ContactMobileNumber", err, pn.ContactMobileNumber)
+		addFieldError(&errs, TagPaymentNotification, fieldError("ContactMobileNumber", err, pn.ContactMobileNumber))
 	}
 	if err := pn.isAlphanumeric(pn.ContactFaxNumber); err != nil {
-		return fieldError("FaxNumber", err, pn.ContactFaxNumber)
+		addFieldError(&errs, TagPaymentNotification, fieldError("FaxNumber", err, pn.ContactFaxNumber))
 	}
 	if err := pn.isAlphanumeric(pn.EndToEndIdentification); err != nil {
-		return fieldError("EndToEndIdentification", err, pn.EndToEndIdentification)
+		addFieldError(&errs, TagPaymentNotification, fieldError("EndToEndIdentification", err, pn.EndToEndIdentification))
 	}
-	return nil
+	return errs
 }
 
 // PaymentNotificationIndicatorField gets a string of PaymentNotificationIndicator field
@@ -105,7 +105,7 @@ func TestParsePaymentNotificationWrongLength(t *testing.T) {
 
 	err := r.parsePaymentNotification()
 
-	require.EqualError(t, err, r.parseError(fieldError("ContactNotificationElectronicAddress", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(7, "ContactNotificationElectronicAddress", ErrRequireDelimiter)).Error())
 }
 
 // TestParsePaymentNotificationReaderParseError parses a wrong PaymentNotification reader parse error
@@ -120,7 +120,7 @@ func TestParsePaymentNotificationReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("PaymentNotificationIndicator", ErrNonNumeric, "Z")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("PaymentNotificationIndicator", ErrNonNumeric, "Z"))).Error())
 }
 
 // TestPaymentNotificationTagError validates a PaymentNotification tag
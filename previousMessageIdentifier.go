@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // PreviousMessageIdentifier is the PreviousMessageIdentifier of the wire
@@ -45,7 +47,7 @@ func (pmi *PreviousMessageIdentifier) Parse(record string) error {
 
 	value, read, err := pmi.parseFixedStringField(record[length:], 22)
 	if err != nil {
-		return fieldError("PreviousMessageIdentifier", err)
+		return fieldErrorAt(length, "PreviousMessageIdentifier", err)
 	}
 	pmi.PreviousMessageIdentifier = value
 	length += read
@@ -90,15 +92,21 @@ func (pmi *PreviousMessageIdentifier) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on PreviousMessageIdentifier and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (pmi *PreviousMessageIdentifier) Validate() error {
+	return pmi.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on PreviousMessageIdentifier and returns every error found.
+// Only the first error for each field is returned.
+func (pmi *PreviousMessageIdentifier) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if pmi.tag != TagPreviousMessageIdentifier {
-		return fieldError("tag", ErrValidTagForType, pmi.tag)
+		addFieldError(&errs, TagPreviousMessageIdentifier, fieldError("tag", ErrValidTagForType, pmi.tag))
 	}
 	if err := pmi.isAlphanumeric(pmi.PreviousMessageIdentifier); err != nil {
-		return fieldError("PreviousMessageIdentifier", err, pmi.PreviousMessageIdentifier)
+		addFieldError(&errs, TagPreviousMessageIdentifier, fieldError("PreviousMessageIdentifier", err, pmi.PreviousMessageIdentifier))
 	}
-	return nil
+	return errs
 }
 
 // PreviousMessageIdentifierField gets a string of PreviousMessageIdentifier field
@@ -40,7 +40,7 @@ func TestParsePreviousMessageIdentifierWrongLength(t *testing.T) {
 
 	err := r.parsePreviousMessageIdentifier()
 
-	require.EqualError(t, err, r.parseError(fieldError("PreviousMessageIdentifier", ErrValidLength)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "PreviousMessageIdentifier", ErrValidLength)).Error())
 }
 
 // TestParsePreviousMessageIdentifierReaderParseError parses a wrong PreviousMessageIdentifier reader parse error
@@ -55,7 +55,7 @@ func TestParsePreviousMessageIdentifierReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("PreviousMessageIdentifier", ErrNonAlphanumeric, "Previous®Message Iden")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("PreviousMessageIdentifier", ErrNonAlphanumeric, "Previous®Message Iden"))).Error())
 }
 
 // TestPreviousMessageIdentifierTagError validates a PreviousMessageIdentifier tag
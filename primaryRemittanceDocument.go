@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // PrimaryRemittanceDocument is primary remittance document
@@ -52,21 +54,21 @@ func (prd *PrimaryRemittanceDocument) Parse(record string) error {
 
 	value, read, err := prd.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("ProprietaryDocumentTypeCode", err)
+		return fieldErrorAt(length, "ProprietaryDocumentTypeCode", err)
 	}
 	prd.ProprietaryDocumentTypeCode = value
 	length += read
 
 	value, read, err = prd.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("DocumentIdentificationNumber", err)
+		return fieldErrorAt(length, "DocumentIdentificationNumber", err)
 	}
 	prd.DocumentIdentificationNumber = value
 	length += read
 
 	value, read, err = prd.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("Issuer", err)
+		return fieldErrorAt(length, "Issuer", err)
 	}
 	prd.Issuer = value
 	length += read
@@ -118,48 +120,52 @@ func (prd *PrimaryRemittanceDocument) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on PrimaryRemittanceDocument and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 // Document Type Code and Document Identification Number are mandatory for each set of remittance data.
 // Proprietary Document Type Code is mandatory for Document Type Code PROP; otherwise not permitted.
 func (prd *PrimaryRemittanceDocument) Validate() error {
-	if err := prd.fieldInclusion(); err != nil {
-		return err
-	}
+	return prd.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on PrimaryRemittanceDocument and returns every error found.
+// Only the first error for each field is returned.
+func (prd *PrimaryRemittanceDocument) ValidateAll() base.ErrorList {
+	errs := prd.fieldInclusion()
 	if prd.tag != TagPrimaryRemittanceDocument {
-		return fieldError("tag", ErrValidTagForType, prd.tag)
+		addFieldError(&errs, TagPrimaryRemittanceDocument, fieldError("tag", ErrValidTagForType, prd.tag))
 	}
 	if err := prd.isDocumentTypeCode(prd.DocumentTypeCode); err != nil {
-		return fieldError("DocumentTypeCode", err, prd.DocumentTypeCode)
+		addFieldError(&errs, TagPrimaryRemittanceDocument, fieldError("DocumentTypeCode", err, prd.DocumentTypeCode))
 	}
 	if err := prd.isAlphanumeric(prd.ProprietaryDocumentTypeCode); err != nil {
-		return fieldError("ProprietaryDocumentTypeCode", err, prd.ProprietaryDocumentTypeCode)
+		addFieldError(&errs, TagPrimaryRemittanceDocument, fieldError("ProprietaryDocumentTypeCode", err, prd.ProprietaryDocumentTypeCode))
 	}
 	if err := prd.isAlphanumeric(prd.DocumentIdentificationNumber); err != nil {
-		return fieldError("DocumentIdentificationNumber", err, prd.DocumentIdentificationNumber)
+		addFieldError(&errs, TagPrimaryRemittanceDocument, fieldError("DocumentIdentificationNumber", err, prd.DocumentIdentificationNumber))
 	}
 	if err := prd.isAlphanumeric(prd.Issuer); err != nil {
-		return fieldError("Issuer", err, prd.Issuer)
+		addFieldError(&errs, TagPrimaryRemittanceDocument, fieldError("Issuer", err, prd.Issuer))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (prd *PrimaryRemittanceDocument) fieldInclusion() error {
+func (prd *PrimaryRemittanceDocument) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if prd.DocumentIdentificationNumber == "" {
-		return fieldError("DocumentIdentificationNumber", ErrFieldRequired)
+		addFieldError(&errs, TagPrimaryRemittanceDocument, fieldError("DocumentIdentificationNumber", ErrFieldRequired))
 	}
 	switch prd.DocumentTypeCode {
 	case ProprietaryDocumentType:
 		if prd.ProprietaryDocumentTypeCode == "" {
-			return fieldError("ProprietaryDocumentTypeCode", ErrFieldRequired)
+			addFieldError(&errs, TagPrimaryRemittanceDocument, fieldError("ProprietaryDocumentTypeCode", ErrFieldRequired))
 		}
 	default:
 		if strings.TrimSpace(prd.ProprietaryDocumentTypeCode) != "" {
-			return fieldError("ProprietaryDocumentTypeCode", ErrInvalidProperty, prd.ProprietaryDocumentTypeCode)
+			addFieldError(&errs, TagPrimaryRemittanceDocument, fieldError("ProprietaryDocumentTypeCode", ErrInvalidProperty, prd.ProprietaryDocumentTypeCode))
 		}
 	}
-	return nil
+	return errs
 }
 
 // DocumentTypeCodeField gets a string of the DocumentTypeCode field
@@ -106,7 +106,7 @@ func TestParsePrimaryRemittanceDocumentWrongLength(t *testing.T) {
 
 	err := r.parsePrimaryRemittanceDocument()
 
-	require.EqualError(t, err, r.parseError(fieldError("ProprietaryDocumentTypeCode", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(10, "ProprietaryDocumentTypeCode", ErrRequireDelimiter)).Error())
 }
 
 // TestParsePrimaryRemittanceDocumentReaderParseError parses a wrong PrimaryRemittanceDocument reader parse error
@@ -121,7 +121,7 @@ func TestParsePrimaryRemittanceDocumentReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("DocumentTypeCode", ErrDocumentTypeCode, "ZZZZ")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("DocumentTypeCode", ErrDocumentTypeCode, "ZZZZ"))).Error())
 }
 
 // TestPrimaryRemittanceDocumentTagError validates a PrimaryRemittanceDocument tag
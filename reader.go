@@ -6,9 +6,12 @@ package wire
 
 import (
 	"bufio"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"regexp"
+	"strconv"
 	"strings"
 	"unicode/utf8"
 
@@ -23,17 +26,32 @@ type Reader struct {
 	File File
 	// line is the current line being parsed from the input r
 	line string
-	// ToDo:  Do we need a current FEDWireMessage, just use FEDWireMessage
 	// currentFEDWireMessage is the current FEDWireMessage being parsed
 	currentFEDWireMessage FEDWireMessage
-	// lineNum is the line number of the file being parsed
+	// lineNum is the physical line number of the input where the current line begins
 	lineNum int
+	// offset is the byte offset in the input of the current line
+	offset int
+	// scanned is the number of bytes read from the input r
+	scanned int
+	// scannedLines is the number of line breaks read from the input r
+	scannedLines int
 	// tagName holds the current tag name being parsed.
 	tagName string
 	// errors holds each error encountered when attempting to parse the file
 	errors base.ErrorList
-	// headerData holds header static data for file
-	headerData string
+	// segments holds the segments read from the input r which are yet to be parsed
+	segments []segment
+	// messageIndex is the index in the file of the FEDWireMessage being parsed
+	messageIndex int
+	// messageTags holds each tag read for the current FEDWireMessage
+	messageTags []string
+	// messagePositions holds the position in the input of each tag read for the current FEDWireMessage
+	messagePositions map[string]tagPosition
+	// messageOrder is the position in FAIM order of the last tag read for the current FEDWireMessage
+	messageOrder int
+	// messageErrors holds each error encountered when attempting to parse the current FEDWireMessage
+	messageErrors base.ErrorList
 }
 
 var (
@@ -45,16 +63,64 @@ func (r *Reader) parseError(err error) error {
 	if err == nil {
 		return nil
 	}
-	if _, ok := err.(*base.ParseError); ok {
+	if _, ok := err.(*ParseError); ok {
 		return err
 	}
-	return &base.ParseError{
-		Line:   r.lineNum,
-		Record: r.tagName,
-		Err:    err,
+	return &ParseError{
+		Line:          r.lineNum,
+		Offset:        r.offset,
+		ElementOffset: elementOffset(r.line, err),
+		Record:        r.tagName,
+		Err:           err,
 	}
 }
 
+// tagPosition is where a tag was read in the input
+type tagPosition struct {
+	line   int
+	offset int
+	text   string
+	record string
+}
+
+// validationError returns a ParseError for err at the position its tag was read, if err is a *FieldError
+// of a tag in the current FEDWireMessage.
+func (r *Reader) validationError(err error) error {
+	var fe *FieldError
+	if !errors.As(err, &fe) {
+		return err
+	}
+	pos, ok := r.messagePositions[fe.Tag]
+	if !ok {
+		return err
+	}
+	return &ParseError{
+		Line:          pos.line,
+		Offset:        pos.offset,
+		ElementOffset: elementOffset(pos.text, err),
+		Record:        pos.record,
+		Err:           err,
+	}
+}
+
+// elementOffset returns the byte offset within line of the element err is for. Fields parsed from line
+// record their offset, otherwise the field's value is looked for in line after the tag.
+func elementOffset(line string, err error) int {
+	var fe *FieldError
+	if !errors.As(err, &fe) {
+		return 0
+	}
+	if fe.Offset > 0 {
+		return fe.Offset
+	}
+	if value, ok := fe.Value.(string); ok && value != "" && len(line) > 6 {
+		if i := strings.Index(line[6:], value); i >= 0 {
+			return 6 + i
+		}
+	}
+	return 0
+}
+
 // NewReader returns a new ACH Reader that reads from r.
 func NewReader(r io.Reader, opts ...FilePropertyFunc) *Reader {
 	reader := &Reader{
@@ -67,15 +133,12 @@ func NewReader(r io.Reader, opts ...FilePropertyFunc) *Reader {
 	return reader
 }
 
-// addCurrentFEDWireMessage creates the current FEDWireMessage for the file being read. A successful
-// current FEDWireMessage will be added to r.File once parsed.
-/*func (r *Reader) addCurrentFEDWireMessage(fwm FEDWireMessage) {
-	r.currentFEDWireMessage = FEDWireMessage{}
-}*/
-
 // Read reads each line of the FED Wire file and defines which parser to use based
 // on the first character of each line. It also enforces FED Wire formatting rules and returns
 // the appropriate error if issues are found.
+//
+// A file may hold several FEDWireMessages. A new message begins at each {1500} SenderSupplied tag,
+// at a repeated {1100} MessageDisposition tag or at an interface header line between messages.
 func (r *Reader) Read() (File, error) {
 	return r.read(nil)
 }
@@ -85,50 +148,293 @@ func (r *Reader) ReadWithOpts(opts *ValidateOpts) (File, error) {
 }
 
 func (r *Reader) read(opts *ValidateOpts) (File, error) {
-	spiltString := func(line string) []string {
-
-		// strip new lines
-		line = strings.ReplaceAll(strings.ReplaceAll(line, "\r\n", ""), "\n", "")
+	if opts != nil {
+		r.File.SetValidation(opts)
+	}
 
-		// split line by tag again
-		indexes := tagRegex.FindAllStringIndex(line, -1)
-		var result []string
-		last := len(line)
-		for i := range indexes {
-			index := indexes[len(indexes)-1-i][0]
-			result = append([]string{line[index:last]}, result...)
-			last = index
+	// read through the entire file
+	for {
+		fwm, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if fwm != nil {
+			r.File.AddFEDWireMessage(*fwm)
+		}
+		if err != nil {
+			if errs, ok := err.(base.ErrorList); ok {
+				for i := range errs {
+					r.errors.Add(errs[i])
+				}
+				continue
+			}
+			r.errors.Add(err)
+			break
 		}
-		return result
 	}
 
-	r.lineNum = 0
-	// read through the entire file
-	for r.scanner.Scan() {
-		line := r.scanner.Text()
-		for _, subLine := range spiltString(line) {
-			r.lineNum++
-			r.line = subLine
-			if err := r.parseLine(); err != nil {
-				r.errors.Add(err)
+	r.File.SourceFormat = newSourceFormat(r.File.headerSource, r.File.FEDWireMessages)
+
+	if len(r.File.FEDWireMessages) == 0 && r.errors.Empty() {
+		r.errors.Add(fmt.Errorf("file validation failed: %w", r.File.Validate()))
+	}
+	if r.errors.Empty() {
+		return r.File, nil
+	}
+	return r.File, r.errors
+}
+
+// Next reads the next FEDWireMessage from the underlying io.Reader, returning io.EOF once every message
+// has been read. Messages returned by Next are not added to r.File, so inputs of any size can be read
+// one message at a time.
+//
+// A message which could not be parsed or validated is returned along with a base.ErrorList of its errors,
+// and reading may continue with the following message. Validation options set on r.File are applied to
+// each message.
+func (r *Reader) Next() (*FEDWireMessage, error) {
+	return r.NextContext(context.Background())
+}
+
+// NextContext reads the next FEDWireMessage like Next, returning ctx.Err() once ctx is done.
+func (r *Reader) NextContext(ctx context.Context) (*FEDWireMessage, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if len(r.segments) == 0 {
+			if !r.scanner.Scan() {
+				if err := r.scanner.Err(); err != nil {
+					return nil, err
+				}
+				if len(r.messageTags) > 0 {
+					return r.nextFEDWireMessage()
+				}
+				return nil, io.EOF
+			}
+			text := r.scanner.Text()
+			r.segments = splitSegments(text)
+			for i := range r.segments {
+				r.segments[i].line += r.scannedLines + 1
+				r.segments[i].offset += r.scanned
+			}
+			r.scanned += len(text)
+			r.scannedLines += strings.Count(text, "\n")
+			continue
+		}
+
+		seg := r.segments[0]
+		if seg.header {
+			if len(r.messageTags) > 0 {
+				return r.nextFEDWireMessage()
+			}
+			r.segments = r.segments[1:]
+			r.lineNum, r.offset = seg.line, seg.offset
+			r.line = seg.text
+			fileHeader := r.File.InterfaceHeader == nil
+			if err := r.parseInterfaceHeader(); err != nil {
+				r.messageErrors.Add(NewFEDWireMessageError(r.messageIndex, err))
+			}
+			src := sourceSegment{text: seg.text, raw: seg.raw}
+			if fileHeader && r.File.InterfaceHeader != nil {
+				r.File.headerSource = &src
+			} else {
+				r.currentFEDWireMessage.source = append(r.currentFEDWireMessage.source, src)
+			}
+			continue
+		}
+		tag := seg.text[:6]
+		if r.startsFEDWireMessage(tag) {
+			return r.nextFEDWireMessage()
+		}
+		r.segments = r.segments[1:]
+
+		r.lineNum, r.offset = seg.line, seg.offset
+		r.line = seg.text
+		if err := r.parseLine(); err != nil {
+			r.messageErrors.Add(NewFEDWireMessageError(r.messageIndex, err))
+		} else if err := r.checkTagSequence(tag); err != nil {
+			r.messageErrors.Add(NewFEDWireMessageError(r.messageIndex, err))
+		}
+		if _, ok := r.messagePositions[tag]; !ok {
+			if r.messagePositions == nil {
+				r.messagePositions = make(map[string]tagPosition)
 			}
+			r.messagePositions[tag] = tagPosition{line: r.lineNum, offset: r.offset, text: r.line, record: r.tagName}
+		}
+		r.messageTags = append(r.messageTags, tag)
+		r.currentFEDWireMessage.source = append(r.currentFEDWireMessage.source, sourceSegment{tag: tag, text: seg.text, raw: seg.raw})
+	}
+}
+
+// nextFEDWireMessage returns the current FEDWireMessage and starts the next one. A message read without
+// errors is validated on its own before it is returned.
+func (r *Reader) nextFEDWireMessage() (*FEDWireMessage, error) {
+	fwm := r.currentFEDWireMessage
+	if fwm.ValidateOptions == nil {
+		fwm.ValidateOptions = r.File.validateOpts
+	}
+	errs := r.messageErrors
+	if errs.Empty() {
+		if err := fwm.verify(); err != nil {
+			errs.Add(fmt.Errorf("file validation failed: %w", NewFEDWireMessageError(r.messageIndex, r.validationError(err))))
 		}
 	}
 
-	r.File.AddFEDWireMessage(r.currentFEDWireMessage)
 	r.currentFEDWireMessage = FEDWireMessage{}
+	r.messageIndex++
+	r.messageTags = nil
+	r.messagePositions = nil
+	r.messageOrder = 0
+	r.messageErrors = nil
 
-	if r.errors.Empty() {
-		if opts != nil {
-			r.File.SetValidation(opts)
+	if errs.Empty() {
+		return &fwm, nil
+	}
+	return &fwm, errs
+}
+
+// startsFEDWireMessage returns true when tag begins a FEDWireMessage after the one being read.
+//
+// Messages begin with {1500} SenderSupplied, unless the Fed has appended tags {1100} through {1130}
+// or UnknownTags were read ahead of it. A repeated {1100} MessageDisposition, {1510} TypeSubType or {1520} IMAD also begins
+// a new message, as incoming messages may not include {1500} SenderSupplied.
+func (r *Reader) startsFEDWireMessage(tag string) bool {
+	switch tag {
+	case TagSenderSupplied:
+		for _, t := range r.messageTags {
+			if !isFedAppendedTag(t) && !r.currentFEDWireMessage.hasUnknownTag(t) {
+				return true
+			}
 		}
-		err := r.File.Validate()
-		if err == nil {
-			return r.File, nil
+	case TagMessageDisposition, TagTypeSubType, TagInputMessageAccountabilityData:
+		for _, t := range r.messageTags {
+			if t == tag {
+				return true
+			}
 		}
-		r.errors.Add(fmt.Errorf("file validation failed: %v", err))
 	}
-	return r.File, r.errors
+	return false
+}
+
+// checkTagSequence returns an error when tag repeats a tag of the current FEDWireMessage, or is read
+// after a tag which must follow it. UnknownTags are not checked.
+func (r *Reader) checkTagSequence(tag string) error {
+	if opts := r.File.validateOpts; opts != nil && opts.SkipTagSequenceCheck {
+		return nil
+	}
+	if r.currentFEDWireMessage.hasUnknownTag(tag) {
+		return nil
+	}
+	for _, t := range r.messageTags {
+		if t == tag {
+			return r.parseError(NewErrDuplicateTag(tag))
+		}
+	}
+	order, previous := r.tagOrder(tag), r.messageOrder
+	r.messageOrder = order
+	if order < previous {
+		return r.parseError(NewErrTagOutOfOrder(tag, r.lastKnownTag()))
+	}
+	return nil
+}
+
+// tagOrder returns the position of tag in the FAIM order of a FEDWireMessage, in which tags are ascending.
+// Tags the Fed appends are read either ahead of every other tag or after them.
+func (r *Reader) tagOrder(tag string) int {
+	const fedAppendedOrder = 10000
+	n := tagNumber(tag)
+	if isFedAppendedTag(tag) && r.messageOrder > tagNumber(TagErrorWire) {
+		return fedAppendedOrder + n
+	}
+	return n
+}
+
+// lastKnownTag returns the last tag read for the current FEDWireMessage which is not an UnknownTag
+func (r *Reader) lastKnownTag() string {
+	for i := len(r.messageTags) - 1; i >= 0; i-- {
+		if !r.currentFEDWireMessage.hasUnknownTag(r.messageTags[i]) {
+			return r.messageTags[i]
+		}
+	}
+	return ""
+}
+
+// isFedAppendedTag returns true for the tags the Fed appends to messages it sends
+func isFedAppendedTag(tag string) bool {
+	switch tag {
+	case TagMessageDisposition, TagReceiptTimeStamp, TagOutputMessageAccountabilityData, TagErrorWire:
+		return true
+	}
+	return false
+}
+
+// tagNumber returns the number of tag, such as 1500 for {1500}
+func tagNumber(tag string) int {
+	n, _ := strconv.Atoi(strings.Trim(tag, "{}"))
+	return n
+}
+
+// segment is a tag and its value, or a line of interface header data, read from a file
+type segment struct {
+	text   string
+	header bool
+	// raw is the data read for the segment, from the end of the segment before it to the start of the next
+	raw string
+	// line is the number of line breaks in the data read before text
+	line int
+	// offset is the byte offset of text in the data read
+	offset int
+}
+
+// splitSegments splits data read from a file into segments which each begin with a tag. Text on its own
+// line which doesn't begin with a tag is interface header data, except for {8200} UnstructuredAddenda
+// which may span lines.
+func splitSegments(data string) []segment {
+	var segments []segment
+	for lineNum, start := 0, 0; start < len(data); lineNum++ {
+		end, next := len(data), len(data)
+		if i := strings.IndexByte(data[start:], '\n'); i >= 0 {
+			end, next = start+i, start+i+1
+		}
+		line := strings.TrimSuffix(data[start:end], "\r")
+		if line == "" {
+			start = next
+			continue
+		}
+		indexes := tagRegex.FindAllStringIndex(line, -1)
+		first := len(line)
+		if len(indexes) > 0 {
+			first = indexes[0][0]
+		}
+		if first > 0 {
+			if n := len(segments); n > 0 && !segments[n-1].header && segments[n-1].text[:6] == TagUnstructuredAddenda {
+				segments[n-1].text += line[:first]
+			} else {
+				segments = append(segments, segment{text: line[:first], header: true, line: lineNum, offset: start})
+			}
+		}
+		// split line by tag
+		for i := range indexes {
+			last := len(line)
+			if i+1 < len(indexes) {
+				last = indexes[i+1][0]
+			}
+			segments = append(segments, segment{text: line[indexes[i][0]:last], line: lineNum, offset: start + indexes[i][0]})
+		}
+		start = next
+	}
+	// each segment's raw data runs until the next segment, so that together they hold all of data
+	for i := range segments {
+		start, end := 0, len(data)
+		if i > 0 {
+			start = segments[i].offset
+		}
+		if i+1 < len(segments) {
+			end = segments[i+1].offset
+		}
+		segments[i].raw = data[start:end]
+	}
+	return segments
 }
 
 func (r *Reader) parseLine() error { //nolint:gocyclo
@@ -377,15 +683,46 @@ func (r *Reader) parseLine() error { //nolint:gocyclo
 			return err
 		}
 	default:
-		if r.lineNum == 1 && !tagRegex.MatchString(r.line[:6]) {
-			r.headerData = r.line
-			return nil
+		if opts := r.File.validateOpts; opts != nil && opts.AllowUnknownTags {
+			return r.parseUnknownTag()
 		}
 		return NewErrInvalidTag(r.line[:6])
 	}
 	return nil
 }
 
+// parseUnknownTag keeps a tag which is not part of the WIRE format, recording the tag read before it
+// so Writer can write it back in the same position.
+func (r *Reader) parseUnknownTag() error {
+	r.tagName = "UnknownTag"
+	ut := NewUnknownTag()
+	if err := ut.Parse(r.line); err != nil {
+		return r.parseError(err)
+	}
+	ut.After = r.lastKnownTag()
+	r.currentFEDWireMessage.UnknownTags = append(r.currentFEDWireMessage.UnknownTags, *ut)
+	return nil
+}
+
+// parseInterfaceHeader reads the interface header of the next FEDWireMessage. The header read before
+// the first message is the File's InterfaceHeader.
+func (r *Reader) parseInterfaceHeader() error {
+	r.tagName = "InterfaceHeader"
+	ih := NewInterfaceHeader()
+	if err := ih.Parse(r.line); err != nil {
+		return r.parseError(err)
+	}
+	switch {
+	case r.messageIndex == 0 && r.File.InterfaceHeader == nil:
+		r.File.InterfaceHeader = ih
+	case r.currentFEDWireMessage.InterfaceHeader == nil:
+		r.currentFEDWireMessage.InterfaceHeader = ih
+	default:
+		return r.parseError(ErrInterfaceHeaderRepeated)
+	}
+	return nil
+}
+
 func (r *Reader) parseSenderSupplied() error {
 	r.tagName = "SenderSupplied"
 	ss := new(SenderSupplied)
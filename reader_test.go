@@ -2,12 +2,16 @@ package wire
 
 import (
 	"bytes"
+	"context"
+	"errors"
+	"io"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/moov-io/base"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,6 +33,7 @@ func TestRead(t *testing.T) {
 	t.Run("CustomerTransferPlusStructuredRemittance", testRead(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransferPlusStructuredRemittance.txt")))
 	t.Run("FedAppendedTags", testRead(filepath.Join("test", "testdata", "fedWireMessage-FedAppendedTags.txt")))
 	t.Run("FiservMessage", testRead(filepath.Join("test", "testdata", "fedWireMessage-fiserv.txt")))
+	t.Run("MultipleMessages", testRead(filepath.Join("test", "testdata", "fedWireMessage-MultipleMessages.txt")))
 }
 
 func testRead(filePathName string) func(t *testing.T) {
@@ -95,7 +100,7 @@ func TestReadWithValidateOpts(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, file)
 
-	file.FEDWireMessage.InputMessageAccountabilityData = nil
+	file.FEDWireMessages[0].InputMessageAccountabilityData = nil
 
 	b := &bytes.Buffer{}
 	w := NewWriter(b)
@@ -109,5 +114,311 @@ func TestReadWithValidateOpts(t *testing.T) {
 
 	require.Error(t, err)
 	require.NotNil(t, file)
-	require.Nil(t, file.FEDWireMessage.InputMessageAccountabilityData)
+	require.Empty(t, file.FEDWireMessages)
+}
+
+func TestRead_multipleMessages(t *testing.T) {
+	f, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-MultipleMessages.txt"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	file, err := NewReader(f).Read()
+	require.NoError(t, err)
+	require.Len(t, file.FEDWireMessages, 3)
+
+	require.Equal(t, BankTransfer, file.FEDWireMessages[0].BusinessFunctionCode.BusinessFunctionCode)
+	require.Nil(t, file.FEDWireMessages[0].MessageDisposition)
+	require.Equal(t, CustomerTransfer, file.FEDWireMessages[1].BusinessFunctionCode.BusinessFunctionCode)
+	require.Nil(t, file.FEDWireMessages[1].MessageDisposition)
+	require.Equal(t, BankTransfer, file.FEDWireMessages[2].BusinessFunctionCode.BusinessFunctionCode)
+	require.NotNil(t, file.FEDWireMessages[2].MessageDisposition)
+	require.NotNil(t, file.FEDWireMessages[2].ErrorWire)
+}
+
+func TestRead_multipleMessagesBoundaries(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.txt"))
+	require.NoError(t, err)
+	message := strings.TrimSpace(string(bs)) + "\n"
+	appended := "{1100}30P 2\n{1110}05021230A123\n{1120}20190502Source0800000105021230B123\n"
+
+	t.Run("SenderSupplied", func(t *testing.T) {
+		file, err := NewReader(strings.NewReader(message + message)).Read()
+		require.NoError(t, err)
+		require.Len(t, file.FEDWireMessages, 2)
+	})
+
+	t.Run("MessageDisposition", func(t *testing.T) {
+		file, err := NewReader(strings.NewReader(appended + message + appended + message)).Read()
+		require.NoError(t, err)
+		require.Len(t, file.FEDWireMessages, 2)
+		for i := range file.FEDWireMessages {
+			require.NotNil(t, file.FEDWireMessages[i].MessageDisposition)
+			require.NotNil(t, file.FEDWireMessages[i].SenderSupplied)
+		}
+	})
+
+	t.Run("InterfaceHeader", func(t *testing.T) {
+		// incoming messages may not include {1500} SenderSupplied
+		incoming := message[strings.Index(message, TagTypeSubType):]
+		file, err := NewReader(strings.NewReader("FTI0811 XFT811\n"+incoming+"FTI0811 XFT811\n"+incoming), IncomingFile()).Read()
+		require.NoError(t, err)
+		require.Len(t, file.FEDWireMessages, 2)
+		require.Nil(t, file.FEDWireMessages[1].SenderSupplied)
+		require.Equal(t, "XFT811", file.InterfaceHeader.TerminalID)
+		require.Nil(t, file.FEDWireMessages[0].InterfaceHeader)
+		require.Equal(t, "XFT811", file.FEDWireMessages[1].InterfaceHeader.TerminalID)
+	})
+
+	t.Run("NoNewlines", func(t *testing.T) {
+		file, err := NewReader(strings.NewReader(strings.ReplaceAll(message+message, "\n", ""))).Read()
+		require.NoError(t, err)
+		require.Len(t, file.FEDWireMessages, 2)
+	})
+}
+
+func TestRead_multipleMessagesErrors(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.txt"))
+	require.NoError(t, err)
+	message := strings.TrimSpace(string(bs)) + "\n"
+	invalid := strings.Replace(message, "{2000}000001234567", "{2000}00000123456Z", 1)
+	missing := strings.Replace(message, "{3400}231380104Citadel*\n", "", 1)
+	require.NotEqual(t, message, invalid)
+	require.NotEqual(t, message, missing)
+
+	file, err := NewReader(strings.NewReader(message + invalid + missing)).Read()
+	require.Error(t, err)
+	require.Len(t, file.FEDWireMessages, 3)
+
+	errs, ok := err.(base.ErrorList)
+	require.True(t, ok)
+	require.Len(t, errs, 2)
+
+	var msgErr FEDWireMessageError
+	require.ErrorAs(t, errs[0], &msgErr)
+	require.Equal(t, 1, msgErr.Index)
+	require.Contains(t, errs[0].Error(), "FEDWireMessages[1]: line:")
+
+	require.ErrorAs(t, errs[1], &msgErr)
+	require.Equal(t, 2, msgErr.Index)
+	require.Contains(t, errs[1].Error(), "file validation failed: FEDWireMessages[2]:")
+	require.ErrorIs(t, errs[1], ErrFieldRequired)
+}
+
+func TestReader_Next(t *testing.T) {
+	f, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-MultipleMessages.txt"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	r := NewReader(f)
+	var codes []string
+	for {
+		fwm, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		codes = append(codes, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	}
+	require.Equal(t, []string{BankTransfer, CustomerTransfer, BankTransfer}, codes)
+	require.Empty(t, r.File.FEDWireMessages)
+
+	fwm, err := r.Next()
+	require.Nil(t, fwm)
+	require.Equal(t, io.EOF, err)
+}
+
+func TestReader_NextErrors(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.txt"))
+	require.NoError(t, err)
+	message := strings.TrimSpace(string(bs)) + "\n"
+	invalid := strings.Replace(message, "{2000}000001234567", "{2000}00000123456Z", 1)
+
+	r := NewReader(strings.NewReader(invalid + message))
+
+	fwm, err := r.Next()
+	require.NotNil(t, fwm)
+	require.Nil(t, fwm.Amount)
+	errs, ok := err.(base.ErrorList)
+	require.True(t, ok)
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs[0], ErrNonAmount)
+	var msgErr FEDWireMessageError
+	require.ErrorAs(t, errs[0], &msgErr)
+	require.Equal(t, 0, msgErr.Index)
+
+	fwm, err = r.Next()
+	require.NoError(t, err)
+	require.NotNil(t, fwm.Amount)
+
+	_, err = r.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestReader_NextContext(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.txt"))
+	require.NoError(t, err)
+	message := strings.TrimSpace(string(bs)) + "\n"
+
+	readers := make([]io.Reader, 1000)
+	for i := range readers {
+		readers[i] = strings.NewReader(message)
+	}
+	r := NewReader(io.MultiReader(readers...))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for i := 0; i < 500; i++ {
+		_, err := r.NextContext(ctx)
+		require.NoError(t, err)
+	}
+	cancel()
+
+	fwm, err := r.NextContext(ctx)
+	require.Nil(t, fwm)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestRead_unknownTags(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.txt"))
+	require.NoError(t, err)
+	message := strings.Replace(string(bs), "{3600}BTR   *\n", "{3600}BTR   *\n{9100}Vendor data*\n{9110}More*\n", 1)
+	message = "{0100}First\n" + message
+
+	_, err = NewReader(strings.NewReader(message)).Read()
+	require.ErrorContains(t, err, NewErrInvalidTag("{0100}").Error())
+
+	file, err := NewReader(strings.NewReader(message)).ReadWithOpts(&ValidateOpts{AllowUnknownTags: true})
+	require.NoError(t, err)
+	require.Len(t, file.FEDWireMessages, 1)
+	require.Equal(t, []UnknownTag{
+		{Tag: "{0100}", Value: "First"},
+		{Tag: "{9100}", Value: "Vendor data*", After: TagBusinessFunctionCode},
+		{Tag: "{9110}", Value: "More*", After: TagBusinessFunctionCode},
+	}, file.FEDWireMessages[0].UnknownTags)
+}
+
+func TestRead_interfaceHeader(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.txt"))
+	require.NoError(t, err)
+	message := strings.TrimSpace(string(bs)) + "\n"
+
+	file, err := NewReader(strings.NewReader("FTI0811 XFT811  \nHDR*ACME*\n" + message)).Read()
+	require.NoError(t, err)
+	require.Equal(t, InterfaceHeaderFAIM, file.InterfaceHeader.Layout)
+	require.Equal(t, []string{"HDR", "ACME", ""}, file.FEDWireMessages[0].InterfaceHeader.Fields)
+
+	_, err = NewReader(strings.NewReader("FTI0811 XFT811\nHDR*ACME*\nROUTE 42\n" + message)).Read()
+	require.ErrorContains(t, err, ErrInterfaceHeaderRepeated.Error())
+}
+
+func TestRead_tagSequence(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.txt"))
+	require.NoError(t, err)
+	message := strings.TrimSpace(string(bs)) + "\n"
+
+	t.Run("Duplicate", func(t *testing.T) {
+		duplicate := strings.Replace(message, "{3320}Sender Reference*\n", "{3320}Sender Reference*\n{3320}Other Reference*\n", 1)
+
+		_, err := NewReader(strings.NewReader(duplicate)).Read()
+		require.ErrorContains(t, err, "line:7 offset:133 element:0 record:SenderReference wire.ErrDuplicateTag {3320} is a duplicate tag")
+
+		file, err := NewReader(strings.NewReader(duplicate)).ReadWithOpts(&ValidateOpts{SkipTagSequenceCheck: true})
+		require.NoError(t, err)
+		require.Equal(t, "Other Reference", file.FEDWireMessages[0].SenderReference.SenderReference)
+	})
+
+	t.Run("OutOfOrder", func(t *testing.T) {
+		outOfOrder := strings.Replace(message, "{3500}Previous Message Ident\n", "", 1)
+		outOfOrder = strings.Replace(outOfOrder, "{4100}", "{3500}Previous Message Ident\n{4100}", 1)
+
+		_, err := NewReader(strings.NewReader(outOfOrder)).Read()
+		require.ErrorContains(t, err, "record:PreviousMessageIdentifier wire.ErrTagOutOfOrder {3500} is out of order after {4000}")
+
+		// tags written with {3320} and {3500} after {3600}, as Writer did before tags were ordered
+		legacy := strings.Replace(message, "{3320}Sender Reference*\n", "", 1)
+		legacy = strings.Replace(legacy, "{3500}Previous Message Ident\n", "", 1)
+		legacy = strings.Replace(legacy, "{3600}BTR   *\n", "{3600}BTR   *\n{3320}Sender Reference*\n{3500}Previous Message Ident\n", 1)
+		_, err = NewReader(strings.NewReader(legacy)).Read()
+		require.ErrorContains(t, err, NewErrTagOutOfOrder(TagSenderReference, TagBusinessFunctionCode).Error())
+
+		_, err = NewReader(strings.NewReader(legacy)).ReadWithOpts(&ValidateOpts{SkipTagSequenceCheck: true})
+		require.NoError(t, err)
+	})
+
+	t.Run("FedAppended", func(t *testing.T) {
+		appended := "{1100}30P 2\n{1110}05021230A123\n"
+
+		_, err := NewReader(strings.NewReader(appended + message)).Read()
+		require.NoError(t, err)
+		_, err = NewReader(strings.NewReader(message + appended)).Read()
+		require.NoError(t, err)
+
+		_, err = NewReader(strings.NewReader(strings.Replace(message, "{2000}", appended+"{2000}", 1))).Read()
+		require.ErrorContains(t, err, NewErrTagOutOfOrder(TagAmount, TagReceiptTimeStamp).Error())
+	})
+}
+
+// TestRead_errorPositions reads Files with errors and checks the position of each error in the input
+func TestRead_errorPositions(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.txt"))
+	require.NoError(t, err)
+	message := strings.TrimSpace(string(bs)) + "\n"
+
+	parseError := func(t *testing.T, input string) *ParseError {
+		t.Helper()
+		_, err := NewReader(strings.NewReader(input)).Read()
+		var errs base.ErrorList
+		require.True(t, errors.As(err, &errs), "%v", err)
+		var pe *ParseError
+		require.True(t, errors.As(errs[0], &pe), "%v", err)
+		return pe
+	}
+
+	t.Run("Parse", func(t *testing.T) {
+		pe := parseError(t, strings.Replace(message, "{3400}231380104Citadel*", "{3400}231380104Citadel", 1))
+		require.Equal(t, 7, pe.Line)
+		require.Equal(t, 133, pe.Offset)
+		require.Equal(t, 15, pe.ElementOffset)
+		require.Equal(t, "ReceiverDepositoryInstitution", pe.Record)
+		require.EqualError(t, pe, "line:7 offset:133 element:15 record:ReceiverDepositoryInstitution *wire.FieldError ReceiverShortName is require delimiter")
+	})
+
+	t.Run("CRLF", func(t *testing.T) {
+		input := strings.ReplaceAll(strings.Replace(message, "{3400}231380104Citadel*", "{3400}231380104Citadel", 1), "\n", "\r\n")
+		pe := parseError(t, input)
+		require.Equal(t, 7, pe.Line)
+		require.Equal(t, 139, pe.Offset)
+		require.Equal(t, "{3400}", input[pe.Offset:pe.Offset+6])
+	})
+
+	t.Run("SingleLine", func(t *testing.T) {
+		input := strings.ReplaceAll(strings.Replace(message, "{3600}BTR   *", "{3600}XYZ   *", 1), "\n", "")
+		pe := parseError(t, input)
+		require.Equal(t, 1, pe.Line)
+		require.Equal(t, "{3600}XYZ", input[pe.Offset:pe.Offset+9])
+		require.Equal(t, 6, pe.ElementOffset)
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		pe := parseError(t, strings.Replace(message, "{3600}BTR   *", "{3600}BTRCOV*", 1))
+		require.Equal(t, 9, pe.Line)
+		require.Equal(t, 186, pe.Offset)
+		require.Equal(t, 9, pe.ElementOffset)
+		require.Equal(t, "BusinessFunctionCode", pe.Record)
+	})
+
+	t.Run("BaseParseError", func(t *testing.T) {
+		pe := parseError(t, strings.Replace(message, "{3600}BTR   *", "{3600}XYZ   *", 1))
+
+		var bpe *base.ParseError
+		require.True(t, errors.As(pe, &bpe))
+		require.Equal(t, pe.Line, bpe.Line)
+		require.Equal(t, pe.Record, bpe.Record)
+		require.Equal(t, pe.Err, bpe.Err)
+
+		var bp base.ParseError
+		require.True(t, errors.As(pe, &bp))
+		require.Equal(t, 9, bp.Line)
+	})
 }
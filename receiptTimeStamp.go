@@ -7,7 +7,10 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"time"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // ReceiptTimeStamp is the receipt time stamp of the wire
@@ -49,21 +52,21 @@ func (rts *ReceiptTimeStamp) Parse(record string) error {
 
 	value, read, err := rts.parseFixedStringField(record[length:], 4)
 	if err != nil {
-		return fieldError("ReceiptDate", err)
+		return fieldErrorAt(length, "ReceiptDate", err)
 	}
 	rts.ReceiptDate = value
 	length += read
 
 	value, read, err = rts.parseFixedStringField(record[length:], 4)
 	if err != nil {
-		return fieldError("ReceiptTime", err)
+		return fieldErrorAt(length, "ReceiptTime", err)
 	}
 	rts.ReceiptTime = value
 	length += read
 
 	value, read, err = rts.parseFixedStringField(record[length:], 4)
 	if err != nil {
-		return fieldError("ReceiptApplicationIdentification", err)
+		return fieldErrorAt(length, "ReceiptApplicationIdentification", err)
 	}
 	rts.ReceiptApplicationIdentification = value
 	length += read
@@ -114,13 +117,19 @@ func (rts *ReceiptTimeStamp) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on ReceiptTimeStamp and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (rts *ReceiptTimeStamp) Validate() error {
+	return rts.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on ReceiptTimeStamp and returns every error found.
+// Only the first error for each field is returned.
+func (rts *ReceiptTimeStamp) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	// Currently no validation as the FED is responsible for the values
 	if rts.tag != TagReceiptTimeStamp {
-		return fieldError("tag", ErrValidTagForType, rts.tag)
+		addFieldError(&errs, TagReceiptTimeStamp, fieldError("tag", ErrValidTagForType, rts.tag))
 	}
-	return nil
+	return errs
 }
 
 // ReceiptDateField gets a string of the ReceiptDate field
@@ -152,3 +161,14 @@ func (rts *ReceiptTimeStamp) FormatReceiptTime(options FormatOptions) string {
 func (rts *ReceiptTimeStamp) FormatReceiptApplicationIdentification(options FormatOptions) string {
 	return rts.formatAlphaField(rts.ReceiptApplicationIdentification, 4, options)
 }
+
+// ReceiptDateTime returns the Eastern time of the ReceiptDate and ReceiptTime. The ReceiptDate has no year, so
+// the year is the one which puts it nearest to cycleDate, such as the InputCycleDate of the message.
+func (rts *ReceiptTimeStamp) ReceiptDateTime(cycleDate time.Time) (time.Time, error) {
+	return parseMonthDayTime("ReceiptDate", rts.ReceiptDate, rts.ReceiptTime, cycleDate)
+}
+
+// SetReceiptDateTime sets the ReceiptDate and ReceiptTime to t in Eastern time
+func (rts *ReceiptTimeStamp) SetReceiptDateTime(t time.Time) {
+	rts.ReceiptDate, rts.ReceiptTime = formatMonthDayTime(t)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -103,3 +104,19 @@ func TestStringReceiptTimeStampOptions(t *testing.T) {
 	require.Equal(t, record.Format(FormatOptions{VariableLengthFields: true}), "{1110}            ")
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
+
+func TestReceiptTimeStamp_ReceiptDateTime(t *testing.T) {
+	cycleDate := time.Date(2019, time.May, 2, 0, 0, 0, 0, EasternTime)
+	receipt, err := mockReceiptTimeStamp().ReceiptDateTime(cycleDate)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.May, 2, 12, 30, 0, 0, EasternTime), receipt)
+
+	rts := NewReceiptTimeStamp()
+	rts.SetReceiptDateTime(time.Date(2019, time.May, 2, 16, 45, 0, 0, time.UTC))
+	require.Equal(t, "0502", rts.ReceiptDate)
+	require.Equal(t, "1245", rts.ReceiptTime)
+
+	rts.ReceiptTime = "12:4"
+	_, err = rts.ReceiptDateTime(cycleDate)
+	require.ErrorIs(t, err, ErrValidDate)
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // ReceiverDepositoryInstitution {3400}
@@ -47,14 +49,14 @@ func (rdi *ReceiverDepositoryInstitution) Parse(record string) error {
 
 	value, read, err := rdi.parseFixedStringField(record[length:], 9)
 	if err != nil {
-		return fieldError("ReceiverABANumber", err)
+		return fieldErrorAt(length, "ReceiverABANumber", err)
 	}
 	rdi.ReceiverABANumber = value
 	length += read
 
 	value, read, err = rdi.parseVariableStringField(record[length:], 18)
 	if err != nil {
-		return fieldError("ReceiverShortName", err)
+		return fieldErrorAt(length, "ReceiverShortName", err)
 	}
 	rdi.ReceiverShortName = value
 	length += read
@@ -100,30 +102,34 @@ func (rdi *ReceiverDepositoryInstitution) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on ReceiverDepositoryInstitution and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (rdi *ReceiverDepositoryInstitution) Validate() error {
-	if err := rdi.fieldInclusion(); err != nil {
-		return err
-	}
+	return rdi.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on ReceiverDepositoryInstitution and returns every error found.
+// Only the first error for each field is returned.
+func (rdi *ReceiverDepositoryInstitution) ValidateAll() base.ErrorList {
+	errs := rdi.fieldInclusion()
 	if rdi.tag != TagReceiverDepositoryInstitution {
-		return fieldError("tag", ErrValidTagForType, rdi.tag)
+		addFieldError(&errs, TagReceiverDepositoryInstitution, fieldError("tag", ErrValidTagForType, rdi.tag))
 	}
 	if err := rdi.isNumeric(rdi.ReceiverABANumber); err != nil {
-		return fieldError("ReceiverABANumber", err, rdi.ReceiverABANumber)
+		addFieldError(&errs, TagReceiverDepositoryInstitution, fieldError("ReceiverABANumber", err, rdi.ReceiverABANumber))
 	}
 	if err := rdi.isAlphanumeric(rdi.ReceiverShortName); err != nil {
-		return fieldError("ReceiverShortName", err, rdi.ReceiverShortName)
+		addFieldError(&errs, TagReceiverDepositoryInstitution, fieldError("ReceiverShortName", err, rdi.ReceiverShortName))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (rdi *ReceiverDepositoryInstitution) fieldInclusion() error {
+func (rdi *ReceiverDepositoryInstitution) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if rdi.ReceiverABANumber == "" {
-		return fieldError("ReceiverABANumber", ErrFieldRequired, rdi.ReceiverABANumber)
+		addFieldError(&errs, TagReceiverDepositoryInstitution, fieldError("ReceiverABANumber", ErrFieldRequired, rdi.ReceiverABANumber))
 	}
-	return nil
+	return errs
 }
 
 // ReceiverABANumberField gets a string of the ReceiverABANumber field
@@ -75,7 +75,7 @@ func TestParseReceiverReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("ReceiverABANumber", ErrNonNumeric, "2313Z0104")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("ReceiverABANumber", ErrNonNumeric, "2313Z0104"))).Error())
 }
 
 // TestReceiverDepositoryInstitutionTagError validates a ReceiverDepositoryInstitution tag
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // RelatedRemittance is related remittance
@@ -51,140 +53,140 @@ func (rr *RelatedRemittance) Parse(record string) error {
 
 	value, read, err := rr.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("RemittanceIdentification", err)
+		return fieldErrorAt(length, "RemittanceIdentification", err)
 	}
 	rr.RemittanceIdentification = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 4)
 	if err != nil {
-		return fieldError("RemittanceLocationMethod", err)
+		return fieldErrorAt(length, "RemittanceLocationMethod", err)
 	}
 	rr.RemittanceLocationMethod = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 2048)
 	if err != nil {
-		return fieldError("RemittanceLocationElectronicAddress", err)
+		return fieldErrorAt(length, "RemittanceLocationElectronicAddress", err)
 	}
 	rr.RemittanceLocationElectronicAddress = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 140)
 	if err != nil {
-		return fieldError("RemittanceData", err)
+		return fieldErrorAt(length, "RemittanceData", err)
 	}
 	rr.RemittanceData.Name = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 4)
 	if err != nil {
-		return fieldError("AddressType", err)
+		return fieldErrorAt(length, "AddressType", err)
 	}
 	rr.RemittanceData.AddressType = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("Department", err)
+		return fieldErrorAt(length, "Department", err)
 	}
 	rr.RemittanceData.Department = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("SubDepartment", err)
+		return fieldErrorAt(length, "SubDepartment", err)
 	}
 	rr.RemittanceData.SubDepartment = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("StreetName", err)
+		return fieldErrorAt(length, "StreetName", err)
 	}
 	rr.RemittanceData.StreetName = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 16)
 	if err != nil {
-		return fieldError("BuildingNumber", err)
+		return fieldErrorAt(length, "BuildingNumber", err)
 	}
 	rr.RemittanceData.BuildingNumber = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 16)
 	if err != nil {
-		return fieldError("PostCode", err)
+		return fieldErrorAt(length, "PostCode", err)
 	}
 	rr.RemittanceData.PostCode = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("TownName", err)
+		return fieldErrorAt(length, "TownName", err)
 	}
 	rr.RemittanceData.TownName = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("CountrySubDivisionState", err)
+		return fieldErrorAt(length, "CountrySubDivisionState", err)
 	}
 	rr.RemittanceData.CountrySubDivisionState = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 2)
 	if err != nil {
-		return fieldError("Country", err)
+		return fieldErrorAt(length, "Country", err)
 	}
 	rr.RemittanceData.Country = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineOne", err)
+		return fieldErrorAt(length, "AddressLineOne", err)
 	}
 	rr.RemittanceData.AddressLineOne = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineTwo", err)
+		return fieldErrorAt(length, "AddressLineTwo", err)
 	}
 	rr.RemittanceData.AddressLineTwo = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineThree", err)
+		return fieldErrorAt(length, "AddressLineThree", err)
 	}
 	rr.RemittanceData.AddressLineThree = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineFour", err)
+		return fieldErrorAt(length, "AddressLineFour", err)
 	}
 	rr.RemittanceData.AddressLineFour = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineFive", err)
+		return fieldErrorAt(length, "AddressLineFive", err)
 	}
 	rr.RemittanceData.AddressLineFive = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineSix", err)
+		return fieldErrorAt(length, "AddressLineSix", err)
 	}
 	rr.RemittanceData.AddressLineSix = value
 	length += read
 
 	value, read, err = rr.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineSeven", err)
+		return fieldErrorAt(length, "AddressLineSeven", err)
 	}
 	rr.RemittanceData.AddressLineSeven = value
 	length += read
@@ -252,87 +254,92 @@ func (rr *RelatedRemittance) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on RelatedRemittance and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (rr *RelatedRemittance) Validate() error {
+	return rr.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on RelatedRemittance and returns every error found.
+// Only the first error for each field is returned.
+func (rr *RelatedRemittance) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if rr.tag != TagRelatedRemittance {
-		return fieldError("tag", ErrValidTagForType, rr.tag)
-	}
-	if err := rr.fieldInclusion(); err != nil {
-		return err
+		addFieldError(&errs, TagRelatedRemittance, fieldError("tag", ErrValidTagForType, rr.tag))
 	}
+	errs = append(errs, rr.fieldInclusion()...)
 	if err := rr.isAlphanumeric(rr.RemittanceIdentification); err != nil {
-		return fieldError("RemittanceIdentification", err, rr.RemittanceIdentification)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("RemittanceIdentification", err, rr.RemittanceIdentification))
 	}
 	if err := rr.isRemittanceLocationMethod(rr.RemittanceLocationMethod); err != nil {
-		return fieldError("RemittanceLocationMethod", err, rr.RemittanceLocationMethod)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("RemittanceLocationMethod", err, rr.RemittanceLocationMethod))
 	}
 	if err := rr.isAlphanumeric(rr.RemittanceLocationElectronicAddress); err != nil {
-		return fieldError("RemittanceLocationElectronicAddress", err, rr.RemittanceLocationElectronicAddress)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("RemittanceLocationElectronicAddress", err, rr.RemittanceLocationElectronicAddress))
 	}
 	if err := rr.isAlphanumeric(rr.RemittanceData.Name); err != nil {
-		return fieldError("Name", err, rr.RemittanceData.Name)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("Name", err, rr.RemittanceData.Name))
 	}
 	if err := rr.isAddressType(rr.RemittanceData.AddressType); err != nil {
-		return fieldError("AddressType", err, rr.RemittanceData.AddressType)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("AddressType", err, rr.RemittanceData.AddressType))
 	}
 	if err := rr.isAlphanumeric(rr.RemittanceData.Department); err != nil {
-		return fieldError("Department", err, rr.RemittanceData.Department)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("Department", err, rr.RemittanceData.Department))
 	}
 	if err := rr.isAlphanumeric(rr.RemittanceData.SubDepartment); err != nil {
-		return fieldError("SubDepartment", err, rr.RemittanceData.SubDepartment)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("SubDepartment", err, rr.RemittanceData.SubDepartment))
 	}
 	if err := rr.isAlphanumeric(rr.RemittanceData.StreetName); err != nil {
-		return fieldError("StreetName", err, rr.RemittanceData.StreetName)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("StreetName", err, rr.RemittanceData.StreetName))
 	}
 	if err := rr.isAlphanumeric(rr.RemittanceData.BuildingNumber); err != nil {
-		return fieldError("BuildingNumber", err, rr.RemittanceData.BuildingNumber)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("BuildingNumber", err, rr.RemittanceData.BuildingNumber))
 	}
 	if err := rr.isAlphanumeric(rr.RemittanceData.PostCode); err != nil {
-		return fieldError("PostCode", err, rr.RemittanceData.PostCode)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("PostCode", err, rr.RemittanceData.PostCode))
 	}
 	if err := rr.isAlphanumeric(rr.RemittanceData.TownName); err != nil {
-		return fieldError("TownName", err, rr.RemittanceData.TownName)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("TownName", err, rr.RemittanceData.TownName))
 	}
 	if err := rr.isAlphanumeric(rr.RemittanceData.CountrySubDivisionState); err != nil {
-		return fieldError("CountrySubDivisionState", err, rr.RemittanceData.CountrySubDivisionState)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("CountrySubDivisionState", err, rr.RemittanceData.CountrySubDivisionState))
 	}
 	if err := rr.isAlphanumeric(rr.RemittanceData.Country); err != nil {
-		return fieldError("Country", err, rr.RemittanceData.Country)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("Country", err, rr.RemittanceData.Country))
 	}
 	if err := rr.isAlphanumeric(rr.RemittanceData.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, rr.RemittanceData.AddressLineOne)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("AddressLineOne", err, rr.RemittanceData.AddressLineOne))
 	}
 	if err := rr.isAlphanumeric(rr.RemittanceData.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, rr.RemittanceData.AddressLineTwo)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("AddressLineTwo", err, rr.RemittanceData.AddressLineTwo))
 	}
 	if err := rr.isAlphanumeric(rr.RemittanceData.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, rr.RemittanceData.AddressLineThree)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("AddressLineThree", err, rr.RemittanceData.AddressLineThree))
 	}
 	if err := rr.isAlphanumeric(rr.RemittanceData.AddressLineFour); err != nil {
-		return fieldError("AddressLineFour", err, rr.RemittanceData.AddressLineFour)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("AddressLineFour", err, rr.RemittanceData.AddressLineFour))
 	}
 	if err := rr.isAlphanumeric(rr.RemittanceData.AddressLineFive); err != nil {
-		return fieldError("AddressLineFive", err, rr.RemittanceData.AddressLineFive)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("AddressLineFive", err, rr.RemittanceData.AddressLineFive))
 	}
 	if err := rr.isAlphanumeric(rr.RemittanceData.AddressLineSix); err != nil {
-		return fieldError("AddressLineSix", err, rr.RemittanceData.AddressLineSix)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("AddressLineSix", err, rr.RemittanceData.AddressLineSix))
 	}
 	if err := rr.isAlphanumeric(rr.RemittanceData.AddressLineSeven); err != nil {
-		return fieldError("AddressLineSeven", err, rr.RemittanceData.AddressLineSeven)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("AddressLineSeven", err, rr.RemittanceData.AddressLineSeven))
 	}
 	if err := rr.isAlphanumeric(rr.RemittanceData.CountryOfResidence); err != nil {
-		return fieldError("CountryOfResidence", err, rr.RemittanceData.CountryOfResidence)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("CountryOfResidence", err, rr.RemittanceData.CountryOfResidence))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (rr *RelatedRemittance) fieldInclusion() error {
+func (rr *RelatedRemittance) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if rr.RemittanceData.Name == "" {
-		return fieldError("Name", ErrFieldRequired)
+		addFieldError(&errs, TagRelatedRemittance, fieldError("Name", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // RemittanceIdentificationField gets a string of the RemittanceIdentification field
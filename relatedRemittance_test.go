@@ -260,7 +260,7 @@ func TestParseRelatedRemittanceWrongLength(t *testing.T) {
 
 	err := r.parseRelatedRemittance()
 
-	require.EqualError(t, err, r.parseError(fieldError("StreetName", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(2978, "StreetName", ErrRequireDelimiter)).Error())
 }
 
 // TestParseRelatedRemittanceReaderParseError parses a wrong RelatedRemittance reader parse error
@@ -275,7 +275,7 @@ func TestParseRelatedRemittanceReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("RemittanceIdentification", ErrNonAlphanumeric, "Remittance ®dentification")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("RemittanceIdentification", ErrNonAlphanumeric, "Remittance ®dentification"))).Error())
 }
 
 // TestRelatedRemittanceTagError validates a RelatedRemittance tag
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // Remittance is the remittance information
@@ -45,35 +47,35 @@ func (ri *Remittance) Parse(record string) error {
 
 	value, read, err := ri.parseVariableStringField(record[length:], 5)
 	if err != nil {
-		return fieldError("SwiftFieldTag", err)
+		return fieldErrorAt(length, "SwiftFieldTag", err)
 	}
 	ri.CoverPayment.SwiftFieldTag = value
 	length += read
 
 	value, read, err = ri.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineOne", err)
+		return fieldErrorAt(length, "SwiftLineOne", err)
 	}
 	ri.CoverPayment.SwiftLineOne = value
 	length += read
 
 	value, read, err = ri.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineTwo", err)
+		return fieldErrorAt(length, "SwiftLineTwo", err)
 	}
 	ri.CoverPayment.SwiftLineTwo = value
 	length += read
 
 	value, read, err = ri.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineThree", err)
+		return fieldErrorAt(length, "SwiftLineThree", err)
 	}
 	ri.CoverPayment.SwiftLineThree = value
 	length += read
 
 	value, read, err = ri.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineFour", err)
+		return fieldErrorAt(length, "SwiftLineFour", err)
 	}
 	ri.CoverPayment.SwiftLineFour = value
 	length += read
@@ -126,42 +128,46 @@ func (ri *Remittance) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on Remittance and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (ri *Remittance) Validate() error {
-	if err := ri.fieldInclusion(); err != nil {
-		return err
-	}
+	return ri.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on Remittance and returns every error found.
+// Only the first error for each field is returned.
+func (ri *Remittance) ValidateAll() base.ErrorList {
+	errs := ri.fieldInclusion()
 	if ri.tag != TagRemittance {
-		return fieldError("tag", ErrValidTagForType, ri.tag)
+		addFieldError(&errs, TagRemittance, fieldError("tag", ErrValidTagForType, ri.tag))
 	}
 	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, ri.CoverPayment.SwiftFieldTag)
+		addFieldError(&errs, TagRemittance, fieldError("SwiftFieldTag", err, ri.CoverPayment.SwiftFieldTag))
 	}
 	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, ri.CoverPayment.SwiftLineOne)
+		addFieldError(&errs, TagRemittance, fieldError("SwiftLineOne", err, ri.CoverPayment.SwiftLineOne))
 	}
 	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, ri.CoverPayment.SwiftLineTwo)
+		addFieldError(&errs, TagRemittance, fieldError("SwiftLineTwo", err, ri.CoverPayment.SwiftLineTwo))
 	}
 	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, ri.CoverPayment.SwiftLineThree)
+		addFieldError(&errs, TagRemittance, fieldError("SwiftLineThree", err, ri.CoverPayment.SwiftLineThree))
 	}
 	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, ri.CoverPayment.SwiftLineFour)
+		addFieldError(&errs, TagRemittance, fieldError("SwiftLineFour", err, ri.CoverPayment.SwiftLineFour))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (ri *Remittance) fieldInclusion() error {
+func (ri *Remittance) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if ri.CoverPayment.SwiftLineFive != "" {
-		return fieldError("SwiftLineFive", ErrInvalidProperty, ri.CoverPayment.SwiftLineFive)
+		addFieldError(&errs, TagRemittance, fieldError("SwiftLineFive", ErrInvalidProperty, ri.CoverPayment.SwiftLineFive))
 	}
 	if ri.CoverPayment.SwiftLineSix != "" {
-		return fieldError("SwiftLineSix", ErrInvalidProperty, ri.CoverPayment.SwiftLineSix)
+		addFieldError(&errs, TagRemittance, fieldError("SwiftLineSix", ErrInvalidProperty, ri.CoverPayment.SwiftLineSix))
 	}
-	return nil
+	return errs
 }
 
 // SwiftFieldTagField gets a string of the SwiftFieldTag field
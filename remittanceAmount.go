@@ -4,6 +4,10 @@
 
 package wire
 
+import (
+	"strings"
+)
+
 // RemittanceAmount is remittance amount
 type RemittanceAmount struct {
 	// CurrencyCode
@@ -11,3 +15,25 @@ type RemittanceAmount struct {
 	// Amount Must contain at least one numeric character and only one decimal period marker (e.g., $1,234.56 should be entered as 1234.56). Can have up to 5 numeric characters following the decimal period marker (e.g., 1234.56789). Amount must be greater than zero (i.e., at least .01).
 	Amount string `json:"amount,omitempty"`
 }
+
+// Money returns the CurrencyCode and Amount as Money. Money holds only the minor units of its currency, such as the
+// 2 decimal places of USD, while a remittance Amount can have up to 5, so ErrAmountPrecision is returned for a valid
+// Amount such as 1234.56789 USD. Use the Amount string for those.
+func (ra *RemittanceAmount) Money() (Money, error) {
+	return ParseMoney(ra.CurrencyCode, ra.Amount)
+}
+
+// SetMoney sets the CurrencyCode and Amount to m, writing the Amount with a decimal period
+func (ra *RemittanceAmount) SetMoney(m Money) error {
+	m, err := NewMoney(m.CurrencyCode, m.MinorUnits)
+	if err != nil {
+		return err
+	}
+	amount, err := formatMoney(m, ".", 19)
+	if err != nil {
+		return err
+	}
+	ra.CurrencyCode = m.CurrencyCode
+	ra.Amount = strings.TrimSuffix(amount, ".")
+	return nil
+}
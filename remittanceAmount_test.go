@@ -0,0 +1,30 @@
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemittanceAmount_Money(t *testing.T) {
+	aap := mockActualAmountPaid()
+	m, err := aap.RemittanceAmount.Money()
+	require.NoError(t, err)
+	require.Equal(t, Money{CurrencyCode: "USD", MinorUnits: 123456}, m)
+
+	adj := mockAdjustment()
+	require.NoError(t, adj.RemittanceAmount.SetMoney(Money{CurrencyCode: "KWD", MinorUnits: 1005}))
+	require.Equal(t, "KWD", adj.RemittanceAmount.CurrencyCode)
+	require.Equal(t, "1.005", adj.RemittanceAmount.Amount)
+	require.NoError(t, adj.Validate())
+
+	require.NoError(t, adj.RemittanceAmount.SetMoney(Money{CurrencyCode: "JPY", MinorUnits: 1005}))
+	require.Equal(t, "1005", adj.RemittanceAmount.Amount)
+
+	// Remittance amounts may have more decimal places than the minor unit of their currency
+	aap.RemittanceAmount.Amount = "1234.56789"
+	_, err = aap.RemittanceAmount.Money()
+	require.ErrorIs(t, err, ErrAmountPrecision)
+
+	require.ErrorIs(t, adj.RemittanceAmount.SetMoney(Money{CurrencyCode: "USD", MinorUnits: -1}), ErrAmountRange)
+}
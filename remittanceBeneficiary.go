@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // RemittanceBeneficiary is remittance beneficiary
@@ -53,161 +55,161 @@ func (rb *RemittanceBeneficiary) Parse(record string) error {
 
 	value, read, err := rb.parseVariableStringField(record[length:], 140)
 	if err != nil {
-		return fieldError("Name", err)
+		return fieldErrorAt(length, "Name", err)
 	}
 	rb.RemittanceData.Name = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 2)
 	if err != nil {
-		return fieldError("IdentificationType", err)
+		return fieldErrorAt(length, "IdentificationType", err)
 	}
 	rb.IdentificationType = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 4)
 	if err != nil {
-		return fieldError("IdentificationCode", err)
+		return fieldErrorAt(length, "IdentificationCode", err)
 	}
 	rb.IdentificationCode = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("IdentificationNumber", err)
+		return fieldErrorAt(length, "IdentificationNumber", err)
 	}
 	rb.IdentificationNumber = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("IdentificationNumberIssuer", err)
+		return fieldErrorAt(length, "IdentificationNumberIssuer", err)
 	}
 	rb.IdentificationNumberIssuer = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 82)
 	if err != nil {
-		return fieldError("DateBirthPlace", err)
+		return fieldErrorAt(length, "DateBirthPlace", err)
 	}
 	rb.RemittanceData.DateBirthPlace = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 4)
 	if err != nil {
-		return fieldError("AddressType", err)
+		return fieldErrorAt(length, "AddressType", err)
 	}
 	rb.RemittanceData.AddressType = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("Department", err)
+		return fieldErrorAt(length, "Department", err)
 	}
 	rb.RemittanceData.Department = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("SubDepartment", err)
+		return fieldErrorAt(length, "SubDepartment", err)
 	}
 	rb.RemittanceData.SubDepartment = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("StreetName", err)
+		return fieldErrorAt(length, "StreetName", err)
 	}
 	rb.RemittanceData.StreetName = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 16)
 	if err != nil {
-		return fieldError("BuildingNumber", err)
+		return fieldErrorAt(length, "BuildingNumber", err)
 	}
 	rb.RemittanceData.BuildingNumber = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 16)
 	if err != nil {
-		return fieldError("PostCode", err)
+		return fieldErrorAt(length, "PostCode", err)
 	}
 	rb.RemittanceData.PostCode = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("TownName", err)
+		return fieldErrorAt(length, "TownName", err)
 	}
 	rb.RemittanceData.TownName = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("CountrySubDivisionState", err)
+		return fieldErrorAt(length, "CountrySubDivisionState", err)
 	}
 	rb.RemittanceData.CountrySubDivisionState = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 2)
 	if err != nil {
-		return fieldError("Country", err)
+		return fieldErrorAt(length, "Country", err)
 	}
 	rb.RemittanceData.Country = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineOne", err)
+		return fieldErrorAt(length, "AddressLineOne", err)
 	}
 	rb.RemittanceData.AddressLineOne = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineTwo", err)
+		return fieldErrorAt(length, "AddressLineTwo", err)
 	}
 	rb.RemittanceData.AddressLineTwo = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineThree", err)
+		return fieldErrorAt(length, "AddressLineThree", err)
 	}
 	rb.RemittanceData.AddressLineThree = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineFour", err)
+		return fieldErrorAt(length, "AddressLineFour", err)
 	}
 	rb.RemittanceData.AddressLineFour = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineFive", err)
+		return fieldErrorAt(length, "AddressLineFive", err)
 	}
 	rb.RemittanceData.AddressLineFive = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineSix", err)
+		return fieldErrorAt(length, "AddressLineSix", err)
 	}
 	rb.RemittanceData.AddressLineSix = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineSeven", err)
+		return fieldErrorAt(length, "AddressLineSeven", err)
 	}
 	rb.RemittanceData.AddressLineSeven = value
 	length += read
 
 	value, read, err = rb.parseVariableStringField(record[length:], 2)
 	if err != nil {
-		return fieldError("CountryOfResidence", err)
+		return fieldErrorAt(length, "CountryOfResidence", err)
 	}
 	rb.RemittanceData.CountryOfResidence = value
 	length += read
@@ -278,7 +280,6 @@ func (rb *RemittanceBeneficiary) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on RemittanceBeneficiary and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 // * Name is mandatory.
 // * Identification Number
 //   - Not permitted unless Identification Type and Identification Code are present.
@@ -290,114 +291,119 @@ func (rb *RemittanceBeneficiary) Format(options FormatOptions) string {
 //
 // * Date & Place of Birth is only permitted for Identification Code PICDateBirthPlace.
 func (rb *RemittanceBeneficiary) Validate() error {
-	if err := rb.fieldInclusion(); err != nil {
-		return err
-	}
+	return rb.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on RemittanceBeneficiary and returns every error found.
+// Only the first error for each field is returned.
+func (rb *RemittanceBeneficiary) ValidateAll() base.ErrorList {
+	errs := rb.fieldInclusion()
 	if rb.tag != TagRemittanceBeneficiary {
-		return fieldError("tag", ErrValidTagForType, rb.tag)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("tag", ErrValidTagForType, rb.tag))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.Name); err != nil {
-		return fieldError("Name", err, rb.RemittanceData.Name)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("Name", err, rb.RemittanceData.Name))
 	}
 	if err := rb.isIdentificationType(rb.IdentificationType); err != nil {
-		return fieldError("IdentificationType", err, rb.IdentificationType)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("IdentificationType", err, rb.IdentificationType))
 	}
 	switch rb.IdentificationType {
 	case OrganizationID:
 		if err := rb.isOrganizationIdentificationCode(rb.IdentificationCode); err != nil {
-			return fieldError("IdentificationCode", err, rb.IdentificationCode)
+			addFieldError(&errs, TagRemittanceBeneficiary, fieldError("IdentificationCode", err, rb.IdentificationCode))
 		}
 	case PrivateID:
 		if err := rb.isPrivateIdentificationCode(rb.IdentificationCode); err != nil {
-			return fieldError("IdentificationCode", err, rb.IdentificationCode)
+			addFieldError(&errs, TagRemittanceBeneficiary, fieldError("IdentificationCode", err, rb.IdentificationCode))
 		}
 	}
 	if err := rb.isAlphanumeric(rb.IdentificationNumber); err != nil {
-		return fieldError("IdentificationNumber", err, rb.IdentificationNumber)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("IdentificationNumber", err, rb.IdentificationNumber))
 	}
 	if err := rb.isAlphanumeric(rb.IdentificationNumberIssuer); err != nil {
-		return fieldError("IdentificationNumberIssuer", err, rb.IdentificationNumberIssuer)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("IdentificationNumberIssuer", err, rb.IdentificationNumberIssuer))
 	}
 	if err := rb.isAddressType(rb.RemittanceData.AddressType); err != nil {
-		return fieldError("AddressType", err, rb.RemittanceData.AddressType)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("AddressType", err, rb.RemittanceData.AddressType))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.Department); err != nil {
-		return fieldError("Department", err, rb.RemittanceData.Department)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("Department", err, rb.RemittanceData.Department))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.SubDepartment); err != nil {
-		return fieldError("SubDepartment", err, rb.RemittanceData.SubDepartment)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("SubDepartment", err, rb.RemittanceData.SubDepartment))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.StreetName); err != nil {
-		return fieldError("StreetName", err, rb.RemittanceData.StreetName)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("StreetName", err, rb.RemittanceData.StreetName))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.BuildingNumber); err != nil {
-		return fieldError("BuildingNumber", err, rb.RemittanceData.BuildingNumber)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("BuildingNumber", err, rb.RemittanceData.BuildingNumber))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.PostCode); err != nil {
-		return fieldError("PostCode", err, rb.RemittanceData.PostCode)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("PostCode", err, rb.RemittanceData.PostCode))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.TownName); err != nil {
-		return fieldError("TownName", err, rb.RemittanceData.TownName)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("TownName", err, rb.RemittanceData.TownName))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.CountrySubDivisionState); err != nil {
-		return fieldError("CountrySubDivisionState", err, rb.RemittanceData.CountrySubDivisionState)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("CountrySubDivisionState", err, rb.RemittanceData.CountrySubDivisionState))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.Country); err != nil {
-		return fieldError("Country", err, rb.RemittanceData.Country)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("Country", err, rb.RemittanceData.Country))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, rb.RemittanceData.AddressLineOne)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("AddressLineOne", err, rb.RemittanceData.AddressLineOne))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, rb.RemittanceData.AddressLineTwo)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("AddressLineTwo", err, rb.RemittanceData.AddressLineTwo))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, rb.RemittanceData.AddressLineThree)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("AddressLineThree", err, rb.RemittanceData.AddressLineThree))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineFour); err != nil {
-		return fieldError("AddressLineFour", err, rb.RemittanceData.AddressLineFour)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("AddressLineFour", err, rb.RemittanceData.AddressLineFour))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineFive); err != nil {
-		return fieldError("AddressLineFive", err, rb.RemittanceData.AddressLineFive)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("AddressLineFive", err, rb.RemittanceData.AddressLineFive))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineSix); err != nil {
-		return fieldError("AddressLineSix", err, rb.RemittanceData.AddressLineSix)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("AddressLineSix", err, rb.RemittanceData.AddressLineSix))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineSeven); err != nil {
-		return fieldError("AddressLineSeven", err, rb.RemittanceData.AddressLineSeven)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("AddressLineSeven", err, rb.RemittanceData.AddressLineSeven))
 	}
 	if err := rb.isAlphanumeric(rb.RemittanceData.CountryOfResidence); err != nil {
-		return fieldError("CountryOfResidence", err, rb.RemittanceData.CountryOfResidence)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("CountryOfResidence", err, rb.RemittanceData.CountryOfResidence))
 	}
 
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (rb *RemittanceBeneficiary) fieldInclusion() error {
+func (rb *RemittanceBeneficiary) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if rb.RemittanceData.Name == "" {
-		return fieldError("Name", ErrFieldRequired)
+		addFieldError(&errs, TagRemittanceBeneficiary, fieldError("Name", ErrFieldRequired))
 	}
 
 	if rb.IdentificationCode == PICDateBirthPlace {
 		if rb.IdentificationNumber != "" {
-			return fieldError("IdentificationNumber", ErrInvalidProperty, rb.IdentificationNumber)
+			addFieldError(&errs, TagRemittanceBeneficiary, fieldError("IdentificationNumber", ErrInvalidProperty, rb.IdentificationNumber))
 		}
 	}
 	if rb.IdentificationNumber == "" || rb.IdentificationCode == OICSWIFTBICORBEI ||
 		rb.IdentificationCode == PICDateBirthPlace {
 		if rb.IdentificationNumberIssuer != "" {
-			return fieldError("IdentificationNumberIssuer", ErrInvalidProperty, rb.IdentificationNumberIssuer)
+			addFieldError(&errs, TagRemittanceBeneficiary, fieldError("IdentificationNumberIssuer", ErrInvalidProperty, rb.IdentificationNumberIssuer))
 		}
 	}
 	if rb.IdentificationCode != PICDateBirthPlace {
 		if rb.RemittanceData.DateBirthPlace != "" {
-			return fieldError("DateBirthPlace", ErrInvalidProperty, rb.RemittanceData.DateBirthPlace)
+			addFieldError(&errs, TagRemittanceBeneficiary, fieldError("DateBirthPlace", ErrInvalidProperty, rb.RemittanceData.DateBirthPlace))
 		}
 	}
 
-	return nil
+	return errs
 }
 
 // NameField gets a string of the Name field
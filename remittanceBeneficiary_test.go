@@ -349,7 +349,7 @@ func TestParseRemittanceBeneficiaryWrongLength(t *testing.T) {
 
 	err := r.parseRemittanceBeneficiary()
 
-	require.EqualError(t, err, r.parseError(fieldError("Name", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "Name", ErrRequireDelimiter)).Error())
 }
 
 // TestParseRemittanceBeneficiaryReaderParseError parses a wrong RemittanceBeneficiary reader parse error
@@ -364,7 +364,7 @@ func TestParseRemittanceBeneficiaryReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("Name", ErrNonAlphanumeric, "®ame")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("Name", ErrNonAlphanumeric, "®ame"))).Error())
 }
 
 // TestRemittanceBeneficiaryTagError validates a RemittanceBeneficiary tag
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // RemittanceFreeText is the remittance free text
@@ -49,21 +51,21 @@ func (rft *RemittanceFreeText) Parse(record string) error {
 
 	value, read, err := rft.parseVariableStringField(record[length:], 140)
 	if err != nil {
-		return fieldError("LineOne", err)
+		return fieldErrorAt(length, "LineOne", err)
 	}
 	rft.LineOne = value
 	length += read
 
 	value, read, err = rft.parseVariableStringField(record[length:], 140)
 	if err != nil {
-		return fieldError("LineTwo", err)
+		return fieldErrorAt(length, "LineTwo", err)
 	}
 	rft.LineTwo = value
 	length += read
 
 	value, read, err = rft.parseVariableStringField(record[length:], 140)
 	if err != nil {
-		return fieldError("LineThree", err)
+		return fieldErrorAt(length, "LineThree", err)
 	}
 	rft.LineThree = value
 	length += read
@@ -114,21 +116,27 @@ func (rft *RemittanceFreeText) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on RemittanceFreeText and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (rft *RemittanceFreeText) Validate() error {
+	return rft.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on RemittanceFreeText and returns every error found.
+// Only the first error for each field is returned.
+func (rft *RemittanceFreeText) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if rft.tag != TagRemittanceFreeText {
-		return fieldError("tag", ErrValidTagForType, rft.tag)
+		addFieldError(&errs, TagRemittanceFreeText, fieldError("tag", ErrValidTagForType, rft.tag))
 	}
 	if err := rft.isAlphanumeric(rft.LineOne); err != nil {
-		return fieldError("LineOne", err, rft.LineOne)
+		addFieldError(&errs, TagRemittanceFreeText, fieldError("LineOne", err, rft.LineOne))
 	}
 	if err := rft.isAlphanumeric(rft.LineTwo); err != nil {
-		return fieldError("LineTwo", err, rft.LineTwo)
+		addFieldError(&errs, TagRemittanceFreeText, fieldError("LineTwo", err, rft.LineTwo))
 	}
 	if err := rft.isAlphanumeric(rft.LineThree); err != nil {
-		return fieldError("LineThree", err, rft.LineThree)
+		addFieldError(&errs, TagRemittanceFreeText, fieldError("LineThree", err, rft.LineThree))
 	}
-	return nil
+	return errs
 }
 
 // LineOneField gets a string of the LineOne field
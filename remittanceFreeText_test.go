@@ -62,7 +62,7 @@ func TestParseRemittanceFreeTextWrongLength(t *testing.T) {
 
 	err := r.parseRemittanceFreeText()
 
-	require.EqualError(t, err, r.parseError(fieldError("LineOne", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "LineOne", ErrRequireDelimiter)).Error())
 }
 
 // TestParseRemittanceFreeTextReaderParseError parses a wrong RemittanceFreeText reader parse error
@@ -77,7 +77,7 @@ func TestParseRemittanceFreeTextReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("LineOne", ErrNonAlphanumeric, "Re®ittance Free Text Line One")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("LineOne", ErrNonAlphanumeric, "Re®ittance Free Text Line One"))).Error())
 }
 
 // TestRemittanceFreeTextTagError validates a RemittanceFreeText tag
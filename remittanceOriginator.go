@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // RemittanceOriginator is remittance originator
@@ -65,203 +67,203 @@ func (ro *RemittanceOriginator) Parse(record string) error {
 
 	value, read, err := ro.parseFixedStringField(record[length:], 2)
 	if err != nil {
-		return fieldError("IdentificationType", err)
+		return fieldErrorAt(length, "IdentificationType", err)
 	}
 	ro.IdentificationType = value
 	length += read
 
 	value, read, err = ro.parseFixedStringField(record[length:], 4)
 	if err != nil {
-		return fieldError("IdentificationCode", err)
+		return fieldErrorAt(length, "IdentificationCode", err)
 	}
 	ro.IdentificationCode = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 140)
 	if err != nil {
-		return fieldError("Name", err)
+		return fieldErrorAt(length, "Name", err)
 	}
 	ro.RemittanceData.Name = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("IdentificationNumber", err)
+		return fieldErrorAt(length, "IdentificationNumber", err)
 	}
 	ro.IdentificationNumber = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("IdentificationNumberIssuer", err)
+		return fieldErrorAt(length, "IdentificationNumberIssuer", err)
 	}
 	ro.IdentificationNumberIssuer = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 82)
 	if err != nil {
-		return fieldError("DateBirthPlace", err)
+		return fieldErrorAt(length, "DateBirthPlace", err)
 	}
 	ro.RemittanceData.DateBirthPlace = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 4)
 	if err != nil {
-		return fieldError("AddressType", err)
+		return fieldErrorAt(length, "AddressType", err)
 	}
 	ro.RemittanceData.AddressType = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("Department", err)
+		return fieldErrorAt(length, "Department", err)
 	}
 	ro.RemittanceData.Department = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("SubDepartment", err)
+		return fieldErrorAt(length, "SubDepartment", err)
 	}
 	ro.RemittanceData.SubDepartment = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("StreetName", err)
+		return fieldErrorAt(length, "StreetName", err)
 	}
 	ro.RemittanceData.StreetName = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 16)
 	if err != nil {
-		return fieldError("BuildingNumber", err)
+		return fieldErrorAt(length, "BuildingNumber", err)
 	}
 	ro.RemittanceData.BuildingNumber = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 16)
 	if err != nil {
-		return fieldError("PostCode", err)
+		return fieldErrorAt(length, "PostCode", err)
 	}
 	ro.RemittanceData.PostCode = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("TownName", err)
+		return fieldErrorAt(length, "TownName", err)
 	}
 	ro.RemittanceData.TownName = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("CountrySubDivisionState", err)
+		return fieldErrorAt(length, "CountrySubDivisionState", err)
 	}
 	ro.RemittanceData.CountrySubDivisionState = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 2)
 	if err != nil {
-		return fieldError("Country", err)
+		return fieldErrorAt(length, "Country", err)
 	}
 	ro.RemittanceData.Country = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineOne", err)
+		return fieldErrorAt(length, "AddressLineOne", err)
 	}
 	ro.RemittanceData.AddressLineOne = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineTwo", err)
+		return fieldErrorAt(length, "AddressLineTwo", err)
 	}
 	ro.RemittanceData.AddressLineTwo = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineThree", err)
+		return fieldErrorAt(length, "AddressLineThree", err)
 	}
 	ro.RemittanceData.AddressLineThree = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineFour", err)
+		return fieldErrorAt(length, "AddressLineFour", err)
 	}
 	ro.RemittanceData.AddressLineFour = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineFive", err)
+		return fieldErrorAt(length, "AddressLineFive", err)
 	}
 	ro.RemittanceData.AddressLineFive = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineSix", err)
+		return fieldErrorAt(length, "AddressLineSix", err)
 	}
 	ro.RemittanceData.AddressLineSix = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 70)
 	if err != nil {
-		return fieldError("AddressLineSeven", err)
+		return fieldErrorAt(length, "AddressLineSeven", err)
 	}
 	ro.RemittanceData.AddressLineSeven = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 2)
 	if err != nil {
-		return fieldError("CountryOfResidence", err)
+		return fieldErrorAt(length, "CountryOfResidence", err)
 	}
 	ro.RemittanceData.CountryOfResidence = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 140)
 	if err != nil {
-		return fieldError("ContactName", err)
+		return fieldErrorAt(length, "ContactName", err)
 	}
 	ro.ContactName = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("ContactPhoneNumber", err)
+		return fieldErrorAt(length, "ContactPhoneNumber", err)
 	}
 	ro.ContactPhoneNumber = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("ContactMobileNumber", err)
+		return fieldErrorAt(length, "ContactMobileNumber", err)
 	}
 	ro.ContactMobileNumber = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("ContactFaxNumber", err)
+		return fieldErrorAt(length, "ContactFaxNumber", err)
 	}
 	ro.ContactFaxNumber = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 2048)
 	if err != nil {
-		return fieldError("ContactElectronicAddress", err)
+		return fieldErrorAt(length, "ContactElectronicAddress", err)
 	}
 	ro.ContactElectronicAddress = value
 	length += read
 
 	value, read, err = ro.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("ContactOther", err)
+		return fieldErrorAt(length, "ContactOther", err)
 	}
 	ro.ContactOther = value
 	length += read
@@ -338,141 +340,145 @@ func (ro *RemittanceOriginator) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on RemittanceOriginator and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 // * Identification Type, Identification Code and Name are mandatory.
 // * Identification Number is mandatory for all Identification Codes except PICDateBirthPlace.
 // * Identification Number is not permitted for Identification Code PICDateBirthPlace.
 // * Identification Number Issuer is not permitted for Identification Code OICSWIFTBICORBEI and PICDateBirthPlace.
 // * Date & Place of Birth is only permitted for Identification Code PICDateBirthPlace.
-func (ro *RemittanceOriginator) Validate() error { //nolint:gocyclo
-	if err := ro.fieldInclusion(); err != nil {
-		return err
-	}
+func (ro *RemittanceOriginator) Validate() error {
+	return ro.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on RemittanceOriginator and returns every error found.
+// Only the first error for each field is returned.
+func (ro *RemittanceOriginator) ValidateAll() base.ErrorList { //nolint:gocyclo
+	errs := ro.fieldInclusion()
 	if ro.tag != TagRemittanceOriginator {
-		return fieldError("tag", ErrValidTagForType, ro.tag)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("tag", ErrValidTagForType, ro.tag))
 	}
 	if err := ro.isIdentificationType(ro.IdentificationType); err != nil {
-		return fieldError("IdentificationType", err, ro.IdentificationType)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("IdentificationType", err, ro.IdentificationType))
 	}
 
 	switch ro.IdentificationType {
 	case OrganizationID:
 		if err := ro.isOrganizationIdentificationCode(ro.IdentificationCode); err != nil {
-			return fieldError("IdentificationCode", err, ro.IdentificationCode)
+			addFieldError(&errs, TagRemittanceOriginator, fieldError("IdentificationCode", err, ro.IdentificationCode))
 		}
 
 	case PrivateID:
 		if err := ro.isPrivateIdentificationCode(ro.IdentificationCode); err != nil {
-			return fieldError("IdentificationCode", err, ro.IdentificationCode)
+			addFieldError(&errs, TagRemittanceOriginator, fieldError("IdentificationCode", err, ro.IdentificationCode))
 		}
 	}
 
 	if err := ro.isAlphanumeric(ro.IdentificationNumber); err != nil {
-		return fieldError("IdentificationNumber", err, ro.IdentificationNumber)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("IdentificationNumber", err, ro.IdentificationNumber))
 	}
 	if err := ro.isAlphanumeric(ro.IdentificationNumberIssuer); err != nil {
-		return fieldError("IdentificationNumberIssuer", err, ro.IdentificationNumberIssuer)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("IdentificationNumberIssuer", err, ro.IdentificationNumberIssuer))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.Name); err != nil {
-		return fieldError("Name", err, ro.RemittanceData.Name)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("Name", err, ro.RemittanceData.Name))
 	}
 	if err := ro.isAddressType(ro.RemittanceData.AddressType); err != nil {
-		return fieldError("AddressType", err, ro.RemittanceData.AddressType)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("AddressType", err, ro.RemittanceData.AddressType))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.Department); err != nil {
-		return fieldError("Department", err, ro.RemittanceData.Department)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("Department", err, ro.RemittanceData.Department))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.SubDepartment); err != nil {
-		return fieldError("SubDepartment", err, ro.RemittanceData.SubDepartment)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("SubDepartment", err, ro.RemittanceData.SubDepartment))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.StreetName); err != nil {
-		return fieldError("StreetName", err, ro.RemittanceData.StreetName)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("StreetName", err, ro.RemittanceData.StreetName))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.BuildingNumber); err != nil {
-		return fieldError("BuildingNumber", err, ro.RemittanceData.BuildingNumber)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("BuildingNumber", err, ro.RemittanceData.BuildingNumber))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.PostCode); err != nil {
-		return fieldError("PostCode", err, ro.RemittanceData.PostCode)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("PostCode", err, ro.RemittanceData.PostCode))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.TownName); err != nil {
-		return fieldError("TownName", err, ro.RemittanceData.TownName)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("TownName", err, ro.RemittanceData.TownName))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.CountrySubDivisionState); err != nil {
-		return fieldError("CountrySubDivisionState", err, ro.RemittanceData.CountrySubDivisionState)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("CountrySubDivisionState", err, ro.RemittanceData.CountrySubDivisionState))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.Country); err != nil {
-		return fieldError("Country", err, ro.RemittanceData.Country)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("Country", err, ro.RemittanceData.Country))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, ro.RemittanceData.AddressLineOne)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("AddressLineOne", err, ro.RemittanceData.AddressLineOne))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, ro.RemittanceData.AddressLineTwo)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("AddressLineTwo", err, ro.RemittanceData.AddressLineTwo))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, ro.RemittanceData.AddressLineThree)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("AddressLineThree", err, ro.RemittanceData.AddressLineThree))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineFour); err != nil {
-		return fieldError("AddressLineFour", err, ro.RemittanceData.AddressLineFour)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("AddressLineFour", err, ro.RemittanceData.AddressLineFour))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineFive); err != nil {
-		return fieldError("AddressLineFive", err, ro.RemittanceData.AddressLineFive)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("AddressLineFive", err, ro.RemittanceData.AddressLineFive))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineSix); err != nil {
-		return fieldError("AddressLineSix", err, ro.RemittanceData.AddressLineSix)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("AddressLineSix", err, ro.RemittanceData.AddressLineSix))
 	}
 	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineSeven); err != nil {
-		return fieldError("AddressLineSeven", err, ro.RemittanceData.AddressLineSeven)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("AddressLineSeven", err, ro.RemittanceData.AddressLineSeven))
 	}
 
 	if err := ro.isAlphanumeric(ro.RemittanceData.CountryOfResidence); err != nil {
-		return fieldError("CountryOfResidence", err, ro.RemittanceData.CountryOfResidence)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("CountryOfResidence", err, ro.RemittanceData.CountryOfResidence))
 	}
 	if err := ro.isAlphanumeric(ro.ContactName); err != nil {
-		return fieldError("ContactName", err, ro.ContactName)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("ContactName", err, ro.ContactName))
 	}
 	if err := ro.isAlphanumeric(ro.ContactPhoneNumber); err != nil {
-		return fieldError("ContactPhoneNumber", err, ro.ContactPhoneNumber)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("ContactPhoneNumber", err, ro.ContactPhoneNumber))
 	}
 	if err := ro.isAlphanumeric(ro.ContactMobileNumber); err != nil {
-		return fieldError("ContactMobileNumber", err, ro.ContactMobileNumber)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("ContactMobileNumber", err, ro.ContactMobileNumber))
 	}
 	if err := ro.isAlphanumeric(ro.ContactFaxNumber); err != nil {
-		return fieldError("ContactFaxNumber", err, ro.ContactFaxNumber)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("ContactFaxNumber", err, ro.ContactFaxNumber))
 	}
 	if err := ro.isAlphanumeric(ro.ContactElectronicAddress); err != nil {
-		return fieldError("ContactElectronicAddress", err, ro.ContactElectronicAddress)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("ContactElectronicAddress", err, ro.ContactElectronicAddress))
 	}
 	if err := ro.isAlphanumeric(ro.ContactOther); err != nil {
-		return fieldError("ContactOther", err, ro.ContactOther)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("ContactOther", err, ro.ContactOther))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (ro *RemittanceOriginator) fieldInclusion() error {
+func (ro *RemittanceOriginator) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if ro.RemittanceData.Name == "" {
-		return fieldError("Name", ErrFieldRequired)
+		addFieldError(&errs, TagRemittanceOriginator, fieldError("Name", ErrFieldRequired))
 	}
 	if ro.IdentificationCode == PICDateBirthPlace {
 		if ro.IdentificationNumber != "" {
-			return fieldError("IdentificationNumber", ErrInvalidProperty, ro.IdentificationNumber)
+			addFieldError(&errs, TagRemittanceOriginator, fieldError("IdentificationNumber", ErrInvalidProperty, ro.IdentificationNumber))
 		}
 	}
 
 	if ro.IdentificationNumber == "" || ro.IdentificationCode == OICSWIFTBICORBEI ||
 		ro.IdentificationCode == PICDateBirthPlace {
 		if ro.IdentificationNumberIssuer != "" {
-			return fieldError("IdentificationNumberIssuer", ErrInvalidProperty, ro.IdentificationNumberIssuer)
+			addFieldError(&errs, TagRemittanceOriginator, fieldError("IdentificationNumberIssuer", ErrInvalidProperty, ro.IdentificationNumberIssuer))
 		}
 	}
 	if ro.IdentificationCode != PICDateBirthPlace {
 		if ro.RemittanceData.DateBirthPlace != "" {
-			return fieldError("DateBirthPlace", ErrInvalidProperty, ro.RemittanceData.DateBirthPlace)
+			addFieldError(&errs, TagRemittanceOriginator, fieldError("DateBirthPlace", ErrInvalidProperty, ro.RemittanceData.DateBirthPlace))
 		}
 	}
-	return nil
+	return errs
 }
 
 // IdentificationTypeField gets a string of the IdentificationType field
@@ -417,7 +417,7 @@ func TestParseRemittanceOriginatorWrongLength(t *testing.T) {
 
 	err := r.parseRemittanceOriginator()
 
-	require.EqualError(t, err, r.parseError(fieldError("Name", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(12, "Name", ErrRequireDelimiter)).Error())
 }
 
 // TestRemittanceOriginatorTagError validates a RemittanceOriginator tag
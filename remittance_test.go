@@ -104,7 +104,7 @@ func TestParseRemittanceWrongLength(t *testing.T) {
 
 	err := r.parseRemittance()
 
-	require.EqualError(t, err, r.parseError(fieldError("SwiftLineFour", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(120, "SwiftLineFour", ErrRequireDelimiter)).Error())
 }
 
 // TestParseRemittanceReaderParseError parses a wrong Remittance reader parse error
@@ -119,7 +119,7 @@ func TestParseRemittanceReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("SwiftLineOne", ErrNonAlphanumeric, "®wift Line One")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("SwiftLineOne", ErrNonAlphanumeric, "®wift Line One"))).Error())
 }
 
 // TestRemittanceTagError validates a Remittance tag
@@ -0,0 +1,185 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+)
+
+// ErrNotReversible is returned for a FEDWireMessage whose business function code has no reversal subtypes, such as
+// a drawdown request or service message
+var ErrNotReversible = errors.New("cannot be reversed")
+
+// reversibleBusinessFunctionCodes are the business function codes whose TypeSubTypes include ReversalTransfer and
+// ReversalPriorDayTransfer
+var reversibleBusinessFunctionCodes = []string{
+	BankTransfer, CustomerTransfer, CustomerTransferPlus, CheckSameDaySettlement, DepositSendersAccount,
+	FEDFundsReturned, FEDFundsSold,
+}
+
+// NewReversalRequest returns a request for the reversal of the original FEDWireMessage, with the RequestReversal
+// subtype, or RequestReversalPriorDayTransfer when priorDay is true. Like the original it is sent by the
+// SenderDepositoryInstitution to the ReceiverDepositoryInstitution, for the same Amount and parties. A request for
+// the reversal of a CustomerTransferPlus is a CustomerTransferPlus, and of any other transfer a BFCServiceMessage.
+//
+// The PreviousMessageIdentifier is the IMAD of the original, or its OMAD when it has no IMAD. The request has no IMAD,
+// so it is validated once one is assigned.
+func NewReversalRequest(original *FEDWireMessage, priorDay bool) (FEDWireMessage, error) {
+	subTypeCode := RequestReversal
+	if priorDay {
+		subTypeCode = RequestReversalPriorDayTransfer
+	}
+	fwm, err := newReversalMessage(original, subTypeCode)
+	if err != nil {
+		return fwm, err
+	}
+	if original.BusinessFunctionCode.BusinessFunctionCode != CustomerTransferPlus {
+		fwm.BusinessFunctionCode.BusinessFunctionCode = BFCServiceMessage
+	}
+
+	if original.SenderDepositoryInstitution != nil {
+		sdi := *original.SenderDepositoryInstitution
+		fwm.SenderDepositoryInstitution = &sdi
+	}
+	if original.ReceiverDepositoryInstitution != nil {
+		rdi := *original.ReceiverDepositoryInstitution
+		fwm.ReceiverDepositoryInstitution = &rdi
+	}
+	if original.Originator != nil {
+		o := *original.Originator
+		fwm.Originator = &o
+	}
+	if original.OriginatorOptionF != nil && fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus {
+		oof := *original.OriginatorOptionF
+		fwm.OriginatorOptionF = &oof
+	}
+	if original.OriginatorFI != nil {
+		ofi := *original.OriginatorFI
+		fwm.OriginatorFI = &ofi
+	}
+	if original.BeneficiaryFI != nil {
+		bfi := *original.BeneficiaryFI
+		fwm.BeneficiaryFI = &bfi
+	}
+	if original.Beneficiary != nil {
+		ben := *original.Beneficiary
+		fwm.Beneficiary = &ben
+	}
+	return fwm, nil
+}
+
+// NewReversal returns the reversal of the original FEDWireMessage, with the ReversalTransfer subtype, or
+// ReversalPriorDayTransfer when priorDay is true. It returns the Amount of the original from its
+// ReceiverDepositoryInstitution to its SenderDepositoryInstitution, with the same business function code. The
+// Originator and OriginatorFI of the reversal are the Beneficiary and BeneficiaryFI of the original, and its
+// Beneficiary and BeneficiaryFI are the Originator and OriginatorFI of the original. An OriginatorOptionF is not
+// mirrored, so the Beneficiary of the reversal of a transfer with one must be set.
+//
+// The PreviousMessageIdentifier is the IMAD of the original, or its OMAD when it has no IMAD. The reversal has no
+// IMAD, so it is validated once one is assigned.
+func NewReversal(original *FEDWireMessage, priorDay bool) (FEDWireMessage, error) {
+	subTypeCode := ReversalTransfer
+	if priorDay {
+		subTypeCode = ReversalPriorDayTransfer
+	}
+	fwm, err := newReversalMessage(original, subTypeCode)
+	if err != nil {
+		return fwm, err
+	}
+
+	if sdi := original.SenderDepositoryInstitution; sdi != nil {
+		rdi := NewReceiverDepositoryInstitution()
+		rdi.ReceiverABANumber = sdi.SenderABANumber
+		rdi.ReceiverShortName = sdi.SenderShortName
+		fwm.ReceiverDepositoryInstitution = rdi
+	}
+	if rdi := original.ReceiverDepositoryInstitution; rdi != nil {
+		sdi := NewSenderDepositoryInstitution()
+		sdi.SenderABANumber = rdi.ReceiverABANumber
+		sdi.SenderShortName = rdi.ReceiverShortName
+		fwm.SenderDepositoryInstitution = sdi
+	}
+	if ben := original.Beneficiary; ben != nil {
+		o := NewOriginator()
+		o.Personal = ben.Personal
+		fwm.Originator = o
+	}
+	if o := original.Originator; o != nil {
+		ben := NewBeneficiary()
+		ben.Personal = o.Personal
+		fwm.Beneficiary = ben
+	}
+	if bfi := original.BeneficiaryFI; bfi != nil {
+		ofi := NewOriginatorFI()
+		ofi.FinancialInstitution = bfi.FinancialInstitution
+		fwm.OriginatorFI = ofi
+	}
+	if ofi := original.OriginatorFI; ofi != nil {
+		bfi := NewBeneficiaryFI()
+		bfi.FinancialInstitution = ofi.FinancialInstitution
+		fwm.BeneficiaryFI = bfi
+	}
+	return fwm, nil
+}
+
+// newReversalMessage returns a FEDWireMessage of the subtype with the TypeCode, business function code, Amount and
+// PreviousMessageIdentifier of the reversal of original
+func newReversalMessage(original *FEDWireMessage, subTypeCode string) (FEDWireMessage, error) {
+	fwm := FEDWireMessage{}
+	if original.TypeSubType == nil {
+		return fwm, fieldError("TypeSubType", ErrFieldRequired)
+	}
+	if original.BusinessFunctionCode == nil {
+		return fwm, fieldError("BusinessFunctionCode", ErrFieldRequired)
+	}
+	if !isReversible(original.BusinessFunctionCode.BusinessFunctionCode) {
+		return fwm, fieldError("BusinessFunctionCode", ErrNotReversible, original.BusinessFunctionCode.BusinessFunctionCode)
+	}
+	if original.Amount == nil {
+		return fwm, fieldError("Amount", ErrFieldRequired)
+	}
+	pmi, err := previousMessageIdentifierOf(original)
+	if err != nil {
+		return fwm, err
+	}
+
+	fwm.SenderSupplied = NewSenderSupplied()
+	tst := NewTypeSubType()
+	tst.TypeCode = original.TypeSubType.TypeCode
+	tst.SubTypeCode = subTypeCode
+	fwm.TypeSubType = tst
+	amt := NewAmount()
+	amt.Amount = original.Amount.Amount
+	fwm.Amount = amt
+	bfc := NewBusinessFunctionCode()
+	bfc.BusinessFunctionCode = original.BusinessFunctionCode.BusinessFunctionCode
+	fwm.BusinessFunctionCode = bfc
+	fwm.PreviousMessageIdentifier = pmi
+	return fwm, nil
+}
+
+// previousMessageIdentifierOf returns a PreviousMessageIdentifier of the IMAD of fwm, or of its OMAD when it has no
+// IMAD
+func previousMessageIdentifierOf(fwm *FEDWireMessage) (*PreviousMessageIdentifier, error) {
+	pmi := NewPreviousMessageIdentifier()
+	switch imad, omad := fwm.InputMessageAccountabilityData, fwm.OutputMessageAccountabilityData; {
+	case imad != nil && imad.InputCycleDate+imad.InputSource+imad.InputSequenceNumber != "":
+		pmi.PreviousMessageIdentifier = imad.InputCycleDateField() + imad.InputSourceField() + imad.InputSequenceNumberField()
+	case omad != nil && omad.OutputCycleDate+omad.OutputDestinationID+omad.OutputSequenceNumber != "":
+		pmi.PreviousMessageIdentifier = omad.OutputCycleDateField() + omad.OutputDestinationIDField() + omad.OutputSequenceNumberField()
+	default:
+		return nil, fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	}
+	return pmi, nil
+}
+
+func isReversible(businessFunctionCode string) bool {
+	for _, code := range reversibleBusinessFunctionCodes {
+		if code == businessFunctionCode {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mockReversibleCustomerTransfer() *FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20230705"
+	fwm.Originator = mockOriginator()
+	fwm.OriginatorFI = mockOriginatorFI()
+	fwm.OriginatorFI.FinancialInstitution.Name = "Originator FI"
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.BeneficiaryFI = mockBeneficiaryFI()
+	fwm.BeneficiaryFI.FinancialInstitution.Name = "Beneficiary FI"
+	return &fwm
+}
+
+func TestNewReversal(t *testing.T) {
+	original := mockReversibleCustomerTransfer()
+
+	fwm, err := NewReversal(original, false)
+	require.NoError(t, err)
+	require.Equal(t, FundsTransfer, fwm.TypeSubType.TypeCode)
+	require.Equal(t, ReversalTransfer, fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, CustomerTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, original.Amount.Amount, fwm.Amount.Amount)
+	require.Equal(t, "20230705Source08000001", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+
+	// the reversal is sent back from the receiver to the sender
+	require.Equal(t, "231380104", fwm.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, "Citadel", fwm.SenderDepositoryInstitution.SenderShortName)
+	require.Equal(t, "121042882", fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+	require.Equal(t, "Wells Fargo NA", fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+	require.Equal(t, original.Beneficiary.Personal, fwm.Originator.Personal)
+	require.Equal(t, original.Originator.Personal, fwm.Beneficiary.Personal)
+	require.Equal(t, "Beneficiary FI", fwm.OriginatorFI.FinancialInstitution.Name)
+	require.Equal(t, "Originator FI", fwm.BeneficiaryFI.FinancialInstitution.Name)
+
+	fwm.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	require.NoError(t, fwm.verify())
+
+	fwm, err = NewReversal(original, true)
+	require.NoError(t, err)
+	require.Equal(t, ReversalPriorDayTransfer, fwm.TypeSubType.SubTypeCode)
+}
+
+func TestNewReversal_errors(t *testing.T) {
+	original := mockReversibleCustomerTransfer()
+	original.BusinessFunctionCode.BusinessFunctionCode = BFCServiceMessage
+	_, err := NewReversal(original, false)
+	require.ErrorIs(t, err, ErrNotReversible)
+
+	original = mockReversibleCustomerTransfer()
+	original.InputMessageAccountabilityData = nil
+	_, err = NewReversal(original, false)
+	require.ErrorIs(t, err, ErrFieldRequired)
+
+	original.OutputMessageAccountabilityData = mockOutputMessageAccountabilityData()
+	fwm, err := NewReversal(original, false)
+	require.NoError(t, err)
+	require.Equal(t, "20190502Source08000001", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+
+	original.Amount = nil
+	_, err = NewReversal(original, false)
+	require.ErrorIs(t, err, ErrFieldRequired)
+}
+
+func TestNewReversalRequest(t *testing.T) {
+	original := mockReversibleCustomerTransfer()
+
+	fwm, err := NewReversalRequest(original, false)
+	require.NoError(t, err)
+	require.Equal(t, RequestReversal, fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, BFCServiceMessage, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, original.Amount.Amount, fwm.Amount.Amount)
+	require.Equal(t, "20230705Source08000001", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+
+	// the request is sent by the sender of the original
+	require.Equal(t, *original.SenderDepositoryInstitution, *fwm.SenderDepositoryInstitution)
+	require.Equal(t, *original.ReceiverDepositoryInstitution, *fwm.ReceiverDepositoryInstitution)
+	require.Equal(t, original.Originator.Personal, fwm.Originator.Personal)
+	require.Equal(t, original.Beneficiary.Personal, fwm.Beneficiary.Personal)
+
+	// the tags of the request are copies
+	fwm.SenderDepositoryInstitution.SenderShortName = "Changed"
+	require.Equal(t, "Wells Fargo NA", original.SenderDepositoryInstitution.SenderShortName)
+
+	fwm.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	require.NoError(t, fwm.verify())
+
+	fwm, err = NewReversalRequest(original, true)
+	require.NoError(t, err)
+	require.Equal(t, RequestReversalPriorDayTransfer, fwm.TypeSubType.SubTypeCode)
+}
+
+func TestNewReversalRequest_customerTransferPlus(t *testing.T) {
+	original := mockReversibleCustomerTransfer()
+	original.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	original.Originator = nil
+	original.OriginatorFI = nil
+	original.OriginatorOptionF = mockOriginatorOptionF()
+
+	fwm, err := NewReversalRequest(original, false)
+	require.NoError(t, err)
+	require.Equal(t, CustomerTransferPlus, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, original.OriginatorOptionF.Name, fwm.OriginatorOptionF.Name)
+
+	fwm.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	require.NoError(t, fwm.verify())
+}
@@ -0,0 +1,155 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/moov-io/base"
+)
+
+// ErrRoutingNumberCheckDigit is returned for a routing number whose check digit is not its ABA checksum
+var ErrRoutingNumberCheckDigit = errors.New("has an invalid routing number check digit")
+
+// routingNumberPrefix begins a line of an FI to FI tag which holds a Fed routing number, such as //FW121042882
+const routingNumberPrefix = "//FW"
+
+// lineNames are the names of the lines of the FI to FI and advice tags
+var lineNames = []string{"LineOne", "LineTwo", "LineThree", "LineFour", "LineFive", "LineSix"}
+
+// CalculateCheckDigit returns the ABA check digit of the first 8 digits of a routing number, or -1 when they are
+// not digits
+func CalculateCheckDigit(routingNumber string) int {
+	if len(routingNumber) < 8 || !isDigits(routingNumber[:8]) {
+		return -1
+	}
+	weights := [8]int{3, 7, 1, 3, 7, 1, 3, 7}
+	sum := 0
+	for i, w := range weights {
+		sum += int(routingNumber[i]-'0') * w
+	}
+	return (10 - sum%10) % 10
+}
+
+// CheckRoutingNumber returns an error when the routing number is not 9 digits ending with its ABA check digit
+func CheckRoutingNumber(routingNumber string) error {
+	if !isDigits(routingNumber) {
+		return ErrNonNumeric
+	}
+	if len(routingNumber) != 9 {
+		return ErrValidLength
+	}
+	if CalculateCheckDigit(routingNumber) != int(routingNumber[8]-'0') {
+		return ErrRoutingNumberCheckDigit
+	}
+	return nil
+}
+
+// validateRoutingNumbers validates the check digit of every routing number within a FEDWireMessage: the ABA
+// numbers of {3100} and {3400}, parties identified by a FEDRoutingNumber, the {5400} drawdown credit account and the
+// //FW lines of the {6xxx} FI to FI tags
+func (fwm *FEDWireMessage) validateRoutingNumbers() base.ErrorList {
+	var errs base.ErrorList
+	check := func(tag, field, routingNumber string) {
+		if err := CheckRoutingNumber(routingNumber); err != nil {
+			addFieldError(&errs, tag, fieldError(field, err, routingNumber))
+		}
+	}
+	checkIdentifier := func(tag, code, identifier string) {
+		if code == FEDRoutingNumber {
+			check(tag, "Identifier", identifier)
+		}
+	}
+	checkLines := func(tag string, lines ...string) {
+		for i, line := range lines {
+			if strings.HasPrefix(line, routingNumberPrefix) {
+				check(tag, lineNames[i], routingNumberOf(line[len(routingNumberPrefix):]))
+			}
+		}
+	}
+
+	if fwm.SenderDepositoryInstitution != nil {
+		check(TagSenderDepositoryInstitution, "SenderABANumber", fwm.SenderDepositoryInstitution.SenderABANumber)
+	}
+	if fwm.ReceiverDepositoryInstitution != nil {
+		check(TagReceiverDepositoryInstitution, "ReceiverABANumber", fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+	}
+	if fi := fwm.BeneficiaryIntermediaryFI; fi != nil {
+		checkIdentifier(TagBeneficiaryIntermediaryFI, fi.FinancialInstitution.IdentificationCode, fi.FinancialInstitution.Identifier)
+	}
+	if fi := fwm.BeneficiaryFI; fi != nil {
+		checkIdentifier(TagBeneficiaryFI, fi.FinancialInstitution.IdentificationCode, fi.FinancialInstitution.Identifier)
+	}
+	if ben := fwm.Beneficiary; ben != nil {
+		checkIdentifier(TagBeneficiary, ben.Personal.IdentificationCode, ben.Personal.Identifier)
+	}
+	if o := fwm.Originator; o != nil {
+		checkIdentifier(TagOriginator, o.Personal.IdentificationCode, o.Personal.Identifier)
+	}
+	if fi := fwm.OriginatorFI; fi != nil {
+		checkIdentifier(TagOriginatorFI, fi.FinancialInstitution.IdentificationCode, fi.FinancialInstitution.Identifier)
+	}
+	if fi := fwm.InstructingFI; fi != nil {
+		checkIdentifier(TagInstructingFI, fi.FinancialInstitution.IdentificationCode, fi.FinancialInstitution.Identifier)
+	}
+	if creditDD := fwm.AccountCreditedDrawdown; creditDD != nil {
+		check(TagAccountCreditedDrawdown, "DrawdownCreditAccountNumber", creditDD.DrawdownCreditAccountNumber)
+	}
+
+	if fi := fwm.FIReceiverFI; fi != nil {
+		checkLines(TagFIReceiverFI, fi.FIToFI.lines()...)
+	}
+	if fi := fwm.FIDrawdownDebitAccountAdvice; fi != nil {
+		checkLines(TagFIDrawdownDebitAccountAdvice, fi.Advice.lines()...)
+	}
+	if fi := fwm.FIIntermediaryFI; fi != nil {
+		checkLines(TagFIIntermediaryFI, fi.FIToFI.lines()...)
+	}
+	if fi := fwm.FIIntermediaryFIAdvice; fi != nil {
+		checkLines(TagFIIntermediaryFIAdvice, fi.Advice.lines()...)
+	}
+	if fi := fwm.FIBeneficiaryFI; fi != nil {
+		checkLines(TagFIBeneficiaryFI, fi.FIToFI.lines()...)
+	}
+	if fi := fwm.FIBeneficiaryFIAdvice; fi != nil {
+		checkLines(TagFIBeneficiaryFIAdvice, fi.Advice.lines()...)
+	}
+	if fi := fwm.FIBeneficiary; fi != nil {
+		checkLines(TagFIBeneficiary, fi.FIToFI.lines()...)
+	}
+	if fi := fwm.FIBeneficiaryAdvice; fi != nil {
+		checkLines(TagFIBeneficiaryAdvice, fi.Advice.lines()...)
+	}
+	if fi := fwm.FIAdditionalFIToFI; fi != nil {
+		checkLines(TagFIAdditionalFIToFI, fi.AdditionalFIToFI.lines()...)
+	}
+	return errs
+}
+
+// routingNumberOf returns the leading digits of s, which may be followed by other text
+func routingNumberOf(s string) string {
+	for i, r := range s {
+		if r < '0' || r > '9' {
+			return s[:i]
+		}
+	}
+	return s
+}
+
+// lines returns LineOne to LineSix of fi
+func (fi FIToFI) lines() []string {
+	return []string{fi.LineOne, fi.LineTwo, fi.LineThree, fi.LineFour, fi.LineFive, fi.LineSix}
+}
+
+// lines returns LineOne to LineSix of a
+func (a Advice) lines() []string {
+	return []string{a.LineOne, a.LineTwo, a.LineThree, a.LineFour, a.LineFive, a.LineSix}
+}
+
+// lines returns LineOne to LineSix of fi
+func (fi AdditionalFIToFI) lines() []string {
+	return []string{fi.LineOne, fi.LineTwo, fi.LineThree, fi.LineFour, fi.LineFive, fi.LineSix}
+}
@@ -0,0 +1,97 @@
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateCheckDigit(t *testing.T) {
+	require.Equal(t, 2, CalculateCheckDigit("121042882"))
+	require.Equal(t, 4, CalculateCheckDigit("23138010"))
+	require.Equal(t, 1, CalculateCheckDigit("021000021"))
+	require.Equal(t, -1, CalculateCheckDigit("1210428"))
+	require.Equal(t, -1, CalculateCheckDigit("1210A2882"))
+}
+
+func TestCheckRoutingNumber(t *testing.T) {
+	tests := []struct {
+		routingNumber string
+		err           error
+	}{
+		{"121042882", nil},
+		{"231380104", nil},
+		{"011000015", nil},
+		{"123456789", ErrRoutingNumberCheckDigit},
+		{"121042883", ErrRoutingNumberCheckDigit},
+		{"12104288", ErrValidLength},
+		{"1210428820", ErrValidLength},
+		{"", ErrValidLength},
+		{"12104288A", ErrNonNumeric},
+	}
+	for _, tt := range tests {
+		err := CheckRoutingNumber(tt.routingNumber)
+		if tt.err == nil {
+			require.NoError(t, err, tt.routingNumber)
+		} else {
+			require.ErrorIs(t, err, tt.err, tt.routingNumber)
+		}
+	}
+}
+
+func TestFEDWireMessage_validateRoutingNumbers(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	require.Empty(t, fwm.validateRoutingNumbers())
+
+	fwm.SenderDepositoryInstitution.SenderABANumber = "123456789"
+	fwm.ReceiverDepositoryInstitution.ReceiverABANumber = "12345678"
+	fwm.BeneficiaryFI = mockBeneficiaryFI()
+	fwm.BeneficiaryFI.FinancialInstitution.IdentificationCode = FEDRoutingNumber
+	fwm.BeneficiaryFI.FinancialInstitution.Identifier = "121042883"
+	fwm.Originator.Personal.IdentificationCode = FEDRoutingNumber
+	fwm.Originator.Personal.Identifier = "121042882"
+	fwm.AccountCreditedDrawdown = mockAccountCreditedDrawdown()
+	fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber = "111111111"
+	fwm.FIIntermediaryFI = mockFIIntermediaryFI()
+	fwm.FIIntermediaryFI.FIToFI.LineTwo = "//FW123456789 Bank"
+	fwm.FIBeneficiaryAdvice = mockFIBeneficiaryAdvice()
+	fwm.FIBeneficiaryAdvice.Advice.LineOne = "//FW231380104"
+
+	errs := fwm.validateRoutingNumbers()
+	expected := []struct {
+		tag       string
+		fieldName string
+		err       error
+	}{
+		{TagSenderDepositoryInstitution, "SenderABANumber", ErrRoutingNumberCheckDigit},
+		{TagReceiverDepositoryInstitution, "ReceiverABANumber", ErrValidLength},
+		{TagBeneficiaryFI, "Identifier", ErrRoutingNumberCheckDigit},
+		{TagAccountCreditedDrawdown, "DrawdownCreditAccountNumber", ErrRoutingNumberCheckDigit},
+		{TagFIIntermediaryFI, "LineTwo", ErrRoutingNumberCheckDigit},
+	}
+	require.Len(t, errs, len(expected))
+	for i := range expected {
+		var fe *FieldError
+		require.ErrorAs(t, errs[i], &fe)
+		require.Equal(t, expected[i].tag, fe.Tag)
+		require.Equal(t, expected[i].fieldName, fe.FieldName)
+		require.ErrorIs(t, errs[i], expected[i].err)
+	}
+}
+
+func TestFEDWireMessage_SkipRoutingNumberCheck(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	fwm.SenderDepositoryInstitution.SenderABANumber = "123456789"
+	require.ErrorIs(t, fwm.ValidateAll().Err(), ErrRoutingNumberCheckDigit)
+
+	fwm.ValidateOptions = &ValidateOpts{SkipRoutingNumberCheck: true}
+	require.Empty(t, fwm.ValidateAll())
+
+	// the format of the ABA number is still checked
+	fwm.SenderDepositoryInstitution.SenderABANumber = "12345678Z"
+	require.ErrorIs(t, fwm.ValidateAll().Err(), ErrNonNumeric)
+}
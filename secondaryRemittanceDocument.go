@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // SecondaryRemittanceDocument is the date of remittance document
@@ -51,28 +53,28 @@ func (srd *SecondaryRemittanceDocument) Parse(record string) error {
 
 	value, read, err := srd.parseFixedStringField(record[length:], 4)
 	if err != nil {
-		return fieldError("DocumentTypeCode", err)
+		return fieldErrorAt(length, "DocumentTypeCode", err)
 	}
 	srd.DocumentTypeCode = value
 	length += read
 
 	value, read, err = srd.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("ProprietaryDocumentTypeCode", err)
+		return fieldErrorAt(length, "ProprietaryDocumentTypeCode", err)
 	}
 	srd.ProprietaryDocumentTypeCode = value
 	length += read
 
 	value, read, err = srd.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("DocumentIdentificationNumber", err)
+		return fieldErrorAt(length, "DocumentIdentificationNumber", err)
 	}
 	srd.DocumentIdentificationNumber = value
 	length += read
 
 	value, read, err = srd.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("Issuer", err)
+		return fieldErrorAt(length, "Issuer", err)
 	}
 	srd.Issuer = value
 	length += read
@@ -124,48 +126,52 @@ func (srd *SecondaryRemittanceDocument) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on SecondaryRemittanceDocument and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 // * Document Type Code and Document Identification Number are mandatory.
 // * Proprietary Document Type Code is mandatory for Document Type Code PROP; otherwise not permitted.
 func (srd *SecondaryRemittanceDocument) Validate() error {
-	if err := srd.fieldInclusion(); err != nil {
-		return err
-	}
+	return srd.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on SecondaryRemittanceDocument and returns every error found.
+// Only the first error for each field is returned.
+func (srd *SecondaryRemittanceDocument) ValidateAll() base.ErrorList {
+	errs := srd.fieldInclusion()
 	if srd.tag != TagSecondaryRemittanceDocument {
-		return fieldError("tag", ErrValidTagForType, srd.tag)
+		addFieldError(&errs, TagSecondaryRemittanceDocument, fieldError("tag", ErrValidTagForType, srd.tag))
 	}
 	if err := srd.isDocumentTypeCode(srd.DocumentTypeCode); err != nil {
-		return fieldError("DocumentTypeCode", err, srd.DocumentTypeCode)
+		addFieldError(&errs, TagSecondaryRemittanceDocument, fieldError("DocumentTypeCode", err, srd.DocumentTypeCode))
 	}
 	if err := srd.isAlphanumeric(srd.ProprietaryDocumentTypeCode); err != nil {
-		return fieldError("ProprietaryDocumentTypeCode", err, srd.ProprietaryDocumentTypeCode)
+		addFieldError(&errs, TagSecondaryRemittanceDocument, fieldError("ProprietaryDocumentTypeCode", err, srd.ProprietaryDocumentTypeCode))
 	}
 	if err := srd.isAlphanumeric(srd.DocumentIdentificationNumber); err != nil {
-		return fieldError("DocumentIdentificationNumber", err, srd.DocumentIdentificationNumber)
+		addFieldError(&errs, TagSecondaryRemittanceDocument, fieldError("DocumentIdentificationNumber", err, srd.DocumentIdentificationNumber))
 	}
 	if err := srd.isAlphanumeric(srd.Issuer); err != nil {
-		return fieldError("Issuer", err, srd.Issuer)
+		addFieldError(&errs, TagSecondaryRemittanceDocument, fieldError("Issuer", err, srd.Issuer))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (srd *SecondaryRemittanceDocument) fieldInclusion() error {
+func (srd *SecondaryRemittanceDocument) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if srd.DocumentIdentificationNumber == "" {
-		return fieldError("DocumentIdentificationNumber", ErrFieldRequired)
+		addFieldError(&errs, TagSecondaryRemittanceDocument, fieldError("DocumentIdentificationNumber", ErrFieldRequired))
 	}
 	switch srd.DocumentTypeCode {
 	case ProprietaryDocumentType:
 		if srd.ProprietaryDocumentTypeCode == "" {
-			return fieldError("ProprietaryDocumentTypeCode", ErrFieldRequired)
+			addFieldError(&errs, TagSecondaryRemittanceDocument, fieldError("ProprietaryDocumentTypeCode", ErrFieldRequired))
 		}
 	default:
 		if srd.ProprietaryDocumentTypeCode != "" {
-			return fieldError("ProprietaryDocumentTypeCode", ErrInvalidProperty, srd.ProprietaryDocumentTypeCode)
+			addFieldError(&errs, TagSecondaryRemittanceDocument, fieldError("ProprietaryDocumentTypeCode", ErrInvalidProperty, srd.ProprietaryDocumentTypeCode))
 		}
 	}
-	return nil
+	return errs
 }
 
 // DocumentTypeCodeField
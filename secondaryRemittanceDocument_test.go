@@ -106,7 +106,7 @@ func TestParseSecondaryRemittanceDocumentWrongLength(t *testing.T) {
 
 	err := r.parseSecondaryRemittanceDocument()
 
-	require.EqualError(t, err, r.parseError(fieldError("ProprietaryDocumentTypeCode", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(10, "ProprietaryDocumentTypeCode", ErrRequireDelimiter)).Error())
 }
 
 // TestParseSecondaryRemittanceDocumentReaderParseError parses a wrong SecondaryRemittanceDocument reader parse error
@@ -121,7 +121,7 @@ func TestParseSecondaryRemittanceDocumentReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("DocumentTypeCode", ErrDocumentTypeCode, "ZZZZ")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("DocumentTypeCode", ErrDocumentTypeCode, "ZZZZ"))).Error())
 }
 
 // TestSecondaryRemittanceDocumentTagError validates a SecondaryRemittanceDocument tag
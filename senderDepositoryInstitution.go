@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // SenderDepositoryInstitution {3100}
@@ -47,14 +49,14 @@ func (sdi *SenderDepositoryInstitution) Parse(record string) error {
 
 	value, read, err := sdi.parseFixedStringField(record[length:], 9)
 	if err != nil {
-		return fieldError("SenderABANumber", err)
+		return fieldErrorAt(length, "SenderABANumber", err)
 	}
 	sdi.SenderABANumber = value
 	length += read
 
 	value, read, err = sdi.parseVariableStringField(record[length:], 18)
 	if err != nil {
-		return fieldError("SenderShortName", err)
+		return fieldErrorAt(length, "SenderShortName", err)
 	}
 	sdi.SenderShortName = value
 	length += read
@@ -100,30 +102,34 @@ func (sdi *SenderDepositoryInstitution) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on SenderDepositoryInstitution and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (sdi *SenderDepositoryInstitution) Validate() error {
-	if err := sdi.fieldInclusion(); err != nil {
-		return err
-	}
+	return sdi.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on SenderDepositoryInstitution and returns every error found.
+// Only the first error for each field is returned.
+func (sdi *SenderDepositoryInstitution) ValidateAll() base.ErrorList {
+	errs := sdi.fieldInclusion()
 	if sdi.tag != TagSenderDepositoryInstitution {
-		return fieldError("tag", ErrValidTagForType, sdi.tag)
+		addFieldError(&errs, TagSenderDepositoryInstitution, fieldError("tag", ErrValidTagForType, sdi.tag))
 	}
 	if err := sdi.isNumeric(sdi.SenderABANumber); err != nil {
-		return fieldError("SenderABANumber", err, sdi.SenderABANumber)
+		addFieldError(&errs, TagSenderDepositoryInstitution, fieldError("SenderABANumber", err, sdi.SenderABANumber))
 	}
 	if err := sdi.isAlphanumeric(sdi.SenderShortName); err != nil {
-		return fieldError("SenderShortName", err, sdi.SenderShortName)
+		addFieldError(&errs, TagSenderDepositoryInstitution, fieldError("SenderShortName", err, sdi.SenderShortName))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (sdi *SenderDepositoryInstitution) fieldInclusion() error {
+func (sdi *SenderDepositoryInstitution) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if sdi.SenderABANumber == "" {
-		return fieldError("SenderABANumber", ErrFieldRequired, sdi.SenderABANumber)
+		addFieldError(&errs, TagSenderDepositoryInstitution, fieldError("SenderABANumber", ErrFieldRequired, sdi.SenderABANumber))
 	}
-	return nil
+	return errs
 }
 
 // SenderABANumberField gets a string of the SenderABANumber field
@@ -63,7 +63,7 @@ func TestParseSenderWrongLength(t *testing.T) {
 
 	err := r.parseSenderDepositoryInstitution()
 
-	require.EqualError(t, err, r.parseError(fieldError("SenderABANumber", ErrValidLength)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "SenderABANumber", ErrValidLength)).Error())
 }
 
 // TestParseSenderReaderParseError parses a wrong Sender reader parse error
@@ -78,7 +78,7 @@ func TestParseSenderReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("SenderABANumber", ErrNonNumeric, "1210Z2882")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("SenderABANumber", ErrNonNumeric, "1210Z2882"))).Error())
 }
 
 // TestSenderDepositoryInstitutionTagError validates a SenderDepositoryInstitution tag
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // SenderReference is the SenderReference of the wire
@@ -45,7 +47,7 @@ func (sr *SenderReference) Parse(record string) error {
 
 	value, read, err := sr.parseVariableStringField(record[length:], 16)
 	if err != nil {
-		return fieldError("SenderReference", err)
+		return fieldErrorAt(length, "SenderReference", err)
 	}
 	sr.SenderReference = value
 	length += read
@@ -90,15 +92,21 @@ func (sr *SenderReference) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on SenderReference and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (sr *SenderReference) Validate() error {
+	return sr.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on SenderReference and returns every error found.
+// Only the first error for each field is returned.
+func (sr *SenderReference) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if sr.tag != TagSenderReference {
-		return fieldError("tag", ErrValidTagForType, sr.tag)
+		addFieldError(&errs, TagSenderReference, fieldError("tag", ErrValidTagForType, sr.tag))
 	}
 	if err := sr.isAlphanumeric(sr.SenderReference); err != nil {
-		return fieldError("SenderReference", err, sr.SenderReference)
+		addFieldError(&errs, TagSenderReference, fieldError("SenderReference", err, sr.SenderReference))
 	}
-	return nil
+	return errs
 }
 
 // FormatSenderReference returns SenderReference formatted according to the FormatOptions
@@ -40,7 +40,7 @@ func TestParseSenderReferenceWrongLength(t *testing.T) {
 
 	err := r.parseSenderReference()
 
-	require.EqualError(t, err, r.parseError(fieldError("SenderReference", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "SenderReference", ErrRequireDelimiter)).Error())
 }
 
 // TestParseSenderReferenceReaderParseError parses a wrong SenderReference reader parse error
@@ -55,7 +55,7 @@ func TestParseSenderReferenceReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("SenderReference", ErrNonAlphanumeric, "Sender®Referenc")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("SenderReference", ErrNonAlphanumeric, "Sender®Referenc"))).Error())
 }
 
 // TestSenderReferenceTagError validates a SenderReference tag
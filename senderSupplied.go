@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // SenderSupplied {1500}
@@ -55,13 +57,13 @@ func (ss *SenderSupplied) Parse(record string) error {
 
 	value, read, err := ss.parseFixedStringField(record[length:], 8)
 	if err != nil {
-		return fieldError("UserRequestCorrelation", err)
+		return fieldErrorAt(length, "UserRequestCorrelation", err)
 	}
 	ss.UserRequestCorrelation = value
 	length += read
 
 	if len(record) < length+1 {
-		return fieldError("TestProductionCode", ErrValidLength)
+		return fieldErrorAt(length, "TestProductionCode", ErrValidLength)
 	}
 
 	ss.TestProductionCode = ss.parseStringField(record[length : length+1])
@@ -113,32 +115,35 @@ func (ss *SenderSupplied) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on SenderSupplied and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (ss *SenderSupplied) Validate() error {
-	if err := ss.fieldInclusion(); err != nil {
-		return err
-	}
+	return ss.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on SenderSupplied and returns every error found.
+// Only the first error for each field is returned.
+func (ss *SenderSupplied) ValidateAll() base.ErrorList {
+	errs := ss.fieldInclusion()
 	if ss.tag != TagSenderSupplied {
-		return fieldError("tag", ErrValidTagForType, ss.tag)
+		addFieldError(&errs, TagSenderSupplied, fieldError("tag", ErrValidTagForType, ss.tag))
 	}
 	if ss.FormatVersion != FormatVersion {
-		return fieldError("FormatVersion", ErrFormatVersion, ss.FormatVersion)
+		addFieldError(&errs, TagSenderSupplied, fieldError("FormatVersion", ErrFormatVersion, ss.FormatVersion))
 	}
 	if err := ss.isAlphanumeric(ss.UserRequestCorrelation); err != nil {
-		return fieldError("UserRequestCorrelation", err, ss.UserRequestCorrelation)
+		addFieldError(&errs, TagSenderSupplied, fieldError("UserRequestCorrelation", err, ss.UserRequestCorrelation))
 	}
 	if err := ss.isTestProductionCode(ss.TestProductionCode); err != nil {
-		return fieldError("TestProductionCode", err, ss.TestProductionCode)
+		addFieldError(&errs, TagSenderSupplied, fieldError("TestProductionCode", err, ss.TestProductionCode))
 	}
 	if err := ss.isMessageDuplicationCode(ss.MessageDuplicationCode); err != nil {
-		return fieldError("MessageDuplicationCode", err, ss.MessageDuplicationCode)
+		addFieldError(&errs, TagSenderSupplied, fieldError("MessageDuplicationCode", err, ss.MessageDuplicationCode))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (ss *SenderSupplied) fieldInclusion() error {
+func (ss *SenderSupplied) fieldInclusion() base.ErrorList {
 	return nil
 }
 
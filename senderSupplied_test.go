@@ -109,7 +109,7 @@ func TestParseSenderSuppliedReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("FormatVersion", ErrFormatVersion, "25")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("FormatVersion", ErrFormatVersion, "25"))).Error())
 }
 
 // TestSenderSuppliedTagError validates a SenderSupplied tag
@@ -158,3 +158,26 @@ func TestStringSenderSuppliedOptions(t *testing.T) {
 	require.Equal(t, record.Format(FormatOptions{VariableLengthFields: true}), "{1500}301       T ")
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
+
+// TestSenderSuppliedValidateAll validates every invalid SenderSupplied field is reported
+func TestSenderSuppliedValidateAll(t *testing.T) {
+	ss := mockSenderSupplied()
+	ss.FormatVersion = "55"
+	ss.UserRequestCorrelation = "®"
+	ss.TestProductionCode = "Z"
+
+	errs := ss.ValidateAll()
+
+	require.Len(t, errs, 3)
+	require.EqualError(t, ss.Validate(), errs[0].Error())
+	for _, err := range errs {
+		var fe *FieldError
+		require.ErrorAs(t, err, &fe)
+		require.Equal(t, TagSenderSupplied, fe.Tag)
+	}
+	require.ErrorIs(t, errs[0], ErrFormatVersion)
+	require.ErrorIs(t, errs[1], ErrNonAlphanumeric)
+	require.ErrorIs(t, errs[2], ErrTestProductionCode)
+
+	require.Empty(t, mockSenderSupplied().ValidateAll())
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // SenderToReceiver is the remittance information
@@ -45,49 +47,49 @@ func (str *SenderToReceiver) Parse(record string) error {
 
 	value, read, err := str.parseVariableStringField(record[length:], 5)
 	if err != nil {
-		return fieldError("SwiftFieldTag", err)
+		return fieldErrorAt(length, "SwiftFieldTag", err)
 	}
 	str.CoverPayment.SwiftFieldTag = value
 	length += read
 
 	value, read, err = str.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineOne", err)
+		return fieldErrorAt(length, "SwiftLineOne", err)
 	}
 	str.CoverPayment.SwiftLineOne = value
 	length += read
 
 	value, read, err = str.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineTwo", err)
+		return fieldErrorAt(length, "SwiftLineTwo", err)
 	}
 	str.CoverPayment.SwiftLineTwo = value
 	length += read
 
 	value, read, err = str.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineThree", err)
+		return fieldErrorAt(length, "SwiftLineThree", err)
 	}
 	str.CoverPayment.SwiftLineThree = value
 	length += read
 
 	value, read, err = str.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineFour", err)
+		return fieldErrorAt(length, "SwiftLineFour", err)
 	}
 	str.CoverPayment.SwiftLineFour = value
 	length += read
 
 	value, read, err = str.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineFive", err)
+		return fieldErrorAt(length, "SwiftLineFive", err)
 	}
 	str.CoverPayment.SwiftLineFive = value
 	length += read
 
 	value, read, err = str.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("SwiftLineSix", err)
+		return fieldErrorAt(length, "SwiftLineSix", err)
 	}
 	str.CoverPayment.SwiftLineSix = value
 	length += read
@@ -142,33 +144,39 @@ func (str *SenderToReceiver) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on SenderToReceiver and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (str *SenderToReceiver) Validate() error {
+	return str.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on SenderToReceiver and returns every error found.
+// Only the first error for each field is returned.
+func (str *SenderToReceiver) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
 	if str.tag != TagSenderToReceiver {
-		return fieldError("tag", ErrValidTagForType, str.tag)
+		addFieldError(&errs, TagSenderToReceiver, fieldError("tag", ErrValidTagForType, str.tag))
 	}
 	if err := str.isAlphanumeric(str.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, str.CoverPayment.SwiftFieldTag)
+		addFieldError(&errs, TagSenderToReceiver, fieldError("SwiftFieldTag", err, str.CoverPayment.SwiftFieldTag))
 	}
 	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, str.CoverPayment.SwiftLineOne)
+		addFieldError(&errs, TagSenderToReceiver, fieldError("SwiftLineOne", err, str.CoverPayment.SwiftLineOne))
 	}
 	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, str.CoverPayment.SwiftLineTwo)
+		addFieldError(&errs, TagSenderToReceiver, fieldError("SwiftLineTwo", err, str.CoverPayment.SwiftLineTwo))
 	}
 	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, str.CoverPayment.SwiftLineThree)
+		addFieldError(&errs, TagSenderToReceiver, fieldError("SwiftLineThree", err, str.CoverPayment.SwiftLineThree))
 	}
 	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, str.CoverPayment.SwiftLineFour)
+		addFieldError(&errs, TagSenderToReceiver, fieldError("SwiftLineFour", err, str.CoverPayment.SwiftLineFour))
 	}
 	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineFive); err != nil {
-		return fieldError("SwiftLineFive", err, str.CoverPayment.SwiftLineFive)
+		addFieldError(&errs, TagSenderToReceiver, fieldError("SwiftLineFive", err, str.CoverPayment.SwiftLineFive))
 	}
 	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineSix); err != nil {
-		return fieldError("SwiftLineSix", err, str.CoverPayment.SwiftLineSix)
+		addFieldError(&errs, TagSenderToReceiver, fieldError("SwiftLineSix", err, str.CoverPayment.SwiftLineSix))
 	}
-	return nil
+	return errs
 }
 
 // SwiftFieldTagField gets a string of the SwiftFieldTag field
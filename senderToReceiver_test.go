@@ -106,7 +106,7 @@ func TestParseSenderToReceiverWrongLength(t *testing.T) {
 
 	err := r.parseSenderToReceiver()
 
-	require.EqualError(t, err, r.parseError(fieldError("SwiftFieldTag", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "SwiftFieldTag", ErrRequireDelimiter)).Error())
 }
 
 // TestParseSenderToReceiverReaderParseError parses a wrong SenderToReceiver reader parse error
@@ -121,7 +121,7 @@ func TestParseSenderToReceiverReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("SwiftLineOne", ErrNonAlphanumeric, "®wift Line One")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("SwiftLineOne", ErrNonAlphanumeric, "®wift Line One"))).Error())
 }
 
 // TestSenderToReceiverTagError validates a SenderToReceiver tag
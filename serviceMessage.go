@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // ServiceMessage is the ServiceMessage of the wire
@@ -67,84 +69,84 @@ func (sm *ServiceMessage) Parse(record string) error {
 
 	value, read, err := sm.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineOne", err)
+		return fieldErrorAt(length, "LineOne", err)
 	}
 	sm.LineOne = value
 	length += read
 
 	value, read, err = sm.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineTwo", err)
+		return fieldErrorAt(length, "LineTwo", err)
 	}
 	sm.LineTwo = value
 	length += read
 
 	value, read, err = sm.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineThree", err)
+		return fieldErrorAt(length, "LineThree", err)
 	}
 	sm.LineThree = value
 	length += read
 
 	value, read, err = sm.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineFour", err)
+		return fieldErrorAt(length, "LineFour", err)
 	}
 	sm.LineFour = value
 	length += read
 
 	value, read, err = sm.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineFive", err)
+		return fieldErrorAt(length, "LineFive", err)
 	}
 	sm.LineFive = value
 	length += read
 
 	value, read, err = sm.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineSix", err)
+		return fieldErrorAt(length, "LineSix", err)
 	}
 	sm.LineSix = value
 	length += read
 
 	value, read, err = sm.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineSeven", err)
+		return fieldErrorAt(length, "LineSeven", err)
 	}
 	sm.LineSeven = value
 	length += read
 
 	value, read, err = sm.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineEight", err)
+		return fieldErrorAt(length, "LineEight", err)
 	}
 	sm.LineEight = value
 	length += read
 
 	value, read, err = sm.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineNine", err)
+		return fieldErrorAt(length, "LineNine", err)
 	}
 	sm.LineNine = value
 	length += read
 
 	value, read, err = sm.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineTen", err)
+		return fieldErrorAt(length, "LineTen", err)
 	}
 	sm.LineTen = value
 	length += read
 
 	value, read, err = sm.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineEleven", err)
+		return fieldErrorAt(length, "LineEleven", err)
 	}
 	sm.LineEleven = value
 	length += read
 
 	value, read, err = sm.parseVariableStringField(record[length:], 35)
 	if err != nil {
-		return fieldError("LineTwelve", err)
+		return fieldErrorAt(length, "LineTwelve", err)
 	}
 	sm.LineTwelve = value
 	length += read
@@ -204,62 +206,66 @@ func (sm *ServiceMessage) Format(options FormatOptions) string {
 }
 
 // Validate performs WIRE format rule checks on ServiceMessage and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (sm *ServiceMessage) Validate() error {
-	if err := sm.fieldInclusion(); err != nil {
-		return err
-	}
+	return sm.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on ServiceMessage and returns every error found.
+// Only the first error for each field is returned.
+func (sm *ServiceMessage) ValidateAll() base.ErrorList {
+	errs := sm.fieldInclusion()
 	if sm.tag != TagServiceMessage {
-		return fieldError("tag", ErrValidTagForType, sm.tag)
+		addFieldError(&errs, TagServiceMessage, fieldError("tag", ErrValidTagForType, sm.tag))
 	}
 	if err := sm.isAlphanumeric(sm.LineOne); err != nil {
-		return fieldError("LineOne", err, sm.LineOne)
+		addFieldError(&errs, TagServiceMessage, fieldError("LineOne", err, sm.LineOne))
 	}
 	if err := sm.isAlphanumeric(sm.LineTwo); err != nil {
-		return fieldError("LineTwo", err, sm.LineTwo)
+		addFieldError(&errs, TagServiceMessage, fieldError("LineTwo", err, sm.LineTwo))
 	}
 	if err := sm.isAlphanumeric(sm.LineThree); err != nil {
-		return fieldError("LineThree", err, sm.LineThree)
+		addFieldError(&errs, TagServiceMessage, fieldError("LineThree", err, sm.LineThree))
 	}
 	if err := sm.isAlphanumeric(sm.LineFour); err != nil {
-		return fieldError("LineFour", err, sm.LineFour)
+		addFieldError(&errs, TagServiceMessage, fieldError("LineFour", err, sm.LineFour))
 	}
 	if err := sm.isAlphanumeric(sm.LineFive); err != nil {
-		return fieldError("LineFive", err, sm.LineFive)
+		addFieldError(&errs, TagServiceMessage, fieldError("LineFive", err, sm.LineFive))
 	}
 	if err := sm.isAlphanumeric(sm.LineSix); err != nil {
-		return fieldError("LineSix", err, sm.LineSix)
+		addFieldError(&errs, TagServiceMessage, fieldError("LineSix", err, sm.LineSix))
 	}
 	if err := sm.isAlphanumeric(sm.LineSeven); err != nil {
-		return fieldError("LineSeven", err, sm.LineSeven)
+		addFieldError(&errs, TagServiceMessage, fieldError("LineSeven", err, sm.LineSeven))
 	}
 	if err := sm.isAlphanumeric(sm.LineEight); err != nil {
-		return fieldError("LineEight", err, sm.LineEight)
+		addFieldError(&errs, TagServiceMessage, fieldError("LineEight", err, sm.LineEight))
 	}
 	if err := sm.isAlphanumeric(sm.LineNine); err != nil {
-		return fieldError("LineNine", err, sm.LineNine)
+		addFieldError(&errs, TagServiceMessage, fieldError("LineNine", err, sm.LineNine))
 	}
 	if err := sm.isAlphanumeric(sm.LineTen); err != nil {
-		return fieldError("LineTen", err, sm.LineTen)
+		addFieldError(&errs, TagServiceMessage, fieldError("LineTen", err, sm.LineTen))
 	}
 	if err := sm.isAlphanumeric(sm.LineEleven); err != nil {
-		return fieldError("LineEleven", err, sm.LineEleven)
+		addFieldError(&errs, TagServiceMessage, fieldError("LineEleven", err, sm.LineEleven))
 	}
 	if err := sm.isAlphanumeric(sm.LineTwelve); err != nil {
-		return fieldError("LineTwelve", err, sm.LineTwelve)
+		addFieldError(&errs, TagServiceMessage, fieldError("LineTwelve", err, sm.LineTwelve))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (sm *ServiceMessage) fieldInclusion() error {
+func (sm *ServiceMessage) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	// If ServiceMessage is defined, LineOne is required
 	if sm.LineOne == "" {
-		return fieldError("LineOne", ErrFieldRequired)
+		addFieldError(&errs, TagServiceMessage, fieldError("LineOne", ErrFieldRequired))
 	}
 
-	return nil
+	return errs
 }
 
 // LineOneField gets a string of the LineOne field
@@ -171,7 +171,7 @@ func TestParseServiceMessageWrongLength(t *testing.T) {
 
 	err := r.parseServiceMessage()
 
-	require.EqualError(t, err, r.parseError(fieldError("LineOne", ErrRequireDelimiter)).Error())
+	require.EqualError(t, err, r.parseError(fieldErrorAt(6, "LineOne", ErrRequireDelimiter)).Error())
 }
 
 // TestParseServiceMessageReaderParseError parses a wrong ServiceMessage reader parse error
@@ -186,7 +186,7 @@ func TestParseServiceMessageReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("LineOne", ErrNonAlphanumeric, "®ine One")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("LineOne", ErrNonAlphanumeric, "®ine One"))).Error())
 }
 
 // TestTransactionTypeCodeForServiceMessage test an invalid TransactionTypeCode
@@ -198,7 +198,7 @@ func TestInvalidTransactionTypeCodeForServiceMessage(t *testing.T) {
 	bfc.TransactionTypeCode = "COV"
 	fwm.BusinessFunctionCode = bfc
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedServiceMessageTags().Err()
 
 	require.EqualError(t, err, fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, bfc.TransactionTypeCode).Error())
 }
@@ -211,7 +211,7 @@ func TestInvalidLocalInstrumentForServiceMessage(t *testing.T) {
 	li := mockLocalInstrument()
 	fwm.LocalInstrument = li
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedServiceMessageTags().Err()
 
 	require.EqualError(t, err, fieldError("LocalInstrument", ErrInvalidProperty, li).Error())
 }
@@ -224,7 +224,7 @@ func TestInvalidPaymentNotificationForServiceMessage(t *testing.T) {
 	pn := mockPaymentNotification()
 	fwm.PaymentNotification = pn
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedServiceMessageTags().Err()
 
 	require.EqualError(t, err, fieldError("PaymentNotification", ErrInvalidProperty, pn).Error())
 }
@@ -237,7 +237,7 @@ func TestInvalidChargesForServiceMessage(t *testing.T) {
 	c := mockCharges()
 	fwm.Charges = c
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedServiceMessageTags().Err()
 
 	require.EqualError(t, err, fieldError("Charges", ErrInvalidProperty, c).Error())
 }
@@ -250,7 +250,7 @@ func TestInvalidInstructedAmountForServiceMessage(t *testing.T) {
 	ia := mockInstructedAmount()
 	fwm.InstructedAmount = ia
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedServiceMessageTags().Err()
 
 	require.EqualError(t, err, fieldError("InstructedAmount", ErrInvalidProperty, ia).Error())
 }
@@ -263,7 +263,7 @@ func TestInvalidExchangeRateForServiceMessage(t *testing.T) {
 	eRate := mockExchangeRate()
 	fwm.ExchangeRate = eRate
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedServiceMessageTags().Err()
 
 	require.EqualError(t, err, fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate).Error())
 }
@@ -277,7 +277,7 @@ func TestInvalidBeneficiaryIdentificationCodeForServiceMessage(t *testing.T) {
 	ben.Personal.IdentificationCode = SWIFTBICORBEIANDAccountNumber
 	fwm.Beneficiary = ben
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedServiceMessageTags().Err()
 
 	require.EqualError(t, err, fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty, ben.Personal.IdentificationCode).Error())
 }
@@ -291,7 +291,7 @@ func TestInvalidOriginatorIdentificationCodeForServiceMessage(t *testing.T) {
 	o.Personal.IdentificationCode = SWIFTBICORBEIANDAccountNumber
 	fwm.Originator = o
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedServiceMessageTags().Err()
 
 	require.EqualError(t, err, fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty, o.Personal.IdentificationCode).Error())
 }
@@ -304,7 +304,7 @@ func TestInvalidOriginatorOptionFForServiceMessage(t *testing.T) {
 	off := mockOriginatorOptionF()
 	fwm.OriginatorOptionF = off
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedServiceMessageTags().Err()
 
 	require.EqualError(t, err, fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF).Error())
 }
@@ -317,7 +317,7 @@ func TestInvalidUnstructuredAddendaForServiceMessage(t *testing.T) {
 	ua := mockUnstructuredAddenda()
 	fwm.UnstructuredAddenda = ua
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedServiceMessageTags().Err()
 
 	require.EqualError(t, err, fieldError("BusinessFunctionCode", ErrInvalidProperty, "Unstructured Addenda").Error())
 }
@@ -330,7 +330,7 @@ func TestInvalidCurrencyInstructedAmountForServiceMessage(t *testing.T) {
 	cia := mockCurrencyInstructedAmount()
 	fwm.CurrencyInstructedAmount = cia
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedServiceMessageTags().Err()
 
 	require.EqualError(t, err, fieldError("CurrencyInstructedAmount", ErrInvalidProperty, fwm.CurrencyInstructedAmount).Error())
 }
@@ -343,7 +343,7 @@ func TestInvalidRelatedRemittanceForServiceMessage(t *testing.T) {
 	rr := mockRelatedRemittance()
 	fwm.RelatedRemittance = rr
 
-	err := fwm.checkProhibitedServiceMessageTags()
+	err := fwm.checkProhibitedServiceMessageTags().Err()
 
 	require.EqualError(t, err, fieldError("RelatedRemittance", ErrInvalidProperty, fwm.RelatedRemittance).Error())
 }
@@ -0,0 +1,98 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+)
+
+// SourceFormat is how a File was encoded when it was read.
+//
+// A Writer created with PreserveSourceFormat writes Files in their SourceFormat, and writes each tag which
+// is unchanged since it was read exactly as it was read.
+type SourceFormat struct {
+	// NewlineCharacter which followed tags, or "" when tags were not separated by line breaks
+	NewlineCharacter string `json:"newlineCharacter"`
+	// VariableLengthFields is true when any tag was read with variable length fields
+	VariableLengthFields bool `json:"variableLengthFields"`
+	// Tags holds the TagFormat of each tag read, by the index of its FEDWireMessage in FEDWireMessages
+	Tags [][]TagFormat `json:"tags,omitempty"`
+}
+
+// TagFormat is how a tag was encoded when it was read
+type TagFormat struct {
+	// Tag such as {3100}
+	Tag string `json:"tag"`
+	// VariableLength is true when the tag's fields were delimited rather than fixed-width
+	VariableLength bool `json:"variableLength"`
+}
+
+// sourceSegment is a tag or an interface header as it was read
+type sourceSegment struct {
+	// tag of the segment, or "" for an interface header
+	tag string
+	// text of the tag or interface header as it was parsed
+	text string
+	// raw bytes read for the segment, including any line breaks before and after it
+	raw string
+}
+
+// separator returns the line break which followed the segment
+func (seg sourceSegment) separator() string {
+	return seg.raw[len(strings.TrimRight(seg.raw, "\r\n")):]
+}
+
+// newSourceFormat returns the SourceFormat of messages read after the interface header source
+func newSourceFormat(header *sourceSegment, messages []FEDWireMessage) *SourceFormat {
+	sf := &SourceFormat{}
+	if header != nil {
+		sf.NewlineCharacter = newlineOf(header.separator())
+	}
+	for i := range messages {
+		// tags read with variable length fields differ from those written fixed-width
+		fixed := captureTags(messages[i], FormatOptions{VariableLengthFields: false})
+		var tags []TagFormat
+		for _, seg := range messages[i].source {
+			if sf.NewlineCharacter == "" {
+				sf.NewlineCharacter = newlineOf(seg.separator())
+			}
+			if seg.tag == "" {
+				continue
+			}
+			line, ok := fixed[seg.tag]
+			if !ok {
+				continue
+			}
+			tf := TagFormat{Tag: seg.tag, VariableLength: seg.text != line}
+			sf.VariableLengthFields = sf.VariableLengthFields || tf.VariableLength
+			tags = append(tags, tf)
+		}
+		sf.Tags = append(sf.Tags, tags)
+	}
+	return sf
+}
+
+// newlineOf returns the NewlineCharacter ending separator
+func newlineOf(separator string) string {
+	switch {
+	case strings.HasSuffix(separator, "\r\n"):
+		return "\r\n"
+	case strings.HasSuffix(separator, "\n"):
+		return "\n"
+	}
+	return ""
+}
+
+// captureTags returns each tag of fwm as written with options, by tag
+func captureTags(fwm FEDWireMessage, options FormatOptions) map[string]string {
+	w := &Writer{
+		FormatOptions: options,
+		capture:       make(map[string]string),
+	}
+	if err := w.writeFEDWireMessage(fwm); err != nil {
+		return nil
+	}
+	return w.capture
+}
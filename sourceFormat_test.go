@@ -0,0 +1,41 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestSourceFormat reads the SourceFormat of files written with fixed-width and variable length fields
+func TestSourceFormat(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	file, err := NewReader(bytes.NewReader(bs)).Read()
+	require.NoError(t, err)
+
+	for _, variableLength := range []bool{false, true} {
+		var buf bytes.Buffer
+		require.NoError(t, NewWriter(&buf, VariableLengthFields(variableLength), NewlineCharacter("\r\n")).Write(&file))
+
+		read, err := NewReader(&buf).Read()
+		require.NoError(t, err)
+		require.Equal(t, "\r\n", read.SourceFormat.NewlineCharacter)
+		require.Equal(t, variableLength, read.SourceFormat.VariableLengthFields)
+		require.Len(t, read.SourceFormat.Tags, 1)
+		require.NotEmpty(t, read.SourceFormat.Tags[0])
+	}
+}
+
+func TestSourceSegmentSeparator(t *testing.T) {
+	require.Equal(t, "\r\n", sourceSegment{raw: "{1510}1000\r\n"}.separator())
+	require.Equal(t, "", sourceSegment{raw: "{1510}1000"}.separator())
+	require.Equal(t, "\n", newlineOf("\n\n"))
+	require.Equal(t, "", newlineOf(""))
+}
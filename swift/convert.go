@@ -0,0 +1,564 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/moov-io/wire"
+	"github.com/moov-io/wire/internal/convert"
+)
+
+// partyField is the SWIFT field holding a FEDWireMessage party in a message
+type partyField struct {
+	// tag of the FEDWireMessage party, such as {5000}
+	tag string
+	// field is the SWIFT field, with "a" standing for its options, such as 50a
+	field string
+}
+
+// partyFields are the SWIFT fields of the FEDWireMessage parties of each message in field order, by message type.
+// The parties of sequence A of an MT202 COV are those of an MT202.
+var partyFields = map[string][]partyField{
+	MT103: {
+		{wire.TagOriginator, "50a"},
+		{wire.TagOriginatorOptionF, "50F"},
+		{wire.TagOriginatorFI, "52a"},
+		{wire.TagBeneficiaryIntermediaryFI, "56a"},
+		{wire.TagBeneficiaryFI, "57a"},
+		{wire.TagBeneficiary, "59a"},
+	},
+	MT202: {
+		{wire.TagOriginator, "52a"},
+		{wire.TagBeneficiaryIntermediaryFI, "56a"},
+		{wire.TagBeneficiaryFI, "57a"},
+		{wire.TagBeneficiary, "58a"},
+	},
+}
+
+// coverFields are the SWIFT fields of the {7xxx} cover payment tags in sequence B of an MT202 COV in field order.
+// The option of each field is the SwiftFieldTag of its cover payment.
+var coverFields = []partyField{
+	{wire.TagOrderingCustomer, "50a"},
+	{wire.TagOrderingInstitution, "52a"},
+	{wire.TagIntermediaryInstitution, "56a"},
+	{wire.TagInstitutionAccount, "57a"},
+	{wire.TagBeneficiaryCustomer, "59a"},
+	{wire.TagRemittance, "70"},
+	{wire.TagSenderToReceiver, "72"},
+}
+
+// coverLines are the number of lines of the cover payment tags with other than five
+var coverLines = map[string]int{
+	wire.TagRemittance:       4,
+	wire.TagSenderToReceiver: 6,
+}
+
+// nameOptions are the options of the party fields holding an identifier, name and address rather than a BIC
+var nameOptions = map[string]string{
+	"50": "50K",
+	"52": "52D",
+	"56": "56D",
+	"57": "57D",
+	"58": "58D",
+	"59": "59",
+}
+
+// clearingCodes are the SWIFT clearing system codes of the FEDWireMessage identification codes of clearing
+// system members, which are written as //<code><identifier>
+var clearingCodes = map[string]string{
+	wire.FEDRoutingNumber: "FW",
+	wire.CHIPSParticipant: "CP",
+	wire.CHIPSIdentifier:  "CH",
+}
+
+// identificationCodes are the FEDWireMessage identification codes written as /<code>/<identifier>
+var identificationCodes = []string{
+	wire.SWIFTBankIdentifierCode,
+	wire.SWIFTBICORBEIANDAccountNumber,
+	wire.PassportNumber,
+	wire.TaxIdentificationNumber,
+	wire.DriversLicenseNumber,
+	wire.AlienRegistrationNumber,
+	wire.CorporateIdentification,
+	wire.OtherIdentification,
+}
+
+// party holds the identification, name and address of a wire.Personal or wire.FinancialInstitution
+type party struct {
+	IdentificationCode string
+	Identifier         string
+	Name               string
+	Address            wire.Address
+}
+
+// toField returns the field of p as the field, such as 52a, of a message. A party identified by a BIC is written
+// as option A, reporting any name and address; others as the field's name and address option.
+func (p party) toField(tag, field string, report *Report) Field {
+	if p.IdentificationCode == wire.SWIFTBankIdentifierCode && bicRegex.MatchString(p.Identifier) {
+		if p.Name != "" || p.Address != (wire.Address{}) {
+			report.Unmapped(tag, "Name and Address of a BIC", nowhere)
+		}
+		return Field{Tag: field[:2] + "A", Lines: []string{p.Identifier}}
+	}
+	option := nameOptions[field[:2]]
+	var lines []string
+	if p.Identifier != "" {
+		lines = append(lines, identifierLine(p.IdentificationCode, p.Identifier))
+	}
+	lines = append(lines, p.Name, p.Address.AddressLineOne, p.Address.AddressLineTwo, p.Address.AddressLineThree)
+	return Field{Tag: option, Lines: report.FitLines(at(tag, option), lines, 5, 35)}
+}
+
+// identifierLine returns the party identifier line of an identifier: the account of a DemandDepositAccountNumber,
+// the clearing code of a clearing system member, or the identification code of other identifiers
+func identifierLine(code, id string) string {
+	if cc, ok := clearingCodes[code]; ok {
+		return "//" + cc + id
+	}
+	if code == wire.DemandDepositAccountNumber || code == "" {
+		return "/" + id
+	}
+	return "/" + code + "/" + id
+}
+
+// partyOf returns the party of f, a field of the FEDWireMessage tag, fitting its lines to the party
+func partyOf(tag string, f Field, report *Report) party {
+	lines := f.Lines
+	if strings.HasSuffix(f.Tag, "A") {
+		p := party{IdentificationCode: wire.SWIFTBankIdentifierCode, Identifier: lines[len(lines)-1]}
+		if len(lines) > 1 {
+			report.NoTag(at("", f.Tag), "party identifier")
+		}
+		return p
+	}
+	var p party
+	if len(lines) > 0 && strings.HasPrefix(lines[0], "/") {
+		p.IdentificationCode, p.Identifier = identifierOf(lines[0])
+		p.Identifier = report.Fit(at(tag, f.Tag), p.Identifier, 34)
+		lines = lines[1:]
+	}
+	lines = report.FitLines(at(tag, f.Tag), lines, 4, 35)
+	address := make([]string, 4)
+	copy(address, lines)
+	p.Name = address[0]
+	p.Address = wire.Address{AddressLineOne: address[1], AddressLineTwo: address[2], AddressLineThree: address[3]}
+	return p
+}
+
+// identifierOf returns the identification code and identifier of a party identifier line
+func identifierOf(line string) (string, string) {
+	id := line[1:]
+	if strings.HasPrefix(id, "/") {
+		for code, cc := range clearingCodes {
+			if strings.HasPrefix(id[1:], cc) {
+				return code, id[1+len(cc):]
+			}
+		}
+		return wire.DemandDepositAccountNumber, id[1:]
+	}
+	if len(id) > 2 && id[1] == '/' && contains(identificationCodes, id[:1]) {
+		return id[:1], id[2:]
+	}
+	return wire.DemandDepositAccountNumber, id
+}
+
+// fieldOf returns the field of the FEDWireMessage tag of fwm as the field, such as 52a, of a message, or false when
+// fwm does not hold the tag
+func fieldOf(fwm *wire.FEDWireMessage, pf partyField, report *Report) (Field, bool) {
+	if cp := coverPaymentOf(fwm, pf.tag); cp != nil {
+		return coverField(pf, *cp, report), true
+	}
+	switch {
+	case pf.tag == wire.TagOriginator && fwm.Originator != nil:
+		if pf.field == "50a" && fwm.OriginatorOptionF != nil {
+			report.Unmapped(pf.tag, "Originator with an OriginatorOptionF", nowhere)
+			return Field{}, false
+		}
+		return party(fwm.Originator.Personal).toField(pf.tag, pf.field, report), true
+	case pf.tag == wire.TagOriginatorOptionF && fwm.OriginatorOptionF != nil:
+		o := fwm.OriginatorOptionF
+		lines := []string{o.PartyIdentifier, o.Name, o.LineOne, o.LineTwo, o.LineThree}
+		return Field{Tag: pf.field, Lines: report.FitLines(at(pf.tag, pf.field), lines, 5, 35)}, true
+	case pf.tag == wire.TagOriginatorFI && fwm.OriginatorFI != nil:
+		return party(fwm.OriginatorFI.FinancialInstitution).toField(pf.tag, pf.field, report), true
+	case pf.tag == wire.TagBeneficiaryIntermediaryFI && fwm.BeneficiaryIntermediaryFI != nil:
+		return party(fwm.BeneficiaryIntermediaryFI.FinancialInstitution).toField(pf.tag, pf.field, report), true
+	case pf.tag == wire.TagBeneficiaryFI && fwm.BeneficiaryFI != nil:
+		return party(fwm.BeneficiaryFI.FinancialInstitution).toField(pf.tag, pf.field, report), true
+	case pf.tag == wire.TagBeneficiary && fwm.Beneficiary != nil:
+		return party(fwm.Beneficiary.Personal).toField(pf.tag, pf.field, report), true
+	}
+	return Field{}, false
+}
+
+// setField sets the FEDWireMessage tag of fwm from the field f
+func setField(fwm *wire.FEDWireMessage, tag string, f Field, report *Report) {
+	if cp := newCoverPayment(fwm, tag); cp != nil {
+		count := 5
+		if n, ok := coverLines[tag]; ok {
+			count = n
+		}
+		lines := append(report.FitLines(at(tag, f.Tag), f.Lines, count, 35), make([]string, 6)...)
+		*cp = wire.CoverPayment{
+			SwiftFieldTag:  f.Tag,
+			SwiftLineOne:   lines[0],
+			SwiftLineTwo:   lines[1],
+			SwiftLineThree: lines[2],
+			SwiftLineFour:  lines[3],
+			SwiftLineFive:  lines[4],
+			SwiftLineSix:   lines[5],
+		}
+		return
+	}
+	switch tag {
+	case wire.TagOriginatorOptionF:
+		lines := append(report.FitLines(at(tag, f.Tag), f.Lines, 5, 35), make([]string, 5)...)
+		fwm.OriginatorOptionF = wire.NewOriginatorOptionF()
+		fwm.OriginatorOptionF.PartyIdentifier = lines[0]
+		fwm.OriginatorOptionF.Name = lines[1]
+		fwm.OriginatorOptionF.LineOne = lines[2]
+		fwm.OriginatorOptionF.LineTwo = lines[3]
+		fwm.OriginatorOptionF.LineThree = lines[4]
+	case wire.TagOriginator:
+		fwm.Originator = wire.NewOriginator()
+		fwm.Originator.Personal = wire.Personal(partyOf(tag, f, report))
+	case wire.TagOriginatorFI:
+		fwm.OriginatorFI = wire.NewOriginatorFI()
+		fwm.OriginatorFI.FinancialInstitution = wire.FinancialInstitution(partyOf(tag, f, report))
+	case wire.TagBeneficiaryIntermediaryFI:
+		fwm.BeneficiaryIntermediaryFI = wire.NewBeneficiaryIntermediaryFI()
+		fwm.BeneficiaryIntermediaryFI.FinancialInstitution = wire.FinancialInstitution(partyOf(tag, f, report))
+	case wire.TagBeneficiaryFI:
+		fwm.BeneficiaryFI = wire.NewBeneficiaryFI()
+		fwm.BeneficiaryFI.FinancialInstitution = wire.FinancialInstitution(partyOf(tag, f, report))
+	case wire.TagBeneficiary:
+		fwm.Beneficiary = wire.NewBeneficiary()
+		fwm.Beneficiary.Personal = wire.Personal(partyOf(tag, f, report))
+	}
+}
+
+// coverField returns the field of the cover payment cp of the tag. A SwiftFieldTag which is not an option of the
+// field is replaced by its name and address option.
+func coverField(pf partyField, cp wire.CoverPayment, report *Report) Field {
+	option := cp.SwiftFieldTag
+	if _, ok := fieldFormats[option]; !ok || !fieldMatches(option, pf.field) {
+		option = pf.field
+		if strings.HasSuffix(option, "a") {
+			option = nameOptions[option[:2]]
+		}
+		report.Warnings = append(report.Warnings, Warning{Tag: pf.tag, Field: option,
+			Reason: fmt.Sprintf("replaces SwiftFieldTag %q", cp.SwiftFieldTag)})
+	}
+	format := fieldFormats[option]
+	lines := []string{cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree, cp.SwiftLineFour, cp.SwiftLineFive, cp.SwiftLineSix}
+	return Field{Tag: option, Lines: report.FitLines(at(pf.tag, option), lines, format.lines, format.length)}
+}
+
+// coverPaymentOf returns the cover payment of fwm with the tag, or nil when fwm has none
+func coverPaymentOf(fwm *wire.FEDWireMessage, tag string) *wire.CoverPayment {
+	switch {
+	case tag == wire.TagOrderingCustomer && fwm.OrderingCustomer != nil:
+		return &fwm.OrderingCustomer.CoverPayment
+	case tag == wire.TagOrderingInstitution && fwm.OrderingInstitution != nil:
+		return &fwm.OrderingInstitution.CoverPayment
+	case tag == wire.TagIntermediaryInstitution && fwm.IntermediaryInstitution != nil:
+		return &fwm.IntermediaryInstitution.CoverPayment
+	case tag == wire.TagInstitutionAccount && fwm.InstitutionAccount != nil:
+		return &fwm.InstitutionAccount.CoverPayment
+	case tag == wire.TagBeneficiaryCustomer && fwm.BeneficiaryCustomer != nil:
+		return &fwm.BeneficiaryCustomer.CoverPayment
+	case tag == wire.TagRemittance && fwm.Remittance != nil:
+		return &fwm.Remittance.CoverPayment
+	case tag == wire.TagSenderToReceiver && fwm.SenderToReceiver != nil:
+		return &fwm.SenderToReceiver.CoverPayment
+	}
+	return nil
+}
+
+// newCoverPayment sets the cover payment tag of fwm, returning its CoverPayment, or nil when tag is not a cover
+// payment tag
+func newCoverPayment(fwm *wire.FEDWireMessage, tag string) *wire.CoverPayment {
+	switch tag {
+	case wire.TagOrderingCustomer:
+		fwm.OrderingCustomer = wire.NewOrderingCustomer()
+	case wire.TagOrderingInstitution:
+		fwm.OrderingInstitution = wire.NewOrderingInstitution()
+	case wire.TagIntermediaryInstitution:
+		fwm.IntermediaryInstitution = wire.NewIntermediaryInstitution()
+	case wire.TagInstitutionAccount:
+		fwm.InstitutionAccount = wire.NewInstitutionAccount()
+	case wire.TagBeneficiaryCustomer:
+		fwm.BeneficiaryCustomer = wire.NewBeneficiaryCustomer()
+	case wire.TagRemittance:
+		fwm.Remittance = wire.NewRemittance()
+	case wire.TagSenderToReceiver:
+		fwm.SenderToReceiver = wire.NewSenderToReceiver()
+	}
+	return coverPaymentOf(fwm, tag)
+}
+
+// addFields adds the fields of the tags of fwm listed in fields to m
+func (m *Message) addFields(fwm *wire.FEDWireMessage, fields []partyField, report *Report) {
+	for _, pf := range fields {
+		if f, ok := fieldOf(fwm, pf, report); ok {
+			m.add(f.Tag, f.Lines...)
+		}
+	}
+}
+
+// setFields sets the tags of fwm listed in fields from the fields of m which hold them, returning the others.
+// A field repeated for a tag which is already set is reported.
+func setFields(fwm *wire.FEDWireMessage, fields []partyField, from []Field, report *Report) []Field {
+	var rest []Field
+	for _, f := range from {
+		tag, ok := tagOfField(fields, f.Tag)
+		if !ok {
+			rest = append(rest, f)
+			continue
+		}
+		if convert.TagPresent(fwm, tag) {
+			report.NoTag(at("", f.Tag), "repeated field")
+			continue
+		}
+		setField(fwm, tag, f, report)
+	}
+	return rest
+}
+
+// tagOfField returns the FEDWireMessage tag held in the field with the tag, preferring a field listed with its
+// option, such as 50F, to one listed with all options, such as 50a
+func tagOfField(fields []partyField, tag string) (string, bool) {
+	for _, pf := range fields {
+		if pf.field == tag {
+			return pf.tag, true
+		}
+	}
+	for _, pf := range fields {
+		if fieldMatches(tag, pf.field) {
+			return pf.tag, true
+		}
+	}
+	return "", false
+}
+
+// valueDate returns the :32A: value of fwm: the value date of its cycle date, USD and its amount
+func valueDate(fwm *wire.FEDWireMessage) string {
+	date := ""
+	if imad := fwm.InputMessageAccountabilityData; imad != nil && len(imad.InputCycleDate) == 8 {
+		date = imad.InputCycleDate[2:]
+	}
+	amount := ""
+	if fwm.Amount != nil {
+		amount = convert.AmountFromCents(fwm.Amount.Amount, ",")
+	}
+	return date + currencyUSD + amount
+}
+
+// swiftAmount returns a FEDWireMessage amount or rate, which uses a decimal comma, without leading zeros
+func swiftAmount(value string) string {
+	value = strings.TrimSpace(value)
+	for len(value) > 1 && value[0] == '0' && value[1] != ',' {
+		value = value[1:]
+	}
+	return value
+}
+
+// fromValueDate sets the {1520} cycle date and {2000} amount of fwm from the :32A: value date field
+func fromValueDate(fwm *wire.FEDWireMessage, f *Field) error {
+	if f == nil {
+		return &FieldError{Field: "32A", Err: ErrFieldRequired}
+	}
+	value := strings.Join(f.Lines, "")
+	if !valueDateRegex.MatchString(value) {
+		return &FieldError{Field: f.Tag, Value: value, Err: ErrFieldFormat}
+	}
+	date, err := time.Parse("060102", value[:6])
+	if err != nil {
+		return &FieldError{Field: f.Tag, Value: value, Err: ErrFieldFormat}
+	}
+	if value[6:9] != currencyUSD {
+		return &FieldError{Field: f.Tag, Value: value, Err: ErrFieldCode}
+	}
+	// SWIFT amounts always have a decimal comma
+	cents, ok := convert.AmountInCents(value[9:], ",")
+	if !ok || !strings.Contains(value[9:], ",") {
+		return &FieldError{Field: f.Tag, Value: value, Err: ErrFieldFormat}
+	}
+	fwm.InputMessageAccountabilityData.InputCycleDate = date.Format("20060102")
+	fwm.Amount.Amount = cents
+	return nil
+}
+
+// newFEDWireMessage returns a FEDWireMessage with the mandatory tags of a basic funds transfer with the
+// BusinessFunctionCode bfc, whose cycle date, amount and depository institutions are not set
+func newFEDWireMessage(bfc string) *wire.FEDWireMessage {
+	fwm := &wire.FEDWireMessage{
+		SenderSupplied:                 wire.NewSenderSupplied(),
+		TypeSubType:                    wire.NewTypeSubType(),
+		InputMessageAccountabilityData: wire.NewInputMessageAccountabilityData(),
+		Amount:                         wire.NewAmount(),
+		SenderDepositoryInstitution:    wire.NewSenderDepositoryInstitution(),
+		ReceiverDepositoryInstitution:  wire.NewReceiverDepositoryInstitution(),
+		BusinessFunctionCode:           wire.NewBusinessFunctionCode(),
+	}
+	fwm.TypeSubType.TypeCode = wire.FundsTransfer
+	fwm.TypeSubType.SubTypeCode = wire.BasicFundsTransfer
+	fwm.BusinessFunctionCode.BusinessFunctionCode = bfc
+	return fwm
+}
+
+// reportHeaders reports the data of the mandatory tags of fwm which a message has no field for: the sender
+// supplied values, the source and sequence number of the IMAD and the depository institutions, which are
+// identified by BICs in a message
+func reportHeaders(fwm *wire.FEDWireMessage, report *Report) {
+	if ss := fwm.SenderSupplied; ss != nil {
+		if ss.UserRequestCorrelation != "" {
+			report.Unmapped(wire.TagSenderSupplied, "UserRequestCorrelation", nowhere)
+		}
+		if ss.TestProductionCode == wire.EnvironmentTest {
+			report.Unmapped(wire.TagSenderSupplied, "TestProductionCode", nowhere)
+		}
+	}
+	if fwm.TypeSubType != nil && fwm.TypeSubType.SubTypeCode != wire.BasicFundsTransfer {
+		report.Unmapped(wire.TagTypeSubType, "SubTypeCode other than "+wire.BasicFundsTransfer, nowhere)
+	}
+	if fwm.InputMessageAccountabilityData != nil {
+		report.Unmapped(wire.TagInputMessageAccountabilityData, "InputSource", nowhere)
+		report.Unmapped(wire.TagInputMessageAccountabilityData, "InputSequenceNumber", nowhere)
+	}
+	if fwm.SenderDepositoryInstitution != nil {
+		report.Unmapped(wire.TagSenderDepositoryInstitution, "", nowhere)
+	}
+	if fwm.ReceiverDepositoryInstitution != nil {
+		report.Unmapped(wire.TagReceiverDepositoryInstitution, "", nowhere)
+	}
+}
+
+// senderReference returns the :20: reference of the {3320} SenderReference of fwm, or NonReference when it has none
+func senderReference(fwm *wire.FEDWireMessage, report *Report) string {
+	if fwm.SenderReference == nil {
+		return NonReference
+	}
+	return reference(wire.TagSenderReference, "20", fwm.SenderReference.SenderReference, report)
+}
+
+// reference returns a reference as the :20: or :21: field, without the slashes it may not start or end with, or
+// NonReference when it is blank
+func reference(tag, field, value string, report *Report) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return NonReference
+	}
+	trimmed := strings.Trim(value, "/")
+	if trimmed != value {
+		report.Truncated(at(tag, field))
+	}
+	if trimmed == "" {
+		return NonReference
+	}
+	return report.Fit(at(tag, field), trimmed, 16)
+}
+
+// setSenderReference sets the {3320} SenderReference of fwm from the :20: reference
+func setSenderReference(fwm *wire.FEDWireMessage, value string, report *Report) {
+	if value == NonReference {
+		return
+	}
+	fwm.SenderReference = wire.NewSenderReference()
+	fwm.SenderReference.SenderReference = report.Fit(at(wire.TagSenderReference, "20"), value, 16)
+}
+
+// fiReceiverLines returns the :72: sender to receiver information lines of the {6100} FIReceiverFI of fwm
+func fiReceiverLines(fwm *wire.FEDWireMessage, report *Report) []string {
+	if fwm.FIReceiverFI == nil {
+		return nil
+	}
+	fi := fwm.FIReceiverFI.FIToFI
+	lines := []string{fi.LineOne, fi.LineTwo, fi.LineThree, fi.LineFour, fi.LineFive, fi.LineSix}
+	return report.FitLines(at(wire.TagFIReceiverFI, "72"), lines, 6, 35)
+}
+
+// setFIReceiverFI sets the {6100} FIReceiverFI of fwm from the :72: sender to receiver information, whose first
+// line has 30 characters and the others 33
+func setFIReceiverFI(fwm *wire.FEDWireMessage, f Field, report *Report) {
+	lines := append(report.FitLines(at(wire.TagFIReceiverFI, f.Tag), f.Lines, 6, 35), make([]string, 6)...)
+	fwm.FIReceiverFI = wire.NewFIReceiverFI()
+	fwm.FIReceiverFI.FIToFI = wire.FIToFI{
+		LineOne:   report.Fit(at(wire.TagFIReceiverFI, f.Tag), lines[0], 30),
+		LineTwo:   report.Fit(at(wire.TagFIReceiverFI, f.Tag), lines[1], 33),
+		LineThree: report.Fit(at(wire.TagFIReceiverFI, f.Tag), lines[2], 33),
+		LineFour:  report.Fit(at(wire.TagFIReceiverFI, f.Tag), lines[3], 33),
+		LineFive:  report.Fit(at(wire.TagFIReceiverFI, f.Tag), lines[4], 33),
+		LineSix:   report.Fit(at(wire.TagFIReceiverFI, f.Tag), lines[5], 33),
+	}
+}
+
+// unexpected returns the error of a field which cannot be converted
+func unexpected(f Field, err error) error {
+	return &FieldError{Field: f.Tag, Value: strings.Join(f.Lines, "\n"), Err: err}
+}
+
+// Tags which no message has a field for
+var (
+	// appendedTags are the tags the Fedwire Funds Service appends to messages it sends
+	appendedTags = []string{
+		wire.TagMessageDisposition,
+		wire.TagReceiptTimeStamp,
+		wire.TagOutputMessageAccountabilityData,
+		wire.TagErrorWire,
+	}
+	// fiToFITags are the {6110} to {6500} financial institution to financial institution information tags
+	fiToFITags = []string{
+		wire.TagFIDrawdownDebitAccountAdvice,
+		wire.TagFIIntermediaryFI,
+		wire.TagFIIntermediaryFIAdvice,
+		wire.TagFIBeneficiaryFI,
+		wire.TagFIBeneficiaryFIAdvice,
+		wire.TagFIBeneficiary,
+		wire.TagFIBeneficiaryAdvice,
+		wire.TagFIPaymentMethodToBeneficiary,
+		wire.TagFIAdditionalFIToFI,
+	}
+	// coverTags are the {7033} to {7072} cover payment tags
+	coverTags = []string{
+		wire.TagCurrencyInstructedAmount,
+		wire.TagOrderingCustomer,
+		wire.TagOrderingInstitution,
+		wire.TagIntermediaryInstitution,
+		wire.TagInstitutionAccount,
+		wire.TagBeneficiaryCustomer,
+		wire.TagRemittance,
+		wire.TagSenderToReceiver,
+	}
+	// remittanceTags are the {8200} unstructured addenda and {8250} to {8750} remittance tags, and the {9000}
+	// ServiceMessage
+	remittanceTags = []string{
+		wire.TagUnstructuredAddenda,
+		wire.TagRelatedRemittance,
+		wire.TagRemittanceOriginator,
+		wire.TagRemittanceBeneficiary,
+		wire.TagPrimaryRemittanceDocument,
+		wire.TagActualAmountPaid,
+		wire.TagGrossAmountRemittanceDocument,
+		wire.TagAmountNegotiatedDiscount,
+		wire.TagAdjustment,
+		wire.TagDateRemittanceDocument,
+		wire.TagSecondaryRemittanceDocument,
+		wire.TagRemittanceFreeText,
+		wire.TagServiceMessage,
+	}
+)
+
+// unmappedTags returns the appendedTags followed by the tags of each list
+func unmappedTags(lists ...[]string) []string {
+	tags := append([]string{}, appendedTags...)
+	for _, list := range lists {
+		tags = append(tags, list...)
+	}
+	return tags
+}
@@ -0,0 +1,139 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParty_Field(t *testing.T) {
+	for name, tt := range map[string]struct {
+		party party
+		want  Field
+	}{
+		"BIC": {
+			party{IdentificationCode: wire.SWIFTBankIdentifierCode, Identifier: "CITIUS33"},
+			Field{Tag: "57A", Lines: []string{"CITIUS33"}},
+		},
+		"routing number": {
+			party{IdentificationCode: wire.FEDRoutingNumber, Identifier: "121042882", Name: "FI Name"},
+			Field{Tag: "57D", Lines: []string{"//FW121042882", "FI Name"}},
+		},
+		"account": {
+			party{IdentificationCode: wire.DemandDepositAccountNumber, Identifier: "123456789", Name: "FI Name",
+				Address: wire.Address{AddressLineOne: "Address One", AddressLineThree: "Address Three"}},
+			Field{Tag: "57D", Lines: []string{"/123456789", "FI Name", "Address One", "Address Three"}},
+		},
+		"passport": {
+			party{IdentificationCode: wire.PassportNumber, Identifier: "1234", Name: "Name"},
+			Field{Tag: "57D", Lines: []string{"/1/1234", "Name"}},
+		},
+		"invalid BIC": {
+			party{IdentificationCode: wire.SWIFTBankIdentifierCode, Identifier: "CITI"},
+			Field{Tag: "57D", Lines: []string{"/B/CITI"}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			report := &Report{}
+			require.Equal(t, tt.want, tt.party.toField(wire.TagBeneficiaryFI, "57a", report))
+			require.True(t, report.Empty())
+
+			// Blank address lines are dropped, so the remaining lines move up
+			want := tt.party
+			if want.Address.AddressLineTwo == "" {
+				want.Address.AddressLineTwo, want.Address.AddressLineThree = want.Address.AddressLineThree, ""
+			}
+			require.Equal(t, want, partyOf(wire.TagBeneficiaryFI, tt.want, report))
+			require.True(t, report.Empty())
+		})
+	}
+
+	report := &Report{}
+	p := party{IdentificationCode: wire.SWIFTBankIdentifierCode, Identifier: "CITIUS33", Name: "FI Name"}
+	require.Equal(t, "59A", p.toField(wire.TagBeneficiary, "59a", report).Tag)
+	require.Equal(t, []string{wire.TagBeneficiary}, report.Tags())
+
+	report = &Report{}
+	p = party{Name: "Name", Address: wire.Address{AddressLineOne: "Address One Which Is Longer Than A Line"}}
+	require.Equal(t, Field{Tag: "59", Lines: []string{"Name", "Address One Which Is Longer Than A "}},
+		p.toField(wire.TagBeneficiary, "59a", report))
+	require.Equal(t, []string{wire.TagBeneficiary}, report.Tags())
+}
+
+func TestIdentifierOf(t *testing.T) {
+	for line, want := range map[string][2]string{
+		"/123456789":    {wire.DemandDepositAccountNumber, "123456789"},
+		"//FW121042882": {wire.FEDRoutingNumber, "121042882"},
+		"//CP1234":      {wire.CHIPSParticipant, "1234"},
+		"//CH123456":    {wire.CHIPSIdentifier, "123456"},
+		"//SC123456":    {wire.DemandDepositAccountNumber, "SC123456"},
+		"/T/12-3456789": {wire.SWIFTBICORBEIANDAccountNumber, "12-3456789"},
+		"/X/1234":       {wire.DemandDepositAccountNumber, "X/1234"},
+	} {
+		code, id := identifierOf(line)
+		require.Equal(t, want, [2]string{code, id}, line)
+	}
+}
+
+func TestAmount(t *testing.T) {
+	require.Equal(t, "4567,89", swiftAmount("000000004567,89"))
+	require.Equal(t, "0,99", swiftAmount("0,99"))
+}
+
+func TestFromValueDate(t *testing.T) {
+	fwm := newFEDWireMessage(wire.BankTransfer)
+	require.NoError(t, fromValueDate(fwm, &Field{Tag: "32A", Lines: []string{"190410USD12345,67"}}))
+	require.Equal(t, "20190410", fwm.InputMessageAccountabilityData.InputCycleDate)
+	require.Equal(t, "000001234567", fwm.Amount.Amount)
+
+	require.ErrorIs(t, fromValueDate(fwm, nil), ErrFieldRequired)
+	for value, want := range map[string]error{
+		"190410USD12345.67": ErrFieldFormat,
+		"191310USD12345,67": ErrFieldFormat,
+		"190410EUR12345,67": ErrFieldCode,
+		"190410USD1,234":    ErrFieldFormat,
+		"190410USD12345":    ErrFieldFormat,
+		"190410USD,67":      ErrFieldFormat,
+	} {
+		require.ErrorIs(t, fromValueDate(fwm, &Field{Tag: "32A", Lines: []string{value}}), want, value)
+	}
+}
+
+func TestCoverField(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.json")
+	fwm.OrderingCustomer.CoverPayment.SwiftFieldTag = "50F"
+	fwm.OrderingInstitution.CoverPayment.SwiftFieldTag = "Swift"
+
+	report := &Report{}
+	f, ok := fieldOf(fwm, coverFields[0], report)
+	require.True(t, ok)
+	require.Equal(t, "50F", f.Tag)
+	require.True(t, report.Empty())
+
+	f, ok = fieldOf(fwm, coverFields[1], report)
+	require.True(t, ok)
+	require.Equal(t, "52D", f.Tag)
+	require.Equal(t, []string{wire.TagOrderingInstitution}, report.Tags())
+	require.Equal(t, `{7052} :52D: replaces SwiftFieldTag "Swift"`, report.Warnings[0].String())
+}
+
+func TestSetFields(t *testing.T) {
+	fwm := newFEDWireMessage(wire.CustomerTransfer)
+	report := &Report{}
+	rest := setFields(fwm, partyFields[MT103], []Field{
+		{Tag: "20", Lines: []string{"Sender Reference"}},
+		{Tag: "57A", Lines: []string{"/123456789", "CITIUS33"}},
+		{Tag: "59", Lines: []string{"/1234", "Name"}},
+		{Tag: "59A", Lines: []string{"CITIUS33"}},
+	}, report)
+	require.Equal(t, []Field{{Tag: "20", Lines: []string{"Sender Reference"}}}, rest)
+	require.Equal(t, "CITIUS33", fwm.BeneficiaryFI.FinancialInstitution.Identifier)
+	require.Equal(t, "1234", fwm.Beneficiary.Personal.Identifier)
+	require.Equal(t, ":57A: party identifier has no FEDWireMessage tag", report.Warnings[0].String())
+	require.Equal(t, ":59A: repeated field has no FEDWireMessage tag", report.Warnings[1].String())
+}
@@ -0,0 +1,185 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"strings"
+
+	"github.com/moov-io/wire"
+)
+
+// mt103Unmapped are the tags of the messages converted to MT103 which it has no field for
+var mt103Unmapped = unmappedTags([]string{
+	wire.TagPreviousMessageIdentifier,
+	wire.TagLocalInstrument,
+	wire.TagPaymentNotification,
+	wire.TagBeneficiaryReference,
+	wire.TagAccountDebitedDrawdown,
+	wire.TagInstructingFI,
+	wire.TagAccountCreditedDrawdown,
+}, fiToFITags, coverTags, remittanceTags)
+
+// MT103FromFEDWireMessage converts a CTR customer transfer or CTP customer transfer plus to an MT103 message.
+//
+// The {3320} SenderReference is the :20: reference, the {3600} TransactionTypeCode the :26T: transaction type
+// code and the cycle date and {2000} Amount the :32A: value date and amount. The {3700} Charges, {3710}
+// InstructedAmount and {3720} ExchangeRate are the :71A:, :71F:, :33B: and :36: fields, the {6000}
+// OriginatorToBeneficiary the :70: remittance information and the {6100} FIReceiverFI the :72: sender to receiver
+// information. The SWIFT field of each party is listed in partyFields. Data of the FEDWireMessage which an MT103 has
+// no field for, including whether a transfer without an {5010} OriginatorOptionF is a CTP, is listed in the
+// returned Report.
+func MT103FromFEDWireMessage(fwm *wire.FEDWireMessage) (*Message, *Report, error) {
+	if err := requireBusinessFunctionCode(fwm, wire.CustomerTransfer, wire.CustomerTransferPlus); err != nil {
+		return nil, nil, err
+	}
+	report := &Report{}
+	m := &Message{Type: MT103}
+	reportHeaders(fwm, report)
+
+	m.add("20", senderReference(fwm, report))
+	m.add("23B", BankOperationCodeCredit)
+	if code := strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode); code != "" {
+		m.add("26T", code)
+	}
+	if fwm.BusinessFunctionCode.BusinessFunctionCode == wire.CustomerTransferPlus && fwm.OriginatorOptionF == nil {
+		report.Unmapped(wire.TagBusinessFunctionCode, wire.CustomerTransferPlus, nowhere)
+	}
+	m.add("32A", valueDate(fwm))
+	if ia := fwm.InstructedAmount; ia != nil {
+		m.add("33B", ia.CurrencyCode+swiftAmount(ia.Amount))
+	}
+	if xr := fwm.ExchangeRate; xr != nil {
+		m.add("36", swiftAmount(xr.ExchangeRate))
+	}
+	m.addFields(fwm, partyFields[MT103], report)
+	if ob := fwm.OriginatorToBeneficiary; ob != nil {
+		lines := []string{ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour}
+		m.add("70", report.FitLines(at(wire.TagOriginatorToBeneficiary, "70"), lines, 4, 35)...)
+	}
+	m.addCharges(fwm, report)
+	m.add("72", fiReceiverLines(fwm, report)...)
+
+	report.UnmappedTags(fwm, mt103Unmapped, nowhere)
+	return m, report, nil
+}
+
+// addCharges adds the :71A: details of charges and :71F: sender's charges of the {3700} Charges of fwm to m. A
+// transfer without charges is borne by the ordering customer.
+func (m *Message) addCharges(fwm *wire.FEDWireMessage, report *Report) {
+	c := fwm.Charges
+	if c == nil {
+		m.add("71A", ChargesOurs)
+		return
+	}
+	details := ChargesOurs
+	switch c.ChargeDetails {
+	case wire.CDBeneficiary:
+		details = ChargesBeneficiary
+	case wire.CDShared:
+		details = ChargesShared
+	}
+	m.add("71A", details)
+	for _, charges := range []string{c.SendersChargesOne, c.SendersChargesTwo, c.SendersChargesThree, c.SendersChargesFour} {
+		charges = strings.TrimSpace(charges)
+		switch {
+		case charges == "":
+		case details == ChargesOurs:
+			report.Unmapped(wire.TagCharges, "SendersCharges of charges borne by the ordering customer", nowhere)
+			return
+		case len(charges) > 3:
+			m.add("71F", charges[:3]+swiftAmount(charges[3:]))
+		}
+	}
+}
+
+// mt103FEDWireMessage converts an MT103 to a CTR customer transfer, or a CTP customer transfer plus when its
+// ordering customer is a :50F: field
+func (m *Message) mt103FEDWireMessage() (*wire.FEDWireMessage, *Report, error) {
+	bfc := wire.CustomerTransfer
+	if findField(m.Fields, "50F") != nil {
+		bfc = wire.CustomerTransferPlus
+	}
+	report := &Report{}
+	fwm := newFEDWireMessage(bfc)
+	if err := fromValueDate(fwm, m.Field("32A")); err != nil {
+		return nil, nil, err
+	}
+
+	var details string
+	var charges []string
+	for _, f := range setFields(fwm, partyFields[MT103], m.Fields, report) {
+		value := strings.Join(f.Lines, "")
+		switch f.Tag {
+		case "20":
+			setSenderReference(fwm, value, report)
+		case "23B":
+			if value != BankOperationCodeCredit {
+				report.NoTag(at("", f.Tag), "bank operation code "+value)
+			}
+		case "26T":
+			fwm.BusinessFunctionCode.TransactionTypeCode = report.Fit(at(wire.TagBusinessFunctionCode, f.Tag), value, 3)
+		case "32A":
+		case "33B":
+			if len(value) <= 3 {
+				return nil, nil, unexpected(f, ErrFieldFormat)
+			}
+			fwm.InstructedAmount = wire.NewInstructedAmount()
+			fwm.InstructedAmount.CurrencyCode = value[:3]
+			fwm.InstructedAmount.Amount = report.Fit(at(wire.TagInstructedAmount, f.Tag), value[3:], 15)
+		case "36":
+			fwm.ExchangeRate = wire.NewExchangeRate()
+			fwm.ExchangeRate.ExchangeRate = report.Fit(at(wire.TagExchangeRate, f.Tag), value, 12)
+		case "70":
+			lines := append(report.FitLines(at(wire.TagOriginatorToBeneficiary, f.Tag), f.Lines, 4, 35), make([]string, 4)...)
+			fwm.OriginatorToBeneficiary = wire.NewOriginatorToBeneficiary()
+			fwm.OriginatorToBeneficiary.LineOne = lines[0]
+			fwm.OriginatorToBeneficiary.LineTwo = lines[1]
+			fwm.OriginatorToBeneficiary.LineThree = lines[2]
+			fwm.OriginatorToBeneficiary.LineFour = lines[3]
+		case "71A":
+			details = value
+		case "71F":
+			charges = append(charges, value)
+		case "72":
+			setFIReceiverFI(fwm, f, report)
+		default:
+			report.NoTag(at("", f.Tag), "")
+		}
+	}
+	if err := setCharges(fwm, details, charges, report); err != nil {
+		return nil, nil, err
+	}
+	return fwm, report, nil
+}
+
+// setCharges sets the {3700} Charges of fwm from the :71A: details of charges and :71F: sender's charges of an
+// MT103. Charges borne by the ordering customer have no Charges.
+func setCharges(fwm *wire.FEDWireMessage, details string, charges []string, report *Report) error {
+	c := wire.NewCharges()
+	switch details {
+	case ChargesBeneficiary:
+		c.ChargeDetails = wire.CDBeneficiary
+	case ChargesShared:
+		c.ChargeDetails = wire.CDShared
+	case ChargesOurs, "":
+		if len(charges) > 0 {
+			report.NoTag(at("", "71F"), "")
+		}
+		return nil
+	default:
+		return &FieldError{Field: "71A", Value: details, Err: ErrFieldCode}
+	}
+	if len(charges) > 4 {
+		report.Truncated(at(wire.TagCharges, "71F"))
+		charges = charges[:4]
+	}
+	charges = append(charges, make([]string, 4)...)
+	c.SendersChargesOne = report.Fit(at(wire.TagCharges, "71F"), charges[0], 15)
+	c.SendersChargesTwo = report.Fit(at(wire.TagCharges, "71F"), charges[1], 15)
+	c.SendersChargesThree = report.Fit(at(wire.TagCharges, "71F"), charges[2], 15)
+	c.SendersChargesFour = report.Fit(at(wire.TagCharges, "71F"), charges[3], 15)
+	fwm.Charges = c
+	return nil
+}
@@ -0,0 +1,185 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMT103FromFEDWireMessage_CustomerTransfer(t *testing.T) {
+	m, report, err := MT103FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerTransfer.json"))
+	require.NoError(t, err)
+
+	require.Equal(t, "MT103", m.Name())
+	require.Equal(t, []Field{
+		{Tag: "20", Lines: []string{"Sender Reference"}},
+		{Tag: "23B", Lines: []string{BankOperationCodeCredit}},
+		{Tag: "32A", Lines: []string{"190410USD12345,67"}},
+		{Tag: "33B", Lines: []string{"USD4567,89"}},
+		{Tag: "36", Lines: []string{"1,2345"}},
+		{Tag: "50K", Lines: []string{"/1/1234", "Name", "Address One", "Address Three"}},
+		{Tag: "52D", Lines: []string{"/123456789", "FI Name", "Address One", "Address Two", "Address Three"}},
+		{Tag: "56D", Lines: []string{"/123456789", "FI Name", "Address One", "Address Two", "Address Three"}},
+		{Tag: "57D", Lines: []string{"/123456789", "FI Name", "Address One", "Address Two", "Address Three"}},
+		{Tag: "59", Lines: []string{"/3/1234", "Name", "Address One", "Address Two", "Address Three"}},
+		{Tag: "70", Lines: []string{"LineOne", "LineTwo", "LineThree", "LineFour"}},
+		{Tag: "71A", Lines: []string{ChargesBeneficiary}},
+		{Tag: "71F", Lines: []string{"USD0,99"}},
+		{Tag: "71F", Lines: []string{"USD2,99"}},
+		{Tag: "71F", Lines: []string{"USD3,99"}},
+		{Tag: "71F", Lines: []string{"USD1,00"}},
+		{Tag: "72", Lines: []string{"Line Six"}},
+	}, m.Fields)
+
+	tags := report.Tags()
+	for _, tag := range []string{
+		wire.TagSenderSupplied,
+		wire.TagInputMessageAccountabilityData,
+		wire.TagSenderDepositoryInstitution,
+		wire.TagReceiverDepositoryInstitution,
+		wire.TagPreviousMessageIdentifier,
+		wire.TagBeneficiaryReference,
+		wire.TagInstructingFI,
+		wire.TagFIIntermediaryFI,
+	} {
+		require.Contains(t, tags, tag)
+	}
+	for _, tag := range []string{wire.TagOriginator, wire.TagCharges, wire.TagFIReceiverFI} {
+		require.NotContains(t, tags, tag)
+	}
+}
+
+func TestMT103FromFEDWireMessage_CustomerTransferPlus(t *testing.T) {
+	m, report := convertMessage(t, readMessage(t, "fedWireMessage-CustomerTransferPlus.json"))
+
+	require.Equal(t, "MT103", m.Name())
+	require.Nil(t, m.Field("50K"))
+	require.Equal(t, []string{"TXID/123-45-6789", "1/Name", "1/1234", "2/1000 Colonial Farm Rd", "5/Pottstown"},
+		m.Field("50F").Lines)
+	require.Contains(t, report.Tags(), wire.TagOriginator)
+	require.NotContains(t, report.Tags(), wire.TagBusinessFunctionCode)
+	require.NoError(t, m.ValidateAll().Err())
+}
+
+func TestMT103FromFEDWireMessage_Charges(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-CustomerTransfer.json")
+	fwm.Charges = nil
+	m, report, err := MT103FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+	require.Equal(t, []string{ChargesOurs}, m.Field("71A").Lines)
+	require.Nil(t, m.Field("71F"))
+	require.NotContains(t, report.Tags(), wire.TagCharges)
+
+	fwm.Charges = wire.NewCharges()
+	fwm.Charges.SendersChargesOne = "USD0,99"
+	m, report, err = MT103FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+	require.Equal(t, []string{ChargesOurs}, m.Field("71A").Lines)
+	require.Nil(t, m.Field("71F"))
+	require.Contains(t, report.Tags(), wire.TagCharges)
+
+	fwm.Charges.ChargeDetails = wire.CDShared
+	m, _, err = MT103FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+	require.Equal(t, []string{ChargesShared}, m.Field("71A").Lines)
+	require.Equal(t, []string{"USD0,99"}, m.Field("71F").Lines)
+}
+
+func TestMT103FromFEDWireMessage_Errors(t *testing.T) {
+	_, _, err := MT103FromFEDWireMessage(readMessage(t, "fedWireMessage-BankTransfer.json"))
+	require.ErrorIs(t, err, ErrBusinessFunctionCode)
+
+	_, _, err = MT103FromFEDWireMessage(nil)
+	require.ErrorIs(t, err, ErrNoMessage)
+}
+
+func TestMT103_FEDWireMessage(t *testing.T) {
+	for _, name := range []string{
+		"fedWireMessage-CustomerTransfer.json",
+		"fedWireMessage-CustomerTransferPlus.json",
+	} {
+		t.Run(name, func(t *testing.T) {
+			fwm := readMessage(t, name)
+			// Blank values and lines are not carried by MT103
+			fwm.BusinessFunctionCode.TransactionTypeCode = strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode)
+			if fwm.Originator != nil {
+				fwm.Originator.Personal.Address.AddressLineTwo = "Address Two"
+			}
+			m, exported, err := MT103FromFEDWireMessage(fwm)
+			require.NoError(t, err)
+			m.Sender, m.Receiver = senderBIC, receiverBIC
+
+			got, imported, err := writeRead(t, m).FEDWireMessage()
+			require.NoError(t, err)
+			require.True(t, imported.Empty(), imported.Warnings)
+			setHeaders(got, fwm)
+			require.NoError(t, got.ValidateAll().Err())
+			requireSameTags(t, fwm, got, exported)
+		})
+	}
+}
+
+func TestMT103_FEDWireMessageFields(t *testing.T) {
+	m := &Message{Type: MT103, Fields: []Field{
+		{Tag: "20", Lines: []string{NonReference}},
+		{Tag: "23B", Lines: []string{"SPAY"}},
+		{Tag: "32A", Lines: []string{"190410USD12345,67"}},
+		{Tag: "50A", Lines: []string{"/123456789", "WFBIUS6S"}},
+		{Tag: "59", Lines: []string{"/1234", "Name"}},
+		{Tag: "71A", Lines: []string{ChargesOurs}},
+		{Tag: "71F", Lines: []string{"USD0,99"}},
+		{Tag: "77B", Lines: []string{"/ORDERRES/US//Reporting"}},
+	}}
+	fwm, report, err := m.FEDWireMessage()
+	require.NoError(t, err)
+
+	require.Equal(t, wire.CustomerTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Nil(t, fwm.SenderReference)
+	require.Nil(t, fwm.Charges)
+	require.Equal(t, "20190410", fwm.InputMessageAccountabilityData.InputCycleDate)
+	require.Equal(t, "000001234567", fwm.Amount.Amount)
+	require.Equal(t, wire.SWIFTBankIdentifierCode, fwm.Originator.Personal.IdentificationCode)
+	require.Equal(t, "WFBIUS6S", fwm.Originator.Personal.Identifier)
+	require.Equal(t, wire.DemandDepositAccountNumber, fwm.Beneficiary.Personal.IdentificationCode)
+
+	var warnings []string
+	for _, w := range report.Warnings {
+		warnings = append(warnings, w.String())
+	}
+	require.Equal(t, []string{
+		":50A: party identifier has no FEDWireMessage tag",
+		":23B: bank operation code SPAY has no FEDWireMessage tag",
+		":77B: has no FEDWireMessage tag",
+		":71F: has no FEDWireMessage tag",
+	}, warnings)
+}
+
+func TestMT103_FEDWireMessageErrors(t *testing.T) {
+	for name, tt := range map[string]struct {
+		field Field
+		err   error
+	}{
+		"value date": {Field{Tag: "32A", Lines: []string{"190410EUR12345,67"}}, ErrFieldCode},
+		"charges":    {Field{Tag: "71A", Lines: []string{"ALL"}}, ErrFieldCode},
+		"amount":     {Field{Tag: "33B", Lines: []string{"USD"}}, ErrFieldFormat},
+	} {
+		t.Run(name, func(t *testing.T) {
+			m := &Message{Type: MT103, Fields: []Field{{Tag: "32A", Lines: []string{"190410USD12345,67"}}}}
+			if tt.field.Tag == "32A" {
+				m.Fields = nil
+			}
+			m.Fields = append(m.Fields, tt.field)
+			_, _, err := m.FEDWireMessage()
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	_, _, err := (&Message{Type: MT103}).FEDWireMessage()
+	require.ErrorIs(t, err, ErrFieldRequired)
+}
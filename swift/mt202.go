@@ -0,0 +1,173 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moov-io/wire"
+)
+
+// mt202Unmapped are the tags of the messages converted to MT202 which it has no field for
+var mt202Unmapped = unmappedTags(mt202SequenceAUnmapped, fiToFITags, coverTags, remittanceTags)
+
+// mt202COVUnmapped are the tags of the messages converted to MT202 COV which it has no field for
+var mt202COVUnmapped = unmappedTags(mt202SequenceAUnmapped, fiToFITags, remittanceTags)
+
+// mt202SequenceAUnmapped are the tags of a transfer which neither an MT202 nor sequence A of an MT202 COV have a
+// field for
+var mt202SequenceAUnmapped = []string{
+	wire.TagPreviousMessageIdentifier,
+	wire.TagPaymentNotification,
+	wire.TagCharges,
+	wire.TagInstructedAmount,
+	wire.TagExchangeRate,
+	wire.TagAccountDebitedDrawdown,
+	wire.TagOriginatorOptionF,
+	wire.TagOriginatorFI,
+	wire.TagInstructingFI,
+	wire.TagAccountCreditedDrawdown,
+	wire.TagOriginatorToBeneficiary,
+}
+
+// MT202FromFEDWireMessage converts a BTR bank transfer to an MT202 message.
+//
+// The {3320} SenderReference is the :20: reference, the {4320} BeneficiaryReference the :21: related reference, the
+// cycle date and {2000} Amount the :32A: value date and amount and the {6100} FIReceiverFI the :72: sender to
+// receiver information. The SWIFT field of each party is listed in partyFields. Data of the FEDWireMessage which an
+// MT202 has no field for is listed in the returned Report.
+func MT202FromFEDWireMessage(fwm *wire.FEDWireMessage) (*Message, *Report, error) {
+	if err := requireBusinessFunctionCode(fwm, wire.BankTransfer); err != nil {
+		return nil, nil, err
+	}
+	report := &Report{}
+	m := &Message{Type: MT202}
+	m.addSequenceA(fwm, report)
+	report.UnmappedTags(fwm, mt202Unmapped, nowhere)
+	return m, report, nil
+}
+
+// MT202COVFromFEDWireMessage converts a CTP customer transfer plus with the LocalInstrument
+// SequenceBCoverPaymentStructured to an MT202 COV message.
+//
+// Sequence A holds the fields of an MT202. The {7050} to {7072} cover payment tags are the fields of sequence B,
+// whose options are their SwiftFieldTags, followed by the {7033} CurrencyInstructedAmount as the :33B: currency and
+// instructed amount. Data of the FEDWireMessage which an MT202 COV has no field for is listed in the returned Report.
+func MT202COVFromFEDWireMessage(fwm *wire.FEDWireMessage) (*Message, *Report, error) {
+	if err := requireBusinessFunctionCode(fwm, wire.CustomerTransferPlus); err != nil {
+		return nil, nil, err
+	}
+	if !isCover(fwm) {
+		return nil, nil, fmt.Errorf("%w: %s without the %s LocalInstrument", ErrBusinessFunctionCode,
+			wire.CustomerTransferPlus, wire.SequenceBCoverPaymentStructured)
+	}
+	report := &Report{}
+	m := &Message{Type: MT202, ValidationFlag: ValidationFlagCover}
+	m.addSequenceA(fwm, report)
+	m.addFields(fwm, coverFields, report)
+	if cia := fwm.CurrencyInstructedAmount; cia != nil {
+		if cia.SwiftFieldTag != "33B" {
+			report.Warnings = append(report.Warnings, Warning{Tag: wire.TagCurrencyInstructedAmount, Field: "33B",
+				Reason: fmt.Sprintf("replaces SwiftFieldTag %q", cia.SwiftFieldTag)})
+		}
+		m.add("33B", currencyUSD+swiftAmount(cia.Amount))
+	}
+	report.UnmappedTags(fwm, mt202COVUnmapped, nowhere)
+	return m, report, nil
+}
+
+// addSequenceA adds the fields of an MT202, or of sequence A of an MT202 COV, to m
+func (m *Message) addSequenceA(fwm *wire.FEDWireMessage, report *Report) {
+	reportHeaders(fwm, report)
+	if code := strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode); code != "" {
+		report.Unmapped(wire.TagBusinessFunctionCode, "TransactionTypeCode", nowhere)
+	}
+	m.add("20", senderReference(fwm, report))
+	related := NonReference
+	if fwm.BeneficiaryReference != nil {
+		related = reference(wire.TagBeneficiaryReference, "21", fwm.BeneficiaryReference.BeneficiaryReference, report)
+	}
+	m.add("21", related)
+	m.add("32A", valueDate(fwm))
+	m.addFields(fwm, partyFields[MT202], report)
+	m.add("72", fiReceiverLines(fwm, report)...)
+}
+
+// mt202FEDWireMessage converts an MT202 to a BTR bank transfer
+func (m *Message) mt202FEDWireMessage() (*wire.FEDWireMessage, *Report, error) {
+	report := &Report{}
+	fwm := newFEDWireMessage(wire.BankTransfer)
+	if err := setSequenceA(fwm, m.Fields, report); err != nil {
+		return nil, nil, err
+	}
+	return fwm, report, nil
+}
+
+// mt202COVFEDWireMessage converts an MT202 COV to a CTP customer transfer plus with the LocalInstrument
+// SequenceBCoverPaymentStructured. Sequence B starts at the ordering customer's :50a: field.
+func (m *Message) mt202COVFEDWireMessage() (*wire.FEDWireMessage, *Report, error) {
+	report := &Report{}
+	fwm := newFEDWireMessage(wire.CustomerTransferPlus)
+	fwm.LocalInstrument = wire.NewLocalInstrument()
+	fwm.LocalInstrument.LocalInstrumentCode = wire.SequenceBCoverPaymentStructured
+
+	sequenceB := len(m.Fields)
+	for i, f := range m.Fields {
+		if fieldMatches(f.Tag, "50a") {
+			sequenceB = i
+			break
+		}
+	}
+	if err := setSequenceA(fwm, m.Fields[:sequenceB], report); err != nil {
+		return nil, nil, err
+	}
+	for _, f := range setFields(fwm, coverFields, m.Fields[sequenceB:], report) {
+		if f.Tag != "33B" {
+			report.NoTag(at("", f.Tag), "")
+			continue
+		}
+		value := strings.Join(f.Lines, "")
+		if len(value) <= 3 {
+			return nil, nil, unexpected(f, ErrFieldFormat)
+		}
+		if value[:3] != currencyUSD {
+			report.NoTag(at("", f.Tag), "currency "+value[:3])
+		}
+		amount := value[3:]
+		if len(amount) < 18 {
+			amount = strings.Repeat("0", 18-len(amount)) + amount
+		}
+		fwm.CurrencyInstructedAmount = wire.NewCurrencyInstructedAmount()
+		fwm.CurrencyInstructedAmount.SwiftFieldTag = f.Tag
+		fwm.CurrencyInstructedAmount.Amount = report.Fit(at(wire.TagCurrencyInstructedAmount, f.Tag), amount, 18)
+	}
+	return fwm, report, nil
+}
+
+// setSequenceA sets the tags of fwm from the fields of an MT202, or of sequence A of an MT202 COV
+func setSequenceA(fwm *wire.FEDWireMessage, fields []Field, report *Report) error {
+	if err := fromValueDate(fwm, findField(fields, "32A")); err != nil {
+		return err
+	}
+	for _, f := range setFields(fwm, partyFields[MT202], fields, report) {
+		value := strings.Join(f.Lines, "")
+		switch f.Tag {
+		case "20":
+			setSenderReference(fwm, value, report)
+		case "21":
+			if value != NonReference {
+				fwm.BeneficiaryReference = wire.NewBeneficiaryReference()
+				fwm.BeneficiaryReference.BeneficiaryReference = report.Fit(at(wire.TagBeneficiaryReference, f.Tag), value, 16)
+			}
+		case "32A":
+		case "72":
+			setFIReceiverFI(fwm, f, report)
+		default:
+			report.NoTag(at("", f.Tag), "")
+		}
+	}
+	return nil
+}
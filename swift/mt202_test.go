@@ -0,0 +1,140 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// setSwiftFieldTags sets the SwiftFieldTags of the cover payment tags of fwm to options of their fields
+func setSwiftFieldTags(fwm *wire.FEDWireMessage) {
+	fwm.OrderingCustomer.CoverPayment.SwiftFieldTag = "50K"
+	fwm.OrderingInstitution.CoverPayment.SwiftFieldTag = "52D"
+	fwm.IntermediaryInstitution.CoverPayment.SwiftFieldTag = "56D"
+	fwm.InstitutionAccount.CoverPayment.SwiftFieldTag = "57D"
+	fwm.BeneficiaryCustomer.CoverPayment.SwiftFieldTag = "59"
+	fwm.Remittance.CoverPayment.SwiftFieldTag = "70"
+	fwm.SenderToReceiver.CoverPayment.SwiftFieldTag = "72"
+	fwm.CurrencyInstructedAmount.SwiftFieldTag = "33B"
+}
+
+func TestMT202FromFEDWireMessage(t *testing.T) {
+	m, report, err := MT202FromFEDWireMessage(readMessage(t, "fedWireMessage-BankTransfer.json"))
+	require.NoError(t, err)
+
+	require.Equal(t, "MT202", m.Name())
+	require.Equal(t, []string{"Sender Reference"}, m.Field("20").Lines)
+	require.Equal(t, []string{"Reference"}, m.Field("21").Lines)
+	require.Equal(t, []string{"190410USD12345,67"}, m.Field("32A").Lines)
+	require.Equal(t, "58D", m.Field("58a").Tag)
+	require.Nil(t, m.Field("50a"))
+
+	tags := report.Tags()
+	require.Contains(t, tags, wire.TagSenderSupplied)
+	require.NotContains(t, tags, wire.TagBeneficiaryReference)
+	require.NotContains(t, tags, wire.TagBeneficiary)
+}
+
+func TestMT202COVFromFEDWireMessage(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.json")
+	m, report, err := MT202COVFromFEDWireMessage(fwm)
+	require.NoError(t, err)
+
+	require.Equal(t, "MT202 COV", m.Name())
+	require.Equal(t, []string{"Swift Line One", "Swift Line Two", "Swift Line Three", "Swift Line Four", "Swift Line Five"},
+		m.Field("50K").Lines)
+	require.Len(t, m.Field("72").Lines, 6)
+	require.Equal(t, []string{"USD1500,49"}, m.Field("33B").Lines)
+
+	// The placeholder SwiftFieldTags of the test file are replaced
+	tags := report.Tags()
+	require.Contains(t, tags, wire.TagOrderingCustomer)
+	require.Contains(t, tags, wire.TagCurrencyInstructedAmount)
+
+	setSwiftFieldTags(fwm)
+	_, report, err = MT202COVFromFEDWireMessage(fwm)
+	require.NoError(t, err)
+	tags = report.Tags()
+	require.NotContains(t, tags, wire.TagOrderingCustomer)
+	require.NotContains(t, tags, wire.TagCurrencyInstructedAmount)
+}
+
+func TestMT202COVFromFEDWireMessage_Errors(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-CustomerTransferPlus.json")
+	_, _, err := MT202COVFromFEDWireMessage(fwm)
+	require.ErrorIs(t, err, ErrBusinessFunctionCode)
+	require.Contains(t, err.Error(), wire.SequenceBCoverPaymentStructured)
+
+	_, _, err = MT202COVFromFEDWireMessage(readMessage(t, "fedWireMessage-BankTransfer.json"))
+	require.ErrorIs(t, err, ErrBusinessFunctionCode)
+
+	_, _, err = MT202FromFEDWireMessage(readMessage(t, "fedWireMessage-CustomerTransfer.json"))
+	require.ErrorIs(t, err, ErrBusinessFunctionCode)
+}
+
+func TestMT202_FEDWireMessage(t *testing.T) {
+	for _, name := range []string{
+		"fedWireMessage-BankTransfer.json",
+		"fedWireMessage-CustomerTransferPlusCOVS.json",
+	} {
+		t.Run(name, func(t *testing.T) {
+			fwm := readMessage(t, name)
+			// Blank values and lines are not carried by MT202
+			fwm.BusinessFunctionCode.TransactionTypeCode = strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode)
+			if fwm.Originator != nil {
+				fwm.Originator.Personal.Address.AddressLineTwo = "Address Two"
+			}
+			if isCover(fwm) {
+				setSwiftFieldTags(fwm)
+			}
+			m, exported, err := FromFEDWireMessage(fwm)
+			require.NoError(t, err)
+			m.Sender, m.Receiver = senderBIC, receiverBIC
+
+			got, imported, err := writeRead(t, m).FEDWireMessage()
+			require.NoError(t, err)
+			require.True(t, imported.Empty(), imported.Warnings)
+			setHeaders(got, fwm)
+			require.NoError(t, got.ValidateAll().Err())
+			requireSameTags(t, fwm, got, exported)
+		})
+	}
+}
+
+func TestMT202COV_FEDWireMessageFields(t *testing.T) {
+	m := &Message{Type: MT202, ValidationFlag: ValidationFlagCover, Fields: []Field{
+		{Tag: "20", Lines: []string{"Sender Reference"}},
+		{Tag: "21", Lines: []string{NonReference}},
+		{Tag: "32A", Lines: []string{"190508USD1500,49"}},
+		{Tag: "58A", Lines: []string{"CITIUS33"}},
+		{Tag: "50F", Lines: []string{"/1234", "1/Name"}},
+		{Tag: "59A", Lines: []string{"CITIUS33"}},
+		{Tag: "33B", Lines: []string{"EUR1500,49"}},
+	}}
+	fwm, report, err := m.FEDWireMessage()
+	require.NoError(t, err)
+
+	require.Equal(t, wire.CustomerTransferPlus, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, wire.SequenceBCoverPaymentStructured, fwm.LocalInstrument.LocalInstrumentCode)
+	require.Nil(t, fwm.BeneficiaryReference)
+	require.Equal(t, "CITIUS33", fwm.Beneficiary.Personal.Identifier)
+	require.Equal(t, "50F", fwm.OrderingCustomer.CoverPayment.SwiftFieldTag)
+	require.Equal(t, "59A", fwm.BeneficiaryCustomer.CoverPayment.SwiftFieldTag)
+	require.Equal(t, "000000000001500,49", fwm.CurrencyInstructedAmount.Amount)
+	require.Len(t, report.Warnings, 1)
+	require.Equal(t, ":33B: currency EUR has no FEDWireMessage tag", report.Warnings[0].String())
+
+	m.Fields[6].Lines = []string{"USD"}
+	_, _, err = m.FEDWireMessage()
+	require.ErrorIs(t, err, ErrFieldFormat)
+
+	m.Fields = m.Fields[:2]
+	_, _, err = m.FEDWireMessage()
+	require.ErrorIs(t, err, ErrFieldRequired)
+}
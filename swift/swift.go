@@ -0,0 +1,314 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package swift converts FEDWireMessages to and from the SWIFT MT103, MT202 and MT202 COV messages used by
+// correspondent banks.
+//
+// Messages are read and written in the SWIFT FIN format: a basic header, an application header, an optional user
+// header and the text block holding the message's fields. The BICs of the sender and receiver have no
+// FEDWireMessage tag, so they are set by the caller, as are the ABA numbers of a FEDWireMessage converted from a
+// Message. Conversions return a Report of the data which could not be converted.
+package swift
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/moov-io/wire"
+	"github.com/moov-io/wire/internal/convert"
+)
+
+const (
+	// MT103 is the message type of single customer credit transfers
+	MT103 = "103"
+	// MT202 is the message type of general financial institution transfers, and of MT202 COV cover payments
+	MT202 = "202"
+
+	// ValidationFlagCover is the {119} validation flag of an MT202 COV
+	ValidationFlagCover = "COV"
+
+	// NonReference is the :20: or :21: reference of a message without one
+	NonReference = "NONREF"
+	// BankOperationCodeCredit is the :23B: bank operation code of a credit transfer
+	BankOperationCodeCredit = "CRED"
+
+	// ChargesBeneficiary is the :71A: details of charges when charges are borne by the beneficiary
+	ChargesBeneficiary = "BEN"
+	// ChargesShared is the :71A: details of charges when charges are shared
+	ChargesShared = "SHA"
+	// ChargesOurs is the :71A: details of charges when charges are borne by the ordering customer
+	ChargesOurs = "OUR"
+
+	currencyUSD = "USD"
+)
+
+var (
+	// ErrNoMessage is given when there is no FEDWireMessage to convert
+	ErrNoMessage = errors.New("no FEDWireMessage")
+	// ErrBusinessFunctionCode is given when a FEDWireMessage's BusinessFunctionCode cannot be converted to a message
+	ErrBusinessFunctionCode = errors.New("business function code cannot be converted to this message")
+	// ErrMessageType is given when a Message is not of a type which can be converted
+	ErrMessageType = errors.New("message type cannot be converted")
+	// ErrMessageFormat is given when text read is not a SWIFT FIN message
+	ErrMessageFormat = errors.New("not a SWIFT FIN message")
+)
+
+// Warning describes data which could not be converted, or was changed to fit its new format
+type Warning = convert.Warning
+
+// Report lists the Warnings of a conversion
+type Report = convert.Report
+
+// nowhere completes the "has no ..." reason of the Warnings for unmapped data
+const nowhere = "SWIFT field"
+
+// at returns a Warning about the data of tag and the SWIFT field
+func at(tag, field string) Warning {
+	return Warning{Tag: tag, Field: field}
+}
+
+// Field is a field of the text block of a Message
+type Field struct {
+	// Tag of the field including its option letter, such as 50K
+	Tag string `json:"tag"`
+	// Lines of the field's value
+	Lines []string `json:"lines"`
+}
+
+// Message is a SWIFT MT message
+type Message struct {
+	// Type is the message type, MT103 or MT202
+	Type string `json:"type"`
+	// ValidationFlag is the {119} validation flag of the user header, ValidationFlagCover for an MT202 COV
+	ValidationFlag string `json:"validationFlag,omitempty"`
+	// Sender is the BIC of the financial institution sending the message
+	Sender string `json:"sender"`
+	// Receiver is the BIC of the financial institution receiving the message
+	Receiver string `json:"receiver"`
+	// Fields of the text block in order
+	Fields []Field `json:"fields"`
+}
+
+// Name returns the name of the message type, such as MT202 COV
+func (m *Message) Name() string {
+	if m.ValidationFlag == ValidationFlagCover {
+		return "MT" + m.Type + " " + ValidationFlagCover
+	}
+	return "MT" + m.Type
+}
+
+// Field returns the first field of m with the tag, or the first with any option of tag when it ends in "a", such
+// as 50a, or nil when there is none
+func (m *Message) Field(tag string) *Field {
+	return findField(m.Fields, tag)
+}
+
+// add appends a field of lines to m unless the lines are empty
+func (m *Message) add(tag string, lines ...string) {
+	if len(lines) == 0 || (len(lines) == 1 && lines[0] == "") {
+		return
+	}
+	m.Fields = append(m.Fields, Field{Tag: tag, Lines: lines})
+}
+
+// findField returns the first of fields with the tag, or with any option of tag when it ends in "a"
+func findField(fields []Field, tag string) *Field {
+	for i := range fields {
+		if fieldMatches(fields[i].Tag, tag) {
+			return &fields[i]
+		}
+	}
+	return nil
+}
+
+// fieldMatches returns true when the field tag is tag, or an option of tag when it ends in "a"
+func fieldMatches(tag, want string) bool {
+	if strings.HasSuffix(want, "a") {
+		return len(tag) >= 2 && tag[:2] == want[:2]
+	}
+	return tag == want
+}
+
+var (
+	basicHeaderRegex  = regexp.MustCompile(`^F01([A-Z0-9]{12})[0-9]{10}$`)
+	inputHeaderRegex  = regexp.MustCompile(`^I([0-9]{3})([A-Z0-9]{12})[SNU]?[0-9]?([0-9]{3})?$`)
+	outputHeaderRegex = regexp.MustCompile(`^O([0-9]{3})[0-9]{4}[0-9]{6}([A-Z0-9]{12})[0-9]{10}[0-9]{10}[SNU]?$`)
+	fieldRegex        = regexp.MustCompile(`^:([0-9]{2}[A-Z]?):(.*)$`)
+	flagRegex         = regexp.MustCompile(`\{119:([A-Z0-9]{0,8})\}`)
+)
+
+// Read reads a SWIFT FIN message from r. The message may be an input message, as written by Write, or an output
+// message delivered by SWIFT. Blocks after the text block are ignored.
+func Read(r io.Reader) (*Message, error) {
+	bs, err := io.ReadAll(bufio.NewReader(r))
+	if err != nil {
+		return nil, err
+	}
+	text := strings.TrimSpace(strings.ReplaceAll(string(bs), "\r\n", "\n"))
+
+	basic, ok := block(text, "1")
+	if !ok {
+		return nil, fmt.Errorf("%w: missing basic header", ErrMessageFormat)
+	}
+	match := basicHeaderRegex.FindStringSubmatch(basic)
+	if match == nil {
+		return nil, fmt.Errorf("%w: basic header %q", ErrMessageFormat, basic)
+	}
+	local := match[1]
+
+	app, ok := block(text, "2")
+	if !ok {
+		return nil, fmt.Errorf("%w: missing application header", ErrMessageFormat)
+	}
+	msg := &Message{}
+	if match = inputHeaderRegex.FindStringSubmatch(app); match != nil {
+		msg.Type, msg.Sender, msg.Receiver = match[1], bicOf(local), bicOf(match[2])
+	} else if match = outputHeaderRegex.FindStringSubmatch(app); match != nil {
+		msg.Type, msg.Sender, msg.Receiver = match[1], bicOf(match[2]), bicOf(local)
+	} else {
+		return nil, fmt.Errorf("%w: application header %q", ErrMessageFormat, app)
+	}
+
+	if user, ok := block(text, "3"); ok {
+		if match = flagRegex.FindStringSubmatch(user); match != nil {
+			msg.ValidationFlag = match[1]
+		}
+	}
+
+	start := strings.Index(text, "{4:")
+	end := strings.Index(text, "\n-}")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("%w: missing text block", ErrMessageFormat)
+	}
+	for _, line := range strings.Split(text[start+len("{4:"):end], "\n") {
+		if match = fieldRegex.FindStringSubmatch(line); match != nil {
+			msg.Fields = append(msg.Fields, Field{Tag: match[1], Lines: []string{match[2]}})
+			continue
+		}
+		if len(msg.Fields) == 0 {
+			if line == "" {
+				continue
+			}
+			return nil, fmt.Errorf("%w: text block line %q", ErrMessageFormat, line)
+		}
+		field := &msg.Fields[len(msg.Fields)-1]
+		field.Lines = append(field.Lines, line)
+	}
+	return msg, nil
+}
+
+// block returns the content of the block with the id in text
+func block(text, id string) (string, bool) {
+	start := strings.Index(text, "{"+id+":")
+	if start < 0 {
+		return "", false
+	}
+	content := text[start+len(id)+2:]
+	depth := 0
+	for i, r := range content {
+		switch r {
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				return content[:i], true
+			}
+			depth--
+		}
+	}
+	return "", false
+}
+
+// Write writes m to w as a SWIFT FIN input message with CRLF line endings
+func (m *Message) Write(w io.Writer) error {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "{1:F01%s0000000000}{2:I%s%sN}", logicalTerminal(m.Sender), m.Type, logicalTerminal(m.Receiver))
+	if m.ValidationFlag != "" {
+		fmt.Fprintf(&buf, "{3:{119:%s}}", m.ValidationFlag)
+	}
+	buf.WriteString("{4:\r\n")
+	for _, f := range m.Fields {
+		fmt.Fprintf(&buf, ":%s:%s\r\n", f.Tag, strings.Join(f.Lines, "\r\n"))
+	}
+	buf.WriteString("-}")
+	_, err := io.WriteString(w, buf.String())
+	return err
+}
+
+// logicalTerminal returns the 12 character logical terminal address of a BIC
+func logicalTerminal(bic string) string {
+	bic = strings.ToUpper(bic)
+	if len(bic) < 8 {
+		return bic
+	}
+	branch := "XXX"
+	if len(bic) == 11 {
+		branch = bic[8:]
+	}
+	return bic[:8] + "X" + branch
+}
+
+// bicOf returns the BIC of a logical terminal address
+func bicOf(lt string) string {
+	return lt[:8] + lt[9:]
+}
+
+// requireBusinessFunctionCode returns an error unless fwm has one of the BusinessFunctionCodes codes
+func requireBusinessFunctionCode(fwm *wire.FEDWireMessage, codes ...string) error {
+	if fwm == nil {
+		return ErrNoMessage
+	}
+	if fwm.BusinessFunctionCode == nil {
+		return fmt.Errorf("%w: missing %s", ErrBusinessFunctionCode, wire.TagBusinessFunctionCode)
+	}
+	for _, code := range codes {
+		if fwm.BusinessFunctionCode.BusinessFunctionCode == code {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrBusinessFunctionCode, fwm.BusinessFunctionCode.BusinessFunctionCode)
+}
+
+// isCover returns true when fwm has the LocalInstrument SequenceBCoverPaymentStructured
+func isCover(fwm *wire.FEDWireMessage) bool {
+	return fwm.LocalInstrument != nil && fwm.LocalInstrument.LocalInstrumentCode == wire.SequenceBCoverPaymentStructured
+}
+
+// FromFEDWireMessage converts fwm to the Message of its BusinessFunctionCode: an MT202 COV for a CTP customer
+// transfer with the LocalInstrument SequenceBCoverPaymentStructured, an MT103 for other CTR and CTP customer
+// transfers and an MT202 for a BTR bank transfer.
+func FromFEDWireMessage(fwm *wire.FEDWireMessage) (*Message, *Report, error) {
+	if err := requireBusinessFunctionCode(fwm, wire.CustomerTransfer, wire.CustomerTransferPlus, wire.BankTransfer); err != nil {
+		return nil, nil, err
+	}
+	switch {
+	case fwm.BusinessFunctionCode.BusinessFunctionCode == wire.BankTransfer:
+		return MT202FromFEDWireMessage(fwm)
+	case isCover(fwm):
+		return MT202COVFromFEDWireMessage(fwm)
+	}
+	return MT103FromFEDWireMessage(fwm)
+}
+
+// FEDWireMessage converts m to a FEDWireMessage: a CTR or CTP customer transfer for an MT103, a BTR bank transfer
+// for an MT202 and a CTP customer transfer with the LocalInstrument SequenceBCoverPaymentStructured for an MT202 COV.
+//
+// Only the cycle date of the {1520} InputMessageAccountabilityData is set, from the :32A: value date; the
+// InputSource, InputSequenceNumber and the {3100} and {3400} depository institutions are set by the caller.
+// Fields of m which the FEDWireMessage has no tag for are listed in the returned Report.
+func (m *Message) FEDWireMessage() (*wire.FEDWireMessage, *Report, error) {
+	switch {
+	case m.Type == MT103:
+		return m.mt103FEDWireMessage()
+	case m.Type == MT202 && m.ValidationFlag == ValidationFlagCover:
+		return m.mt202COVFEDWireMessage()
+	case m.Type == MT202:
+		return m.mt202FEDWireMessage()
+	}
+	return nil, nil, fmt.Errorf("%w: %s", ErrMessageType, m.Name())
+}
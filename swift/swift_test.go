@@ -0,0 +1,207 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// BICs of the sender and receiver of the test messages
+const (
+	senderBIC   = "WFBIUS6SXXX"
+	receiverBIC = "CITIUS33"
+)
+
+// readMessage returns the FEDWireMessage of the JSON test file name
+func readMessage(t *testing.T, name string) *wire.FEDWireMessage {
+	t.Helper()
+
+	bs, err := os.ReadFile(filepath.Join("..", "test", "testdata", name))
+	require.NoError(t, err)
+
+	file, err := wire.FileFromJSON(bs)
+	require.NoError(t, err)
+	require.Len(t, file.FEDWireMessages, 1)
+	return &file.FEDWireMessages[0]
+}
+
+// convertMessage converts fwm to its Message, addressed from senderBIC to receiverBIC
+func convertMessage(t *testing.T, fwm *wire.FEDWireMessage) (*Message, *Report) {
+	t.Helper()
+
+	m, report, err := FromFEDWireMessage(fwm)
+	require.NoError(t, err)
+	m.Sender, m.Receiver = senderBIC, receiverBIC
+	return m, report
+}
+
+// writeRead returns m written and read back
+func writeRead(t *testing.T, m *Message) *Message {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, m.Write(&buf))
+	read, err := Read(&buf)
+	require.NoError(t, err)
+	return read
+}
+
+// setHeaders sets the tags of got which a Message has no field for to those of want
+func setHeaders(got, want *wire.FEDWireMessage) {
+	got.SenderSupplied = want.SenderSupplied
+	got.InputMessageAccountabilityData.InputSource = want.InputMessageAccountabilityData.InputSource
+	got.InputMessageAccountabilityData.InputSequenceNumber = want.InputMessageAccountabilityData.InputSequenceNumber
+	got.SenderDepositoryInstitution = want.SenderDepositoryInstitution
+	got.ReceiverDepositoryInstitution = want.ReceiverDepositoryInstitution
+}
+
+// messageTags returns each tag of fwm as written with variable length fields, keyed by tag
+func messageTags(t *testing.T, fwm *wire.FEDWireMessage) map[string]string {
+	t.Helper()
+
+	file := wire.NewFile()
+	file.AddFEDWireMessage(*fwm)
+	var buf bytes.Buffer
+	require.NoError(t, wire.NewWriter(&buf, wire.VariableLengthFields(true)).Write(file))
+
+	tags := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		tags[line[:6]] = line
+	}
+	return tags
+}
+
+// requireSameTags checks the tags of want and got match, other than those listed in the reports
+func requireSameTags(t *testing.T, want, got *wire.FEDWireMessage, reports ...*Report) {
+	t.Helper()
+
+	wantTags, gotTags := messageTags(t, want), messageTags(t, got)
+	for _, r := range reports {
+		for _, tag := range r.Tags() {
+			delete(wantTags, tag)
+			delete(gotTags, tag)
+		}
+	}
+	require.Equal(t, wantTags, gotTags)
+}
+
+func TestRequireBusinessFunctionCode(t *testing.T) {
+	require.ErrorIs(t, requireBusinessFunctionCode(nil, wire.CustomerTransfer), ErrNoMessage)
+
+	fwm := &wire.FEDWireMessage{}
+	require.ErrorIs(t, requireBusinessFunctionCode(fwm, wire.CustomerTransfer), ErrBusinessFunctionCode)
+
+	fwm.BusinessFunctionCode = wire.NewBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = wire.BankTransfer
+	err := requireBusinessFunctionCode(fwm, wire.CustomerTransfer, wire.CustomerTransferPlus)
+	require.ErrorIs(t, err, ErrBusinessFunctionCode)
+	require.Contains(t, err.Error(), wire.BankTransfer)
+
+	fwm.BusinessFunctionCode.BusinessFunctionCode = wire.CustomerTransferPlus
+	require.NoError(t, requireBusinessFunctionCode(fwm, wire.CustomerTransfer, wire.CustomerTransferPlus))
+}
+
+func TestFromFEDWireMessage(t *testing.T) {
+	for name, want := range map[string]string{
+		"fedWireMessage-CustomerTransfer.json":         "MT103",
+		"fedWireMessage-CustomerTransferPlus.json":     "MT103",
+		"fedWireMessage-CustomerTransferPlusCOVS.json": "MT202 COV",
+		"fedWireMessage-BankTransfer.json":             "MT202",
+	} {
+		t.Run(name, func(t *testing.T) {
+			m, _, err := FromFEDWireMessage(readMessage(t, name))
+			require.NoError(t, err)
+			require.Equal(t, want, m.Name())
+		})
+	}
+
+	_, _, err := FromFEDWireMessage(readMessage(t, "fedWireMessage-FEDFundsSold.json"))
+	require.ErrorIs(t, err, ErrBusinessFunctionCode)
+
+	_, _, err = FromFEDWireMessage(nil)
+	require.ErrorIs(t, err, ErrNoMessage)
+}
+
+func TestMessage_FEDWireMessageType(t *testing.T) {
+	m := &Message{Type: "950"}
+	_, _, err := m.FEDWireMessage()
+	require.ErrorIs(t, err, ErrMessageType)
+	require.Contains(t, err.Error(), "MT950")
+}
+
+func TestMessage_Write(t *testing.T) {
+	m := &Message{
+		Type:           MT202,
+		ValidationFlag: ValidationFlagCover,
+		Sender:         senderBIC,
+		Receiver:       receiverBIC,
+		Fields: []Field{
+			{Tag: "20", Lines: []string{"Sender Reference"}},
+			{Tag: "58A", Lines: []string{"/123456789", "CITIUS33"}},
+		},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, m.Write(&buf))
+	require.Equal(t, "{1:F01WFBIUS6SXXXX0000000000}{2:I202CITIUS33XXXXN}{3:{119:COV}}{4:\r\n"+
+		":20:Sender Reference\r\n"+
+		":58A:/123456789\r\nCITIUS33\r\n"+
+		"-}", buf.String())
+
+	read, err := Read(&buf)
+	require.NoError(t, err)
+	require.Equal(t, "CITIUS33XXX", read.Receiver)
+	read.Receiver = m.Receiver
+	require.Equal(t, m, read)
+}
+
+func TestRead_OutputMessage(t *testing.T) {
+	text := "{1:F01CITIUS33AXXX1234123456}{2:O1031015190410WFBIUS6SAXXX12341234561904101015N}" +
+		"{3:{108:MUR}{121:eb6305c9-1f7f-49de-aed0-16487c27b42d}}{4:\n" +
+		":20:Sender Reference\n" +
+		":23B:CRED\n" +
+		":32A:190410USD12345,67\n" +
+		":50K:/1234\nName\n" +
+		":59:/5678\nName\n" +
+		":71A:OUR\n" +
+		"-}{5:{CHK:123456789ABC}}"
+	m, err := Read(strings.NewReader(text))
+	require.NoError(t, err)
+	require.Equal(t, MT103, m.Type)
+	require.Empty(t, m.ValidationFlag)
+	require.Equal(t, "WFBIUS6SXXX", m.Sender)
+	require.Equal(t, "CITIUS33XXX", m.Receiver)
+	require.Len(t, m.Fields, 6)
+	require.Equal(t, []string{"/1234", "Name"}, m.Field("50a").Lines)
+	require.NoError(t, m.Validate())
+}
+
+func TestRead_Errors(t *testing.T) {
+	for name, text := range map[string]string{
+		"basic header":       "{2:I103CITIUS33XXXXN}{4:\n:20:REF\n-}",
+		"basic header value": "{1:A01WFBIUS6SXXXX0000000000}{2:I103CITIUS33XXXXN}{4:\n:20:REF\n-}",
+		"application header": "{1:F01WFBIUS6SXXXX0000000000}{2:X103CITIUS33XXXXN}{4:\n:20:REF\n-}",
+		"text block":         "{1:F01WFBIUS6SXXXX0000000000}{2:I103CITIUS33XXXXN}{4:\n:20:REF\n",
+		"text block line":    "{1:F01WFBIUS6SXXXX0000000000}{2:I103CITIUS33XXXXN}{4:\nREF\n:20:REF\n-}",
+		"unterminated block": "{1:F01WFBIUS6SXXXX0000000000",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Read(strings.NewReader(text))
+			require.ErrorIs(t, err, ErrMessageFormat)
+		})
+	}
+}
+
+func TestLogicalTerminal(t *testing.T) {
+	require.Equal(t, "CITIUS33XXXX", logicalTerminal("CITIUS33"))
+	require.Equal(t, "CITIUS33XNYC", logicalTerminal("citius33nyc"))
+	require.Equal(t, "CITIUS33NYC", bicOf("CITIUS33ANYC"))
+}
@@ -0,0 +1,186 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
+)
+
+var (
+	// ErrFieldRequired is given when a mandatory field is missing
+	ErrFieldRequired = errors.New("is required")
+	// ErrFieldLength is given when a field has more lines, or longer lines, than its format allows
+	ErrFieldLength = errors.New("has an invalid length")
+	// ErrFieldFormat is given when a field does not match its format
+	ErrFieldFormat = errors.New("has an invalid format")
+	// ErrFieldCode is given when a field is not one of its format's codes
+	ErrFieldCode = errors.New("is not a valid code")
+)
+
+var (
+	bicRegex             = regexp.MustCompile(`^[A-Z0-9]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+	referenceRegex       = regexp.MustCompile(`^[^/](.*[^/])?$`)
+	valueDateRegex       = regexp.MustCompile(`^[0-9]{6}[A-Z]{3}[0-9]{1,14},[0-9]{0,2}$`)
+	amountRegex          = regexp.MustCompile(`^[A-Z]{3}[0-9]{1,14},[0-9]*$`)
+	rateRegex            = regexp.MustCompile(`^[0-9]{1,11},[0-9]*$`)
+	transactionTypeRegex = regexp.MustCompile(`^[A-Z0-9]{3}$`)
+)
+
+// FieldError is the error given when a field or header of a Message does not match its format
+type FieldError struct {
+	// Field is the tag of the field, such as 32A, or the name of the header value, such as Sender
+	Field string
+	// Value of the field
+	Value string
+	// Err is ErrFieldRequired, ErrFieldLength, ErrFieldFormat or ErrFieldCode
+	Err error
+}
+
+func (e *FieldError) Error() string {
+	if e.Value == "" {
+		return fmt.Sprintf("%s %s", e.Field, e.Err)
+	}
+	return fmt.Sprintf("%s %q %s", e.Field, e.Value, e.Err)
+}
+
+// Unwrap returns the underlying error of the FieldError
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// fieldFormat is the network validated format of a field
+type fieldFormat struct {
+	// lines is the most lines the field has
+	lines int
+	// length is the most characters of each line
+	length int
+	// pattern the field's value matches, when it is a single line
+	pattern *regexp.Regexp
+	// codes are the values of a code field
+	codes []string
+	// bic is true for an option A field, whose last line is a BIC
+	bic bool
+}
+
+// fieldFormats are the formats of the fields converted to and from FEDWireMessages
+var fieldFormats = map[string]fieldFormat{
+	"20":  {lines: 1, length: 16, pattern: referenceRegex},
+	"21":  {lines: 1, length: 16, pattern: referenceRegex},
+	"23B": {lines: 1, length: 4, codes: []string{BankOperationCodeCredit, "CRTS", "SPAY", "SPRI", "SSTD"}},
+	"26T": {lines: 1, length: 3, pattern: transactionTypeRegex},
+	"32A": {lines: 1, length: 24, pattern: valueDateRegex},
+	"33B": {lines: 1, length: 18, pattern: amountRegex},
+	"36":  {lines: 1, length: 12, pattern: rateRegex},
+	"50A": {lines: 2, length: 35, bic: true},
+	"50F": {lines: 5, length: 35},
+	"50K": {lines: 5, length: 35},
+	"52A": {lines: 2, length: 35, bic: true},
+	"52D": {lines: 5, length: 35},
+	"56A": {lines: 2, length: 35, bic: true},
+	"56D": {lines: 5, length: 35},
+	"57A": {lines: 2, length: 35, bic: true},
+	"57D": {lines: 5, length: 35},
+	"58A": {lines: 2, length: 35, bic: true},
+	"58D": {lines: 5, length: 35},
+	"59":  {lines: 5, length: 35},
+	"59A": {lines: 2, length: 35, bic: true},
+	"70":  {lines: 4, length: 35},
+	"71A": {lines: 1, length: 3, codes: []string{ChargesBeneficiary, ChargesShared, ChargesOurs}},
+	"71F": {lines: 1, length: 18, pattern: amountRegex},
+	"72":  {lines: 6, length: 35},
+}
+
+// requiredFields are the mandatory fields of each message, by the name of the message
+var requiredFields = map[string][]string{
+	"MT103":     {"20", "23B", "32A", "50a", "59a", "71A"},
+	"MT202":     {"20", "21", "32A", "58a"},
+	"MT202 COV": {"20", "21", "32A", "58a", "50a", "59a"},
+}
+
+// Validate checks m against the formats of its fields, returning the first error found
+func (m *Message) Validate() error {
+	return m.ValidateAll().Err()
+}
+
+// ValidateAll checks m against the formats of its fields, returning every error found. Fields which are not
+// converted to and from FEDWireMessages are not checked.
+func (m *Message) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
+	add := func(field, value string, err error) {
+		errs.Add(&FieldError{Field: field, Value: value, Err: err})
+	}
+
+	required, ok := requiredFields[m.Name()]
+	if !ok {
+		add("Type", m.Name(), ErrFieldCode)
+	}
+	if !bicRegex.MatchString(m.Sender) {
+		add("Sender", m.Sender, ErrFieldFormat)
+	}
+	if !bicRegex.MatchString(m.Receiver) {
+		add("Receiver", m.Receiver, ErrFieldFormat)
+	}
+	for _, tag := range required {
+		if m.Field(tag) == nil {
+			add(tag, "", ErrFieldRequired)
+		}
+	}
+	for _, f := range m.Fields {
+		format, ok := fieldFormats[f.Tag]
+		if !ok {
+			continue
+		}
+		value := strings.Join(f.Lines, "\n")
+		if strings.TrimSpace(value) == "" {
+			add(f.Tag, "", ErrFieldRequired)
+			continue
+		}
+		if len(f.Lines) > format.lines {
+			add(f.Tag, value, ErrFieldLength)
+			continue
+		}
+		if err := checkLines(f.Lines, format.length); err != nil {
+			add(f.Tag, value, err)
+			continue
+		}
+		switch {
+		case format.pattern != nil && !format.pattern.MatchString(value):
+			add(f.Tag, value, ErrFieldFormat)
+		case format.bic && !bicRegex.MatchString(f.Lines[len(f.Lines)-1]):
+			add(f.Tag, value, ErrFieldFormat)
+		case format.codes != nil && !contains(format.codes, value):
+			add(f.Tag, value, ErrFieldCode)
+		}
+	}
+	return errs
+}
+
+// checkLines returns an error when a line is longer than length or blank
+func checkLines(lines []string, length int) error {
+	for _, line := range lines {
+		if utf8.RuneCountInString(line) > length {
+			return ErrFieldLength
+		}
+		if strings.TrimSpace(line) == "" {
+			return ErrFieldFormat
+		}
+	}
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
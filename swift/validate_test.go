@@ -0,0 +1,88 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package swift
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessage_Validate(t *testing.T) {
+	for _, name := range []string{
+		"fedWireMessage-CustomerTransfer.json",
+		"fedWireMessage-CustomerTransferPlus.json",
+		"fedWireMessage-CustomerTransferPlusCOVS.json",
+		"fedWireMessage-BankTransfer.json",
+	} {
+		t.Run(name, func(t *testing.T) {
+			m, _ := convertMessage(t, readMessage(t, name))
+			require.NoError(t, m.Validate())
+		})
+	}
+}
+
+func TestMessage_ValidateAll(t *testing.T) {
+	m, _ := convertMessage(t, readMessage(t, "fedWireMessage-CustomerTransfer.json"))
+	m.Sender = "WFBI"
+	m.Field("20").Lines = []string{"/Reference"}
+	m.Field("71A").Lines = []string{"ALL"}
+
+	errs := m.ValidateAll()
+	require.Len(t, errs, 3)
+	require.Equal(t, errs[0], m.Validate())
+
+	var fe *FieldError
+	require.True(t, errors.As(errs[0], &fe))
+	require.Equal(t, "Sender", fe.Field)
+	require.ErrorIs(t, errs[0], ErrFieldFormat)
+	require.ErrorIs(t, errs[1], ErrFieldFormat)
+	require.ErrorIs(t, errs[2], ErrFieldCode)
+	require.Equal(t, `71A "ALL" is not a valid code`, errs[2].Error())
+}
+
+func TestMessage_ValidateFields(t *testing.T) {
+	valid := func() *Message {
+		return &Message{Type: MT202, Sender: senderBIC, Receiver: receiverBIC, Fields: []Field{
+			{Tag: "20", Lines: []string{"Sender Reference"}},
+			{Tag: "21", Lines: []string{NonReference}},
+			{Tag: "32A", Lines: []string{"190410USD12345,67"}},
+			{Tag: "58A", Lines: []string{"CITIUS33"}},
+		}}
+	}
+	require.NoError(t, valid().Validate())
+
+	for name, tt := range map[string]struct {
+		field Field
+		err   error
+	}{
+		"missing":        {Field{Tag: "58A"}, ErrFieldRequired},
+		"too many lines": {Field{Tag: "58A", Lines: []string{"/1", "/2", "CITIUS33"}}, ErrFieldLength},
+		"too long":       {Field{Tag: "20", Lines: []string{"Sender Reference 1"}}, ErrFieldLength},
+		"blank line":     {Field{Tag: "58D", Lines: []string{"Name", " ", "Address"}}, ErrFieldFormat},
+		"pattern":        {Field{Tag: "32A", Lines: []string{"190410USD12345.67"}}, ErrFieldFormat},
+		"BIC":            {Field{Tag: "58A", Lines: []string{"/123456789", "CITI"}}, ErrFieldFormat},
+		"code":           {Field{Tag: "23B", Lines: []string{"DEBT"}}, ErrFieldCode},
+	} {
+		t.Run(name, func(t *testing.T) {
+			m := valid()
+			m.Fields = append(m.Fields, tt.field)
+			require.ErrorIs(t, m.Validate(), tt.err)
+		})
+	}
+
+	m := valid()
+	m.Fields = m.Fields[:3]
+	require.ErrorIs(t, m.Validate(), ErrFieldRequired)
+
+	m = valid()
+	m.ValidationFlag = ValidationFlagCover
+	require.Len(t, m.ValidateAll(), 2)
+
+	m = valid()
+	m.Type = "950"
+	require.ErrorIs(t, m.Validate(), ErrFieldCode)
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // TypeSubType {1510}
@@ -72,33 +74,37 @@ func (tst *TypeSubType) String() string {
 }
 
 // Validate performs WIRE format rule checks on TypeSubType and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 func (tst *TypeSubType) Validate() error {
-	if err := tst.fieldInclusion(); err != nil {
-		return err
-	}
+	return tst.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on TypeSubType and returns every error found.
+// Only the first error for each field is returned.
+func (tst *TypeSubType) ValidateAll() base.ErrorList {
+	errs := tst.fieldInclusion()
 	if tst.tag != TagTypeSubType {
-		return fieldError("tag", ErrValidTagForType, tst.tag)
+		addFieldError(&errs, TagTypeSubType, fieldError("tag", ErrValidTagForType, tst.tag))
 	}
 	if err := tst.isTypeCode(tst.TypeCode); err != nil {
-		return fieldError("TypeCode", err, tst.TypeCode)
+		addFieldError(&errs, TagTypeSubType, fieldError("TypeCode", err, tst.TypeCode))
 	}
 	if err := tst.isSubTypeCode(tst.SubTypeCode); err != nil {
-		return fieldError("SubTypeCode", err, tst.SubTypeCode)
+		addFieldError(&errs, TagTypeSubType, fieldError("SubTypeCode", err, tst.SubTypeCode))
 	}
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (tst *TypeSubType) fieldInclusion() error {
+func (tst *TypeSubType) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	if tst.TypeCode == "" {
-		return fieldError("TypeCode", ErrFieldRequired)
+		addFieldError(&errs, TagTypeSubType, fieldError("TypeCode", ErrFieldRequired))
 	}
 	if tst.SubTypeCode == "" {
-		return fieldError("SubTypeCode", ErrFieldRequired)
+		addFieldError(&errs, TagTypeSubType, fieldError("SubTypeCode", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // TypeCodeField gets a string of the TypeCode field
@@ -85,7 +85,7 @@ func TestParseTypeSubTypeReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("SubTypeCode", ErrSubTypeCode, "0Z")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("SubTypeCode", ErrSubTypeCode, "0Z"))).Error())
 }
 
 // TestTypeSubTypeTagError validates a TypeSubType tag
@@ -0,0 +1,67 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+
+	"github.com/moov-io/base"
+)
+
+// UnknownTag is a tag which is not part of the WIRE format, such as a vendor specific tag added by a processor.
+//
+// UnknownTags are only kept when ValidateOpts.AllowUnknownTags is set and are written back unchanged.
+type UnknownTag struct {
+	// Tag such as {9100}
+	Tag string `json:"tag"`
+	// Value is the raw contents following Tag
+	Value string `json:"value"`
+	// After is the tag which preceded Tag when it was read. UnknownTags read before any other tag have no After.
+	After string `json:"after,omitempty"`
+}
+
+// NewUnknownTag returns a new UnknownTag
+func NewUnknownTag() *UnknownTag {
+	return &UnknownTag{}
+}
+
+// Parse takes the input string and parses the UnknownTag values
+func (ut *UnknownTag) Parse(record string) error {
+	if len(record) < 6 {
+		return NewTagMinLengthErr(6, len(record))
+	}
+	ut.Tag = record[:6]
+	ut.Value = record[6:]
+	return nil
+}
+
+// String returns the UnknownTag as it was read
+func (ut *UnknownTag) String() string {
+	var buf strings.Builder
+	buf.Grow(len(ut.Tag) + len(ut.Value))
+	buf.WriteString(ut.Tag)
+	buf.WriteString(ut.Value)
+	return buf.String()
+}
+
+// Validate checks UnknownTag can be written back
+func (ut *UnknownTag) Validate() error {
+	return ut.ValidateAll().Err()
+}
+
+// ValidateAll checks UnknownTag can be written back and returns every error found.
+func (ut *UnknownTag) ValidateAll() base.ErrorList {
+	var errs base.ErrorList
+	if len(ut.Tag) != 6 || !tagRegex.MatchString(ut.Tag) {
+		addFieldError(&errs, ut.Tag, fieldError("Tag", ErrValidTagForType, ut.Tag))
+	}
+	if strings.ContainsAny(ut.Value, "\r\n") || tagRegex.MatchString(ut.Value) {
+		addFieldError(&errs, ut.Tag, fieldError("Value", ErrInvalidProperty, ut.Value))
+	}
+	if ut.After != "" && (len(ut.After) != 6 || !tagRegex.MatchString(ut.After)) {
+		addFieldError(&errs, ut.Tag, fieldError("After", ErrValidTagForType, ut.After))
+	}
+	return errs
+}
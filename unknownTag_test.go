@@ -0,0 +1,35 @@
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnknownTag_Parse(t *testing.T) {
+	ut := NewUnknownTag()
+	require.NoError(t, ut.Parse("{9100}Vendor data*"))
+	require.Equal(t, "{9100}", ut.Tag)
+	require.Equal(t, "Vendor data*", ut.Value)
+	require.Equal(t, "{9100}Vendor data*", ut.String())
+	require.NoError(t, ut.Validate())
+
+	require.EqualError(t, ut.Parse("{90"), NewTagMinLengthErr(6, 3).Error())
+}
+
+func TestUnknownTag_ValidateAll(t *testing.T) {
+	ut := &UnknownTag{Tag: "9000", Value: "one{9110}two", After: "{3600"}
+
+	errs := ut.ValidateAll()
+	require.Len(t, errs, 3)
+	require.EqualError(t, errs[0], fieldError("Tag", ErrValidTagForType, ut.Tag).Error())
+	require.EqualError(t, errs[1], fieldError("Value", ErrInvalidProperty, ut.Value).Error())
+	require.EqualError(t, errs[2], fieldError("After", ErrValidTagForType, ut.After).Error())
+}
+
+func TestFEDWireMessage_invalidUnknownTag(t *testing.T) {
+	fwm := createCustomerTransferData()
+	fwm.UnknownTags = []UnknownTag{{Tag: "{9100}", Value: "line\nbreak"}}
+
+	require.ErrorIs(t, fwm.verify(), ErrInvalidProperty)
+}
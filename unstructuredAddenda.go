@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // UnstructuredAddenda is the unstructured addenda information
@@ -79,37 +81,41 @@ func (ua *UnstructuredAddenda) String() string {
 }
 
 // Validate performs WIRE format rule checks on UnstructuredAddenda and returns an error if not Validated
-// The first error encountered is returned and stops that parsing.
 // AddendaLength must be numeric, padded with leading zeros if less than four characters and must equal
 //
 //	length of content in Addenda Information (e.g., if content of Addenda Information is 987 characters,
 //	Addenda Length must be 0987).
 func (ua *UnstructuredAddenda) Validate() error {
-	if err := ua.fieldInclusion(); err != nil {
-		return err
-	}
+	return ua.ValidateAll().Err()
+}
+
+// ValidateAll performs WIRE format rule checks on UnstructuredAddenda and returns every error found.
+// Only the first error for each field is returned.
+func (ua *UnstructuredAddenda) ValidateAll() base.ErrorList {
+	errs := ua.fieldInclusion()
 	if ua.tag != TagUnstructuredAddenda {
-		return fieldError("tag", ErrValidTagForType, ua.tag)
+		addFieldError(&errs, TagUnstructuredAddenda, fieldError("tag", ErrValidTagForType, ua.tag))
 	}
 	if err := ua.isNumeric(ua.AddendaLength); err != nil {
-		return fieldError("AddendaLength", err, ua.AddendaLength)
+		addFieldError(&errs, TagUnstructuredAddenda, fieldError("AddendaLength", err, ua.AddendaLength))
 	}
 	if err := ua.isAlphanumeric(ua.Addenda); err != nil {
-		return fieldError("Addenda", err, ua.Addenda)
+		addFieldError(&errs, TagUnstructuredAddenda, fieldError("Addenda", err, ua.Addenda))
 	}
 
-	return nil
+	return errs
 }
 
 // fieldInclusion validate mandatory fields. If fields are
 // invalid the WIRE will return an error.
-func (ua *UnstructuredAddenda) fieldInclusion() error {
+func (ua *UnstructuredAddenda) fieldInclusion() base.ErrorList {
+	var errs base.ErrorList
 	// If UnstructuredAddenda is defined, AddendaLength is required, however it could be "0000"), but
 	// I'm not sure of the point
 	if ua.AddendaLength == "" {
-		return fieldError("AddendaLength", ErrFieldRequired)
+		addFieldError(&errs, TagUnstructuredAddenda, fieldError("AddendaLength", ErrFieldRequired))
 	}
-	return nil
+	return errs
 }
 
 // AddendaLengthField gets a string of the AddendaLength field
@@ -75,7 +75,7 @@ func TestParseUnstructuredAddendaReaderParseError(t *testing.T) {
 
 	_, err = r.Read()
 
-	require.EqualError(t, err, r.parseError(fieldError("Addenda", ErrNonAlphanumeric, "®nstructured Addend")).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, r.parseError(fieldError("Addenda", ErrNonAlphanumeric, "®nstructured Addend"))).Error())
 }
 
 // TestUnstructuredAddendaTagError validates a UnstructuredAddenda tag
@@ -1,5 +1,9 @@
 package wire
 
+import (
+	"github.com/moov-io/wire/directory"
+)
+
 // ValidateOpts contains specific overrides from the default set of validations
 type ValidateOpts struct {
 	// SkipMandatoryIMAD skips checking that InputMessageAccountabilityData is mandatory tag.
@@ -7,4 +11,28 @@ type ValidateOpts struct {
 
 	// AllowMissingSenderSupplied allows the senderSupplied field to be omitted.
 	AllowMissingSenderSupplied bool `json:"allowMissingSenderSupplied"`
+
+	// AllowUnknownTags keeps tags which are not part of the WIRE format as UnknownTags when reading,
+	// instead of returning an error.
+	AllowUnknownTags bool `json:"allowUnknownTags"`
+
+	// SkipTagSequenceCheck skips checking that tags are read in FAIM order and are not repeated.
+	// The last value read for a repeated tag is kept.
+	SkipTagSequenceCheck bool `json:"skipTagSequenceCheck"`
+
+	// CheckBusinessDay checks that the InputCycleDate of InputMessageAccountabilityData is a Fedwire Funds Service
+	// business day, rather than a weekend or Federal Reserve holiday.
+	CheckBusinessDay bool `json:"checkBusinessDay"`
+
+	// SkipRoutingNumberCheck skips checking the ABA check digit of routing numbers, such as the SenderABANumber
+	// and identifiers with the FEDRoutingNumber IdentificationCode.
+	SkipRoutingNumberCheck bool `json:"skipRoutingNumberCheck"`
+
+	// Directory rejects FEDWireMessages whose ReceiverABANumber is not a participant of the fedwire directory which
+	// is eligible to receive funds transfers. It is not read from or written to JSON.
+	Directory *directory.Directory `json:"-"`
+
+	// DrawdownRequest rejects DrawdownResponses and drawdown refusals which are not consistent with the drawdown
+	// request they answer, such as one for more than the Amount requested. It is not read from or written to JSON.
+	DrawdownRequest *FEDWireMessage `json:"-"`
 }
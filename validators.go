@@ -22,6 +22,17 @@ var (
 
 	numericRegex = regexp.MustCompile(`[^0-9]`)
 	amountRegex  = regexp.MustCompile("[^0-9,.]")
+
+	// bicRegex is an ISO 9362 BIC: a 4 character party prefix, 2 letter country code, 2 character location
+	// and optional 3 character branch
+	bicRegex = regexp.MustCompile(`^[A-Z0-9]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+	// ibanRegex is the format of an ISO 13616 IBAN: a 2 letter country code, 2 check digits and up to 30
+	// characters of account number
+	ibanRegex = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`)
+	// chipsParticipantRegex is a 4 digit CHIPS participant number
+	chipsParticipantRegex = regexp.MustCompile(`^[0-9]{4}$`)
+	// chipsIdentifierRegex is a 6 digit CHIPS universal identifier (UID)
+	chipsIdentifierRegex = regexp.MustCompile(`^[0-9]{6}$`)
 )
 
 // validator is common validation and formatting of golang types to WIRE type strings
@@ -195,6 +206,115 @@ func (v *validator) isIdentificationCode(code string) error {
 	return ErrIdentificationCode
 }
 
+// isBIC checks if a string is an ISO 9362 SWIFT BIC of 8 or 11 characters
+func (v *validator) isBIC(s string) error {
+	if !bicRegex.MatchString(s) {
+		return ErrNonBIC
+	}
+	return nil
+}
+
+// validateAccountIdentifier checks the mod-97 check digits of an account identifier which has the format of an
+// IBAN. Other account identifiers are not checked.
+func (v *validator) validateAccountIdentifier(s string) error {
+	iban := strings.ReplaceAll(s, " ", "")
+	if !ibanRegex.MatchString(iban) {
+		return nil
+	}
+	// the country code and check digits are moved to the end and each letter is replaced by 10 to 35
+	remainder := 0
+	for _, r := range iban[4:] + iban[:4] {
+		if r >= 'A' {
+			remainder = (remainder*100 + int(r-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(r-'0')) % 97
+		}
+	}
+	if remainder != 1 {
+		return ErrIBANCheckDigit
+	}
+	return nil
+}
+
+// validateIdentifier checks the Identifier of a FinancialInstitution or Personal has the format of its
+// IdentificationCode: a BIC for SWIFTBankIdentifierCode, CHIPS numbers for CHIPSParticipant and CHIPSIdentifier,
+// and valid IBAN check digits for the account of DemandDepositAccountNumber and SWIFTBICORBEIANDAccountNumber.
+// FEDRoutingNumbers are checked with the routing numbers of the FEDWireMessage.
+func (v *validator) validateIdentifier(code, identifier string) error {
+	switch code {
+	case SWIFTBankIdentifierCode:
+		return v.isBIC(identifier)
+	case CHIPSParticipant:
+		if !chipsParticipantRegex.MatchString(identifier) {
+			return ErrCHIPSParticipant
+		}
+	case CHIPSIdentifier:
+		if !chipsIdentifierRegex.MatchString(identifier) {
+			return ErrCHIPSIdentifier
+		}
+	case DemandDepositAccountNumber, SWIFTBICORBEIANDAccountNumber:
+		return v.validateAccountIdentifier(identifier)
+	}
+	return nil
+}
+
+// validateIdentifierName checks the Name of a party identified by a SWIFTBICORBEIANDAccountNumber begins with the
+// party's BIC or BEI, as its Identifier is the account number
+func (v *validator) validateIdentifierName(code, name string) error {
+	if code != SWIFTBICORBEIANDAccountNumber {
+		return nil
+	}
+	words := strings.Fields(name)
+	if len(words) == 0 {
+		return ErrNonBIC
+	}
+	return v.isBIC(words[0])
+}
+
+// validateCoverPaymentParty checks the party of a CoverPayment and returns the index of the first invalid line. A
+// party identifier line holds an account, such as /C/DE89370400440532013000, whose IBAN check digits are checked,
+// or a CHIPS number, such as //CH123456. The party of an option A field, such as 52A, is the BIC on the line
+// following its party identifier.
+func (v *validator) validateCoverPaymentParty(cp CoverPayment) (int, error) {
+	lines := cp.lines()
+	i := 0
+	if strings.HasPrefix(lines[0], "/") {
+		if err := v.validatePartyIdentifierLine(lines[0]); err != nil {
+			return 0, err
+		}
+		i = 1
+	}
+	if cp.isOptionA() && lines[i] != "" {
+		if err := v.isBIC(strings.TrimSpace(lines[i])); err != nil {
+			return i, err
+		}
+	}
+	return 0, nil
+}
+
+// validatePartyIdentifierLine checks a party identifier line of a CoverPayment
+func (v *validator) validatePartyIdentifierLine(line string) error {
+	switch {
+	case strings.HasPrefix(line, "//CP"):
+		if !chipsParticipantRegex.MatchString(strings.TrimSpace(line[4:])) {
+			return ErrCHIPSParticipant
+		}
+	case strings.HasPrefix(line, "//CH"):
+		if !chipsIdentifierRegex.MatchString(strings.TrimSpace(line[4:])) {
+			return ErrCHIPSIdentifier
+		}
+	case strings.HasPrefix(line, "//"):
+		// other clearing system codes, such as //FW, are not checked
+	default:
+		account := line[1:]
+		if strings.HasPrefix(account, "C/") || strings.HasPrefix(account, "D/") {
+			account = account[2:]
+		}
+		return v.validateAccountIdentifier(account)
+	}
+	return nil
+}
+
 func (v *validator) isAdviceCode(code string) error {
 	switch code {
 	case
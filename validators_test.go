@@ -27,3 +27,92 @@ func TestValidators__isAlphanumeric(t *testing.T) {
 	require.Error(t, v.isAlphanumeric("{1100}"))
 	require.Error(t, v.isAlphanumeric("*"))
 }
+
+func TestValidators__isBIC(t *testing.T) {
+	v := &validator{}
+
+	require.NoError(t, v.isBIC("CITIUS33"))
+	require.NoError(t, v.isBIC("DRESDEFFXXX"))
+	require.NoError(t, v.isBIC("BCITIGB2LXX"))
+	require.ErrorIs(t, v.isBIC("CITIUS3"), ErrNonBIC)
+	require.ErrorIs(t, v.isBIC("CITI1S33"), ErrNonBIC)
+	require.ErrorIs(t, v.isBIC("citius33"), ErrNonBIC)
+	require.ErrorIs(t, v.isBIC("CITIUS33XX"), ErrNonBIC)
+}
+
+func TestValidators__validateAccountIdentifier(t *testing.T) {
+	v := &validator{}
+
+	require.NoError(t, v.validateAccountIdentifier("GB82WEST12345698765432"))
+	require.NoError(t, v.validateAccountIdentifier("DE89370400440532013000"))
+	require.NoError(t, v.validateAccountIdentifier("GB82 WEST 1234 5698 7654 32"))
+	require.ErrorIs(t, v.validateAccountIdentifier("GB83WEST12345698765432"), ErrIBANCheckDigit)
+	require.ErrorIs(t, v.validateAccountIdentifier("DE89370400440532013001"), ErrIBANCheckDigit)
+	// account numbers which are not IBANs are not checked
+	require.NoError(t, v.validateAccountIdentifier("123456789"))
+	require.NoError(t, v.validateAccountIdentifier("Account 1234"))
+}
+
+func TestValidators__validateIdentifier(t *testing.T) {
+	v := &validator{}
+
+	require.NoError(t, v.validateIdentifier(SWIFTBankIdentifierCode, "CITIUS33"))
+	require.ErrorIs(t, v.validateIdentifier(SWIFTBankIdentifierCode, "123456789"), ErrNonBIC)
+	require.NoError(t, v.validateIdentifier(CHIPSParticipant, "0123"))
+	require.ErrorIs(t, v.validateIdentifier(CHIPSParticipant, "01234"), ErrCHIPSParticipant)
+	require.NoError(t, v.validateIdentifier(CHIPSIdentifier, "012345"))
+	require.ErrorIs(t, v.validateIdentifier(CHIPSIdentifier, "A12345"), ErrCHIPSIdentifier)
+	require.NoError(t, v.validateIdentifier(DemandDepositAccountNumber, "123456789"))
+	require.ErrorIs(t, v.validateIdentifier(DemandDepositAccountNumber, "GB83WEST12345698765432"), ErrIBANCheckDigit)
+	require.NoError(t, v.validateIdentifier(SWIFTBICORBEIANDAccountNumber, "DE89370400440532013000"))
+	require.NoError(t, v.validateIdentifier(FEDRoutingNumber, "123456789"))
+}
+
+func TestValidators__validateIdentifierName(t *testing.T) {
+	v := &validator{}
+
+	require.NoError(t, v.validateIdentifierName(SWIFTBICORBEIANDAccountNumber, "DRESDEFFXXX Dresdner Bank"))
+	require.ErrorIs(t, v.validateIdentifierName(SWIFTBICORBEIANDAccountNumber, "Dresdner Bank"), ErrNonBIC)
+	require.ErrorIs(t, v.validateIdentifierName(SWIFTBICORBEIANDAccountNumber, ""), ErrNonBIC)
+	require.NoError(t, v.validateIdentifierName(DemandDepositAccountNumber, "Dresdner Bank"))
+}
+
+func TestValidators__validateCoverPaymentParty(t *testing.T) {
+	v := &validator{}
+
+	cp := CoverPayment{SwiftFieldTag: "52A", SwiftLineOne: "/C/DE89370400440532013000", SwiftLineTwo: "DRESDEFFXXX"}
+	_, err := v.validateCoverPaymentParty(cp)
+	require.NoError(t, err)
+
+	cp.SwiftLineTwo = "Dresdner Bank"
+	i, err := v.validateCoverPaymentParty(cp)
+	require.ErrorIs(t, err, ErrNonBIC)
+	require.Equal(t, 1, i)
+
+	cp.SwiftLineOne = "/C/DE89370400440532013001"
+	i, err = v.validateCoverPaymentParty(cp)
+	require.ErrorIs(t, err, ErrIBANCheckDigit)
+	require.Equal(t, 0, i)
+
+	// the BIC of an option A field without a party identifier is its first line
+	cp = CoverPayment{SwiftFieldTag: ":57A:", SwiftLineOne: "CITIUS33"}
+	_, err = v.validateCoverPaymentParty(cp)
+	require.NoError(t, err)
+
+	// the party of other options is not a BIC
+	cp = CoverPayment{SwiftFieldTag: "50K", SwiftLineOne: "/GB82WEST12345698765432", SwiftLineTwo: "John Smith"}
+	_, err = v.validateCoverPaymentParty(cp)
+	require.NoError(t, err)
+
+	cp = CoverPayment{SwiftFieldTag: "56D", SwiftLineOne: "//CH12345", SwiftLineTwo: "Bank"}
+	_, err = v.validateCoverPaymentParty(cp)
+	require.ErrorIs(t, err, ErrCHIPSIdentifier)
+
+	cp.SwiftLineOne = "//CP123"
+	_, err = v.validateCoverPaymentParty(cp)
+	require.ErrorIs(t, err, ErrCHIPSParticipant)
+
+	cp.SwiftLineOne = "//FW121042882"
+	_, err = v.validateCoverPaymentParty(cp)
+	require.NoError(t, err)
+}
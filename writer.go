@@ -7,6 +7,7 @@ package wire
 import (
 	"bufio"
 	"io"
+	"strings"
 )
 
 // A Writer writes an fedWireMessage to an encoded file.
@@ -19,6 +20,18 @@ type Writer struct {
 	w       *bufio.Writer
 	lineNum int // current line being written
 	FormatOptions
+
+	// unknownTags holds the UnknownTags of the FEDWireMessage being written which are yet to be written
+	unknownTags []UnknownTag
+
+	// preserveSource writes Files in their SourceFormat
+	preserveSource bool
+	// source holds the tags of the FEDWireMessage being written as they were read, by tag
+	source map[string]sourceSegment
+	// sourceLines holds the tags of the FEDWireMessage being written as they would be written when read, by tag
+	sourceLines map[string]string
+	// capture holds each line written by tag instead of writing it, when set
+	capture map[string]string
 }
 
 type OptionFunc func(*Writer)
@@ -37,6 +50,14 @@ func NewlineCharacter(newline string) OptionFunc {
 	}
 }
 
+// PreserveSourceFormat specify to write Files in the SourceFormat they were read in. Tags which are unchanged
+// since they were read are written exactly as they were read.
+func PreserveSourceFormat(preserve bool) OptionFunc {
+	return func(w *Writer) {
+		w.preserveSource = preserve
+	}
+}
+
 // NewWriter returns a new Writer that writes to w.
 // If no opts are provided, the writer will default to fixed-length fields and use "\n" for newlines.
 func NewWriter(w io.Writer, opts ...OptionFunc) *Writer {
@@ -64,11 +85,24 @@ func (w *Writer) Write(file *File) error {
 		return err
 	}
 	w.lineNum = 0
+	if w.preserveSource && file.SourceFormat != nil {
+		options := w.FormatOptions
+		defer func() { w.FormatOptions = options }()
+		w.VariableLengthFields = file.SourceFormat.VariableLengthFields
+		w.NewlineCharacter = file.SourceFormat.NewlineCharacter
+	}
+	if file.InterfaceHeader != nil {
+		if err := w.writeInterfaceHeader(file.InterfaceHeader, file.headerSource); err != nil {
+			return err
+		}
+	}
 	// Iterate over all records in the file
-	if err := w.writeFEDWireMessage(file); err != nil {
-		return err
+	for i := range file.FEDWireMessages {
+		if err := w.writeFEDWireMessage(file.FEDWireMessages[i]); err != nil {
+			return err
+		}
+		w.lineNum++
 	}
-	w.lineNum++
 
 	return w.w.Flush()
 }
@@ -80,9 +114,27 @@ func (w *Writer) Flush() error {
 	return w.w.Flush()
 }
 
-func (w *Writer) writeFEDWireMessage(file *File) error {
+func (w *Writer) writeFEDWireMessage(fwm FEDWireMessage) error {
+	w.setSource(fwm)
+	defer w.setSource(FEDWireMessage{})
 
-	fwm := file.FEDWireMessage
+	if fwm.InterfaceHeader != nil {
+		var src *sourceSegment
+		for i := range fwm.source {
+			if fwm.source[i].tag == "" {
+				src = &fwm.source[i]
+				break
+			}
+		}
+		if err := w.writeInterfaceHeader(fwm.InterfaceHeader, src); err != nil {
+			return err
+		}
+	}
+
+	w.unknownTags = fwm.UnknownTags
+	if err := w.writeUnknownTags(""); err != nil {
+		return err
+	}
 
 	if err := w.writeMandatory(fwm); err != nil {
 		return err
@@ -109,7 +161,7 @@ func (w *Writer) writeFEDWireMessage(file *File) error {
 	}
 
 	if fwm.UnstructuredAddenda != nil {
-		if _, err := w.w.WriteString(fwm.UnstructuredAddenda.String() + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.UnstructuredAddenda.String()); err != nil {
 			return err
 		}
 	}
@@ -119,7 +171,7 @@ func (w *Writer) writeFEDWireMessage(file *File) error {
 	}
 
 	if fwm.ServiceMessage != nil {
-		if _, err := w.w.WriteString(fwm.ServiceMessage.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.ServiceMessage.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
@@ -128,31 +180,133 @@ func (w *Writer) writeFEDWireMessage(file *File) error {
 		return err
 	}
 
+	// UnknownTags read after a tag which is no longer in the message are written last
+	for _, ut := range w.unknownTags {
+		if err := w.writeLine(ut.String()); err != nil {
+			return err
+		}
+	}
+	w.unknownTags = nil
+
+	return nil
+}
+
+// setSource sets the tags of fwm as they were read, to be written in their place when they are unchanged
+func (w *Writer) setSource(fwm FEDWireMessage) {
+	w.source, w.sourceLines = nil, nil
+	if !w.preserveSource || len(fwm.source) == 0 {
+		return
+	}
+	// read the tags again to find what they would be written as if unchanged
+	var buf strings.Builder
+	for _, seg := range fwm.source {
+		buf.WriteString(seg.raw)
+	}
+	r := NewReader(strings.NewReader(buf.String()))
+	r.File.validateOpts = fwm.ValidateOptions
+	read, _ := r.Next()
+	if read == nil {
+		return
+	}
+	w.sourceLines = captureTags(*read, w.FormatOptions)
+	w.source = make(map[string]sourceSegment)
+	for _, seg := range fwm.source {
+		if seg.tag != "" {
+			w.source[seg.tag] = seg
+		}
+	}
+}
+
+// writeInterfaceHeader writes ih, or src when ih is unchanged since it was read from src
+func (w *Writer) writeInterfaceHeader(ih *InterfaceHeader, src *sourceSegment) error {
+	if w.capture != nil {
+		return nil
+	}
+	line, newline := ih.Format(w.FormatOptions), w.NewlineCharacter
+	if w.preserveSource && src != nil {
+		read := NewInterfaceHeader()
+		if err := read.Parse(src.text); err == nil && read.Format(w.FormatOptions) == line {
+			_, err := w.w.WriteString(src.raw)
+			return err
+		}
+		newline = src.separator()
+	}
+	_, err := w.w.WriteString(line + newline)
+	return err
+}
+
+// writeLine writes line followed by NewlineCharacter. When the Writer preserves the source format, a tag
+// which is unchanged since it was read is written as it was read instead.
+func (w *Writer) writeLine(line string) error {
+	tag := line
+	if len(tag) > 6 {
+		tag = tag[:6]
+	}
+	if w.capture != nil {
+		w.capture[tag] = line
+		return nil
+	}
+	newline := w.NewlineCharacter
+	if src, ok := w.source[tag]; ok {
+		if w.sourceLines[tag] == line {
+			_, err := w.w.WriteString(src.raw)
+			return err
+		}
+		newline = src.separator()
+	}
+	_, err := w.w.WriteString(line + newline)
+	return err
+}
+
+// writeTag writes line followed by the UnknownTags which were read after its tag
+func (w *Writer) writeTag(line string) error {
+	if err := w.writeLine(line); err != nil {
+		return err
+	}
+	if len(w.unknownTags) == 0 || len(line) < 6 {
+		return nil
+	}
+	return w.writeUnknownTags(line[:6])
+}
+
+// writeUnknownTags writes the UnknownTags which were read after the tag, removing them from w.unknownTags
+func (w *Writer) writeUnknownTags(tag string) error {
+	var pending []UnknownTag
+	for _, ut := range w.unknownTags {
+		if ut.After != tag {
+			pending = append(pending, ut)
+			continue
+		}
+		if err := w.writeLine(ut.String()); err != nil {
+			return err
+		}
+	}
+	w.unknownTags = pending
 	return nil
 }
 
 func (w *Writer) writeFedAppended(fwm FEDWireMessage) error {
 
 	if fwm.MessageDisposition != nil {
-		if _, err := w.w.WriteString(fwm.MessageDisposition.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.MessageDisposition.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.ReceiptTimeStamp != nil {
-		if _, err := w.w.WriteString(fwm.ReceiptTimeStamp.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.ReceiptTimeStamp.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.OutputMessageAccountabilityData != nil {
-		if _, err := w.w.WriteString(fwm.OutputMessageAccountabilityData.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.OutputMessageAccountabilityData.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.ErrorWire != nil {
-		if _, err := w.w.WriteString(fwm.ErrorWire.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.ErrorWire.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
@@ -163,7 +317,7 @@ func (w *Writer) writeFedAppended(fwm FEDWireMessage) error {
 func (w *Writer) writeMandatory(fwm FEDWireMessage) error {
 
 	if fwm.SenderSupplied != nil {
-		if _, err := w.w.WriteString(fwm.SenderSupplied.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.SenderSupplied.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	} else {
@@ -173,7 +327,7 @@ func (w *Writer) writeMandatory(fwm FEDWireMessage) error {
 	}
 
 	if fwm.TypeSubType != nil {
-		if _, err := w.w.WriteString(fwm.TypeSubType.String() + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.TypeSubType.String()); err != nil {
 			return err
 		}
 	} else {
@@ -181,7 +335,7 @@ func (w *Writer) writeMandatory(fwm FEDWireMessage) error {
 	}
 
 	if fwm.InputMessageAccountabilityData != nil {
-		if _, err := w.w.WriteString(fwm.InputMessageAccountabilityData.String() + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.InputMessageAccountabilityData.String()); err != nil {
 			return err
 		}
 	} else {
@@ -189,7 +343,7 @@ func (w *Writer) writeMandatory(fwm FEDWireMessage) error {
 	}
 
 	if fwm.Amount != nil {
-		if _, err := w.w.WriteString(fwm.Amount.String() + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.Amount.String()); err != nil {
 			return err
 		}
 	} else {
@@ -197,23 +351,36 @@ func (w *Writer) writeMandatory(fwm FEDWireMessage) error {
 	}
 
 	if fwm.SenderDepositoryInstitution != nil {
-		if _, err := w.w.WriteString(fwm.SenderDepositoryInstitution.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.SenderDepositoryInstitution.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	} else {
 		return fieldError("SenderDepositoryInstitution", ErrFieldRequired)
 	}
 
+	// tags are written in ascending order, so {3320} SenderReference follows {3100} SenderDepositoryInstitution
+	if fwm.SenderReference != nil {
+		if err := w.writeTag(fwm.SenderReference.Format(w.FormatOptions)); err != nil {
+			return err
+		}
+	}
+
 	if fwm.ReceiverDepositoryInstitution != nil {
-		if _, err := w.w.WriteString(fwm.ReceiverDepositoryInstitution.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.ReceiverDepositoryInstitution.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	} else {
 		return fieldError("ReceiverDepositoryInstitution", ErrFieldRequired)
 	}
 
+	if fwm.PreviousMessageIdentifier != nil {
+		if err := w.writeTag(fwm.PreviousMessageIdentifier.Format(w.FormatOptions)); err != nil {
+			return err
+		}
+	}
+
 	if fwm.BusinessFunctionCode != nil {
-		if _, err := w.w.WriteString(fwm.BusinessFunctionCode.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.BusinessFunctionCode.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	} else {
@@ -225,44 +392,32 @@ func (w *Writer) writeMandatory(fwm FEDWireMessage) error {
 
 func (w *Writer) writeOtherTransferInfo(fwm FEDWireMessage) error {
 
-	if fwm.SenderReference != nil {
-		if _, err := w.w.WriteString(fwm.SenderReference.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
-			return err
-		}
-	}
-
-	if fwm.PreviousMessageIdentifier != nil {
-		if _, err := w.w.WriteString(fwm.PreviousMessageIdentifier.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
-			return err
-		}
-	}
-
 	if fwm.LocalInstrument != nil {
-		if _, err := w.w.WriteString(fwm.LocalInstrument.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.LocalInstrument.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.PaymentNotification != nil {
-		if _, err := w.w.WriteString(fwm.PaymentNotification.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.PaymentNotification.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.Charges != nil {
-		if _, err := w.w.WriteString(fwm.Charges.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.Charges.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.InstructedAmount != nil {
-		if _, err := w.w.WriteString(fwm.InstructedAmount.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.InstructedAmount.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.ExchangeRate != nil {
-		if _, err := w.w.WriteString(fwm.ExchangeRate.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.ExchangeRate.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
@@ -273,14 +428,14 @@ func (w *Writer) writeOtherTransferInfo(fwm FEDWireMessage) error {
 func (w *Writer) writeBeneficiary(fwm FEDWireMessage) error {
 
 	if fwm.BeneficiaryIntermediaryFI != nil {
-		if _, err := w.w.WriteString(fwm.BeneficiaryIntermediaryFI.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.BeneficiaryIntermediaryFI.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.BeneficiaryFI != nil {
 		if fwm.BeneficiaryFI != nil {
-			if _, err := w.w.WriteString(fwm.BeneficiaryFI.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+			if err := w.writeTag(fwm.BeneficiaryFI.Format(w.FormatOptions)); err != nil {
 				return err
 			}
 		}
@@ -288,7 +443,7 @@ func (w *Writer) writeBeneficiary(fwm FEDWireMessage) error {
 
 	if fwm.Beneficiary != nil {
 		if fwm.Beneficiary != nil {
-			if _, err := w.w.WriteString(fwm.Beneficiary.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+			if err := w.writeTag(fwm.Beneficiary.Format(w.FormatOptions)); err != nil {
 				return err
 			}
 		}
@@ -296,7 +451,7 @@ func (w *Writer) writeBeneficiary(fwm FEDWireMessage) error {
 
 	if fwm.BeneficiaryReference != nil {
 		if fwm.BeneficiaryReference != nil {
-			if _, err := w.w.WriteString(fwm.BeneficiaryReference.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+			if err := w.writeTag(fwm.BeneficiaryReference.Format(w.FormatOptions)); err != nil {
 				return err
 			}
 		}
@@ -304,7 +459,7 @@ func (w *Writer) writeBeneficiary(fwm FEDWireMessage) error {
 
 	if fwm.AccountDebitedDrawdown != nil {
 		if fwm.AccountDebitedDrawdown != nil {
-			if _, err := w.w.WriteString(fwm.AccountDebitedDrawdown.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+			if err := w.writeTag(fwm.AccountDebitedDrawdown.Format(w.FormatOptions)); err != nil {
 				return err
 			}
 		}
@@ -316,37 +471,37 @@ func (w *Writer) writeBeneficiary(fwm FEDWireMessage) error {
 func (w *Writer) writeOriginator(fwm FEDWireMessage) error {
 
 	if fwm.Originator != nil {
-		if _, err := w.w.WriteString(fwm.Originator.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.Originator.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.OriginatorOptionF != nil {
-		if _, err := w.w.WriteString(fwm.OriginatorOptionF.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.OriginatorOptionF.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.OriginatorFI != nil {
-		if _, err := w.w.WriteString(fwm.OriginatorFI.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.OriginatorFI.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.InstructingFI != nil {
-		if _, err := w.w.WriteString(fwm.InstructingFI.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.InstructingFI.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.AccountCreditedDrawdown != nil {
-		if _, err := w.w.WriteString(fwm.AccountCreditedDrawdown.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.AccountCreditedDrawdown.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.OriginatorToBeneficiary != nil {
-		if _, err := w.w.WriteString(fwm.OriginatorToBeneficiary.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.OriginatorToBeneficiary.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
@@ -357,61 +512,61 @@ func (w *Writer) writeOriginator(fwm FEDWireMessage) error {
 func (w *Writer) writeFinancialInstitution(fwm FEDWireMessage) error {
 
 	if fwm.FIReceiverFI != nil {
-		if _, err := w.w.WriteString(fwm.FIReceiverFI.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.FIReceiverFI.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.FIDrawdownDebitAccountAdvice != nil {
-		if _, err := w.w.WriteString(fwm.FIDrawdownDebitAccountAdvice.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.FIDrawdownDebitAccountAdvice.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.FIIntermediaryFI != nil {
-		if _, err := w.w.WriteString(fwm.FIIntermediaryFI.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.FIIntermediaryFI.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.FIIntermediaryFIAdvice != nil {
-		if _, err := w.w.WriteString(fwm.FIIntermediaryFIAdvice.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.FIIntermediaryFIAdvice.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.FIBeneficiaryFI != nil {
-		if _, err := w.w.WriteString(fwm.FIBeneficiaryFI.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.FIBeneficiaryFI.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.FIBeneficiaryFIAdvice != nil {
-		if _, err := w.w.WriteString(fwm.FIBeneficiaryFIAdvice.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.FIBeneficiaryFIAdvice.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.FIBeneficiary != nil {
-		if _, err := w.w.WriteString(fwm.FIBeneficiary.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.FIBeneficiary.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.FIBeneficiaryAdvice != nil {
-		if _, err := w.w.WriteString(fwm.FIBeneficiaryAdvice.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.FIBeneficiaryAdvice.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.FIPaymentMethodToBeneficiary != nil {
-		if _, err := w.w.WriteString(fwm.FIPaymentMethodToBeneficiary.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.FIPaymentMethodToBeneficiary.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.FIAdditionalFIToFI != nil {
-		if _, err := w.w.WriteString(fwm.FIAdditionalFIToFI.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.FIAdditionalFIToFI.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
@@ -422,49 +577,49 @@ func (w *Writer) writeFinancialInstitution(fwm FEDWireMessage) error {
 func (w *Writer) writeCoverPayment(fwm FEDWireMessage) error {
 
 	if fwm.CurrencyInstructedAmount != nil {
-		if _, err := w.w.WriteString(fwm.CurrencyInstructedAmount.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.CurrencyInstructedAmount.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.OrderingCustomer != nil {
-		if _, err := w.w.WriteString(fwm.OrderingCustomer.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.OrderingCustomer.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.OrderingInstitution != nil {
-		if _, err := w.w.WriteString(fwm.OrderingInstitution.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.OrderingInstitution.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.IntermediaryInstitution != nil {
-		if _, err := w.w.WriteString(fwm.IntermediaryInstitution.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.IntermediaryInstitution.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.InstitutionAccount != nil {
-		if _, err := w.w.WriteString(fwm.InstitutionAccount.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.InstitutionAccount.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.BeneficiaryCustomer != nil {
-		if _, err := w.w.WriteString(fwm.BeneficiaryCustomer.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.BeneficiaryCustomer.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.Remittance != nil {
-		if _, err := w.w.WriteString(fwm.Remittance.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.Remittance.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.SenderToReceiver != nil {
-		if _, err := w.w.WriteString(fwm.SenderToReceiver.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.SenderToReceiver.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
@@ -476,68 +631,68 @@ func (w *Writer) writeRemittance(fwm FEDWireMessage) error {
 
 	// Related Remittance
 	if fwm.RelatedRemittance != nil {
-		if _, err := w.w.WriteString(fwm.RelatedRemittance.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.RelatedRemittance.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	// Structured Remittance
 	if fwm.RemittanceOriginator != nil {
-		if _, err := w.w.WriteString(fwm.RemittanceOriginator.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.RemittanceOriginator.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.RemittanceBeneficiary != nil {
-		if _, err := w.w.WriteString(fwm.RemittanceBeneficiary.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.RemittanceBeneficiary.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.PrimaryRemittanceDocument != nil {
-		if _, err := w.w.WriteString(fwm.PrimaryRemittanceDocument.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.PrimaryRemittanceDocument.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.ActualAmountPaid != nil {
-		if _, err := w.w.WriteString(fwm.ActualAmountPaid.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.ActualAmountPaid.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.GrossAmountRemittanceDocument != nil {
-		if _, err := w.w.WriteString(fwm.GrossAmountRemittanceDocument.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.GrossAmountRemittanceDocument.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.AmountNegotiatedDiscount != nil {
-		if _, err := w.w.WriteString(fwm.AmountNegotiatedDiscount.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.AmountNegotiatedDiscount.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.Adjustment != nil {
-		if _, err := w.w.WriteString(fwm.Adjustment.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.Adjustment.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.DateRemittanceDocument != nil {
-		if _, err := w.w.WriteString(fwm.DateRemittanceDocument.String() + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.DateRemittanceDocument.String()); err != nil {
 			return err
 		}
 	}
 
 	if fwm.SecondaryRemittanceDocument != nil {
-		if _, err := w.w.WriteString(fwm.SecondaryRemittanceDocument.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.SecondaryRemittanceDocument.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
 
 	if fwm.RemittanceFreeText != nil {
-		if _, err := w.w.WriteString(fwm.RemittanceFreeText.Format(w.FormatOptions) + w.NewlineCharacter); err != nil {
+		if err := w.writeTag(fwm.RemittanceFreeText.Format(w.FormatOptions)); err != nil {
 			return err
 		}
 	}
@@ -2,6 +2,9 @@ package wire
 
 import (
 	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -33,7 +36,7 @@ func TestSenderSupplied_Mandatory(t *testing.T) {
 
 	err := file.Validate()
 
-	require.EqualError(t, err, fieldError("SenderSupplied", ErrFieldRequired).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, fieldError("SenderSupplied", ErrFieldRequired)).Error())
 }
 
 func TestTypeSubType_Mandatory(t *testing.T) {
@@ -61,7 +64,7 @@ func TestTypeSubType_Mandatory(t *testing.T) {
 
 	err := file.Validate()
 
-	require.EqualError(t, err, fieldError("TypeSubType", ErrFieldRequired).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, fieldError("TypeSubType", ErrFieldRequired)).Error())
 }
 
 func TestInputMessageAccountabilityData_Mandatory(t *testing.T) {
@@ -89,7 +92,7 @@ func TestInputMessageAccountabilityData_Mandatory(t *testing.T) {
 
 	err := file.Validate()
 
-	require.EqualError(t, err, fieldError("InputMessageAccountabilityData", ErrFieldRequired).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, fieldError("InputMessageAccountabilityData", ErrFieldRequired)).Error())
 }
 
 func TestAmount_Mandatory(t *testing.T) {
@@ -117,7 +120,7 @@ func TestAmount_Mandatory(t *testing.T) {
 
 	err := file.Validate()
 
-	require.EqualError(t, err, fieldError("Amount", ErrFieldRequired).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, fieldError("Amount", ErrFieldRequired)).Error())
 }
 
 func TestSenderDepositoryInstitution_Mandatory(t *testing.T) {
@@ -145,7 +148,7 @@ func TestSenderDepositoryInstitution_Mandatory(t *testing.T) {
 
 	err := file.Validate()
 
-	require.EqualError(t, err, fieldError("SenderDepositoryInstitution", ErrFieldRequired).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, fieldError("SenderDepositoryInstitution", ErrFieldRequired)).Error())
 }
 
 func TestReceiverDepositoryInstitution_Mandatory(t *testing.T) {
@@ -173,7 +176,7 @@ func TestReceiverDepositoryInstitution_Mandatory(t *testing.T) {
 
 	err := file.Validate()
 
-	require.EqualError(t, err, fieldError("ReceiverDepositoryInstitution", ErrFieldRequired).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, fieldError("ReceiverDepositoryInstitution", ErrFieldRequired)).Error())
 }
 
 func TestBusinessFunctionCode_Mandatory(t *testing.T) {
@@ -201,7 +204,7 @@ func TestBusinessFunctionCode_Mandatory(t *testing.T) {
 
 	err := file.Validate()
 
-	require.EqualError(t, err, fieldError("BusinessFunctionCode", ErrFieldRequired).Error())
+	require.EqualError(t, err, NewFEDWireMessageError(0, fieldError("BusinessFunctionCode", ErrFieldRequired)).Error())
 }
 
 // TestFEDWireMessageWriteBankTransfer writes a FEDWireMessage to a file with BusinessFunctionCode = BTR
@@ -1299,3 +1302,183 @@ func TestFEDWireMessageWriteCustomerTransferPlusUnstructuredAddenda(t *testing.T
 
 	require.NoError(t, writeFile(file))
 }
+
+func TestFEDWireMessageWriteMultipleMessages(t *testing.T) {
+	file := NewFile()
+	file.AddFEDWireMessage(createCustomerTransferData())
+	file.AddFEDWireMessage(createMockServiceMessageData())
+	file.AddFEDWireMessage(createCustomerTransferData())
+
+	for _, opt := range []OptionFunc{NewlineCharacter("\n"), NewlineCharacter("")} {
+		b := &bytes.Buffer{}
+		require.NoError(t, NewWriter(b, opt).Write(file))
+
+		fwmFile, err := NewReader(strings.NewReader(b.String())).Read()
+		require.NoError(t, err)
+		require.Len(t, fwmFile.FEDWireMessages, 3)
+		require.Equal(t, BFCServiceMessage, fwmFile.FEDWireMessages[1].BusinessFunctionCode.BusinessFunctionCode)
+
+		rewritten := &bytes.Buffer{}
+		require.NoError(t, NewWriter(rewritten, opt).Write(&fwmFile))
+		require.Equal(t, b.String(), rewritten.String())
+	}
+}
+
+func TestFEDWireMessageWriteInvalidMessage(t *testing.T) {
+	file := NewFile()
+	file.AddFEDWireMessage(createCustomerTransferData())
+	fwm := createCustomerTransferData()
+	fwm.Amount = nil
+	file.AddFEDWireMessage(fwm)
+
+	err := NewWriter(&bytes.Buffer{}).Write(file)
+	require.EqualError(t, err, NewFEDWireMessageError(1, fieldError("Amount", ErrFieldRequired)).Error())
+}
+
+func TestFEDWireMessageWriteUnknownTags(t *testing.T) {
+	file := NewFile()
+	file.AddFEDWireMessage(createCustomerTransferData())
+	b := &bytes.Buffer{}
+	require.NoError(t, NewWriter(b).Write(file))
+
+	input := "{0100}First\n" + strings.Replace(b.String(), "\n{3600}", "\n{9100}Vendor data*\n{3600}", 1) + "{9999}Last*\n"
+	fwmFile, err := NewReader(strings.NewReader(input)).ReadWithOpts(&ValidateOpts{AllowUnknownTags: true})
+	require.NoError(t, err)
+	require.Len(t, fwmFile.FEDWireMessages[0].UnknownTags, 3)
+
+	rewritten := &bytes.Buffer{}
+	require.NoError(t, NewWriter(rewritten).Write(&fwmFile))
+	require.Equal(t, input, rewritten.String())
+
+	// tags read after a tag which was removed are written at the end of the message
+	fwmFile.FEDWireMessages[0].UnknownTags[1].After = TagServiceMessage
+	rewritten.Reset()
+	require.NoError(t, NewWriter(rewritten).Write(&fwmFile))
+	require.True(t, strings.HasSuffix(rewritten.String(), "{9999}Last*\n{9100}Vendor data*\n"))
+
+	// JSON keeps the UnknownTags
+	bs, err := json.Marshal(fwmFile)
+	require.NoError(t, err)
+	fromJSON, err := FileFromJSON(bs)
+	require.NoError(t, err)
+	require.Equal(t, fwmFile.FEDWireMessages[0].UnknownTags, fromJSON.FEDWireMessages[0].UnknownTags)
+}
+
+func TestFEDWireMessageWriteInterfaceHeader(t *testing.T) {
+	file := NewFile()
+	file.InterfaceHeader = &InterfaceHeader{Layout: InterfaceHeaderFAIM, ApplicationID: "FTI", SequenceNumber: "0811", TerminalID: "XFT811"}
+	file.AddFEDWireMessage(createCustomerTransferData())
+	fwm := createCustomerTransferData()
+	fwm.InterfaceHeader = &InterfaceHeader{Layout: InterfaceHeaderVendor, Fields: []string{"HDR", "ACME", ""}}
+	file.AddFEDWireMessage(fwm)
+
+	b := &bytes.Buffer{}
+	require.NoError(t, NewWriter(b).Write(file))
+	require.True(t, strings.HasPrefix(b.String(), "FTI0811 XFT811  \n{1500}"))
+	require.Contains(t, b.String(), "\nHDR*ACME*\n{1500}")
+
+	fwmFile, err := NewReader(strings.NewReader(b.String())).Read()
+	require.NoError(t, err)
+	rewritten := &bytes.Buffer{}
+	require.NoError(t, NewWriter(rewritten).Write(&fwmFile))
+	require.Equal(t, b.String(), rewritten.String())
+
+	// files rebuilt from JSON keep their headers
+	bs, err := json.Marshal(fwmFile)
+	require.NoError(t, err)
+	fromJSON, err := FileFromJSON(bs)
+	require.NoError(t, err)
+	rewritten.Reset()
+	require.NoError(t, NewWriter(rewritten).Write(fromJSON))
+	require.Equal(t, b.String(), rewritten.String())
+
+	file.InterfaceHeader.SequenceNumber = ""
+	require.ErrorContains(t, NewWriter(&bytes.Buffer{}).Write(file), "SequenceNumber")
+}
+
+func TestFEDWireMessageWriteTagOrder(t *testing.T) {
+	fwm := createCustomerTransferData()
+	fwm.SenderReference = mockSenderReference()
+	fwm.PreviousMessageIdentifier = mockPreviousMessageIdentifier()
+	file := NewFile()
+	file.AddFEDWireMessage(fwm)
+
+	b := &bytes.Buffer{}
+	require.NoError(t, NewWriter(b).Write(file))
+
+	tags := tagRegex.FindAllString(b.String(), -1)
+	for i := 1; i < len(tags); i++ {
+		require.Less(t, tags[i-1], tags[i])
+	}
+	require.Contains(t, tags, TagSenderReference)
+	require.Contains(t, tags, TagPreviousMessageIdentifier)
+}
+
+// TestFEDWireMessageWritePreserveSourceFormat writes Files as they were read
+func TestFEDWireMessageWritePreserveSourceFormat(t *testing.T) {
+	write := func(t *testing.T, file File, opts ...OptionFunc) string {
+		t.Helper()
+		var buf bytes.Buffer
+		require.NoError(t, NewWriter(&buf, append(opts, PreserveSourceFormat(true))...).Write(&file))
+		return buf.String()
+	}
+
+	matches, err := filepath.Glob(filepath.Join("test", "testdata", "*.txt"))
+	require.NoError(t, err)
+	for _, name := range matches {
+		bs, err := os.ReadFile(name)
+		require.NoError(t, err)
+		file, err := NewReader(bytes.NewReader(bs)).Read()
+		if err != nil {
+			continue
+		}
+		t.Run(filepath.Base(name), func(t *testing.T) {
+			require.Equal(t, string(bs), write(t, file))
+		})
+	}
+
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.txt"))
+	require.NoError(t, err)
+
+	t.Run("Changed", func(t *testing.T) {
+		file, err := NewReader(bytes.NewReader(bs)).Read()
+		require.NoError(t, err)
+		require.Equal(t, "\n", file.SourceFormat.NewlineCharacter)
+		require.True(t, file.SourceFormat.VariableLengthFields)
+		require.Contains(t, file.SourceFormat.Tags[0], TagFormat{Tag: TagBusinessFunctionCode, VariableLength: false})
+		require.Contains(t, file.SourceFormat.Tags[0], TagFormat{Tag: TagSenderDepositoryInstitution, VariableLength: true})
+
+		file.FEDWireMessages[0].SenderReference.SenderReference = "Changed"
+		expected := strings.Replace(string(bs), "{3320}Sender Reference*", "{3320}Changed*", 1)
+		require.Equal(t, expected, write(t, file, VariableLengthFields(false)))
+	})
+
+	t.Run("CRLF", func(t *testing.T) {
+		input := strings.ReplaceAll(string(bs), "\n", "\r\n")
+		file, err := NewReader(strings.NewReader(input)).Read()
+		require.NoError(t, err)
+		require.Equal(t, "\r\n", file.SourceFormat.NewlineCharacter)
+		require.Equal(t, input, write(t, file))
+	})
+
+	t.Run("SingleLine", func(t *testing.T) {
+		input := strings.ReplaceAll(string(bs), "\n", "")
+		file, err := NewReader(strings.NewReader(input)).Read()
+		require.NoError(t, err)
+		require.Equal(t, "", file.SourceFormat.NewlineCharacter)
+		require.Equal(t, input, write(t, file))
+
+		file.FEDWireMessages[0].SenderReference.SenderReference = "Changed"
+		require.Equal(t, strings.Replace(input, "{3320}Sender Reference*", "{3320}Changed*", 1), write(t, file))
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.json"))
+		require.NoError(t, err)
+		file, err := FileFromJSON(bs)
+		require.NoError(t, err)
+		var buf bytes.Buffer
+		require.NoError(t, NewWriter(&buf, VariableLengthFields(true)).Write(file))
+		require.Equal(t, buf.String(), write(t, *file, VariableLengthFields(true)))
+	})
+}